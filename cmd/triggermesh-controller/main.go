@@ -20,6 +20,7 @@ import (
 	"knative.dev/pkg/injection/sharedmain"
 
 	"github.com/triggermesh/triggermesh/pkg/extensions/reconciler/function"
+	"github.com/triggermesh/triggermesh/pkg/flow/reconciler/aggregator"
 	"github.com/triggermesh/triggermesh/pkg/flow/reconciler/dataweavetransformation"
 	"github.com/triggermesh/triggermesh/pkg/flow/reconciler/jqtransformation"
 	"github.com/triggermesh/triggermesh/pkg/flow/reconciler/synchronizer"
@@ -57,6 +58,7 @@ import (
 	"github.com/triggermesh/triggermesh/pkg/sources/reconciler/googlecloudstoragesource"
 	"github.com/triggermesh/triggermesh/pkg/sources/reconciler/httppollersource"
 	"github.com/triggermesh/triggermesh/pkg/sources/reconciler/ibmmqsource"
+	"github.com/triggermesh/triggermesh/pkg/sources/reconciler/kafkasource"
 	"github.com/triggermesh/triggermesh/pkg/sources/reconciler/ocimetricssource"
 	"github.com/triggermesh/triggermesh/pkg/sources/reconciler/salesforcesource"
 	"github.com/triggermesh/triggermesh/pkg/sources/reconciler/slacksource"
@@ -131,6 +133,7 @@ func main() {
 		googlecloudstoragesource.NewController,
 		httppollersource.NewController,
 		ibmmqsource.NewController,
+		kafkasource.NewController,
 		ocimetricssource.NewController,
 		salesforcesource.NewController,
 		slacksource.NewController,
@@ -174,6 +177,7 @@ func main() {
 		uipathtarget.NewController,
 		zendesktarget.NewController,
 		// flow
+		aggregator.NewController,
 		jqtransformation.NewController,
 		synchronizer.NewController,
 		transformation.NewController,
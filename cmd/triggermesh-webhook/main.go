@@ -27,22 +27,190 @@ import (
 	"knative.dev/pkg/webhook"
 	"knative.dev/pkg/webhook/certificates"
 	"knative.dev/pkg/webhook/resourcesemantics"
+	"knative.dev/pkg/webhook/resourcesemantics/conversion"
 	"knative.dev/pkg/webhook/resourcesemantics/defaulting"
 	"knative.dev/pkg/webhook/resourcesemantics/validation"
 
 	flowv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/flow/v1alpha1"
 	routingv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/routing/v1alpha1"
 	sourcesv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+	sourcesv1beta1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1beta1"
+	targetsv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
+	targetsv1beta1 "github.com/triggermesh/triggermesh/pkg/apis/targets/v1beta1"
 )
 
 var types = map[schema.GroupVersionKind]resourcesemantics.GenericCRD{
-	sourcesv1alpha1.SchemeGroupVersion.WithKind("CloudEventsSource"): &sourcesv1alpha1.CloudEventsSource{},
-	routingv1alpha1.SchemeGroupVersion.WithKind("Filter"):            &routingv1alpha1.Filter{},
-	flowv1alpha1.SchemeGroupVersion.WithKind("XSLTTransformation"):   &flowv1alpha1.XSLTTransformation{},
+	sourcesv1alpha1.SchemeGroupVersion.WithKind("AWSCloudWatchLogsSource"):             &sourcesv1alpha1.AWSCloudWatchLogsSource{},
+	sourcesv1alpha1.SchemeGroupVersion.WithKind("AWSCloudWatchSource"):                 &sourcesv1alpha1.AWSCloudWatchSource{},
+	sourcesv1alpha1.SchemeGroupVersion.WithKind("AWSCodeCommitSource"):                 &sourcesv1alpha1.AWSCodeCommitSource{},
+	sourcesv1alpha1.SchemeGroupVersion.WithKind("AWSCognitoIdentitySource"):            &sourcesv1alpha1.AWSCognitoIdentitySource{},
+	sourcesv1alpha1.SchemeGroupVersion.WithKind("AWSCognitoUserPoolSource"):            &sourcesv1alpha1.AWSCognitoUserPoolSource{},
+	sourcesv1alpha1.SchemeGroupVersion.WithKind("AWSDynamoDBSource"):                   &sourcesv1alpha1.AWSDynamoDBSource{},
+	sourcesv1alpha1.SchemeGroupVersion.WithKind("AWSEventBridgeSource"):                &sourcesv1alpha1.AWSEventBridgeSource{},
+	sourcesv1alpha1.SchemeGroupVersion.WithKind("AWSKinesisSource"):                    &sourcesv1alpha1.AWSKinesisSource{},
+	sourcesv1alpha1.SchemeGroupVersion.WithKind("AWSPerformanceInsightsSource"):        &sourcesv1alpha1.AWSPerformanceInsightsSource{},
+	sourcesv1alpha1.SchemeGroupVersion.WithKind("AWSS3Source"):                         &sourcesv1alpha1.AWSS3Source{},
+	sourcesv1alpha1.SchemeGroupVersion.WithKind("AWSSNSSource"):                        &sourcesv1alpha1.AWSSNSSource{},
+	sourcesv1alpha1.SchemeGroupVersion.WithKind("AWSSQSSource"):                        &sourcesv1alpha1.AWSSQSSource{},
+	sourcesv1alpha1.SchemeGroupVersion.WithKind("AzureActivityLogsSource"):             &sourcesv1alpha1.AzureActivityLogsSource{},
+	sourcesv1alpha1.SchemeGroupVersion.WithKind("AzureBlobStorageSource"):              &sourcesv1alpha1.AzureBlobStorageSource{},
+	sourcesv1alpha1.SchemeGroupVersion.WithKind("AzureEventGridSource"):                &sourcesv1alpha1.AzureEventGridSource{},
+	sourcesv1alpha1.SchemeGroupVersion.WithKind("AzureEventHubSource"):                 &sourcesv1alpha1.AzureEventHubSource{},
+	sourcesv1alpha1.SchemeGroupVersion.WithKind("AzureIOTHubSource"):                   &sourcesv1alpha1.AzureIOTHubSource{},
+	sourcesv1alpha1.SchemeGroupVersion.WithKind("AzureQueueStorageSource"):             &sourcesv1alpha1.AzureQueueStorageSource{},
+	sourcesv1alpha1.SchemeGroupVersion.WithKind("AzureServiceBusQueueSource"):          &sourcesv1alpha1.AzureServiceBusQueueSource{},
+	sourcesv1alpha1.SchemeGroupVersion.WithKind("AzureServiceBusTopicSource"):          &sourcesv1alpha1.AzureServiceBusTopicSource{},
+	sourcesv1alpha1.SchemeGroupVersion.WithKind("CloudEventsSource"):                   &sourcesv1alpha1.CloudEventsSource{},
+	sourcesv1alpha1.SchemeGroupVersion.WithKind("GoogleCloudAuditLogsSource"):          &sourcesv1alpha1.GoogleCloudAuditLogsSource{},
+	sourcesv1alpha1.SchemeGroupVersion.WithKind("GoogleCloudBillingSource"):            &sourcesv1alpha1.GoogleCloudBillingSource{},
+	sourcesv1alpha1.SchemeGroupVersion.WithKind("GoogleCloudIoTSource"):                &sourcesv1alpha1.GoogleCloudIoTSource{},
+	sourcesv1alpha1.SchemeGroupVersion.WithKind("GoogleCloudPubSubSource"):             &sourcesv1alpha1.GoogleCloudPubSubSource{},
+	sourcesv1alpha1.SchemeGroupVersion.WithKind("GoogleCloudSourceRepositoriesSource"): &sourcesv1alpha1.GoogleCloudSourceRepositoriesSource{},
+	sourcesv1alpha1.SchemeGroupVersion.WithKind("GoogleCloudStorageSource"):            &sourcesv1alpha1.GoogleCloudStorageSource{},
+	sourcesv1alpha1.SchemeGroupVersion.WithKind("HTTPPollerSource"):                    &sourcesv1alpha1.HTTPPollerSource{},
+	sourcesv1alpha1.SchemeGroupVersion.WithKind("IBMMQSource"):                         &sourcesv1alpha1.IBMMQSource{},
+	sourcesv1alpha1.SchemeGroupVersion.WithKind("KafkaSource"):                         &sourcesv1alpha1.KafkaSource{},
+	sourcesv1alpha1.SchemeGroupVersion.WithKind("OCIMetricsSource"):                    &sourcesv1alpha1.OCIMetricsSource{},
+	sourcesv1alpha1.SchemeGroupVersion.WithKind("SalesforceSource"):                    &sourcesv1alpha1.SalesforceSource{},
+	sourcesv1alpha1.SchemeGroupVersion.WithKind("SlackSource"):                         &sourcesv1alpha1.SlackSource{},
+	sourcesv1alpha1.SchemeGroupVersion.WithKind("TwilioSource"):                        &sourcesv1alpha1.TwilioSource{},
+	sourcesv1alpha1.SchemeGroupVersion.WithKind("WebhookSource"):                       &sourcesv1alpha1.WebhookSource{},
+	sourcesv1alpha1.SchemeGroupVersion.WithKind("ZendeskSource"):                       &sourcesv1alpha1.ZendeskSource{},
+
+	targetsv1alpha1.SchemeGroupVersion.WithKind("AWSComprehendTarget"):        &targetsv1alpha1.AWSComprehendTarget{},
+	targetsv1alpha1.SchemeGroupVersion.WithKind("AWSDynamoDBTarget"):          &targetsv1alpha1.AWSDynamoDBTarget{},
+	targetsv1alpha1.SchemeGroupVersion.WithKind("AWSEventBridgeTarget"):       &targetsv1alpha1.AWSEventBridgeTarget{},
+	targetsv1alpha1.SchemeGroupVersion.WithKind("AWSKinesisTarget"):           &targetsv1alpha1.AWSKinesisTarget{},
+	targetsv1alpha1.SchemeGroupVersion.WithKind("AWSLambdaTarget"):            &targetsv1alpha1.AWSLambdaTarget{},
+	targetsv1alpha1.SchemeGroupVersion.WithKind("AWSS3Target"):                &targetsv1alpha1.AWSS3Target{},
+	targetsv1alpha1.SchemeGroupVersion.WithKind("AWSSNSTarget"):               &targetsv1alpha1.AWSSNSTarget{},
+	targetsv1alpha1.SchemeGroupVersion.WithKind("AWSSQSTarget"):               &targetsv1alpha1.AWSSQSTarget{},
+	targetsv1alpha1.SchemeGroupVersion.WithKind("AlibabaOSSTarget"):           &targetsv1alpha1.AlibabaOSSTarget{},
+	targetsv1alpha1.SchemeGroupVersion.WithKind("AzureEventHubsTarget"):       &targetsv1alpha1.AzureEventHubsTarget{},
+	targetsv1alpha1.SchemeGroupVersion.WithKind("CloudEventsTarget"):          &targetsv1alpha1.CloudEventsTarget{},
+	targetsv1alpha1.SchemeGroupVersion.WithKind("ConfluentTarget"):            &targetsv1alpha1.ConfluentTarget{},
+	targetsv1alpha1.SchemeGroupVersion.WithKind("DatadogTarget"):              &targetsv1alpha1.DatadogTarget{},
+	targetsv1alpha1.SchemeGroupVersion.WithKind("ElasticsearchTarget"):        &targetsv1alpha1.ElasticsearchTarget{},
+	targetsv1alpha1.SchemeGroupVersion.WithKind("GoogleCloudFirestoreTarget"): &targetsv1alpha1.GoogleCloudFirestoreTarget{},
+	targetsv1alpha1.SchemeGroupVersion.WithKind("GoogleCloudStorageTarget"):   &targetsv1alpha1.GoogleCloudStorageTarget{},
+	targetsv1alpha1.SchemeGroupVersion.WithKind("GoogleCloudWorkflowsTarget"): &targetsv1alpha1.GoogleCloudWorkflowsTarget{},
+	targetsv1alpha1.SchemeGroupVersion.WithKind("GoogleSheetTarget"):          &targetsv1alpha1.GoogleSheetTarget{},
+	targetsv1alpha1.SchemeGroupVersion.WithKind("HTTPTarget"):                 &targetsv1alpha1.HTTPTarget{},
+	targetsv1alpha1.SchemeGroupVersion.WithKind("HasuraTarget"):               &targetsv1alpha1.HasuraTarget{},
+	targetsv1alpha1.SchemeGroupVersion.WithKind("IBMMQTarget"):                &targetsv1alpha1.IBMMQTarget{},
+	targetsv1alpha1.SchemeGroupVersion.WithKind("InfraTarget"):                &targetsv1alpha1.InfraTarget{},
+	targetsv1alpha1.SchemeGroupVersion.WithKind("JiraTarget"):                 &targetsv1alpha1.JiraTarget{},
+	targetsv1alpha1.SchemeGroupVersion.WithKind("LogzMetricsTarget"):          &targetsv1alpha1.LogzMetricsTarget{},
+	targetsv1alpha1.SchemeGroupVersion.WithKind("LogzTarget"):                 &targetsv1alpha1.LogzTarget{},
+	targetsv1alpha1.SchemeGroupVersion.WithKind("OracleTarget"):               &targetsv1alpha1.OracleTarget{},
+	targetsv1alpha1.SchemeGroupVersion.WithKind("SalesforceTarget"):           &targetsv1alpha1.SalesforceTarget{},
+	targetsv1alpha1.SchemeGroupVersion.WithKind("SendGridTarget"):             &targetsv1alpha1.SendGridTarget{},
+	targetsv1alpha1.SchemeGroupVersion.WithKind("SlackTarget"):                &targetsv1alpha1.SlackTarget{},
+	targetsv1alpha1.SchemeGroupVersion.WithKind("SplunkTarget"):               &targetsv1alpha1.SplunkTarget{},
+	targetsv1alpha1.SchemeGroupVersion.WithKind("TektonTarget"):               &targetsv1alpha1.TektonTarget{},
+	targetsv1alpha1.SchemeGroupVersion.WithKind("TwilioTarget"):               &targetsv1alpha1.TwilioTarget{},
+	targetsv1alpha1.SchemeGroupVersion.WithKind("UiPathTarget"):               &targetsv1alpha1.UiPathTarget{},
+	targetsv1alpha1.SchemeGroupVersion.WithKind("ZendeskTarget"):              &targetsv1alpha1.ZendeskTarget{},
+
+	flowv1alpha1.SchemeGroupVersion.WithKind("Aggregator"):         &flowv1alpha1.Aggregator{},
+	flowv1alpha1.SchemeGroupVersion.WithKind("Transformation"):     &flowv1alpha1.Transformation{},
+	flowv1alpha1.SchemeGroupVersion.WithKind("XSLTTransformation"): &flowv1alpha1.XSLTTransformation{},
+
+	routingv1alpha1.SchemeGroupVersion.WithKind("Filter"):   &routingv1alpha1.Filter{},
+	routingv1alpha1.SchemeGroupVersion.WithKind("Splitter"): &routingv1alpha1.Splitter{},
 }
 
 var callbacks = map[schema.GroupVersionKind]validation.Callback{}
 
+// conversions lists the kinds served in more than one API version, with
+// v1beta1 as the hub through which all conversions are performed.
+var conversions = map[schema.GroupKind]conversion.GroupKindConversion{
+	sourcesv1beta1.Kind("AWSCloudWatchLogsSource"):             convertible("awscloudwatchlogssources.sources.triggermesh.io", &sourcesv1alpha1.AWSCloudWatchLogsSource{}, &sourcesv1beta1.AWSCloudWatchLogsSource{}),
+	sourcesv1beta1.Kind("AWSCloudWatchSource"):                 convertible("awscloudwatchsources.sources.triggermesh.io", &sourcesv1alpha1.AWSCloudWatchSource{}, &sourcesv1beta1.AWSCloudWatchSource{}),
+	sourcesv1beta1.Kind("AWSCodeCommitSource"):                 convertible("awscodecommitsources.sources.triggermesh.io", &sourcesv1alpha1.AWSCodeCommitSource{}, &sourcesv1beta1.AWSCodeCommitSource{}),
+	sourcesv1beta1.Kind("AWSCognitoIdentitySource"):            convertible("awscognitoidentitysources.sources.triggermesh.io", &sourcesv1alpha1.AWSCognitoIdentitySource{}, &sourcesv1beta1.AWSCognitoIdentitySource{}),
+	sourcesv1beta1.Kind("AWSCognitoUserPoolSource"):            convertible("awscognitouserpoolsources.sources.triggermesh.io", &sourcesv1alpha1.AWSCognitoUserPoolSource{}, &sourcesv1beta1.AWSCognitoUserPoolSource{}),
+	sourcesv1beta1.Kind("AWSDynamoDBSource"):                   convertible("awsdynamodbsources.sources.triggermesh.io", &sourcesv1alpha1.AWSDynamoDBSource{}, &sourcesv1beta1.AWSDynamoDBSource{}),
+	sourcesv1beta1.Kind("AWSEventBridgeSource"):                convertible("awseventbridgesources.sources.triggermesh.io", &sourcesv1alpha1.AWSEventBridgeSource{}, &sourcesv1beta1.AWSEventBridgeSource{}),
+	sourcesv1beta1.Kind("AWSKinesisSource"):                    convertible("awskinesissources.sources.triggermesh.io", &sourcesv1alpha1.AWSKinesisSource{}, &sourcesv1beta1.AWSKinesisSource{}),
+	sourcesv1beta1.Kind("AWSPerformanceInsightsSource"):        convertible("awsperformanceinsightssources.sources.triggermesh.io", &sourcesv1alpha1.AWSPerformanceInsightsSource{}, &sourcesv1beta1.AWSPerformanceInsightsSource{}),
+	sourcesv1beta1.Kind("AWSS3Source"):                         convertible("awss3sources.sources.triggermesh.io", &sourcesv1alpha1.AWSS3Source{}, &sourcesv1beta1.AWSS3Source{}),
+	sourcesv1beta1.Kind("AWSSNSSource"):                        convertible("awssnssources.sources.triggermesh.io", &sourcesv1alpha1.AWSSNSSource{}, &sourcesv1beta1.AWSSNSSource{}),
+	sourcesv1beta1.Kind("AWSSQSSource"):                        convertible("awssqssources.sources.triggermesh.io", &sourcesv1alpha1.AWSSQSSource{}, &sourcesv1beta1.AWSSQSSource{}),
+	sourcesv1beta1.Kind("AzureActivityLogsSource"):             convertible("azureactivitylogssources.sources.triggermesh.io", &sourcesv1alpha1.AzureActivityLogsSource{}, &sourcesv1beta1.AzureActivityLogsSource{}),
+	sourcesv1beta1.Kind("AzureBlobStorageSource"):              convertible("azureblobstoragesources.sources.triggermesh.io", &sourcesv1alpha1.AzureBlobStorageSource{}, &sourcesv1beta1.AzureBlobStorageSource{}),
+	sourcesv1beta1.Kind("AzureEventGridSource"):                convertible("azureeventgridsources.sources.triggermesh.io", &sourcesv1alpha1.AzureEventGridSource{}, &sourcesv1beta1.AzureEventGridSource{}),
+	sourcesv1beta1.Kind("AzureEventHubSource"):                 convertible("azureeventhubsources.sources.triggermesh.io", &sourcesv1alpha1.AzureEventHubSource{}, &sourcesv1beta1.AzureEventHubSource{}),
+	sourcesv1beta1.Kind("AzureIOTHubSource"):                   convertible("azureiothubsources.sources.triggermesh.io", &sourcesv1alpha1.AzureIOTHubSource{}, &sourcesv1beta1.AzureIOTHubSource{}),
+	sourcesv1beta1.Kind("AzureQueueStorageSource"):             convertible("azurequeuestoragesources.sources.triggermesh.io", &sourcesv1alpha1.AzureQueueStorageSource{}, &sourcesv1beta1.AzureQueueStorageSource{}),
+	sourcesv1beta1.Kind("AzureServiceBusQueueSource"):          convertible("azureservicebusqueuesources.sources.triggermesh.io", &sourcesv1alpha1.AzureServiceBusQueueSource{}, &sourcesv1beta1.AzureServiceBusQueueSource{}),
+	sourcesv1beta1.Kind("AzureServiceBusTopicSource"):          convertible("azureservicebustopicsources.sources.triggermesh.io", &sourcesv1alpha1.AzureServiceBusTopicSource{}, &sourcesv1beta1.AzureServiceBusTopicSource{}),
+	sourcesv1beta1.Kind("CloudEventsSource"):                   convertible("cloudeventssources.sources.triggermesh.io", &sourcesv1alpha1.CloudEventsSource{}, &sourcesv1beta1.CloudEventsSource{}),
+	sourcesv1beta1.Kind("GoogleCloudAuditLogsSource"):          convertible("googlecloudauditlogssources.sources.triggermesh.io", &sourcesv1alpha1.GoogleCloudAuditLogsSource{}, &sourcesv1beta1.GoogleCloudAuditLogsSource{}),
+	sourcesv1beta1.Kind("GoogleCloudBillingSource"):            convertible("googlecloudbillingsources.sources.triggermesh.io", &sourcesv1alpha1.GoogleCloudBillingSource{}, &sourcesv1beta1.GoogleCloudBillingSource{}),
+	sourcesv1beta1.Kind("GoogleCloudIoTSource"):                convertible("googlecloudiotsources.sources.triggermesh.io", &sourcesv1alpha1.GoogleCloudIoTSource{}, &sourcesv1beta1.GoogleCloudIoTSource{}),
+	sourcesv1beta1.Kind("GoogleCloudPubSubSource"):             convertible("googlecloudpubsubsources.sources.triggermesh.io", &sourcesv1alpha1.GoogleCloudPubSubSource{}, &sourcesv1beta1.GoogleCloudPubSubSource{}),
+	sourcesv1beta1.Kind("GoogleCloudSourceRepositoriesSource"): convertible("googlecloudsourcerepositoriessources.sources.triggermesh.io", &sourcesv1alpha1.GoogleCloudSourceRepositoriesSource{}, &sourcesv1beta1.GoogleCloudSourceRepositoriesSource{}),
+	sourcesv1beta1.Kind("GoogleCloudStorageSource"):            convertible("googlecloudstoragesources.sources.triggermesh.io", &sourcesv1alpha1.GoogleCloudStorageSource{}, &sourcesv1beta1.GoogleCloudStorageSource{}),
+	sourcesv1beta1.Kind("HTTPPollerSource"):                    convertible("httppollersources.sources.triggermesh.io", &sourcesv1alpha1.HTTPPollerSource{}, &sourcesv1beta1.HTTPPollerSource{}),
+	sourcesv1beta1.Kind("IBMMQSource"):                         convertible("ibmmqsources.sources.triggermesh.io", &sourcesv1alpha1.IBMMQSource{}, &sourcesv1beta1.IBMMQSource{}),
+	sourcesv1beta1.Kind("KafkaSource"):                         convertible("kafkasources.sources.triggermesh.io", &sourcesv1alpha1.KafkaSource{}, &sourcesv1beta1.KafkaSource{}),
+	sourcesv1beta1.Kind("OCIMetricsSource"):                    convertible("ocimetricssources.sources.triggermesh.io", &sourcesv1alpha1.OCIMetricsSource{}, &sourcesv1beta1.OCIMetricsSource{}),
+	sourcesv1beta1.Kind("SalesforceSource"):                    convertible("salesforcesources.sources.triggermesh.io", &sourcesv1alpha1.SalesforceSource{}, &sourcesv1beta1.SalesforceSource{}),
+	sourcesv1beta1.Kind("SlackSource"):                         convertible("slacksources.sources.triggermesh.io", &sourcesv1alpha1.SlackSource{}, &sourcesv1beta1.SlackSource{}),
+	sourcesv1beta1.Kind("TwilioSource"):                        convertible("twiliosources.sources.triggermesh.io", &sourcesv1alpha1.TwilioSource{}, &sourcesv1beta1.TwilioSource{}),
+	sourcesv1beta1.Kind("WebhookSource"):                       convertible("webhooksources.sources.triggermesh.io", &sourcesv1alpha1.WebhookSource{}, &sourcesv1beta1.WebhookSource{}),
+	sourcesv1beta1.Kind("ZendeskSource"):                       convertible("zendesksources.sources.triggermesh.io", &sourcesv1alpha1.ZendeskSource{}, &sourcesv1beta1.ZendeskSource{}),
+
+	targetsv1beta1.Kind("AWSComprehendTarget"):        convertible("awscomprehendtargets.targets.triggermesh.io", &targetsv1alpha1.AWSComprehendTarget{}, &targetsv1beta1.AWSComprehendTarget{}),
+	targetsv1beta1.Kind("AWSDynamoDBTarget"):          convertible("awsdynamodbtargets.targets.triggermesh.io", &targetsv1alpha1.AWSDynamoDBTarget{}, &targetsv1beta1.AWSDynamoDBTarget{}),
+	targetsv1beta1.Kind("AWSEventBridgeTarget"):       convertible("awseventbridgetargets.targets.triggermesh.io", &targetsv1alpha1.AWSEventBridgeTarget{}, &targetsv1beta1.AWSEventBridgeTarget{}),
+	targetsv1beta1.Kind("AWSKinesisTarget"):           convertible("awskinesistargets.targets.triggermesh.io", &targetsv1alpha1.AWSKinesisTarget{}, &targetsv1beta1.AWSKinesisTarget{}),
+	targetsv1beta1.Kind("AWSLambdaTarget"):            convertible("awslambdatargets.targets.triggermesh.io", &targetsv1alpha1.AWSLambdaTarget{}, &targetsv1beta1.AWSLambdaTarget{}),
+	targetsv1beta1.Kind("AWSS3Target"):                convertible("awss3targets.targets.triggermesh.io", &targetsv1alpha1.AWSS3Target{}, &targetsv1beta1.AWSS3Target{}),
+	targetsv1beta1.Kind("AWSSNSTarget"):               convertible("awssnstargets.targets.triggermesh.io", &targetsv1alpha1.AWSSNSTarget{}, &targetsv1beta1.AWSSNSTarget{}),
+	targetsv1beta1.Kind("AWSSQSTarget"):               convertible("awssqstargets.targets.triggermesh.io", &targetsv1alpha1.AWSSQSTarget{}, &targetsv1beta1.AWSSQSTarget{}),
+	targetsv1beta1.Kind("AlibabaOSSTarget"):           convertible("alibabaosstargets.targets.triggermesh.io", &targetsv1alpha1.AlibabaOSSTarget{}, &targetsv1beta1.AlibabaOSSTarget{}),
+	targetsv1beta1.Kind("AzureEventHubsTarget"):       convertible("azureeventhubstargets.targets.triggermesh.io", &targetsv1alpha1.AzureEventHubsTarget{}, &targetsv1beta1.AzureEventHubsTarget{}),
+	targetsv1beta1.Kind("CloudEventsTarget"):          convertible("cloudeventstargets.targets.triggermesh.io", &targetsv1alpha1.CloudEventsTarget{}, &targetsv1beta1.CloudEventsTarget{}),
+	targetsv1beta1.Kind("ConfluentTarget"):            convertible("confluenttargets.targets.triggermesh.io", &targetsv1alpha1.ConfluentTarget{}, &targetsv1beta1.ConfluentTarget{}),
+	targetsv1beta1.Kind("DatadogTarget"):              convertible("datadogtargets.targets.triggermesh.io", &targetsv1alpha1.DatadogTarget{}, &targetsv1beta1.DatadogTarget{}),
+	targetsv1beta1.Kind("ElasticsearchTarget"):        convertible("elasticsearchtargets.targets.triggermesh.io", &targetsv1alpha1.ElasticsearchTarget{}, &targetsv1beta1.ElasticsearchTarget{}),
+	targetsv1beta1.Kind("GoogleCloudFirestoreTarget"): convertible("googlecloudfirestoretargets.targets.triggermesh.io", &targetsv1alpha1.GoogleCloudFirestoreTarget{}, &targetsv1beta1.GoogleCloudFirestoreTarget{}),
+	targetsv1beta1.Kind("GoogleCloudStorageTarget"):   convertible("googlecloudstoragetargets.targets.triggermesh.io", &targetsv1alpha1.GoogleCloudStorageTarget{}, &targetsv1beta1.GoogleCloudStorageTarget{}),
+	targetsv1beta1.Kind("GoogleCloudWorkflowsTarget"): convertible("googlecloudworkflowstargets.targets.triggermesh.io", &targetsv1alpha1.GoogleCloudWorkflowsTarget{}, &targetsv1beta1.GoogleCloudWorkflowsTarget{}),
+	targetsv1beta1.Kind("GoogleSheetTarget"):          convertible("googlesheettargets.targets.triggermesh.io", &targetsv1alpha1.GoogleSheetTarget{}, &targetsv1beta1.GoogleSheetTarget{}),
+	targetsv1beta1.Kind("HTTPTarget"):                 convertible("httptargets.targets.triggermesh.io", &targetsv1alpha1.HTTPTarget{}, &targetsv1beta1.HTTPTarget{}),
+	targetsv1beta1.Kind("HasuraTarget"):               convertible("hasuratargets.targets.triggermesh.io", &targetsv1alpha1.HasuraTarget{}, &targetsv1beta1.HasuraTarget{}),
+	targetsv1beta1.Kind("IBMMQTarget"):                convertible("ibmmqtargets.targets.triggermesh.io", &targetsv1alpha1.IBMMQTarget{}, &targetsv1beta1.IBMMQTarget{}),
+	targetsv1beta1.Kind("InfraTarget"):                convertible("infratargets.targets.triggermesh.io", &targetsv1alpha1.InfraTarget{}, &targetsv1beta1.InfraTarget{}),
+	targetsv1beta1.Kind("JiraTarget"):                 convertible("jiratargets.targets.triggermesh.io", &targetsv1alpha1.JiraTarget{}, &targetsv1beta1.JiraTarget{}),
+	targetsv1beta1.Kind("LogzMetricsTarget"):          convertible("logzmetricstargets.targets.triggermesh.io", &targetsv1alpha1.LogzMetricsTarget{}, &targetsv1beta1.LogzMetricsTarget{}),
+	targetsv1beta1.Kind("LogzTarget"):                 convertible("logztargets.targets.triggermesh.io", &targetsv1alpha1.LogzTarget{}, &targetsv1beta1.LogzTarget{}),
+	targetsv1beta1.Kind("OracleTarget"):               convertible("oracletargets.targets.triggermesh.io", &targetsv1alpha1.OracleTarget{}, &targetsv1beta1.OracleTarget{}),
+	targetsv1beta1.Kind("SalesforceTarget"):           convertible("salesforcetargets.targets.triggermesh.io", &targetsv1alpha1.SalesforceTarget{}, &targetsv1beta1.SalesforceTarget{}),
+	targetsv1beta1.Kind("SendGridTarget"):             convertible("sendgridtargets.targets.triggermesh.io", &targetsv1alpha1.SendGridTarget{}, &targetsv1beta1.SendGridTarget{}),
+	targetsv1beta1.Kind("SlackTarget"):                convertible("slacktargets.targets.triggermesh.io", &targetsv1alpha1.SlackTarget{}, &targetsv1beta1.SlackTarget{}),
+	targetsv1beta1.Kind("SplunkTarget"):               convertible("splunktargets.targets.triggermesh.io", &targetsv1alpha1.SplunkTarget{}, &targetsv1beta1.SplunkTarget{}),
+	targetsv1beta1.Kind("TektonTarget"):               convertible("tektontargets.targets.triggermesh.io", &targetsv1alpha1.TektonTarget{}, &targetsv1beta1.TektonTarget{}),
+	targetsv1beta1.Kind("TwilioTarget"):               convertible("twiliotargets.targets.triggermesh.io", &targetsv1alpha1.TwilioTarget{}, &targetsv1beta1.TwilioTarget{}),
+	targetsv1beta1.Kind("UiPathTarget"):               convertible("uipathtargets.targets.triggermesh.io", &targetsv1alpha1.UiPathTarget{}, &targetsv1beta1.UiPathTarget{}),
+	targetsv1beta1.Kind("ZendeskTarget"):              convertible("zendesktargets.targets.triggermesh.io", &targetsv1alpha1.ZendeskTarget{}, &targetsv1beta1.ZendeskTarget{}),
+}
+
+// convertible returns the conversion settings of a kind served in the
+// v1alpha1 and v1beta1 API versions.
+func convertible(crdName string, v1alpha1, v1beta1 conversion.ConvertibleObject) conversion.GroupKindConversion {
+	return conversion.GroupKindConversion{
+		DefinitionName: crdName,
+		HubVersion:     "v1beta1",
+		Zygotes: map[string]conversion.ConvertibleObject{
+			"v1alpha1": v1alpha1,
+			"v1beta1":  v1beta1,
+		},
+	}
+}
+
 // NewDefaultingAdmissionController returns defaulting webhook controller implementation.
 func NewDefaultingAdmissionController(ctx context.Context, cmw configmap.Watcher) *controller.Impl {
 	return defaulting.NewAdmissionController(ctx,
@@ -90,6 +258,22 @@ func NewValidationAdmissionController(ctx context.Context, cmw configmap.Watcher
 	)
 }
 
+// NewConversionController returns conversion webhook controller implementation.
+func NewConversionController(ctx context.Context, cmw configmap.Watcher) *controller.Impl {
+	return conversion.NewConversionController(ctx,
+		// The path on which to serve the webhook.
+		"/resource-conversion",
+
+		// The resources to convert.
+		conversions,
+
+		// A function that infuses the context passed to ConvertTo/ConvertFrom with custom metadata.
+		func(ctx context.Context) context.Context {
+			return ctx
+		},
+	)
+}
+
 func main() {
 	webhookName := webhook.NameFromEnv()
 
@@ -104,5 +288,6 @@ func main() {
 		certificates.NewController,
 		NewDefaultingAdmissionController,
 		NewValidationAdmissionController,
+		NewConversionController,
 	)
 }
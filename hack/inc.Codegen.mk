@@ -8,6 +8,9 @@ PKG := github.com/triggermesh/triggermesh
 # List of API groups to generate code for
 # e.g. "sources/v1alpha1 sources/v1alpha2"
 API_GROUPS := sources/v1alpha1 targets/v1alpha1 flow/v1alpha1 extensions/v1alpha1 routing/v1alpha1
+# List of API groups which are only served for conversion purposes, and for
+# which no client is generated
+CONVERSION_API_GROUPS := sources/v1beta1 targets/v1beta1
 # generates e.g. "PKG/pkg/apis/sources/v1alpha1 PKG/pkg/apis/sources/v1alpha2"
 api-import-paths := $(foreach group,$(API_GROUPS),$(PKG)/pkg/apis/$(group))
 
@@ -22,10 +25,12 @@ comma := ,
 null  :=
 space := $(null) $(null)
 
-# Additionally to $(API_GROUPS), generate deepcopy methods for selected shared Go types inside pkg/apis/common/...
+# Additionally to $(API_GROUPS), generate deepcopy methods for $(CONVERSION_API_GROUPS) and for selected shared
+# Go types inside pkg/apis/common/...
+deepcopy: private api-import-paths += $(foreach group,$(CONVERSION_API_GROUPS),$(PKG)/pkg/apis/$(group))
 deepcopy: private api-import-paths += $(PKG)/pkg/apis/common/v1alpha1
 deepcopy:
-	@echo "+ Generating deepcopy funcs for $(API_GROUPS)"
+	@echo "+ Generating deepcopy funcs for $(API_GROUPS) $(CONVERSION_API_GROUPS)"
 	@go run k8s.io/code-generator/cmd/deepcopy-gen \
 		--go-header-file hack/boilerplate/boilerplate.go.txt \
 		--input-dirs $(subst $(space),$(comma),$(api-import-paths))
@@ -81,6 +81,19 @@ func IsMultiTenant(r Reconcilable) bool {
 	return ok && mt.IsMultiTenant()
 }
 
+// CheckpointWriter is implemented by types which receive adapter persists
+// checkpoints and leases in the Kubernetes API.
+type CheckpointWriter interface {
+	WritesCheckpoints() bool
+}
+
+// WritesCheckpoints returns whether the receive adapter of the given component
+// instance persists checkpoints and leases in the Kubernetes API.
+func WritesCheckpoints(r Reconcilable) bool {
+	cw, ok := r.(CheckpointWriter)
+	return ok && cw.WritesCheckpoints()
+}
+
 // ServiceAccountProvider is implemented by types which are able to influence
 // the shape of the ServiceAccount used by their own receive adapter.
 type ServiceAccountProvider interface {
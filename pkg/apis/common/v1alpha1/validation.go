@@ -0,0 +1,127 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"knative.dev/pkg/apis"
+)
+
+// Validate verifies that no more than one source of value is set, and that
+// a reference to a Secret is complete.
+func (v *ValueFromField) Validate(_ context.Context) *apis.FieldError {
+	if v == nil {
+		return nil
+	}
+
+	if v.Value != "" && v.ValueFromSecret != nil {
+		return apis.ErrMultipleOneOf("value", "valueFromSecret")
+	}
+
+	if v.ValueFromSecret != nil {
+		return ValidateSecretKeySelector(v.ValueFromSecret).ViaField("valueFromSecret")
+	}
+
+	return nil
+}
+
+// ValidateRequired verifies that exactly one source of value is set.
+func (v *ValueFromField) ValidateRequired(ctx context.Context) *apis.FieldError {
+	if !v.IsSet() {
+		return apis.ErrMissingOneOf("value", "valueFromSecret")
+	}
+
+	return v.Validate(ctx)
+}
+
+// IsSet returns whether any source of value is set.
+func (v *ValueFromField) IsSet() bool {
+	return v != nil && (v.Value != "" || v.ValueFromSecret != nil)
+}
+
+// ValidateSecretKeySelector verifies that a reference to a key of a Secret
+// is complete.
+func ValidateSecretKeySelector(s *corev1.SecretKeySelector) *apis.FieldError {
+	var errs *apis.FieldError
+
+	if s.Name == "" {
+		errs = errs.Also(apis.ErrMissingField("name"))
+	}
+	if s.Key == "" {
+		errs = errs.Also(apis.ErrMissingField("key"))
+	}
+
+	return errs
+}
+
+// ValidateURL verifies that the given URL is absolute and includes a host.
+func ValidateURL(u *apis.URL) *apis.FieldError {
+	if u == nil || u.IsEmpty() {
+		return apis.ErrMissingField(apis.CurrentField)
+	}
+
+	if !u.URL().IsAbs() || u.Host == "" {
+		return apis.ErrInvalidValue(u.String(), apis.CurrentField, "URL must be absolute and include a host")
+	}
+
+	return nil
+}
+
+// ValidateURLString verifies that the given string is an absolute URL which
+// includes a host.
+func ValidateURLString(s string) *apis.FieldError {
+	if s == "" {
+		return apis.ErrMissingField(apis.CurrentField)
+	}
+
+	u, err := url.Parse(s)
+	if err != nil {
+		return apis.ErrInvalidValue(s, apis.CurrentField, err.Error())
+	}
+
+	return ValidateURL((*apis.URL)(u))
+}
+
+// ValidateDuration verifies that the given string can be parsed as a
+// positive duration.
+func ValidateDuration(s string) *apis.FieldError {
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return apis.ErrInvalidValue(s, apis.CurrentField, err.Error())
+	}
+
+	if d <= 0 {
+		return apis.ErrInvalidValue(s, apis.CurrentField, "duration must be greater than zero")
+	}
+
+	return nil
+}
+
+// ValidatePositiveDuration verifies that the given duration is greater than
+// zero.
+func ValidatePositiveDuration(d time.Duration) *apis.FieldError {
+	if d <= 0 {
+		return apis.ErrInvalidValue(d.String(), apis.CurrentField, "duration must be greater than zero")
+	}
+
+	return nil
+}
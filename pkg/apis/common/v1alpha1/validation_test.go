@@ -0,0 +1,176 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"knative.dev/pkg/apis"
+)
+
+func TestValueFromFieldValidate(t *testing.T) {
+	testCases := map[string]struct {
+		vff            *ValueFromField
+		required       bool
+		expectErrorMsg string
+	}{
+		"Nil, optional": {
+			vff: nil,
+		},
+		"Nil, required": {
+			vff:            nil,
+			required:       true,
+			expectErrorMsg: "expected exactly one, got neither: value, valueFromSecret",
+		},
+		"Empty, required": {
+			vff:            &ValueFromField{},
+			required:       true,
+			expectErrorMsg: "expected exactly one, got neither: value, valueFromSecret",
+		},
+		"Value": {
+			vff:      &ValueFromField{Value: "v"},
+			required: true,
+		},
+		"Secret": {
+			vff:      &ValueFromField{ValueFromSecret: secretKeySelector("name", "key")},
+			required: true,
+		},
+		"Value and secret": {
+			vff: &ValueFromField{
+				Value:           "v",
+				ValueFromSecret: secretKeySelector("name", "key"),
+			},
+			expectErrorMsg: "expected exactly one, got both: value, valueFromSecret",
+		},
+		"Secret without key": {
+			vff:            &ValueFromField{ValueFromSecret: secretKeySelector("name", "")},
+			expectErrorMsg: "missing field(s): valueFromSecret.key",
+		},
+		"Secret without name and key": {
+			vff:            &ValueFromField{ValueFromSecret: secretKeySelector("", "")},
+			required:       true,
+			expectErrorMsg: "missing field(s): valueFromSecret.key, valueFromSecret.name",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			var err *apis.FieldError
+			if tc.required {
+				err = tc.vff.ValidateRequired(context.Background())
+			} else {
+				err = tc.vff.Validate(context.Background())
+			}
+
+			assertFieldError(t, tc.expectErrorMsg, err)
+		})
+	}
+}
+
+func TestValidateURL(t *testing.T) {
+	testCases := map[string]struct {
+		url            string
+		expectErrorMsg string
+	}{
+		"Absolute URL": {
+			url: "https://example.com/path",
+		},
+		"Empty URL": {
+			url:            "",
+			expectErrorMsg: "missing field(s): url",
+		},
+		"Relative URL": {
+			url:            "/path",
+			expectErrorMsg: "invalid value: /path: url\nURL must be absolute and include a host",
+		},
+		"URL without host": {
+			url:            "mailto:user@example.com",
+			expectErrorMsg: "invalid value: mailto:user@example.com: url\nURL must be absolute and include a host",
+		},
+		"Unparsable URL": {
+			url:            "http://exa mple.com",
+			expectErrorMsg: `invalid value: http://exa mple.com: url` + "\n" + `parse "http://exa mple.com": invalid character " " in host name`,
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			assertFieldError(t, tc.expectErrorMsg, ValidateURLString(tc.url).ViaField("url"))
+		})
+	}
+}
+
+func TestValidateDuration(t *testing.T) {
+	testCases := map[string]struct {
+		duration       string
+		expectErrorMsg string
+	}{
+		"Valid duration": {
+			duration: "1m30s",
+		},
+		"Unparsable duration": {
+			duration:       "1 minute",
+			expectErrorMsg: "invalid value: 1 minute: d\n" + `time: unknown unit " minute" in duration "1 minute"`,
+		},
+		"Negative duration": {
+			duration:       "-1s",
+			expectErrorMsg: "invalid value: -1s: d\nduration must be greater than zero",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			assertFieldError(t, tc.expectErrorMsg, ValidateDuration(tc.duration).ViaField("d"))
+		})
+	}
+
+	assertFieldError(t, "invalid value: 0s: d\nduration must be greater than zero",
+		ValidatePositiveDuration(time.Duration(0)).ViaField("d"))
+}
+
+func secretKeySelector(name, key string) *corev1.SecretKeySelector {
+	return &corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{
+			Name: name,
+		},
+		Key: key,
+	}
+}
+
+// assertFieldError asserts that the given error matches the expected error
+// message, or is nil if the expected message is empty.
+func assertFieldError(t *testing.T, expectMsg string, err *apis.FieldError) {
+	t.Helper()
+
+	if expectMsg == "" {
+		assert.Nil(t, err)
+		return
+	}
+
+	if assert.NotNil(t, err) {
+		assert.Equal(t, expectMsg, err.Error())
+	}
+}
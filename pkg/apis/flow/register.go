@@ -24,6 +24,12 @@ const (
 )
 
 var (
+	// AggregatorResource respresents an Aggregator.
+	AggregatorResource = schema.GroupResource{
+		Group:    GroupName,
+		Resource: "aggregators",
+	}
+
 	// DataWeaveTransformationResource respresents a DataWeave transformation.
 	DataWeaveTransformationResource = schema.GroupResource{
 		Group:    GroupName,
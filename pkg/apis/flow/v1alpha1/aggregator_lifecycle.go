@@ -0,0 +1,95 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+// Supported event types
+const (
+	// AggregatorGroupEventType is the type of events emitted for
+	// complete groups.
+	AggregatorGroupEventType = "io.triggermesh.flow.aggregator.group"
+	// AggregatorPartialGroupEventType is the type of events emitted for
+	// groups which timed out before completing.
+	AggregatorPartialGroupEventType = "io.triggermesh.flow.aggregator.group.partial"
+)
+
+// AggregatorDefaultCorrelationKey is the correlation key used when none
+// is specified. It matches the group extension set by the Splitter.
+const AggregatorDefaultCorrelationKey = "splitgroup"
+
+// GetGroupVersionKind implements kmeta.OwnerRefable.
+func (*Aggregator) GetGroupVersionKind() schema.GroupVersionKind {
+	return SchemeGroupVersion.WithKind("Aggregator")
+}
+
+// GetConditionSet implements duckv1.KRShaped.
+func (*Aggregator) GetConditionSet() apis.ConditionSet {
+	return v1alpha1.EventSenderConditionSet
+}
+
+// GetStatus implements duckv1.KRShaped.
+func (a *Aggregator) GetStatus() *duckv1.Status {
+	return &a.Status.Status
+}
+
+// GetStatusManager implements Reconcilable.
+func (a *Aggregator) GetStatusManager() *v1alpha1.StatusManager {
+	return &v1alpha1.StatusManager{
+		ConditionSet: a.GetConditionSet(),
+		Status:       &a.Status,
+	}
+}
+
+// GetEventTypes implements EventSource.
+func (*Aggregator) GetEventTypes() []string {
+	return []string{
+		AggregatorGroupEventType,
+		AggregatorPartialGroupEventType,
+	}
+}
+
+// AsEventSource implements EventSource.
+func (a *Aggregator) AsEventSource() string {
+	return "aggregator/" + a.Namespace + "." + a.Name
+}
+
+// GetSink implements EventSender.
+func (a *Aggregator) GetSink() *duckv1.Destination {
+	return &a.Spec.Sink
+}
+
+// GetAdapterOverrides implements AdapterConfigurable.
+func (a *Aggregator) GetAdapterOverrides() *v1alpha1.AdapterOverrides {
+	return a.Spec.AdapterOverrides
+}
+
+// GetMode returns the aggregation mode of the Aggregator, or its default
+// value when unset.
+func (a *Aggregator) GetMode() AggregatorMode {
+	if a.Spec.Mode == nil {
+		return AggregatorModeArray
+	}
+	return *a.Spec.Mode
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	"github.com/triggermesh/triggermesh/pkg/apis"
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+// +genclient
+// +genreconciler
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Aggregator is the Schema for the Aggregator flow component.
+type Aggregator struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AggregatorSpec  `json:"spec"`
+	Status v1alpha1.Status `json:"status,omitempty"`
+}
+
+// Check the interfaces Aggregator should be implementing.
+var (
+	_ v1alpha1.Reconcilable        = (*Aggregator)(nil)
+	_ v1alpha1.AdapterConfigurable = (*Aggregator)(nil)
+	_ v1alpha1.EventSource         = (*Aggregator)(nil)
+	_ v1alpha1.EventSender         = (*Aggregator)(nil)
+)
+
+// AggregatorSpec defines the desired state of the component.
+type AggregatorSpec struct {
+	// Name of the CloudEvent context attribute or extension which value
+	// identifies events that belong to the same group. Defaults to the
+	// extension set by the Splitter on split events.
+	// +optional
+	CorrelationKey string `json:"correlationKey,omitempty"`
+
+	// Conditions under which a group of events is considered complete.
+	Completion AggregatorCompletion `json:"completion"`
+
+	// Shape of the data of aggregated events.
+	// +optional
+	Mode *AggregatorMode `json:"mode,omitempty"`
+
+	// Destination of aggregated events.
+	duckv1.SourceSpec `json:",inline"`
+
+	// Adapter spec overrides parameters.
+	// +optional
+	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
+}
+
+// AggregatorCompletion defines the conditions which complete a group of
+// events. A group is complete as soon as one of them is met.
+type AggregatorCompletion struct {
+	// Number of events after which a group is complete. When unset, the
+	// count carried by the Splitter's extension is used, if present.
+	// +optional
+	Count *int `json:"count,omitempty"`
+
+	// CEL expression evaluated against each incoming event, which
+	// completes the group when it evaluates to true.
+	// +optional
+	Expression *string `json:"expression,omitempty"`
+
+	// Maximum time a group remains open after its first event was
+	// received. Incomplete groups are emitted as partial groups once
+	// this duration elapses.
+	Timeout apis.Duration `json:"timeout"`
+}
+
+// AggregatorMode is the shape of the data of aggregated events.
+type AggregatorMode string
+
+// Supported aggregation modes.
+const (
+	// AggregatorModeArray wraps the data of all members in a JSON array.
+	AggregatorModeArray AggregatorMode = "array"
+	// AggregatorModeMerge merges the data of all members, which must be
+	// JSON objects, into a single JSON object.
+	AggregatorModeMerge AggregatorMode = "merge"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AggregatorList is a list of component instances.
+type AggregatorList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []Aggregator `json:"items"`
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"knative.dev/pkg/apis"
+
+	"github.com/triggermesh/triggermesh/pkg/routing/eventfilter/cel"
+)
+
+// ceAttributeNameRegexp matches valid CloudEvent context attribute names,
+// as defined in the CloudEvents specification.
+var ceAttributeNameRegexp = regexp.MustCompile(`^[a-z0-9]{1,20}$`)
+
+// Validate implements apis.Validatable
+func (a *Aggregator) Validate(ctx context.Context) *apis.FieldError {
+	return a.Spec.Validate(ctx).ViaField("spec")
+}
+
+// Validate implements apis.Validatable
+func (s *AggregatorSpec) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	if s.CorrelationKey != "" && !ceAttributeNameRegexp.MatchString(s.CorrelationKey) {
+		errs = errs.Also(apis.ErrInvalidValue(s.CorrelationKey, "correlationKey"))
+	}
+
+	errs = errs.Also(s.Completion.Validate(ctx).ViaField("completion"))
+
+	if s.Mode != nil {
+		switch *s.Mode {
+		case AggregatorModeArray, AggregatorModeMerge:
+		default:
+			errs = errs.Also(apis.ErrInvalidValue(*s.Mode, "mode"))
+		}
+	}
+
+	if s.Sink.Ref == nil && s.Sink.URI == nil {
+		errs = errs.Also(apis.ErrMissingOneOf("sink.ref", "sink.uri"))
+	}
+
+	return errs
+}
+
+// Validate implements apis.Validatable
+func (c *AggregatorCompletion) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	if c.Count != nil && *c.Count < 1 {
+		errs = errs.Also(apis.ErrInvalidValue(*c.Count, "count"))
+	}
+
+	if c.Expression != nil {
+		if _, err := cel.CompileExpression(*c.Expression); err != nil {
+			errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("Cannot compile expression: %v", err), "expression"))
+		}
+	}
+
+	if c.Timeout <= 0 {
+		errs = errs.Also(apis.ErrInvalidValue(c.Timeout.String(), "timeout"))
+	}
+
+	return errs
+}
@@ -28,9 +28,139 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Aggregator) DeepCopyInto(out *Aggregator) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Aggregator.
+func (in *Aggregator) DeepCopy() *Aggregator {
+	if in == nil {
+		return nil
+	}
+	out := new(Aggregator)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Aggregator) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AggregatorCompletion) DeepCopyInto(out *AggregatorCompletion) {
+	*out = *in
+	if in.Count != nil {
+		in, out := &in.Count, &out.Count
+		*out = new(int)
+		**out = **in
+	}
+	if in.Expression != nil {
+		in, out := &in.Expression, &out.Expression
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AggregatorCompletion.
+func (in *AggregatorCompletion) DeepCopy() *AggregatorCompletion {
+	if in == nil {
+		return nil
+	}
+	out := new(AggregatorCompletion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AggregatorList) DeepCopyInto(out *AggregatorList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Aggregator, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AggregatorList.
+func (in *AggregatorList) DeepCopy() *AggregatorList {
+	if in == nil {
+		return nil
+	}
+	out := new(AggregatorList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AggregatorList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AggregatorSpec) DeepCopyInto(out *AggregatorSpec) {
+	*out = *in
+	in.Completion.DeepCopyInto(&out.Completion)
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(AggregatorMode)
+		**out = **in
+	}
+	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(commonv1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AggregatorSpec.
+func (in *AggregatorSpec) DeepCopy() *AggregatorSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AggregatorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Correlation) DeepCopyInto(out *Correlation) {
 	*out = *in
+	if in.Generator != nil {
+		in, out := &in.Generator, &out.Generator
+		*out = new(CorrelationGenerator)
+		**out = **in
+	}
+	if in.FromExtension != nil {
+		in, out := &in.FromExtension, &out.FromExtension
+		*out = new(string)
+		**out = **in
+	}
+	if in.FromHeader != nil {
+		in, out := &in.FromHeader, &out.FromHeader
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -229,9 +359,40 @@ func (in *JQTransformationList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JQTransformationOutput) DeepCopyInto(out *JQTransformationOutput) {
+	*out = *in
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(JQOutputMode)
+		**out = **in
+	}
+	if in.Envelope != nil {
+		in, out := &in.Envelope, &out.Envelope
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JQTransformationOutput.
+func (in *JQTransformationOutput) DeepCopy() *JQTransformationOutput {
+	if in == nil {
+		return nil
+	}
+	out := new(JQTransformationOutput)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *JQTransformationSpec) DeepCopyInto(out *JQTransformationSpec) {
 	*out = *in
+	if in.Output != nil {
+		in, out := &in.Output, &out.Output
+		*out = new(JQTransformationOutput)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.EventOptions != nil {
 		in, out := &in.EventOptions, &out.EventOptions
 		*out = new(EventOptions)
@@ -349,11 +510,68 @@ func (in *SynchronizerList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SynchronizerRedisBackend) DeepCopyInto(out *SynchronizerRedisBackend) {
+	*out = *in
+	if in.Password != nil {
+		in, out := &in.Password, &out.Password
+		*out = new(commonv1alpha1.ValueFromField)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Database != nil {
+		in, out := &in.Database, &out.Database
+		*out = new(int)
+		**out = **in
+	}
+	if in.TLSEnabled != nil {
+		in, out := &in.TLSEnabled, &out.TLSEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SynchronizerRedisBackend.
+func (in *SynchronizerRedisBackend) DeepCopy() *SynchronizerRedisBackend {
+	if in == nil {
+		return nil
+	}
+	out := new(SynchronizerRedisBackend)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SynchronizerSessions) DeepCopyInto(out *SynchronizerSessions) {
+	*out = *in
+	if in.Redis != nil {
+		in, out := &in.Redis, &out.Redis
+		*out = new(SynchronizerRedisBackend)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SynchronizerSessions.
+func (in *SynchronizerSessions) DeepCopy() *SynchronizerSessions {
+	if in == nil {
+		return nil
+	}
+	out := new(SynchronizerSessions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SynchronizerSpec) DeepCopyInto(out *SynchronizerSpec) {
 	*out = *in
-	out.CorrelationKey = in.CorrelationKey
+	in.CorrelationKey.DeepCopyInto(&out.CorrelationKey)
 	out.Response = in.Response
+	if in.Sessions != nil {
+		in, out := &in.Sessions, &out.Sessions
+		*out = new(SynchronizerSessions)
+		(*in).DeepCopyInto(*out)
+	}
 	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
 	if in.AdapterOverrides != nil {
 		in, out := &in.AdapterOverrides, &out.AdapterOverrides
@@ -44,9 +44,15 @@ var (
 
 // JQTransformationSpec defines the desired state of the component.
 type JQTransformationSpec struct {
-	// The query that gets passed to the JQ library
+	// The query that gets passed to the JQ library.
+	// The attributes of the incoming event are available to the query
+	// through the $ce variable, e.g. $ce.type or $ce.extensions.myext.
 	Query string `json:"query"`
 
+	// Options which control how the outputs of the query are emitted.
+	// +optional
+	Output *JQTransformationOutput `json:"output,omitempty"`
+
 	// EventOptions for targets
 	EventOptions *EventOptions `json:"eventOptions,omitempty"`
 
@@ -58,6 +64,38 @@ type JQTransformationSpec struct {
 	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
 }
 
+// JQTransformationOutput defines how the outputs of a jq query are emitted.
+type JQTransformationOutput struct {
+	// Mode in which the outputs of the query are emitted. Possible values
+	// are:
+	//
+	// - last: only the last output is emitted.
+	// - each: every output is emitted as its own event.
+	// - array: all outputs are collected into an array, emitted as a
+	//   single event.
+	//
+	// Defaults to "last".
+	// +optional
+	Mode *JQOutputMode `json:"mode,omitempty"`
+
+	// Interpret each output of the query as an envelope of the form
+	// {"ce": {...}, "data": ...}, where "ce" contains CloudEvent attributes
+	// to set on the outgoing event, in the same format as the $ce
+	// variable, and "data" contains the data of the outgoing event.
+	// +optional
+	Envelope *bool `json:"envelope,omitempty"`
+}
+
+// JQOutputMode is a mode in which the outputs of a jq query are emitted.
+type JQOutputMode string
+
+// Supported emission modes of jq outputs.
+const (
+	JQOutputModeLast  JQOutputMode = "last"
+	JQOutputModeEach  JQOutputMode = "each"
+	JQOutputModeArray JQOutputMode = "array"
+)
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // JQTransformationList is a list of component instances.
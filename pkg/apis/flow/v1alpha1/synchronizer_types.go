@@ -50,6 +50,11 @@ type SynchronizerSpec struct {
 	CorrelationKey Correlation `json:"correlationKey"`
 	Response       Response    `json:"response"`
 
+	// Backend used to keep track of client sessions. Defaults to an
+	// in-memory backend, which doesn't support multiple replicas.
+	// +optional
+	Sessions *SynchronizerSessions `json:"sessions,omitempty"`
+
 	// Support sending to an event sink instead of replying.
 	duckv1.SourceSpec `json:",inline"`
 
@@ -62,13 +67,79 @@ type SynchronizerSpec struct {
 type Correlation struct {
 	Attribute string `json:"attribute"`
 	Length    int    `json:"length"`
+
+	// Generator of correlation IDs. Defaults to random strings of the
+	// configured length.
+	// +optional
+	Generator *CorrelationGenerator `json:"generator,omitempty"`
+
+	// Name of a CloudEvent extension containing a correlation ID supplied
+	// by the caller, which is used instead of a generated one.
+	// +optional
+	FromExtension *string `json:"fromExtension,omitempty"`
+	// Name of a HTTP header containing a correlation ID supplied by the
+	// caller, which is used instead of a generated one.
+	// +optional
+	FromHeader *string `json:"fromHeader,omitempty"`
 }
 
+// CorrelationGenerator is the type of generator of correlation IDs.
+type CorrelationGenerator string
+
+// Supported generators of correlation IDs.
+const (
+	// CorrelationGeneratorRandom generates random alphanumeric strings.
+	CorrelationGeneratorRandom CorrelationGenerator = "random"
+	// CorrelationGeneratorUUID generates random UUIDs.
+	CorrelationGeneratorUUID CorrelationGenerator = "uuid"
+)
+
 // Response defines the response handling configuration.
 type Response struct {
 	Timeout apis.Duration `json:"timeout"`
 }
 
+// SynchronizerSessions defines the backend used to keep track of client
+// sessions.
+type SynchronizerSessions struct {
+	// Type of backend.
+	Backend SynchronizerSessionBackend `json:"backend"`
+	// Redis backend parameters.
+	// +optional
+	Redis *SynchronizerRedisBackend `json:"redis,omitempty"`
+}
+
+// SynchronizerSessionBackend is the type of backend used to keep track of
+// client sessions.
+type SynchronizerSessionBackend string
+
+// Supported session backends.
+const (
+	// SynchronizerSessionBackendMemory keeps sessions in the memory of the
+	// adapter. Responses must be received by the replica which holds the
+	// client connection.
+	SynchronizerSessionBackendMemory SynchronizerSessionBackend = "memory"
+	// SynchronizerSessionBackendRedis shares the location of sessions
+	// through a Redis server, so that responses can be routed to the
+	// replica which holds the client connection.
+	SynchronizerSessionBackendRedis SynchronizerSessionBackend = "redis"
+)
+
+// SynchronizerRedisBackend contains the parameters of a Redis session backend.
+type SynchronizerRedisBackend struct {
+	// Address of the Redis server, in the host:port format.
+	Address string `json:"address"`
+	// Password used to authenticate with the Redis server.
+	// +optional
+	Password *v1alpha1.ValueFromField `json:"password,omitempty"`
+	// Index of the Redis database to use.
+	// +optional
+	Database *int `json:"database,omitempty"`
+	// Whether to use TLS to connect to the Redis server.
+	// +optional
+	TLSEnabled *bool `json:"tlsEnabled,omitempty"`
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // SynchronizerList is a list of component instances.
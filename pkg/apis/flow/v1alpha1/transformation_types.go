@@ -66,6 +66,12 @@ type TransformationSpec struct {
 type Transform struct {
 	Operation string `json:"operation"`
 	Paths     []Path `json:"paths"`
+
+	// Condition is a CEL expression evaluated against the incoming
+	// event. When set, the operation only applies to events for
+	// which it evaluates to true.
+	// +optional
+	Condition string `json:"condition,omitempty"`
 }
 
 // Path is a key-value pair that represents JSON object path
@@ -18,8 +18,13 @@ package v1alpha1
 
 import (
 	"context"
+	"fmt"
+	"regexp"
+	"strings"
 
 	"knative.dev/pkg/apis"
+
+	"github.com/triggermesh/triggermesh/pkg/routing/eventfilter/cel"
 )
 
 // Validate implements apis.Validatable
@@ -29,5 +34,95 @@ func (t *Transformation) Validate(ctx context.Context) *apis.FieldError {
 
 // Validate implements apis.Validatable
 func (ts *TransformationSpec) Validate(ctx context.Context) *apis.FieldError {
-	return nil
+	var errs *apis.FieldError
+
+	for i, t := range ts.Context {
+		errs = errs.Also(t.Validate(ctx).ViaFieldIndex("context", i))
+	}
+	for i, t := range ts.Data {
+		errs = errs.Also(t.Validate(ctx).ViaFieldIndex("data", i))
+	}
+
+	return errs
+}
+
+// Validate implements apis.Validatable
+func (t *Transform) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	switch t.Operation {
+	case "add", "delete", "shift", "store", "parse":
+	case "concat", "lower", "upper", "split":
+		errs = errs.Also(validatePathKeys(t.Paths))
+	case "cast":
+		errs = errs.Also(validatePathKeys(t.Paths))
+		for i, p := range t.Paths {
+			switch p.Value {
+			case "string", "number", "bool", "timestamp":
+			default:
+				errs = errs.Also(apis.ErrInvalidValue(p.Value, "value").ViaFieldIndex("paths", i))
+			}
+		}
+	case "replace":
+		errs = errs.Also(validatePathKeys(t.Paths))
+		for i, p := range t.Paths {
+			if _, _, err := ParseReplaceExpression(p.Value); err != nil {
+				errs = errs.Also(apis.ErrInvalidValue(p.Value, "value", err.Error()).ViaFieldIndex("paths", i))
+			}
+		}
+	case "map":
+		errs = errs.Also(validatePathKeys(t.Paths))
+		for i, p := range t.Paths {
+			if p.Value == "" {
+				errs = errs.Also(apis.ErrMissingField("value").ViaFieldIndex("paths", i))
+			}
+		}
+	case "":
+		errs = errs.Also(apis.ErrMissingField("operation"))
+	default:
+		errs = errs.Also(apis.ErrInvalidValue(t.Operation, "operation"))
+	}
+
+	if t.Condition != "" {
+		if _, err := cel.CompileExpression(t.Condition); err != nil {
+			errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("Cannot compile expression: %v", err), "condition"))
+		}
+	}
+
+	return errs
+}
+
+// validatePathKeys verifies that each of the given paths has a key, which is
+// the location of the value that the operation transforms.
+func validatePathKeys(paths []Path) *apis.FieldError {
+	var errs *apis.FieldError
+
+	for i, p := range paths {
+		if p.Key == "" {
+			errs = errs.Also(apis.ErrMissingField("key").ViaFieldIndex("paths", i))
+		}
+	}
+
+	return errs
+}
+
+// ParseReplaceExpression parses the "/regexp/replacement/" expression of a
+// "replace" operation, where the first character is used as the delimiter.
+func ParseReplaceExpression(expr string) (*regexp.Regexp, string, error) {
+	if len(expr) < 3 {
+		return nil, "", fmt.Errorf("expression %q is too short", expr)
+	}
+
+	delim := expr[:1]
+	parts := strings.Split(expr[1:], delim)
+	if len(parts) != 3 || parts[2] != "" {
+		return nil, "", fmt.Errorf("expression %q does not match the %[2]sregexp%[2]sreplacement%[2]s format", expr, delim)
+	}
+
+	re, err := regexp.Compile(parts[0])
+	if err != nil {
+		return nil, "", err
+	}
+
+	return re, parts[1], nil
 }
@@ -0,0 +1,140 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"knative.dev/pkg/apis"
+)
+
+func TestTransformationValidate(t *testing.T) {
+	testCases := map[string]struct {
+		spec        TransformationSpec
+		expectError *apis.FieldError
+	}{
+		"condition on the event type": {
+			spec: TransformationSpec{
+				Context: []Transform{{
+					Operation: "add",
+					Paths:     []Path{{Key: "type", Value: "io.triggermesh.test"}},
+				}},
+				Data: []Transform{{
+					Operation: "upper",
+					Paths:     []Path{{Key: "name"}},
+				}, {
+					Operation: "cast",
+					Paths:     []Path{{Key: "count", Value: "number"}, {Key: "at", Value: "timestamp"}},
+					Condition: `ce.type == "io.triggermesh.test"`,
+				}},
+			},
+			expectError: nil,
+		},
+		"condition on a payload field": {
+			spec: TransformationSpec{
+				Data: []Transform{{
+					Operation: "replace",
+					Paths:     []Path{{Key: "status", Value: "/^ok$/OK/"}},
+					Condition: `$kind.(string) == "order"`,
+				}, {
+					Operation: "map",
+					Paths:     []Path{{Key: "items", Value: "sku"}},
+				}},
+			},
+			expectError: nil,
+		},
+		"missing operation": {
+			spec: TransformationSpec{
+				Data: []Transform{{
+					Paths: []Path{{Key: "name"}},
+				}},
+			},
+			expectError: apis.ErrMissingField("operation").ViaFieldIndex("data", 0).ViaField("spec"),
+		},
+		"unknown operation": {
+			spec: TransformationSpec{
+				Context: []Transform{{
+					Operation: "capitalize",
+					Paths:     []Path{{Key: "type"}},
+				}},
+			},
+			expectError: apis.ErrInvalidValue("capitalize", "operation").ViaFieldIndex("context", 0).ViaField("spec"),
+		},
+		"unknown cast type": {
+			spec: TransformationSpec{
+				Data: []Transform{{
+					Operation: "cast",
+					Paths:     []Path{{Key: "count", Value: "number"}, {Key: "flag", Value: "boolean"}},
+				}},
+			},
+			expectError: apis.ErrInvalidValue("boolean", "value").ViaFieldIndex("paths", 1).
+				ViaFieldIndex("data", 0).ViaField("spec"),
+		},
+		"invalid replace expression": {
+			spec: TransformationSpec{
+				Data: []Transform{{
+					Operation: "replace",
+					Paths:     []Path{{Key: "status", Value: "/ok/"}},
+				}},
+			},
+			expectError: apis.ErrInvalidValue("/ok/", "value",
+				`expression "/ok/" does not match the /regexp/replacement/ format`).
+				ViaFieldIndex("paths", 0).ViaFieldIndex("data", 0).ViaField("spec"),
+		},
+		"invalid replace regexp": {
+			spec: TransformationSpec{
+				Data: []Transform{{
+					Operation: "replace",
+					Paths:     []Path{{Key: "status", Value: "/(ok/OK/"}},
+				}},
+			},
+			expectError: apis.ErrInvalidValue("/(ok/OK/", "value",
+				"error parsing regexp: missing closing ): `(ok`").
+				ViaFieldIndex("paths", 0).ViaFieldIndex("data", 0).ViaField("spec"),
+		},
+		"split path without key": {
+			spec: TransformationSpec{
+				Data: []Transform{{
+					Operation: "split",
+					Paths:     []Path{{Key: "tags"}, {Value: ";"}},
+				}},
+			},
+			expectError: apis.ErrMissingField("key").ViaFieldIndex("paths", 1).
+				ViaFieldIndex("data", 0).ViaField("spec"),
+		},
+		"map path without value": {
+			spec: TransformationSpec{
+				Data: []Transform{{
+					Operation: "map",
+					Paths:     []Path{{Key: "items"}},
+				}},
+			},
+			expectError: apis.ErrMissingField("value").ViaFieldIndex("paths", 0).
+				ViaFieldIndex("data", 0).ViaField("spec"),
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			trn := &Transformation{Spec: tc.spec}
+			assert.Equal(t, tc.expectError.Error(), trn.Validate(context.Background()).Error())
+		})
+	}
+}
@@ -24,6 +24,7 @@ package v1alpha1
 import (
 	commonv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	apis "knative.dev/pkg/apis"
 	v1 "knative.dev/pkg/apis/duck/v1"
 )
 
@@ -111,9 +112,38 @@ func (in *FilterList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FilterRoute) DeepCopyInto(out *FilterRoute) {
+	*out = *in
+	in.Sink.DeepCopyInto(&out.Sink)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FilterRoute.
+func (in *FilterRoute) DeepCopy() *FilterRoute {
+	if in == nil {
+		return nil
+	}
+	out := new(FilterRoute)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FilterSpec) DeepCopyInto(out *FilterSpec) {
 	*out = *in
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]FilterRoute, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RoutingMode != nil {
+		in, out := &in.RoutingMode, &out.RoutingMode
+		*out = new(FilterRoutingMode)
+		**out = **in
+	}
 	if in.Sink != nil {
 		in, out := &in.Sink, &out.Sink
 		*out = new(v1.Destination)
@@ -137,6 +167,34 @@ func (in *FilterSpec) DeepCopy() *FilterSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FilterStatus) DeepCopyInto(out *FilterStatus) {
+	*out = *in
+	in.Status.DeepCopyInto(&out.Status)
+	if in.RouteSinkURIs != nil {
+		in, out := &in.RouteSinkURIs, &out.RouteSinkURIs
+		*out = make([]*apis.URL, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(apis.URL)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FilterStatus.
+func (in *FilterStatus) DeepCopy() *FilterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FilterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Splitter) DeepCopyInto(out *Splitter) {
 	*out = *in
@@ -165,6 +223,27 @@ func (in *Splitter) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SplitterAggregation) DeepCopyInto(out *SplitterAggregation) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SplitterAggregation.
+func (in *SplitterAggregation) DeepCopy() *SplitterAggregation {
+	if in == nil {
+		return nil
+	}
+	out := new(SplitterAggregation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SplitterList) DeepCopyInto(out *SplitterList) {
 	*out = *in
@@ -207,6 +286,16 @@ func (in *SplitterSpec) DeepCopyInto(out *SplitterSpec) {
 		*out = new(v1.Destination)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Aggregation != nil {
+		in, out := &in.Aggregation, &out.Aggregation
+		*out = new(SplitterAggregation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FailurePolicy != nil {
+		in, out := &in.FailurePolicy, &out.FailurePolicy
+		*out = new(SplitterFailurePolicy)
+		**out = **in
+	}
 	if in.AdapterOverrides != nil {
 		in, out := &in.AdapterOverrides, &out.AdapterOverrides
 		*out = new(commonv1alpha1.AdapterOverrides)
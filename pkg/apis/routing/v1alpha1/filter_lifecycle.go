@@ -37,7 +37,7 @@ func (*Filter) GetGroupVersionKind() schema.GroupVersionKind {
 
 // GetStatus implements duckv1.KRShaped.
 func (f *Filter) GetStatus() *duckv1.Status {
-	return &f.Status.Status
+	return &f.Status.Status.Status
 }
 
 // GetConditionSet implements duckv1.KRShaped.
@@ -49,7 +49,7 @@ func (*Filter) GetConditionSet() apis.ConditionSet {
 func (f *Filter) GetStatusManager() *v1alpha1.StatusManager {
 	return &v1alpha1.StatusManager{
 		ConditionSet: f.GetConditionSet(),
-		Status:       &f.Status,
+		Status:       &f.Status.Status,
 	}
 }
 
@@ -75,6 +75,15 @@ func (*Filter) IsMultiTenant() bool {
 	return true
 }
 
+// GetRoutingMode returns the routing mode of the Filter, or its default
+// value when unset.
+func (f *Filter) GetRoutingMode() FilterRoutingMode {
+	if f.Spec.RoutingMode == nil {
+		return FilterRoutingModeFirstMatch
+	}
+	return *f.Spec.RoutingMode
+}
+
 // GetAdapterOverrides implements AdapterConfigurable.
 func (f *Filter) GetAdapterOverrides() *v1alpha1.AdapterOverrides {
 	return f.Spec.AdapterOverrides
@@ -35,8 +35,8 @@ type Filter struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 
-	Spec   FilterSpec      `json:"spec,omitempty"`
-	Status v1alpha1.Status `json:"status,omitempty"`
+	Spec   FilterSpec   `json:"spec,omitempty"`
+	Status FilterStatus `json:"status,omitempty"`
 }
 
 var (
@@ -52,9 +52,23 @@ var (
 
 // FilterSpec defines the desired state of the component.
 type FilterSpec struct {
-	Expression string `json:"expression"`
+	// Expression is a CEL expression that events must match to be
+	// forwarded to the Sink. Mutually exclusive with Routes.
+	// +optional
+	Expression string `json:"expression,omitempty"`
+
+	// Routes is an ordered list of CEL expressions, each associated with
+	// its own destination. Mutually exclusive with Expression.
+	// +optional
+	Routes []FilterRoute `json:"routes,omitempty"`
+
+	// RoutingMode determines how events are dispatched when they match
+	// more than one of the Routes. Defaults to "firstMatch".
+	// +optional
+	RoutingMode *FilterRoutingMode `json:"routingMode,omitempty"`
 
 	// Sink is a reference to an object that will resolve to a domain name to use as the sink.
+	// When Routes are defined, the Sink receives the events which match none of them.
 	Sink *duckv1.Destination `json:"sink"`
 
 	// Adapter spec overrides parameters.
@@ -62,6 +76,38 @@ type FilterSpec struct {
 	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
 }
 
+// FilterRoute associates a CEL expression with the destination of the
+// events that match it.
+type FilterRoute struct {
+	// Expression is a CEL expression that events must match to be
+	// forwarded to the route's Sink.
+	Expression string `json:"expression"`
+
+	// Sink is a reference to an object that will resolve to a domain name to use as the sink.
+	Sink duckv1.Destination `json:"sink"`
+}
+
+// FilterRoutingMode is the strategy used to dispatch events which match
+// multiple routes.
+type FilterRoutingMode string
+
+const (
+	// FilterRoutingModeFirstMatch forwards events to the first matching route only.
+	FilterRoutingModeFirstMatch FilterRoutingMode = "firstMatch"
+	// FilterRoutingModeFanOut forwards events to all matching routes.
+	FilterRoutingModeFanOut FilterRoutingMode = "fanOut"
+)
+
+// FilterStatus defines the observed state of the component.
+type FilterStatus struct {
+	v1alpha1.Status `json:",inline"`
+
+	// RouteSinkURIs are the resolved URIs of the sinks of each route,
+	// in the same order as the routes in the spec.
+	// +optional
+	RouteSinkURIs []*apis.URL `json:"routeSinkUris,omitempty"`
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // FilterList is a list of component instances.
@@ -32,14 +32,50 @@ func (f *Filter) Validate(ctx context.Context) *apis.FieldError {
 
 // Validate implements apis.Validatable
 func (fs *FilterSpec) Validate(ctx context.Context) *apis.FieldError {
-	if fs.Expression == "" {
-		return apis.ErrMissingField("Expression")
-	}
 	if fs.Sink == nil {
 		return apis.ErrMissingField("Sink")
 	}
-	if _, err := cel.CompileExpression(fs.Expression); err != nil {
-		return apis.ErrInvalidValue(fmt.Sprintf("Cannot compile expression: %v", err), "Expression")
+
+	switch {
+	case fs.Expression == "" && len(fs.Routes) == 0:
+		return apis.ErrMissingOneOf("Expression", "Routes")
+	case fs.Expression != "" && len(fs.Routes) != 0:
+		return apis.ErrMultipleOneOf("Expression", "Routes")
+	case fs.Expression != "":
+		if _, err := cel.CompileExpression(fs.Expression); err != nil {
+			return apis.ErrInvalidValue(fmt.Sprintf("Cannot compile expression: %v", err), "Expression")
+		}
+	}
+
+	var errs *apis.FieldError
+	for i, r := range fs.Routes {
+		errs = errs.Also(r.Validate(ctx).ViaFieldIndex("Routes", i))
+	}
+
+	if fs.RoutingMode != nil {
+		switch *fs.RoutingMode {
+		case FilterRoutingModeFirstMatch, FilterRoutingModeFanOut:
+		default:
+			errs = errs.Also(apis.ErrInvalidValue(*fs.RoutingMode, "RoutingMode"))
+		}
 	}
-	return nil
+
+	return errs
+}
+
+// Validate implements apis.Validatable
+func (r *FilterRoute) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	if r.Expression == "" {
+		errs = errs.Also(apis.ErrMissingField("Expression"))
+	} else if _, err := cel.CompileExpression(r.Expression); err != nil {
+		errs = errs.Also(apis.ErrInvalidValue(fmt.Sprintf("Cannot compile expression: %v", err), "Expression"))
+	}
+
+	if r.Sink.Ref == nil && r.Sink.URI == nil {
+		errs = errs.Also(apis.ErrMissingOneOf("Sink.Ref", "Sink.URI"))
+	}
+
+	return errs
 }
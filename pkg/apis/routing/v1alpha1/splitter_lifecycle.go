@@ -27,7 +27,19 @@ import (
 
 // Supported event types
 const (
-	SplitterGenericEventType = "io.triggermesh.routing.splitter"
+	SplitterGenericEventType   = "io.triggermesh.routing.splitter"
+	SplitterAggregateEventType = "io.triggermesh.routing.splitter.aggregate"
+)
+
+// CloudEvent extensions set on split events for correlation purposes.
+const (
+	// SplitterGroupExtension identifies the group of events which
+	// originate from the same incoming event.
+	SplitterGroupExtension = "splitgroup"
+	// SplitterIndexExtension is the position of an event in its group.
+	SplitterIndexExtension = "splitindex"
+	// SplitterCountExtension is the number of events in the group.
+	SplitterCountExtension = "splitcount"
 )
 
 // GetGroupVersionKind implements kmeta.OwnerRefable
@@ -75,6 +87,15 @@ func (*Splitter) IsMultiTenant() bool {
 	return true
 }
 
+// GetFailurePolicy returns the failure policy of the Splitter, or its
+// default value when unset.
+func (s *Splitter) GetFailurePolicy() SplitterFailurePolicy {
+	if s.Spec.FailurePolicy == nil {
+		return SplitterFailurePolicyBestEffort
+	}
+	return *s.Spec.FailurePolicy
+}
+
 // GetAdapterOverrides implements AdapterConfigurable.
 func (s *Splitter) GetAdapterOverrides() *v1alpha1.AdapterOverrides {
 	return s.Spec.AdapterOverrides
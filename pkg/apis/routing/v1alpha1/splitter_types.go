@@ -56,6 +56,16 @@ type SplitterSpec struct {
 	CEContext CloudEventContext   `json:"ceContext"`
 	Sink      *duckv1.Destination `json:"sink"`
 
+	// Aggregation, when set, makes the Splitter collect the replies to the
+	// split events and respond to the caller with a single aggregated event.
+	// +optional
+	Aggregation *SplitterAggregation `json:"aggregation,omitempty"`
+
+	// FailurePolicy determines how failures to deliver split events are
+	// handled and reported to the caller. Defaults to "bestEffort".
+	// +optional
+	FailurePolicy *SplitterFailurePolicy `json:"failurePolicy,omitempty"`
+
 	// Adapter spec overrides parameters.
 	// +optional
 	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
@@ -68,6 +78,31 @@ type CloudEventContext struct {
 	Extensions map[string]string `json:"extensions"`
 }
 
+// SplitterAggregation declares how replies to split events are aggregated.
+type SplitterAggregation struct {
+	// CloudEvent "type" context attribute of the aggregated event.
+	// Defaults to "io.triggermesh.routing.splitter.aggregate".
+	// +optional
+	Type *string `json:"type,omitempty"`
+}
+
+// SplitterFailurePolicy is the strategy used to handle failures to deliver
+// split events.
+type SplitterFailurePolicy string
+
+const (
+	// SplitterFailurePolicyBestEffort attempts to deliver all split events
+	// and always reports a success to the caller.
+	SplitterFailurePolicyBestEffort SplitterFailurePolicy = "bestEffort"
+	// SplitterFailurePolicyFailFast stops delivering split events after the
+	// first failure and reports an error to the caller.
+	SplitterFailurePolicyFailFast SplitterFailurePolicy = "failFast"
+	// SplitterFailurePolicyReportPartial attempts to deliver all split
+	// events and reports partial failures to the caller with a
+	// "207 Multi-Status" response, or an error if all deliveries failed.
+	SplitterFailurePolicyReportPartial SplitterFailurePolicy = "reportPartial"
+)
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // SplitterList is a list of component instances.
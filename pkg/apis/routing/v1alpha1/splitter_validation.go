@@ -29,5 +29,14 @@ func (s *Splitter) Validate(ctx context.Context) *apis.FieldError {
 
 // Validate implements apis.Validatable
 func (ss *SplitterSpec) Validate(ctx context.Context) *apis.FieldError {
+	if ss.FailurePolicy != nil {
+		switch *ss.FailurePolicy {
+		case SplitterFailurePolicyBestEffort,
+			SplitterFailurePolicyFailFast,
+			SplitterFailurePolicyReportPartial:
+		default:
+			return apis.ErrInvalidValue(*ss.FailurePolicy, "FailurePolicy")
+		}
+	}
 	return nil
 }
@@ -207,6 +207,12 @@ var (
 		Resource: "ibmmqsources",
 	}
 
+	// KafkaSourceResource represents an event source for Kafka.
+	KafkaSourceResource = schema.GroupResource{
+		Group:    GroupName,
+		Resource: "kafkasources",
+	}
+
 	// OCIMetricsSourceResource represents an event source for OCI Metrics.
 	OCIMetricsSourceResource = schema.GroupResource{
 		Group:    GroupName,
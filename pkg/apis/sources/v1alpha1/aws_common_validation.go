@@ -0,0 +1,97 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"strings"
+
+	"knative.dev/pkg/apis"
+
+	tmapis "github.com/triggermesh/triggermesh/pkg/apis"
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+// Validate verifies that no more than one authentication method is set. When
+// none is set, the default credential chain of the AWS SDK applies (e.g. the
+// IAM role of the node).
+func (a *AWSAuth) Validate(ctx context.Context) *apis.FieldError {
+	switch {
+	case a.Credentials != nil && a.EksIAMRole != nil:
+		return apis.ErrMultipleOneOf("credentials", "iamRole")
+	case a.Credentials != nil:
+		return a.Credentials.Validate(ctx).ViaField("credentials")
+	case a.EksIAMRole != nil:
+		return validateARN(*a.EksIAMRole, "iam", "role/").ViaField("iamRole")
+	}
+
+	return nil
+}
+
+// Validate verifies that both security credentials are set.
+func (c *AWSSecurityCredentials) Validate(ctx context.Context) *apis.FieldError {
+	return c.AccessKeyID.ValidateRequired(ctx).ViaField("accessKeyID").
+		Also(c.SecretAccessKey.ValidateRequired(ctx).ViaField("secretAccessKey"))
+}
+
+// Validate verifies that the endpoint URL, if set, is absolute.
+func (e *AWSEndpoint) Validate(_ context.Context) *apis.FieldError {
+	if e == nil || e.URL == nil {
+		return nil
+	}
+
+	return v1alpha1.ValidateURL(e.URL).ViaField("url")
+}
+
+// validateARN verifies that the given ARN is set, refers to a resource of the
+// given AWS service, and that the resource part of the ARN starts with the
+// given prefix.
+func validateARN(arn tmapis.ARN, service, resourcePrefix string) *apis.FieldError {
+	if arn == (tmapis.ARN{}) {
+		return apis.ErrMissingField(apis.CurrentField)
+	}
+
+	if arn.Service != service {
+		return apis.ErrInvalidValue(arn.String(), apis.CurrentField,
+			"ARN must refer to a resource of the "+service+" service")
+	}
+
+	if len(arn.Resource) <= len(resourcePrefix) || !strings.HasPrefix(arn.Resource, resourcePrefix) {
+		return apis.ErrInvalidValue(arn.String(), apis.CurrentField,
+			"resource of the ARN must be of the form "+resourcePrefix+"<name>")
+	}
+
+	return nil
+}
+
+// validateARNResourceName verifies that the given ARN is set, refers to a
+// resource of the given AWS service, and that the resource part of the ARN
+// has the format "<resourceType>/<name>".
+func validateARNResourceName(arn tmapis.ARN, service, resourceType string) *apis.FieldError {
+	resourcePrefix := resourceType + "/"
+
+	if err := validateARN(arn, service, resourcePrefix); err != nil {
+		return err
+	}
+
+	if strings.Contains(strings.TrimPrefix(arn.Resource, resourcePrefix), "/") {
+		return apis.ErrInvalidValue(arn.String(), apis.CurrentField,
+			"resource of the ARN must be of the form "+resourcePrefix+"<name>")
+	}
+
+	return nil
+}
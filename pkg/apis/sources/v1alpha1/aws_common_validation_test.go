@@ -0,0 +1,151 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"knative.dev/pkg/apis"
+
+	tmapis "github.com/triggermesh/triggermesh/pkg/apis"
+)
+
+func TestAWSAuthValidate(t *testing.T) {
+	iamRole := tARN("arn:aws:iam::123456789012:role/test-role")
+	notARole := tARN("arn:aws:iam::123456789012:user/test-user")
+
+	testCases := map[string]struct {
+		auth           AWSAuth
+		expectErrorMsg string
+	}{
+		"Credentials": {
+			auth: tAWSAuth(),
+		},
+		"IAM role": {
+			auth: AWSAuth{EksIAMRole: &iamRole},
+		},
+		"No method": {
+			auth: AWSAuth{},
+		},
+		"Both methods": {
+			auth: AWSAuth{
+				Credentials: tAWSAuth().Credentials,
+				EksIAMRole:  &iamRole,
+			},
+			expectErrorMsg: "expected exactly one, got both: auth.credentials, auth.iamRole",
+		},
+		"Incomplete credentials": {
+			auth: AWSAuth{
+				Credentials: &AWSSecurityCredentials{
+					AccessKeyID: tSecretValue(),
+				},
+			},
+			expectErrorMsg: "expected exactly one, got neither: auth.credentials.secretAccessKey.value, auth.credentials.secretAccessKey.valueFromSecret",
+		},
+		"IAM role of wrong resource type": {
+			auth: AWSAuth{EksIAMRole: &notARole},
+			expectErrorMsg: "invalid value: arn:aws:iam::123456789012:user/test-user: auth.iamRole\n" +
+				"resource of the ARN must be of the form role/<name>",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			assertFieldError(t, tc.expectErrorMsg, tc.auth.Validate(context.Background()).ViaField("auth"))
+		})
+	}
+}
+
+func TestValidateARN(t *testing.T) {
+	testCases := map[string]struct {
+		arn            tmapis.ARN
+		expectErrorMsg string
+	}{
+		"Valid ARN": {
+			arn: tARN("arn:aws:dynamodb:us-east-1:123456789012:table/test-table"),
+		},
+		"Empty ARN": {
+			arn:            tmapis.ARN{},
+			expectErrorMsg: "missing field(s): arn",
+		},
+		"Wrong service": {
+			arn: tARN("arn:aws:kinesis:us-east-1:123456789012:stream/test-stream"),
+			expectErrorMsg: "invalid value: arn:aws:kinesis:us-east-1:123456789012:stream/test-stream: arn\n" +
+				"ARN must refer to a resource of the dynamodb service",
+		},
+		"Wrong resource type": {
+			arn: tARN("arn:aws:dynamodb:us-east-1:123456789012:backup/test-backup"),
+			expectErrorMsg: "invalid value: arn:aws:dynamodb:us-east-1:123456789012:backup/test-backup: arn\n" +
+				"resource of the ARN must be of the form table/<name>",
+		},
+		"Missing resource name": {
+			arn: tARN("arn:aws:dynamodb:us-east-1:123456789012:table/"),
+			expectErrorMsg: "invalid value: arn:aws:dynamodb:us-east-1:123456789012:table/: arn\n" +
+				"resource of the ARN must be of the form table/<name>",
+		},
+		"Sub-resource": {
+			arn: tARN("arn:aws:dynamodb:us-east-1:123456789012:table/test-table/stream/2020"),
+			expectErrorMsg: "invalid value: arn:aws:dynamodb:us-east-1:123456789012:table/test-table/stream/2020: arn\n" +
+				"resource of the ARN must be of the form table/<name>",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			assertFieldError(t, tc.expectErrorMsg, validateARNResourceName(tc.arn, "dynamodb", "table").ViaField("arn"))
+		})
+	}
+}
+
+func TestAWSEndpointValidate(t *testing.T) {
+	testCases := map[string]struct {
+		endpoint       *AWSEndpoint
+		expectErrorMsg string
+	}{
+		"Nil endpoint": {
+			endpoint: nil,
+		},
+		"Absolute URL": {
+			endpoint: &AWSEndpoint{URL: apis.HTTP("localhost:4566")},
+		},
+		"Relative URL": {
+			endpoint: &AWSEndpoint{URL: &apis.URL{Path: "/sqs"}},
+			expectErrorMsg: "invalid value: /sqs: endpoint.url\n" +
+				"URL must be absolute and include a host",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			assertFieldError(t, tc.expectErrorMsg, tc.endpoint.Validate(context.Background()).ViaField("endpoint"))
+		})
+	}
+}
+
+// tAWSAuth returns an AWSAuth which uses security credentials.
+func tAWSAuth() AWSAuth {
+	return AWSAuth{
+		Credentials: &AWSSecurityCredentials{
+			AccessKeyID:     tSecretValue(),
+			SecretAccessKey: tSecretValue(),
+		},
+	}
+}
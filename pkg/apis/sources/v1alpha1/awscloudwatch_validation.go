@@ -0,0 +1,94 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	"knative.dev/pkg/apis"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+// Validate implements apis.Validatable
+func (s *AWSCloudWatchSource) Validate(ctx context.Context) *apis.FieldError {
+	return s.Spec.Validate(ctx).ViaField("spec")
+}
+
+// Validate AWSCloudWatchSource spec
+func (s *AWSCloudWatchSourceSpec) Validate(ctx context.Context) *apis.FieldError {
+	errs := s.SourceSpec.Validate(ctx)
+
+	if s.Region == "" {
+		errs = errs.Also(apis.ErrMissingField("region"))
+	}
+
+	if s.PollingInterval != nil {
+		errs = errs.Also(v1alpha1.ValidatePositiveDuration(time.Duration(*s.PollingInterval)).ViaField("pollingInterval"))
+	}
+
+	for i := range s.MetricQueries {
+		errs = errs.Also(s.MetricQueries[i].Validate(ctx).ViaFieldIndex("metricQueries", i))
+	}
+
+	return errs.Also(s.Auth.Validate(ctx).ViaField("auth"))
+}
+
+// Validate verifies that a metric query is either an expression or a metric.
+func (q *AWSCloudWatchMetricQuery) Validate(_ context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	if q.Name == "" {
+		errs = errs.Also(apis.ErrMissingField("name"))
+	}
+
+	switch {
+	case q.Expression != nil && q.Metric != nil:
+		errs = errs.Also(apis.ErrMultipleOneOf("expression", "metric"))
+	case q.Expression != nil:
+		if *q.Expression == "" {
+			errs = errs.Also(apis.ErrMissingField("expression"))
+		}
+	case q.Metric != nil:
+		errs = errs.Also(q.Metric.validate().ViaField("metric"))
+	default:
+		errs = errs.Also(apis.ErrMissingOneOf("expression", "metric"))
+	}
+
+	return errs
+}
+
+// validate verifies that the metric and statistic of a metric query are set.
+func (m *AWSCloudWatchMetricStat) validate() *apis.FieldError {
+	var errs *apis.FieldError
+
+	if m.Metric.MetricName == "" {
+		errs = errs.Also(apis.ErrMissingField("metricName").ViaField("metric"))
+	}
+	if m.Metric.Namespace == "" {
+		errs = errs.Also(apis.ErrMissingField("namespace").ViaField("metric"))
+	}
+	if m.Period <= 0 {
+		errs = errs.Also(apis.ErrOutOfBoundsValue(m.Period, 1, "+Inf", "period"))
+	}
+	if m.Stat == "" {
+		errs = errs.Also(apis.ErrMissingField("stat"))
+	}
+
+	return errs
+}
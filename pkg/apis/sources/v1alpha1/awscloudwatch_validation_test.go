@@ -0,0 +1,102 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/ptr"
+
+	tmapis "github.com/triggermesh/triggermesh/pkg/apis"
+)
+
+func TestAWSCloudWatchSourceValidate(t *testing.T) {
+	testCases := map[string]struct {
+		modify         func(*AWSCloudWatchSourceSpec)
+		expectErrorMsg string
+	}{
+		"Valid": {},
+		"Missing region": {
+			modify:         func(s *AWSCloudWatchSourceSpec) { s.Region = "" },
+			expectErrorMsg: "missing field(s): spec.region",
+		},
+		"Negative polling interval": {
+			modify: func(s *AWSCloudWatchSourceSpec) {
+				d := tmapis.Duration(-time.Minute)
+				s.PollingInterval = &d
+			},
+			expectErrorMsg: "invalid value: -1m0s: spec.pollingInterval\n" +
+				"duration must be greater than zero",
+		},
+		"Query with expression and metric": {
+			modify:         func(s *AWSCloudWatchSourceSpec) { s.MetricQueries[0].Metric = s.MetricQueries[1].Metric },
+			expectErrorMsg: "expected exactly one, got both: spec.metricQueries[0].expression, spec.metricQueries[0].metric",
+		},
+		"Query without expression or metric": {
+			modify:         func(s *AWSCloudWatchSourceSpec) { s.MetricQueries[0].Expression = nil },
+			expectErrorMsg: "expected exactly one, got neither: spec.metricQueries[0].expression, spec.metricQueries[0].metric",
+		},
+		"Incomplete metric": {
+			modify:         func(s *AWSCloudWatchSourceSpec) { s.MetricQueries[1].Metric.Metric.Namespace = "" },
+			expectErrorMsg: "missing field(s): spec.metricQueries[1].metric.metric.namespace",
+		},
+		"Missing sink": {
+			modify:         func(s *AWSCloudWatchSourceSpec) { s.Sink = duckv1.Destination{} },
+			expectErrorMsg: "expected at least one, got none: spec.sink.ref, spec.sink.uri",
+		},
+		"Without credentials": {
+			modify: func(s *AWSCloudWatchSourceSpec) { s.Auth = AWSAuth{} },
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			src := &AWSCloudWatchSource{Spec: tAWSCloudWatchSourceSpec()}
+			if tc.modify != nil {
+				tc.modify(&src.Spec)
+			}
+
+			assertFieldError(t, tc.expectErrorMsg, src.Validate(context.Background()))
+		})
+	}
+}
+
+func tAWSCloudWatchSourceSpec() AWSCloudWatchSourceSpec {
+	return AWSCloudWatchSourceSpec{
+		SourceSpec: tSourceSpec(),
+		Region:     "us-east-1",
+		MetricQueries: []AWSCloudWatchMetricQuery{{
+			Name:       "expr",
+			Expression: ptr.String("SUM(METRICS())"),
+		}, {
+			Name: "metric",
+			Metric: &AWSCloudWatchMetricStat{
+				Metric: AWSCloudWatchMetric{
+					MetricName: "Duration",
+					Namespace:  "AWS/Lambda",
+				},
+				Period: 60,
+				Stat:   "Average",
+			},
+		}},
+		Auth: tAWSAuth(),
+	}
+}
@@ -0,0 +1,44 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	"knative.dev/pkg/apis"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+// Validate implements apis.Validatable
+func (s *AWSCloudWatchLogsSource) Validate(ctx context.Context) *apis.FieldError {
+	return s.Spec.Validate(ctx).ViaField("spec")
+}
+
+// Validate AWSCloudWatchLogsSource spec
+func (s *AWSCloudWatchLogsSourceSpec) Validate(ctx context.Context) *apis.FieldError {
+	errs := s.SourceSpec.Validate(ctx)
+
+	errs = errs.Also(validateARN(s.ARN, "logs", "log-group:").ViaField("arn"))
+
+	if s.PollingInterval != nil {
+		errs = errs.Also(v1alpha1.ValidatePositiveDuration(time.Duration(*s.PollingInterval)).ViaField("pollingInterval"))
+	}
+
+	return errs.Also(s.Auth.Validate(ctx).ViaField("auth"))
+}
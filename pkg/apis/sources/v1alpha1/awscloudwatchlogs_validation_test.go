@@ -0,0 +1,81 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	tmapis "github.com/triggermesh/triggermesh/pkg/apis"
+)
+
+func TestAWSCloudWatchLogsSourceValidate(t *testing.T) {
+	testCases := map[string]struct {
+		modify         func(*AWSCloudWatchLogsSourceSpec)
+		expectErrorMsg string
+	}{
+		"Valid": {},
+		"Missing ARN": {
+			modify:         func(s *AWSCloudWatchLogsSourceSpec) { s.ARN = tmapis.ARN{} },
+			expectErrorMsg: "missing field(s): spec.arn",
+		},
+		"Invalid ARN": {
+			modify: func(s *AWSCloudWatchLogsSourceSpec) {
+				s.ARN = tARN("arn:aws:logs:us-east-1:123456789012:destination:test")
+			},
+			expectErrorMsg: "invalid value: arn:aws:logs:us-east-1:123456789012:destination:test: spec.arn\n" +
+				"resource of the ARN must be of the form log-group:<name>",
+		},
+		"Zero polling interval": {
+			modify: func(s *AWSCloudWatchLogsSourceSpec) {
+				d := tmapis.Duration(0)
+				s.PollingInterval = &d
+			},
+			expectErrorMsg: "invalid value: 0s: spec.pollingInterval\n" +
+				"duration must be greater than zero",
+		},
+		"Missing sink": {
+			modify:         func(s *AWSCloudWatchLogsSourceSpec) { s.Sink = duckv1.Destination{} },
+			expectErrorMsg: "expected at least one, got none: spec.sink.ref, spec.sink.uri",
+		},
+		"Without credentials": {
+			modify: func(s *AWSCloudWatchLogsSourceSpec) { s.Auth = AWSAuth{} },
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			src := &AWSCloudWatchLogsSource{Spec: tAWSCloudWatchLogsSourceSpec()}
+			if tc.modify != nil {
+				tc.modify(&src.Spec)
+			}
+
+			assertFieldError(t, tc.expectErrorMsg, src.Validate(context.Background()))
+		})
+	}
+}
+
+func tAWSCloudWatchLogsSourceSpec() AWSCloudWatchLogsSourceSpec {
+	return AWSCloudWatchLogsSourceSpec{
+		SourceSpec: tSourceSpec(),
+		ARN:        tARN("arn:aws:logs:us-east-1:123456789012:log-group:/aws/lambda/test"),
+		Auth:       tAWSAuth(),
+	}
+}
@@ -0,0 +1,36 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"knative.dev/pkg/apis"
+)
+
+// ConvertTo implements apis.Convertible.
+// Conversions are implemented by the v1beta1 hub version.
+func (*AWSCodeCommitSource) ConvertTo(_ context.Context, to apis.Convertible) error {
+	return fmt.Errorf("v1alpha1 is not the conversion hub, got: %T", to)
+}
+
+// ConvertFrom implements apis.Convertible.
+// Conversions are implemented by the v1beta1 hub version.
+func (*AWSCodeCommitSource) ConvertFrom(_ context.Context, from apis.Convertible) error {
+	return fmt.Errorf("v1alpha1 is not the conversion hub, got: %T", from)
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	tmapis "github.com/triggermesh/triggermesh/pkg/apis"
+)
+
+func TestAWSCodeCommitSourceValidate(t *testing.T) {
+	testCases := map[string]struct {
+		modify         func(*AWSCodeCommitSourceSpec)
+		expectErrorMsg string
+	}{
+		"Valid": {},
+		"Missing ARN": {
+			modify:         func(s *AWSCodeCommitSourceSpec) { s.ARN = tmapis.ARN{} },
+			expectErrorMsg: "missing field(s): spec.arn",
+		},
+		"Invalid ARN": {
+			modify: func(s *AWSCodeCommitSourceSpec) { s.ARN = tARN("arn:aws:sns:us-east-1:123456789012:test-topic") },
+			expectErrorMsg: "invalid value: arn:aws:sns:us-east-1:123456789012:test-topic: spec.arn\n" +
+				"ARN must refer to a resource of the codecommit service",
+		},
+		"Missing branch": {
+			modify:         func(s *AWSCodeCommitSourceSpec) { s.Branch = "" },
+			expectErrorMsg: "missing field(s): spec.branch",
+		},
+		"Missing event types": {
+			modify:         func(s *AWSCodeCommitSourceSpec) { s.EventTypes = nil },
+			expectErrorMsg: "missing field(s): spec.eventTypes",
+		},
+		"Unsupported event type": {
+			modify:         func(s *AWSCodeCommitSourceSpec) { s.EventTypes = append(s.EventTypes, "merge") },
+			expectErrorMsg: "invalid value: merge: spec.eventTypes[2]",
+		},
+		"Missing sink": {
+			modify:         func(s *AWSCodeCommitSourceSpec) { s.Sink = duckv1.Destination{} },
+			expectErrorMsg: "expected at least one, got none: spec.sink.ref, spec.sink.uri",
+		},
+		"Without credentials": {
+			modify: func(s *AWSCodeCommitSourceSpec) { s.Auth = AWSAuth{} },
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			src := &AWSCodeCommitSource{Spec: tAWSCodeCommitSourceSpec()}
+			if tc.modify != nil {
+				tc.modify(&src.Spec)
+			}
+
+			assertFieldError(t, tc.expectErrorMsg, src.Validate(context.Background()))
+		})
+	}
+}
+
+func tAWSCodeCommitSourceSpec() AWSCodeCommitSourceSpec {
+	return AWSCodeCommitSourceSpec{
+		SourceSpec: tSourceSpec(),
+		ARN:        tARN("arn:aws:codecommit:us-east-1:123456789012:test-repo"),
+		Branch:     "main",
+		EventTypes: []string{"push", "pull_request"},
+		Auth:       tAWSAuth(),
+	}
+}
@@ -0,0 +1,37 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"knative.dev/pkg/apis"
+)
+
+// Validate implements apis.Validatable
+func (s *AWSCognitoUserPoolSource) Validate(ctx context.Context) *apis.FieldError {
+	return s.Spec.Validate(ctx).ViaField("spec")
+}
+
+// Validate AWSCognitoUserPoolSource spec
+func (s *AWSCognitoUserPoolSourceSpec) Validate(ctx context.Context) *apis.FieldError {
+	errs := s.SourceSpec.Validate(ctx)
+
+	errs = errs.Also(validateARNResourceName(s.ARN, "cognito-idp", "userpool").ViaField("arn"))
+
+	return errs.Also(s.Auth.Validate(ctx).ViaField("auth"))
+}
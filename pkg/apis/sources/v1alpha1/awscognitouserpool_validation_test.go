@@ -0,0 +1,73 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	tmapis "github.com/triggermesh/triggermesh/pkg/apis"
+)
+
+func TestAWSCognitoUserPoolSourceValidate(t *testing.T) {
+	testCases := map[string]struct {
+		modify         func(*AWSCognitoUserPoolSourceSpec)
+		expectErrorMsg string
+	}{
+		"Valid": {},
+		"Missing ARN": {
+			modify:         func(s *AWSCognitoUserPoolSourceSpec) { s.ARN = tmapis.ARN{} },
+			expectErrorMsg: "missing field(s): spec.arn",
+		},
+		"Invalid ARN": {
+			modify: func(s *AWSCognitoUserPoolSourceSpec) {
+				s.ARN = tARN("arn:aws:cognito-idp:us-east-1:123456789012:identitypool/us-east-1:test")
+			},
+			expectErrorMsg: "invalid value: arn:aws:cognito-idp:us-east-1:123456789012:identitypool/us-east-1:test: spec.arn\n" +
+				"resource of the ARN must be of the form userpool/<name>",
+		},
+		"Missing sink": {
+			modify:         func(s *AWSCognitoUserPoolSourceSpec) { s.Sink = duckv1.Destination{} },
+			expectErrorMsg: "expected at least one, got none: spec.sink.ref, spec.sink.uri",
+		},
+		"Without credentials": {
+			modify: func(s *AWSCognitoUserPoolSourceSpec) { s.Auth = AWSAuth{} },
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			src := &AWSCognitoUserPoolSource{Spec: tAWSCognitoUserPoolSourceSpec()}
+			if tc.modify != nil {
+				tc.modify(&src.Spec)
+			}
+
+			assertFieldError(t, tc.expectErrorMsg, src.Validate(context.Background()))
+		})
+	}
+}
+
+func tAWSCognitoUserPoolSourceSpec() AWSCognitoUserPoolSourceSpec {
+	return AWSCognitoUserPoolSourceSpec{
+		SourceSpec: tSourceSpec(),
+		ARN:        tARN("arn:aws:cognito-idp:us-east-1:123456789012:userpool/us-east-1_test"),
+		Auth:       tAWSAuth(),
+	}
+}
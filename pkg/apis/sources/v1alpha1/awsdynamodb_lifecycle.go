@@ -76,6 +76,11 @@ func (s *AWSDynamoDBSource) GetAdapterOverrides() *v1alpha1.AdapterOverrides {
 	return s.Spec.AdapterOverrides
 }
 
+// WritesCheckpoints implements CheckpointWriter.
+func (s *AWSDynamoDBSource) WritesCheckpoints() bool {
+	return true
+}
+
 // WantsOwnServiceAccount implements ServiceAccountProvider.
 func (s *AWSDynamoDBSource) WantsOwnServiceAccount() bool {
 	return s.Spec.Auth.EksIAMRole != nil
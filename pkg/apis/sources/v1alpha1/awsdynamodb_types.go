@@ -44,6 +44,7 @@ var (
 	_ v1alpha1.EventSource            = (*AWSDynamoDBSource)(nil)
 	_ v1alpha1.EventSender            = (*AWSDynamoDBSource)(nil)
 	_ v1alpha1.ServiceAccountProvider = (*AWSDynamoDBSource)(nil)
+	_ v1alpha1.CheckpointWriter       = (*AWSDynamoDBSource)(nil)
 )
 
 // AWSDynamoDBSourceSpec defines the desired state of the event source.
@@ -57,11 +58,29 @@ type AWSDynamoDBSourceSpec struct {
 	// Authentication method to interact with the Amazon DynamoDB API.
 	Auth AWSAuth `json:"auth"`
 
+	// Position in a shard of the table's stream from which records are
+	// read when no checkpoint was previously recorded for that shard.
+	// Defaults to LATEST.
+	// +optional
+	InitialPosition *AWSDynamoDBInitialPosition `json:"initialPosition,omitempty"`
+
 	// Adapter spec overrides parameters.
 	// +optional
 	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
 }
 
+// AWSDynamoDBInitialPosition is a position in a shard of a DynamoDB stream.
+// https://docs.aws.amazon.com/amazondynamodb/latest/APIReference/API_streams_GetShardIterator.html
+type AWSDynamoDBInitialPosition string
+
+// Supported initial positions in a shard of a DynamoDB stream.
+const (
+	// Oldest record in the shard.
+	AWSDynamoDBInitialPositionTrimHorizon AWSDynamoDBInitialPosition = "TRIM_HORIZON"
+	// Most recent record in the shard.
+	AWSDynamoDBInitialPositionLatest AWSDynamoDBInitialPosition = "LATEST"
+)
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // AWSDynamoDBSourceList contains a list of event sources.
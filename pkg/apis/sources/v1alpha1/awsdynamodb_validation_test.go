@@ -0,0 +1,73 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	tmapis "github.com/triggermesh/triggermesh/pkg/apis"
+)
+
+func TestAWSDynamoDBSourceValidate(t *testing.T) {
+	testCases := map[string]struct {
+		modify         func(*AWSDynamoDBSourceSpec)
+		expectErrorMsg string
+	}{
+		"Valid": {},
+		"Missing ARN": {
+			modify:         func(s *AWSDynamoDBSourceSpec) { s.ARN = tmapis.ARN{} },
+			expectErrorMsg: "missing field(s): spec.arn",
+		},
+		"Invalid ARN": {
+			modify: func(s *AWSDynamoDBSourceSpec) {
+				s.ARN = tARN("arn:aws:dynamodb:us-east-1:123456789012:table/test-table/stream/2020")
+			},
+			expectErrorMsg: "invalid value: arn:aws:dynamodb:us-east-1:123456789012:table/test-table/stream/2020: spec.arn\n" +
+				"resource of the ARN must be of the form table/<name>",
+		},
+		"Missing sink": {
+			modify:         func(s *AWSDynamoDBSourceSpec) { s.Sink = duckv1.Destination{} },
+			expectErrorMsg: "expected at least one, got none: spec.sink.ref, spec.sink.uri",
+		},
+		"Without credentials": {
+			modify: func(s *AWSDynamoDBSourceSpec) { s.Auth = AWSAuth{} },
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			src := &AWSDynamoDBSource{Spec: tAWSDynamoDBSourceSpec()}
+			if tc.modify != nil {
+				tc.modify(&src.Spec)
+			}
+
+			assertFieldError(t, tc.expectErrorMsg, src.Validate(context.Background()))
+		})
+	}
+}
+
+func tAWSDynamoDBSourceSpec() AWSDynamoDBSourceSpec {
+	return AWSDynamoDBSourceSpec{
+		SourceSpec: tSourceSpec(),
+		ARN:        tARN("arn:aws:dynamodb:us-east-1:123456789012:table/test-table"),
+		Auth:       tAWSAuth(),
+	}
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	tmapis "github.com/triggermesh/triggermesh/pkg/apis"
+)
+
+func TestAWSEventBridgeSourceValidate(t *testing.T) {
+	testCases := map[string]struct {
+		modify         func(*AWSEventBridgeSourceSpec)
+		expectErrorMsg string
+	}{
+		"Valid": {},
+		"Missing ARN": {
+			modify:         func(s *AWSEventBridgeSourceSpec) { s.ARN = tmapis.ARN{} },
+			expectErrorMsg: "missing field(s): spec.arn",
+		},
+		"Invalid ARN": {
+			modify: func(s *AWSEventBridgeSourceSpec) {
+				s.ARN = tARN("arn:aws:events:us-east-1:123456789012:rule/test-rule")
+			},
+			expectErrorMsg: "invalid value: arn:aws:events:us-east-1:123456789012:rule/test-rule: spec.arn\n" +
+				"resource of the ARN must be of the form event-bus/<name>",
+		},
+		"Valid destination queue": {
+			modify: func(s *AWSEventBridgeSourceSpec) {
+				s.Destination = &AWSEventBridgeSourceDestination{
+					SQS: &AWSEventBridgeSourceDestinationSQS{
+						QueueARN: tARN("arn:aws:sqs:us-east-1:123456789012:test-queue"),
+					},
+				}
+			},
+		},
+		"Invalid destination queue": {
+			modify: func(s *AWSEventBridgeSourceSpec) {
+				s.Destination = &AWSEventBridgeSourceDestination{
+					SQS: &AWSEventBridgeSourceDestinationSQS{
+						QueueARN: tARN("arn:aws:sns:us-east-1:123456789012:test-topic"),
+					},
+				}
+			},
+			expectErrorMsg: "invalid value: arn:aws:sns:us-east-1:123456789012:test-topic: spec.destination.sqs.queueARN\n" +
+				"ARN must refer to a resource of the sqs service",
+		},
+		"Missing sink": {
+			modify:         func(s *AWSEventBridgeSourceSpec) { s.Sink = duckv1.Destination{} },
+			expectErrorMsg: "expected at least one, got none: spec.sink.ref, spec.sink.uri",
+		},
+		"Without credentials": {
+			modify: func(s *AWSEventBridgeSourceSpec) { s.Auth = AWSAuth{} },
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			src := &AWSEventBridgeSource{Spec: tAWSEventBridgeSourceSpec()}
+			if tc.modify != nil {
+				tc.modify(&src.Spec)
+			}
+
+			assertFieldError(t, tc.expectErrorMsg, src.Validate(context.Background()))
+		})
+	}
+}
+
+func tAWSEventBridgeSourceSpec() AWSEventBridgeSourceSpec {
+	return AWSEventBridgeSourceSpec{
+		SourceSpec: tSourceSpec(),
+		ARN:        tARN("arn:aws:events:us-east-1:123456789012:event-bus/default"),
+		Auth:       tAWSAuth(),
+	}
+}
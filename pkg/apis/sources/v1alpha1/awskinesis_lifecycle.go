@@ -76,6 +76,11 @@ func (s *AWSKinesisSource) GetAdapterOverrides() *v1alpha1.AdapterOverrides {
 	return s.Spec.AdapterOverrides
 }
 
+// WritesCheckpoints implements CheckpointWriter.
+func (s *AWSKinesisSource) WritesCheckpoints() bool {
+	return true
+}
+
 // WantsOwnServiceAccount implements ServiceAccountProvider.
 func (s *AWSKinesisSource) WantsOwnServiceAccount() bool {
 	return s.Spec.Auth.EksIAMRole != nil
@@ -44,6 +44,7 @@ var (
 	_ v1alpha1.EventSource            = (*AWSKinesisSource)(nil)
 	_ v1alpha1.EventSender            = (*AWSKinesisSource)(nil)
 	_ v1alpha1.ServiceAccountProvider = (*AWSKinesisSource)(nil)
+	_ v1alpha1.CheckpointWriter       = (*AWSKinesisSource)(nil)
 )
 
 // AWSKinesisSourceSpec defines the desired state of the event source.
@@ -57,11 +58,48 @@ type AWSKinesisSourceSpec struct {
 	// Authentication method to interact with the Amazon Kinesis API.
 	Auth AWSAuth `json:"auth"`
 
+	// Options which control the consumption of records from the stream.
+	// +optional
+	ConsumerOptions *AWSKinesisSourceConsumerOptions `json:"consumerOptions,omitempty"`
+
 	// Adapter spec overrides parameters.
 	// +optional
 	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
 }
 
+// AWSKinesisSourceConsumerOptions defines how records are consumed from the
+// shards of a Kinesis stream.
+type AWSKinesisSourceConsumerOptions struct {
+	// Position in a shard from which records are read when no checkpoint
+	// was previously recorded for that shard. Defaults to LATEST.
+	// +optional
+	InitialPosition *AWSKinesisInitialPosition `json:"initialPosition,omitempty"`
+
+	// Time from which records are read when the initial position is
+	// AT_TIMESTAMP.
+	// +optional
+	InitialTimestamp *metav1.Time `json:"initialTimestamp,omitempty"`
+
+	// Interval at which the shards of the stream are listed, in order to
+	// discover shards created by resharding operations. Defaults to 30s.
+	// +optional
+	ShardDiscoveryInterval *apis.Duration `json:"shardDiscoveryInterval,omitempty"`
+}
+
+// AWSKinesisInitialPosition is a position in a Kinesis shard.
+// https://docs.aws.amazon.com/kinesis/latest/APIReference/API_GetShardIterator.html
+type AWSKinesisInitialPosition string
+
+// Supported initial positions in a Kinesis shard.
+const (
+	// Oldest record in the shard.
+	AWSKinesisInitialPositionTrimHorizon AWSKinesisInitialPosition = "TRIM_HORIZON"
+	// Most recent record in the shard.
+	AWSKinesisInitialPositionLatest AWSKinesisInitialPosition = "LATEST"
+	// Record which was written at, or right after, a given timestamp.
+	AWSKinesisInitialPositionAtTimestamp AWSKinesisInitialPosition = "AT_TIMESTAMP"
+)
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // AWSKinesisSourceList contains a list of event sources.
@@ -0,0 +1,93 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	tmapis "github.com/triggermesh/triggermesh/pkg/apis"
+)
+
+func TestAWSKinesisSourceValidate(t *testing.T) {
+	testCases := map[string]struct {
+		modify         func(*AWSKinesisSourceSpec)
+		expectErrorMsg string
+	}{
+		"Valid": {},
+		"Missing ARN": {
+			modify:         func(s *AWSKinesisSourceSpec) { s.ARN = tmapis.ARN{} },
+			expectErrorMsg: "missing field(s): spec.arn",
+		},
+		"Invalid ARN": {
+			modify: func(s *AWSKinesisSourceSpec) {
+				s.ARN = tARN("arn:aws:kinesis:us-east-1:123456789012:stream/test-stream/consumer/test")
+			},
+			expectErrorMsg: "invalid value: arn:aws:kinesis:us-east-1:123456789012:stream/test-stream/consumer/test: spec.arn\n" +
+				"resource of the ARN must be of the form stream/<name>",
+		},
+		"At timestamp without timestamp": {
+			modify: func(s *AWSKinesisSourceSpec) {
+				pos := AWSKinesisInitialPositionAtTimestamp
+				s.ConsumerOptions = &AWSKinesisSourceConsumerOptions{
+					InitialPosition: &pos,
+				}
+			},
+			expectErrorMsg: "missing field(s): spec.consumerOptions.initialTimestamp",
+		},
+		"Negative shard discovery interval": {
+			modify: func(s *AWSKinesisSourceSpec) {
+				d := tmapis.Duration(-time.Second)
+				s.ConsumerOptions = &AWSKinesisSourceConsumerOptions{
+					ShardDiscoveryInterval: &d,
+				}
+			},
+			expectErrorMsg: "invalid value: -1s: spec.consumerOptions.shardDiscoveryInterval\n" +
+				"duration must be greater than zero",
+		},
+		"Missing sink": {
+			modify:         func(s *AWSKinesisSourceSpec) { s.Sink = duckv1.Destination{} },
+			expectErrorMsg: "expected at least one, got none: spec.sink.ref, spec.sink.uri",
+		},
+		"Without credentials": {
+			modify: func(s *AWSKinesisSourceSpec) { s.Auth = AWSAuth{} },
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			src := &AWSKinesisSource{Spec: tAWSKinesisSourceSpec()}
+			if tc.modify != nil {
+				tc.modify(&src.Spec)
+			}
+
+			assertFieldError(t, tc.expectErrorMsg, src.Validate(context.Background()))
+		})
+	}
+}
+
+func tAWSKinesisSourceSpec() AWSKinesisSourceSpec {
+	return AWSKinesisSourceSpec{
+		SourceSpec: tSourceSpec(),
+		ARN:        tARN("arn:aws:kinesis:us-east-1:123456789012:stream/test-stream"),
+		Auth:       tAWSAuth(),
+	}
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	tmapis "github.com/triggermesh/triggermesh/pkg/apis"
+)
+
+func TestAWSS3SourceValidate(t *testing.T) {
+	testCases := map[string]struct {
+		modify         func(*AWSS3SourceSpec)
+		expectErrorMsg string
+	}{
+		"Valid": {},
+		"Missing ARN": {
+			modify:         func(s *AWSS3SourceSpec) { s.ARN = tmapis.ARN{} },
+			expectErrorMsg: "missing field(s): spec.arn",
+		},
+		"Invalid ARN": {
+			modify: func(s *AWSS3SourceSpec) { s.ARN = tARN("arn:aws:sqs:us-east-1:123456789012:test-queue") },
+			expectErrorMsg: "invalid value: arn:aws:sqs:us-east-1:123456789012:test-queue: spec.arn\n" +
+				"ARN must refer to a resource of the s3 service",
+		},
+		"Missing event types": {
+			modify:         func(s *AWSS3SourceSpec) { s.EventTypes = nil },
+			expectErrorMsg: "missing field(s): spec.eventTypes",
+		},
+		"Invalid destination queue": {
+			modify: func(s *AWSS3SourceSpec) {
+				s.Destination = &AWSS3SourceDestination{
+					SQS: &AWSS3SourceDestinationSQS{},
+				}
+			},
+			expectErrorMsg: "missing field(s): spec.destination.sqs.queueARN",
+		},
+		"Missing sink": {
+			modify:         func(s *AWSS3SourceSpec) { s.Sink = duckv1.Destination{} },
+			expectErrorMsg: "expected at least one, got none: spec.sink.ref, spec.sink.uri",
+		},
+		"Without credentials": {
+			modify: func(s *AWSS3SourceSpec) { s.Auth = AWSAuth{} },
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			src := &AWSS3Source{Spec: tAWSS3SourceSpec()}
+			if tc.modify != nil {
+				tc.modify(&src.Spec)
+			}
+
+			assertFieldError(t, tc.expectErrorMsg, src.Validate(context.Background()))
+		})
+	}
+}
+
+func tAWSS3SourceSpec() AWSS3SourceSpec {
+	return AWSS3SourceSpec{
+		SourceSpec: tSourceSpec(),
+		ARN:        tARN("arn:aws:s3:::test-bucket"),
+		EventTypes: []string{"s3:ObjectCreated:*"},
+		Auth:       tAWSAuth(),
+	}
+}
@@ -0,0 +1,46 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"knative.dev/pkg/apis"
+)
+
+// Validate implements apis.Validatable
+func (s *AWSSNSSource) Validate(ctx context.Context) *apis.FieldError {
+	return s.Spec.Validate(ctx).ViaField("spec")
+}
+
+// Validate AWSSNSSource spec
+func (s *AWSSNSSourceSpec) Validate(ctx context.Context) *apis.FieldError {
+	errs := s.SourceSpec.Validate(ctx)
+
+	errs = errs.Also(validateARN(s.ARN, "sns", "").ViaField("arn"))
+
+	// IAM roles for service accounts are not supported by this source,
+	// which receives notifications via a public HTTP endpoint.
+	if s.Auth.EksIAMRole != nil {
+		errs = errs.Also(apis.ErrDisallowedFields("iamRole").ViaField("auth"))
+	}
+	if s.Auth.Credentials != nil {
+		errs = errs.Also(s.Auth.Credentials.Validate(ctx).ViaField("auth", "credentials"))
+	}
+
+	return errs
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	tmapis "github.com/triggermesh/triggermesh/pkg/apis"
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+func TestAWSSNSSourceValidate(t *testing.T) {
+	testCases := map[string]struct {
+		modify         func(*AWSSNSSourceSpec)
+		expectErrorMsg string
+	}{
+		"Valid": {},
+		"Without credentials": {
+			modify: func(s *AWSSNSSourceSpec) { s.Auth = AWSAuth{} },
+		},
+		"Missing ARN": {
+			modify:         func(s *AWSSNSSourceSpec) { s.ARN = tmapis.ARN{} },
+			expectErrorMsg: "missing field(s): spec.arn",
+		},
+		"Invalid ARN": {
+			modify: func(s *AWSSNSSourceSpec) { s.ARN = tARN("arn:aws:sqs:us-east-1:123456789012:test-queue") },
+			expectErrorMsg: "invalid value: arn:aws:sqs:us-east-1:123456789012:test-queue: spec.arn\n" +
+				"ARN must refer to a resource of the sns service",
+		},
+		"IAM role": {
+			modify: func(s *AWSSNSSourceSpec) {
+				role := tARN("arn:aws:iam::123456789012:role/test-role")
+				s.Auth.EksIAMRole = &role
+			},
+			expectErrorMsg: "must not set the field(s): spec.auth.iamRole",
+		},
+		"Incomplete credentials": {
+			modify:         func(s *AWSSNSSourceSpec) { s.Auth.Credentials.AccessKeyID = v1alpha1.ValueFromField{} },
+			expectErrorMsg: "expected exactly one, got neither: spec.auth.credentials.accessKeyID.value, spec.auth.credentials.accessKeyID.valueFromSecret",
+		},
+		"Missing sink": {
+			modify:         func(s *AWSSNSSourceSpec) { s.Sink = duckv1.Destination{} },
+			expectErrorMsg: "expected at least one, got none: spec.sink.ref, spec.sink.uri",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			src := &AWSSNSSource{Spec: tAWSSNSSourceSpec()}
+			if tc.modify != nil {
+				tc.modify(&src.Spec)
+			}
+
+			assertFieldError(t, tc.expectErrorMsg, src.Validate(context.Background()))
+		})
+	}
+}
+
+func tAWSSNSSourceSpec() AWSSNSSourceSpec {
+	return AWSSNSSourceSpec{
+		SourceSpec: tSourceSpec(),
+		ARN:        tARN("arn:aws:sns:us-east-1:123456789012:test-topic"),
+		Auth:       tAWSAuth(),
+	}
+}
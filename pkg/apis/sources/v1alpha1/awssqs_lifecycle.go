@@ -38,7 +38,7 @@ func (s *AWSSQSSource) GetConditionSet() apis.ConditionSet {
 
 // GetStatus implements duckv1.KRShaped.
 func (s *AWSSQSSource) GetStatus() *duckv1.Status {
-	return &s.Status.Status
+	return &s.Status.Status.Status
 }
 
 // GetSink implements EventSender.
@@ -50,7 +50,7 @@ func (s *AWSSQSSource) GetSink() *duckv1.Destination {
 func (s *AWSSQSSource) GetStatusManager() *v1alpha1.StatusManager {
 	return &v1alpha1.StatusManager{
 		ConditionSet: s.GetConditionSet(),
-		Status:       &s.Status,
+		Status:       &s.Status.Status,
 	}
 }
 
@@ -18,6 +18,7 @@ package v1alpha1
 
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	pkgapis "knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 
 	"github.com/triggermesh/triggermesh/pkg/apis"
@@ -33,8 +34,8 @@ type AWSSQSSource struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 
-	Spec   AWSSQSSourceSpec `json:"spec,omitempty"`
-	Status v1alpha1.Status  `json:"status,omitempty"`
+	Spec   AWSSQSSourceSpec   `json:"spec,omitempty"`
+	Status AWSSQSSourceStatus `json:"status,omitempty"`
 }
 
 // Check the interfaces the event source should be implementing.
@@ -58,6 +59,11 @@ type AWSSQSSourceSpec struct {
 	// +optional
 	ReceiveOptions *AWSSQSSourceReceiveOptions `json:"receiveOptions,omitempty"`
 
+	// Options that control the handling of messages which events could not
+	// be delivered to the sink.
+	// +optional
+	DeliveryOptions *AWSSQSSourceDeliveryOptions `json:"deliveryOptions,omitempty"`
+
 	// Name of the message processor to use for converting SQS messages to CloudEvents.
 	// Supported values are "default" and "s3".
 	// +optional
@@ -89,6 +95,56 @@ type AWSSQSSourceReceiveOptions struct {
 	//
 	// +optional
 	VisibilityTimeout *apis.Duration `json:"visibilityTimeout,omitempty"`
+
+	// Number of workers which process received messages concurrently.
+	// Defaults to a multiple of the number of CPUs available to the adapter.
+	// +optional
+	ProcessingWorkers *int32 `json:"processingWorkers,omitempty"`
+
+	// Process messages which share a MessageGroupId sequentially, in the
+	// order they were received. Only relevant for FIFO queues, which
+	// messages of distinct groups are still processed concurrently.
+	// +optional
+	PreserveGroupOrder *bool `json:"preserveGroupOrder,omitempty"`
+}
+
+// AWSSQSSourceDeliveryOptions defines options that control the handling of
+// Amazon SQS messages which events could not be delivered to the sink. Such
+// messages are never deleted from the queue, unless they could be forwarded
+// to the dead-letter sink.
+type AWSSQSSourceDeliveryOptions struct {
+	// Base delay before a message which events could not be delivered
+	// becomes visible again for redelivery. The delay is multiplied by the
+	// number of times the message was received, up to 12 hours.
+	// Expressed as a duration string, which format is documented at https://pkg.go.dev/time#ParseDuration.
+	//
+	// If not defined, the message becomes visible again after its current
+	// visibility timeout expires.
+	//
+	// +optional
+	RetryBackoff *apis.Duration `json:"retryBackoff,omitempty"`
+
+	// Number of times a message must have been received before it is
+	// forwarded to the dead-letter sink and deleted from the queue.
+	// Should be lower than the maxReceiveCount of the queue's redrive
+	// policy, if any.
+	// +optional
+	MaxReceiveCount *int32 `json:"maxReceiveCount,omitempty"`
+
+	// Destination of messages which were received maxReceiveCount times.
+	// The reason of the last delivery failure is set in the
+	// "deadletterreason" extension of the event.
+	// +optional
+	DeadLetterSink *duckv1.Destination `json:"deadLetterSink,omitempty"`
+}
+
+// AWSSQSSourceStatus defines the observed state of the event source.
+type AWSSQSSourceStatus struct {
+	v1alpha1.Status `json:",inline"`
+
+	// DeadLetterSinkURI is the resolved URI of the dead-letter sink.
+	// +optional
+	DeadLetterSinkURI *pkgapis.URL `json:"deadLetterSinkUri,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -0,0 +1,65 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	"knative.dev/pkg/apis"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+// Validate implements apis.Validatable
+func (s *AWSSQSSource) Validate(ctx context.Context) *apis.FieldError {
+	return s.Spec.Validate(ctx).ViaField("spec")
+}
+
+// Validate AWSSQSSource spec
+func (s *AWSSQSSourceSpec) Validate(ctx context.Context) *apis.FieldError {
+	errs := s.SourceSpec.Validate(ctx)
+
+	errs = errs.Also(validateARN(s.ARN, "sqs", "").ViaField("arn"))
+
+	if o := s.ReceiveOptions; o != nil {
+		if o.VisibilityTimeout != nil {
+			errs = errs.Also(v1alpha1.ValidatePositiveDuration(time.Duration(*o.VisibilityTimeout)).
+				ViaField("receiveOptions", "visibilityTimeout"))
+		}
+		if o.ProcessingWorkers != nil && *o.ProcessingWorkers < 1 {
+			errs = errs.Also(apis.ErrOutOfBoundsValue(*o.ProcessingWorkers, 1, "+Inf",
+				"processingWorkers").ViaField("receiveOptions"))
+		}
+	}
+
+	if o := s.DeliveryOptions; o != nil {
+		if o.RetryBackoff != nil {
+			errs = errs.Also(v1alpha1.ValidatePositiveDuration(time.Duration(*o.RetryBackoff)).
+				ViaField("deliveryOptions", "retryBackoff"))
+		}
+		if o.MaxReceiveCount != nil && *o.MaxReceiveCount < 1 {
+			errs = errs.Also(apis.ErrOutOfBoundsValue(*o.MaxReceiveCount, 1, "+Inf",
+				"maxReceiveCount").ViaField("deliveryOptions"))
+		}
+		errs = errs.Also(o.DeadLetterSink.Validate(ctx).ViaField("deliveryOptions", "deadLetterSink"))
+	}
+
+	errs = errs.Also(s.Endpoint.Validate(ctx).ViaField("endpoint"))
+
+	return errs.Also(s.Auth.Validate(ctx).ViaField("auth"))
+}
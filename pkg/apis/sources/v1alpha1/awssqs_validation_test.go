@@ -0,0 +1,113 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/ptr"
+
+	tmapis "github.com/triggermesh/triggermesh/pkg/apis"
+)
+
+func TestAWSSQSSourceValidate(t *testing.T) {
+	testCases := map[string]struct {
+		modify         func(*AWSSQSSourceSpec)
+		expectErrorMsg string
+	}{
+		"Valid": {},
+		"Missing ARN": {
+			modify:         func(s *AWSSQSSourceSpec) { s.ARN = tmapis.ARN{} },
+			expectErrorMsg: "missing field(s): spec.arn",
+		},
+		"Invalid ARN": {
+			modify: func(s *AWSSQSSourceSpec) { s.ARN = tARN("arn:aws:sns:us-east-1:123456789012:test-topic") },
+			expectErrorMsg: "invalid value: arn:aws:sns:us-east-1:123456789012:test-topic: spec.arn\n" +
+				"ARN must refer to a resource of the sqs service",
+		},
+		"Zero processing workers": {
+			modify: func(s *AWSSQSSourceSpec) {
+				s.ReceiveOptions = &AWSSQSSourceReceiveOptions{
+					ProcessingWorkers: ptr.Int32(0),
+				}
+			},
+			expectErrorMsg: "expected 1 <= 0 <= +Inf: spec.receiveOptions.processingWorkers",
+		},
+		"Negative visibility timeout": {
+			modify: func(s *AWSSQSSourceSpec) {
+				d := tmapis.Duration(-time.Second)
+				s.ReceiveOptions = &AWSSQSSourceReceiveOptions{
+					VisibilityTimeout: &d,
+				}
+			},
+			expectErrorMsg: "invalid value: -1s: spec.receiveOptions.visibilityTimeout\n" +
+				"duration must be greater than zero",
+		},
+		"Zero max receive count": {
+			modify: func(s *AWSSQSSourceSpec) {
+				s.DeliveryOptions = &AWSSQSSourceDeliveryOptions{
+					MaxReceiveCount: ptr.Int32(0),
+				}
+			},
+			expectErrorMsg: "expected 1 <= 0 <= +Inf: spec.deliveryOptions.maxReceiveCount",
+		},
+		"Empty dead-letter sink": {
+			modify: func(s *AWSSQSSourceSpec) {
+				s.DeliveryOptions = &AWSSQSSourceDeliveryOptions{
+					DeadLetterSink: &duckv1.Destination{},
+				}
+			},
+			expectErrorMsg: "expected at least one, got none: spec.deliveryOptions.deadLetterSink.ref, spec.deliveryOptions.deadLetterSink.uri",
+		},
+		"Relative endpoint URL": {
+			modify: func(s *AWSSQSSourceSpec) { s.Endpoint = &AWSEndpoint{URL: &apis.URL{Path: "/sqs"}} },
+			expectErrorMsg: "invalid value: /sqs: spec.endpoint.url\n" +
+				"URL must be absolute and include a host",
+		},
+		"Missing sink": {
+			modify:         func(s *AWSSQSSourceSpec) { s.Sink = duckv1.Destination{} },
+			expectErrorMsg: "expected at least one, got none: spec.sink.ref, spec.sink.uri",
+		},
+		"Without credentials": {
+			modify: func(s *AWSSQSSourceSpec) { s.Auth = AWSAuth{} },
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			src := &AWSSQSSource{Spec: tAWSSQSSourceSpec()}
+			if tc.modify != nil {
+				tc.modify(&src.Spec)
+			}
+
+			assertFieldError(t, tc.expectErrorMsg, src.Validate(context.Background()))
+		})
+	}
+}
+
+func tAWSSQSSourceSpec() AWSSQSSourceSpec {
+	return AWSSQSSourceSpec{
+		SourceSpec: tSourceSpec(),
+		ARN:        tARN("arn:aws:sqs:us-east-1:123456789012:test-queue"),
+		Auth:       tAWSAuth(),
+	}
+}
@@ -54,6 +54,39 @@ type AzureSASToken struct {
 	ConnectionString v1alpha1.ValueFromField `json:"connectionString"`
 }
 
+// AzureServiceBusSettlement defines how Service Bus messages which could not
+// be delivered to the sink are settled.
+type AzureServiceBusSettlement struct {
+	// Action applied to messages which could not be delivered to the sink.
+	//  - abandon: the message is released after a backoff delay, and
+	//    redelivered by Service Bus.
+	//  - deadLetter: the message is moved to the dead-letter sub-queue of
+	//    the entity.
+	//  - defer: the message is set aside, and its delivery is retried after
+	//    a backoff delay. Not supported by session-enabled entities.
+	// Defaults to "abandon".
+	// +optional
+	OnFailure *AzureServiceBusSettlementAction `json:"onFailure,omitempty"`
+
+	// Number of delivery attempts after which a message is moved to the
+	// dead-letter sub-queue of the entity, regardless of the failure action.
+	// 0 leaves dead-lettering to the max delivery count of the entity.
+	// Defaults to 10.
+	// +optional
+	MaxDeliveryAttempts *int32 `json:"maxDeliveryAttempts,omitempty"`
+}
+
+// AzureServiceBusSettlementAction is the action applied to a Service Bus
+// message which could not be delivered to the sink.
+type AzureServiceBusSettlementAction string
+
+// Supported settlement actions.
+const (
+	AzureServiceBusSettlementAbandon    AzureServiceBusSettlementAction = "abandon"
+	AzureServiceBusSettlementDeadLetter AzureServiceBusSettlementAction = "deadLetter"
+	AzureServiceBusSettlementDefer      AzureServiceBusSettlementAction = "defer"
+)
+
 // AzureResourceID represents a resource ID for an Azure resource.
 type AzureResourceID struct {
 	SubscriptionID   string
@@ -0,0 +1,119 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"strings"
+
+	"knative.dev/pkg/apis"
+)
+
+// Validate verifies that no more than one authentication method is set, and
+// that the selected method is complete.
+func (a *AzureAuth) Validate(ctx context.Context) *apis.FieldError {
+	switch {
+	case a.ServicePrincipal != nil && a.SASToken != nil:
+		return apis.ErrMultipleOneOf("servicePrincipal", "sasToken")
+	case a.ServicePrincipal != nil:
+		return a.ServicePrincipal.Validate(ctx).ViaField("servicePrincipal")
+	case a.SASToken != nil:
+		return a.SASToken.Validate(ctx).ViaField("sasToken")
+	}
+
+	return nil
+}
+
+// validateServicePrincipal verifies that authentication is performed using a
+// service principal.
+func (a *AzureAuth) validateServicePrincipal(ctx context.Context) *apis.FieldError {
+	if a.SASToken != nil {
+		return apis.ErrDisallowedFields("sasToken")
+	}
+	if a.ServicePrincipal == nil {
+		return apis.ErrMissingField("servicePrincipal")
+	}
+
+	return a.ServicePrincipal.Validate(ctx).ViaField("servicePrincipal")
+}
+
+// Validate verifies that all the credentials of the service principal are
+// set.
+func (p *AzureServicePrincipal) Validate(ctx context.Context) *apis.FieldError {
+	return p.TenantID.ValidateRequired(ctx).ViaField("tenantID").
+		Also(p.ClientID.ValidateRequired(ctx).ViaField("clientID")).
+		Also(p.ClientSecret.ValidateRequired(ctx).ViaField("clientSecret"))
+}
+
+// Validate verifies that the SAS token is defined either by a connection
+// string, or by the name and value of a key.
+func (t *AzureSASToken) Validate(ctx context.Context) *apis.FieldError {
+	hasKey := t.KeyName.IsSet() || t.KeyValue.IsSet()
+
+	switch {
+	case t.ConnectionString.IsSet() && hasKey:
+		return apis.ErrGeneric("expected either a connection string or a key, got both",
+			"connectionString", "keyName", "keyValue")
+	case t.ConnectionString.IsSet():
+		return t.ConnectionString.Validate(ctx).ViaField("connectionString")
+	case hasKey:
+		return t.KeyName.ValidateRequired(ctx).ViaField("keyName").
+			Also(t.KeyValue.ValidateRequired(ctx).ViaField("keyValue"))
+	}
+
+	return apis.ErrMissingOneOf("connectionString", "keyName")
+}
+
+// Validate verifies that the failure action, if set, is supported.
+func (s *AzureServiceBusSettlement) Validate(_ context.Context) *apis.FieldError {
+	if s == nil {
+		return nil
+	}
+
+	var errs *apis.FieldError
+
+	if s.OnFailure != nil {
+		switch *s.OnFailure {
+		case AzureServiceBusSettlementAbandon,
+			AzureServiceBusSettlementDeadLetter,
+			AzureServiceBusSettlementDefer:
+		default:
+			errs = errs.Also(apis.ErrInvalidValue(*s.OnFailure, "onFailure"))
+		}
+	}
+
+	if s.MaxDeliveryAttempts != nil && *s.MaxDeliveryAttempts < 0 {
+		errs = errs.Also(apis.ErrOutOfBoundsValue(*s.MaxDeliveryAttempts, 0, "+Inf", "maxDeliveryAttempts"))
+	}
+
+	return errs
+}
+
+// validateAzureResourceID verifies that the given resource ID is set and, if
+// a resource type is given, that it refers to a resource of that type.
+func validateAzureResourceID(rID AzureResourceID, resourceType string) *apis.FieldError {
+	if rID.SubscriptionID == "" {
+		return apis.ErrMissingField(apis.CurrentField)
+	}
+
+	if resourceType != "" && (!strings.EqualFold(rID.ResourceType, resourceType) || rID.SubResourceType != "") {
+		return apis.ErrInvalidValue(rID.String(), apis.CurrentField,
+			"resource ID must refer to a resource of type "+resourceType)
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+func TestAzureActivityLogsSourceValidate(t *testing.T) {
+	testCases := map[string]struct {
+		modify         func(*AzureActivityLogsSourceSpec)
+		expectErrorMsg string
+	}{
+		"Valid": {},
+		"Missing subscription ID": {
+			modify:         func(s *AzureActivityLogsSourceSpec) { s.SubscriptionID = "" },
+			expectErrorMsg: "missing field(s): spec.subscriptionID",
+		},
+		"Missing namespace ID": {
+			modify:         func(s *AzureActivityLogsSourceSpec) { s.Destination.EventHubs.NamespaceID = AzureResourceID{} },
+			expectErrorMsg: "missing field(s): spec.destination.eventHubs.namespaceID",
+		},
+		"Namespace ID of wrong type": {
+			modify: func(s *AzureActivityLogsSourceSpec) {
+				s.Destination.EventHubs.NamespaceID = tAzureResourceID("Microsoft.Storage", "storageAccounts", "test")
+			},
+			expectErrorMsg: "invalid value: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/test-rg/providers/Microsoft.Storage/storageAccounts/test: spec.destination.eventHubs.namespaceID\n" +
+				"resource ID must refer to a resource of type namespaces",
+		},
+		"Missing service principal": {
+			modify:         func(s *AzureActivityLogsSourceSpec) { s.Auth = AzureAuth{} },
+			expectErrorMsg: "missing field(s): spec.auth.servicePrincipal",
+		},
+		"SAS token": {
+			modify:         func(s *AzureActivityLogsSourceSpec) { s.Auth = tAzureSASTokenAuth() },
+			expectErrorMsg: "must not set the field(s): spec.auth.sasToken",
+		},
+		"Missing sink": {
+			modify:         func(s *AzureActivityLogsSourceSpec) { s.Sink = duckv1.Destination{} },
+			expectErrorMsg: "expected at least one, got none: spec.sink.ref, spec.sink.uri",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			src := &AzureActivityLogsSource{Spec: tAzureActivityLogsSourceSpec()}
+			if tc.modify != nil {
+				tc.modify(&src.Spec)
+			}
+
+			assertFieldError(t, tc.expectErrorMsg, src.Validate(context.Background()))
+		})
+	}
+}
+
+func tAzureActivityLogsSourceSpec() AzureActivityLogsSourceSpec {
+	return AzureActivityLogsSourceSpec{
+		SourceSpec:     tSourceSpec(),
+		SubscriptionID: "00000000-0000-0000-0000-000000000000",
+		Destination: AzureActivityLogsSourceDestination{
+			EventHubs: AzureActivityLogsSourceDestinationEventHubs{
+				NamespaceID: tAzureResourceID("Microsoft.EventHub", "namespaces", "test-ns"),
+			},
+		},
+		Auth: tAzureServicePrincipalAuth(),
+	}
+}
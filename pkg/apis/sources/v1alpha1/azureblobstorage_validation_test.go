@@ -0,0 +1,85 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+func TestAzureBlobStorageSourceValidate(t *testing.T) {
+	testCases := map[string]struct {
+		modify         func(*AzureBlobStorageSourceSpec)
+		expectErrorMsg string
+	}{
+		"Valid": {},
+		"Missing storage account ID": {
+			modify:         func(s *AzureBlobStorageSourceSpec) { s.StorageAccountID = AzureResourceID{} },
+			expectErrorMsg: "missing field(s): spec.storageAccountID",
+		},
+		"Storage account ID of wrong type": {
+			modify: func(s *AzureBlobStorageSourceSpec) {
+				s.StorageAccountID = tAzureResourceID("Microsoft.EventHub", "namespaces", "test-ns")
+			},
+			expectErrorMsg: "invalid value: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/test-rg/providers/Microsoft.EventHub/namespaces/test-ns: spec.storageAccountID\n" +
+				"resource ID must refer to a resource of type storageAccounts",
+		},
+		"Missing namespace ID": {
+			modify:         func(s *AzureBlobStorageSourceSpec) { s.Endpoint.EventHubs.NamespaceID = AzureResourceID{} },
+			expectErrorMsg: "missing field(s): spec.endpoint.eventHubs.namespaceID",
+		},
+		"Missing service principal": {
+			modify:         func(s *AzureBlobStorageSourceSpec) { s.Auth = AzureAuth{} },
+			expectErrorMsg: "missing field(s): spec.auth.servicePrincipal",
+		},
+		"SAS token": {
+			modify:         func(s *AzureBlobStorageSourceSpec) { s.Auth = tAzureSASTokenAuth() },
+			expectErrorMsg: "must not set the field(s): spec.auth.sasToken",
+		},
+		"Missing sink": {
+			modify:         func(s *AzureBlobStorageSourceSpec) { s.Sink = duckv1.Destination{} },
+			expectErrorMsg: "expected at least one, got none: spec.sink.ref, spec.sink.uri",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			src := &AzureBlobStorageSource{Spec: tAzureBlobStorageSourceSpec()}
+			if tc.modify != nil {
+				tc.modify(&src.Spec)
+			}
+
+			assertFieldError(t, tc.expectErrorMsg, src.Validate(context.Background()))
+		})
+	}
+}
+
+func tAzureBlobStorageSourceSpec() AzureBlobStorageSourceSpec {
+	return AzureBlobStorageSourceSpec{
+		SourceSpec:       tSourceSpec(),
+		StorageAccountID: tAzureResourceID("Microsoft.Storage", "storageAccounts", "test"),
+		Endpoint: AzureEventGridSourceEndpoint{
+			EventHubs: AzureEventGridSourceDestinationEventHubs{
+				NamespaceID: tAzureResourceID("Microsoft.EventHub", "namespaces", "test-ns"),
+			},
+		},
+		Auth: tAzureServicePrincipalAuth(),
+	}
+}
@@ -75,3 +75,9 @@ func (s *AzureEventHubSource) GetEventTypes() []string {
 func (s *AzureEventHubSource) GetAdapterOverrides() *v1alpha1.AdapterOverrides {
 	return s.Spec.AdapterOverrides
 }
+
+// WritesCheckpoints implements CheckpointWriter.
+func (s *AzureEventHubSource) WritesCheckpoints() bool {
+	opts := s.Spec.ConsumerOptions
+	return opts == nil || opts.CheckpointStore == nil || opts.CheckpointStore.AzureBlob == nil
+}
@@ -42,6 +42,7 @@ var (
 	_ v1alpha1.AdapterConfigurable = (*AzureEventHubSource)(nil)
 	_ v1alpha1.EventSource         = (*AzureEventHubSource)(nil)
 	_ v1alpha1.EventSender         = (*AzureEventHubSource)(nil)
+	_ v1alpha1.CheckpointWriter    = (*AzureEventHubSource)(nil)
 )
 
 // AzureEventHubSourceSpec defines the desired state of the event source.
@@ -57,11 +58,76 @@ type AzureEventHubSourceSpec struct {
 	// Authentication method to interact with the Azure Event Hubs API.
 	Auth AzureAuth `json:"auth"`
 
+	// Consumer group from which events are read. Defaults to the default
+	// consumer group of the Event Hubs instance ("$Default").
+	// +optional
+	ConsumerGroup *string `json:"consumerGroup,omitempty"`
+
+	// Options which control the consumption of events from the partitions
+	// of the Event Hubs instance.
+	// +optional
+	ConsumerOptions *AzureEventHubSourceConsumerOptions `json:"consumerOptions,omitempty"`
+
 	// Adapter spec overrides parameters.
 	// +optional
 	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
 }
 
+// AzureEventHubSourceConsumerOptions defines how events are consumed from the
+// partitions of an Event Hubs instance.
+type AzureEventHubSourceConsumerOptions struct {
+	// Position in a partition from which events are read when no
+	// checkpoint was previously recorded for that partition. Defaults to
+	// "latest".
+	// +optional
+	StartPosition *AzureEventHubStartPosition `json:"startPosition,omitempty"`
+
+	// Time from which events are read when the start position is
+	// "enqueuedTime".
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// Store in which the positions of the last events read from each
+	// partition are persisted. Defaults to Kubernetes ConfigMap objects.
+	// +optional
+	CheckpointStore *AzureEventHubCheckpointStore `json:"checkpointStore,omitempty"`
+}
+
+// AzureEventHubStartPosition is a position in an Event Hubs partition.
+type AzureEventHubStartPosition string
+
+// Supported start positions in an Event Hubs partition.
+const (
+	// Oldest event retained in the partition.
+	AzureEventHubStartPositionEarliest AzureEventHubStartPosition = "earliest"
+	// Events enqueued after the start of the consumer.
+	AzureEventHubStartPositionLatest AzureEventHubStartPosition = "latest"
+	// Events enqueued at, or after, a given time.
+	AzureEventHubStartPositionEnqueuedTime AzureEventHubStartPosition = "enqueuedTime"
+)
+
+// AzureEventHubCheckpointStore is a store for checkpoints and partition
+// leases. Only one store can be set.
+type AzureEventHubCheckpointStore struct {
+	// Container of an Azure Storage account.
+	// +optional
+	AzureBlob *AzureEventHubBlobCheckpointStore `json:"azureBlob,omitempty"`
+}
+
+// AzureEventHubBlobCheckpointStore persists checkpoints and partition leases
+// as blobs in a container of an Azure Storage account.
+type AzureEventHubBlobCheckpointStore struct {
+	// Name of the Storage account.
+	AccountName string `json:"accountName"`
+	// Name of the blob container.
+	ContainerName string `json:"containerName"`
+	// Access key of the Storage account. When omitted, the credentials of
+	// the Service Principal set in the source's authentication method are
+	// used instead.
+	// +optional
+	AccountKey *v1alpha1.ValueFromField `json:"accountKey,omitempty"`
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // AzureEventHubSourceList contains a list of event sources.
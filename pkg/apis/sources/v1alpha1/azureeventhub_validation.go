@@ -0,0 +1,75 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"knative.dev/pkg/apis"
+)
+
+// Validate implements apis.Validatable
+func (s *AzureEventHubSource) Validate(ctx context.Context) *apis.FieldError {
+	return s.Spec.Validate(ctx).ViaField("spec")
+}
+
+// Validate AzureEventHubSource spec
+func (s *AzureEventHubSourceSpec) Validate(ctx context.Context) *apis.FieldError {
+	errs := s.SourceSpec.Validate(ctx)
+
+	errs = errs.Also(validateAzureResourceID(s.EventHubID, "eventhubs").ViaField("eventHubID"))
+
+	errs = errs.Also(s.Auth.Validate(ctx).ViaField("auth"))
+
+	if s.ConsumerOptions != nil {
+		errs = errs.Also(s.ConsumerOptions.Validate(ctx).ViaField("consumerOptions"))
+	}
+
+	return errs
+}
+
+// Validate verifies that a start time is set when consumption starts at an
+// enqueued time, and that the checkpoint store is complete.
+func (o *AzureEventHubSourceConsumerOptions) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	if o.StartPosition != nil && *o.StartPosition == AzureEventHubStartPositionEnqueuedTime &&
+		o.StartTime == nil {
+
+		errs = errs.Also(apis.ErrMissingField("startTime"))
+	}
+
+	if o.CheckpointStore != nil && o.CheckpointStore.AzureBlob != nil {
+		errs = errs.Also(o.CheckpointStore.AzureBlob.Validate(ctx).ViaField("checkpointStore", "azureBlob"))
+	}
+
+	return errs
+}
+
+// Validate verifies that the storage container is set.
+func (s *AzureEventHubBlobCheckpointStore) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	if s.AccountName == "" {
+		errs = errs.Also(apis.ErrMissingField("accountName"))
+	}
+	if s.ContainerName == "" {
+		errs = errs.Also(apis.ErrMissingField("containerName"))
+	}
+
+	return errs.Also(s.AccountKey.Validate(ctx).ViaField("accountKey"))
+}
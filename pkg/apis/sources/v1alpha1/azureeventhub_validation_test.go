@@ -0,0 +1,95 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+func TestAzureEventHubSourceValidate(t *testing.T) {
+	testCases := map[string]struct {
+		modify         func(*AzureEventHubSourceSpec)
+		expectErrorMsg string
+	}{
+		"Valid": {},
+		"Without auth": {
+			modify: func(s *AzureEventHubSourceSpec) { s.Auth = AzureAuth{} },
+		},
+		"SAS token": {
+			modify: func(s *AzureEventHubSourceSpec) { s.Auth = tAzureSASTokenAuth() },
+		},
+		"Missing event hub ID": {
+			modify:         func(s *AzureEventHubSourceSpec) { s.EventHubID = AzureResourceID{} },
+			expectErrorMsg: "missing field(s): spec.eventHubID",
+		},
+		"Event hub ID of wrong type": {
+			modify: func(s *AzureEventHubSourceSpec) {
+				s.EventHubID = tAzureResourceID("Microsoft.EventHub", "namespaces", "test-ns")
+			},
+			expectErrorMsg: "invalid value: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/test-rg/providers/Microsoft.EventHub/namespaces/test-ns: spec.eventHubID\n" +
+				"resource ID must refer to a resource of type eventhubs",
+		},
+		"Enqueued time without start time": {
+			modify: func(s *AzureEventHubSourceSpec) {
+				pos := AzureEventHubStartPositionEnqueuedTime
+				s.ConsumerOptions = &AzureEventHubSourceConsumerOptions{
+					StartPosition: &pos,
+				}
+			},
+			expectErrorMsg: "missing field(s): spec.consumerOptions.startTime",
+		},
+		"Incomplete checkpoint store": {
+			modify: func(s *AzureEventHubSourceSpec) {
+				s.ConsumerOptions = &AzureEventHubSourceConsumerOptions{
+					CheckpointStore: &AzureEventHubCheckpointStore{
+						AzureBlob: &AzureEventHubBlobCheckpointStore{
+							AccountName: "test",
+						},
+					},
+				}
+			},
+			expectErrorMsg: "missing field(s): spec.consumerOptions.checkpointStore.azureBlob.containerName",
+		},
+		"Missing sink": {
+			modify:         func(s *AzureEventHubSourceSpec) { s.Sink = duckv1.Destination{} },
+			expectErrorMsg: "expected at least one, got none: spec.sink.ref, spec.sink.uri",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			src := &AzureEventHubSource{Spec: tAzureEventHubSourceSpec()}
+			if tc.modify != nil {
+				tc.modify(&src.Spec)
+			}
+
+			assertFieldError(t, tc.expectErrorMsg, src.Validate(context.Background()))
+		})
+	}
+}
+
+func tAzureEventHubSourceSpec() AzureEventHubSourceSpec {
+	return AzureEventHubSourceSpec{
+		SourceSpec: tSourceSpec(),
+		EventHubID: tAzureNamespacedResourceID("Microsoft.EventHub", "eventhubs", "test-hub"),
+		Auth:       tAzureServicePrincipalAuth(),
+	}
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+func TestAzureIOTHubSourceValidate(t *testing.T) {
+	testCases := map[string]struct {
+		modify         func(*AzureIOTHubSourceSpec)
+		expectErrorMsg string
+	}{
+		"Valid": {},
+		"Missing SAS token": {
+			modify:         func(s *AzureIOTHubSourceSpec) { s.Auth = AzureAuth{} },
+			expectErrorMsg: "missing field(s): spec.auth.sasToken",
+		},
+		"Service principal": {
+			modify:         func(s *AzureIOTHubSourceSpec) { s.Auth = tAzureServicePrincipalAuth() },
+			expectErrorMsg: "must not set the field(s): spec.auth.servicePrincipal",
+		},
+		"SAS token without connection string": {
+			modify:         func(s *AzureIOTHubSourceSpec) { s.Auth.SASToken = &AzureSASToken{KeyName: tSecretValue()} },
+			expectErrorMsg: "expected exactly one, got neither: spec.auth.sasToken.connectionString.value, spec.auth.sasToken.connectionString.valueFromSecret",
+		},
+		"Missing sink": {
+			modify:         func(s *AzureIOTHubSourceSpec) { s.Sink = duckv1.Destination{} },
+			expectErrorMsg: "expected at least one, got none: spec.sink.ref, spec.sink.uri",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			src := &AzureIOTHubSource{Spec: tAzureIOTHubSourceSpec()}
+			if tc.modify != nil {
+				tc.modify(&src.Spec)
+			}
+
+			assertFieldError(t, tc.expectErrorMsg, src.Validate(context.Background()))
+		})
+	}
+}
+
+func tAzureIOTHubSourceSpec() AzureIOTHubSourceSpec {
+	return AzureIOTHubSourceSpec{
+		SourceSpec: tSourceSpec(),
+		Auth:       tAzureSASTokenAuth(),
+	}
+}
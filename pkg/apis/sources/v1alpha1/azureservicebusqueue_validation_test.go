@@ -0,0 +1,89 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/ptr"
+)
+
+func TestAzureServiceBusQueueSourceValidate(t *testing.T) {
+	testCases := map[string]struct {
+		modify         func(*AzureServiceBusQueueSourceSpec)
+		expectErrorMsg string
+	}{
+		"Valid": {},
+		"Missing queue ID": {
+			modify:         func(s *AzureServiceBusQueueSourceSpec) { s.QueueID = AzureResourceID{} },
+			expectErrorMsg: "missing field(s): spec.queueID",
+		},
+		"Queue ID of wrong type": {
+			modify: func(s *AzureServiceBusQueueSourceSpec) {
+				s.QueueID = tAzureResourceID("Microsoft.EventHub", "namespaces", "test-ns")
+			},
+			expectErrorMsg: "invalid value: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/test-rg/providers/Microsoft.EventHub/namespaces/test-ns: spec.queueID\n" +
+				"resource ID must refer to a resource of type queues",
+		},
+		"SAS token": {
+			modify: func(s *AzureServiceBusQueueSourceSpec) { s.Auth = tAzureSASTokenAuth() },
+		},
+		"Zero max concurrency": {
+			modify:         func(s *AzureServiceBusQueueSourceSpec) { s.MaxConcurrency = ptr.Int32(0) },
+			expectErrorMsg: "expected 1 <= 0 <= +Inf: spec.maxConcurrency",
+		},
+		"Negative prefetch count": {
+			modify:         func(s *AzureServiceBusQueueSourceSpec) { s.PrefetchCount = ptr.Int32(-1) },
+			expectErrorMsg: "expected 0 <= -1 <= +Inf: spec.prefetchCount",
+		},
+		"Unsupported settlement action": {
+			modify: func(s *AzureServiceBusQueueSourceSpec) {
+				action := AzureServiceBusSettlementAction("complete")
+				s.Settlement = &AzureServiceBusSettlement{
+					OnFailure: &action,
+				}
+			},
+			expectErrorMsg: "invalid value: complete: spec.settlement.onFailure",
+		},
+		"Missing sink": {
+			modify:         func(s *AzureServiceBusQueueSourceSpec) { s.Sink = duckv1.Destination{} },
+			expectErrorMsg: "expected at least one, got none: spec.sink.ref, spec.sink.uri",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			src := &AzureServiceBusQueueSource{Spec: tAzureServiceBusQueueSourceSpec()}
+			if tc.modify != nil {
+				tc.modify(&src.Spec)
+			}
+
+			assertFieldError(t, tc.expectErrorMsg, src.Validate(context.Background()))
+		})
+	}
+}
+
+func tAzureServiceBusQueueSourceSpec() AzureServiceBusQueueSourceSpec {
+	return AzureServiceBusQueueSourceSpec{
+		SourceSpec: tSourceSpec(),
+		QueueID:    tAzureNamespacedResourceID("Microsoft.ServiceBus", "queues", "test-queue"),
+		Auth:       tAzureServicePrincipalAuth(),
+	}
+}
@@ -58,6 +58,26 @@ type AzureServiceBusTopicSourceSpec struct {
 	// This event source only supports the ServicePrincipal authentication.
 	Auth AzureAuth `json:"auth"`
 
+	// Whether the Service Bus Subscription requires sessions. Messages which belong
+	// to the same session are processed sequentially, in order.
+	// +optional
+	SessionsEnabled *bool `json:"sessionsEnabled,omitempty"`
+
+	// Maximum number of messages processed concurrently. When sessions are
+	// enabled, maximum number of sessions processed concurrently.
+	// Defaults to 1.
+	// +optional
+	MaxConcurrency *int32 `json:"maxConcurrency,omitempty"`
+
+	// Maximum number of messages received from Service Bus in a single
+	// batch. Defaults to 100.
+	// +optional
+	PrefetchCount *int32 `json:"prefetchCount,omitempty"`
+
+	// Settlement of messages which could not be delivered to the sink.
+	// +optional
+	Settlement *AzureServiceBusSettlement `json:"settlement,omitempty"`
+
 	// Adapter spec overrides parameters.
 	// +optional
 	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
@@ -0,0 +1,46 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"knative.dev/pkg/apis"
+)
+
+// Validate implements apis.Validatable
+func (s *AzureServiceBusTopicSource) Validate(ctx context.Context) *apis.FieldError {
+	return s.Spec.Validate(ctx).ViaField("spec")
+}
+
+// Validate AzureServiceBusTopicSource spec
+func (s *AzureServiceBusTopicSourceSpec) Validate(ctx context.Context) *apis.FieldError {
+	errs := s.SourceSpec.Validate(ctx)
+
+	errs = errs.Also(validateAzureResourceID(s.TopicID, "topics").ViaField("topicID"))
+
+	errs = errs.Also(s.Auth.validateServicePrincipal(ctx).ViaField("auth"))
+
+	if s.MaxConcurrency != nil && *s.MaxConcurrency < 1 {
+		errs = errs.Also(apis.ErrOutOfBoundsValue(*s.MaxConcurrency, 1, "+Inf", "maxConcurrency"))
+	}
+	if s.PrefetchCount != nil && *s.PrefetchCount < 0 {
+		errs = errs.Also(apis.ErrOutOfBoundsValue(*s.PrefetchCount, 0, "+Inf", "prefetchCount"))
+	}
+
+	return errs.Also(s.Settlement.Validate(ctx).ViaField("settlement"))
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/ptr"
+)
+
+func TestAzureServiceBusTopicSourceValidate(t *testing.T) {
+	testCases := map[string]struct {
+		modify         func(*AzureServiceBusTopicSourceSpec)
+		expectErrorMsg string
+	}{
+		"Valid": {},
+		"Missing topic ID": {
+			modify:         func(s *AzureServiceBusTopicSourceSpec) { s.TopicID = AzureResourceID{} },
+			expectErrorMsg: "missing field(s): spec.topicID",
+		},
+		"Topic ID of wrong type": {
+			modify: func(s *AzureServiceBusTopicSourceSpec) {
+				s.TopicID = tAzureResourceID("Microsoft.EventHub", "namespaces", "test-ns")
+			},
+			expectErrorMsg: "invalid value: /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/test-rg/providers/Microsoft.EventHub/namespaces/test-ns: spec.topicID\n" +
+				"resource ID must refer to a resource of type topics",
+		},
+		"Missing service principal": {
+			modify:         func(s *AzureServiceBusTopicSourceSpec) { s.Auth = AzureAuth{} },
+			expectErrorMsg: "missing field(s): spec.auth.servicePrincipal",
+		},
+		"SAS token": {
+			modify:         func(s *AzureServiceBusTopicSourceSpec) { s.Auth = tAzureSASTokenAuth() },
+			expectErrorMsg: "must not set the field(s): spec.auth.sasToken",
+		},
+		"Zero max concurrency": {
+			modify:         func(s *AzureServiceBusTopicSourceSpec) { s.MaxConcurrency = ptr.Int32(0) },
+			expectErrorMsg: "expected 1 <= 0 <= +Inf: spec.maxConcurrency",
+		},
+		"Negative prefetch count": {
+			modify:         func(s *AzureServiceBusTopicSourceSpec) { s.PrefetchCount = ptr.Int32(-1) },
+			expectErrorMsg: "expected 0 <= -1 <= +Inf: spec.prefetchCount",
+		},
+		"Unsupported settlement action": {
+			modify: func(s *AzureServiceBusTopicSourceSpec) {
+				action := AzureServiceBusSettlementAction("complete")
+				s.Settlement = &AzureServiceBusSettlement{
+					OnFailure: &action,
+				}
+			},
+			expectErrorMsg: "invalid value: complete: spec.settlement.onFailure",
+		},
+		"Missing sink": {
+			modify:         func(s *AzureServiceBusTopicSourceSpec) { s.Sink = duckv1.Destination{} },
+			expectErrorMsg: "expected at least one, got none: spec.sink.ref, spec.sink.uri",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			src := &AzureServiceBusTopicSource{Spec: tAzureServiceBusTopicSourceSpec()}
+			if tc.modify != nil {
+				tc.modify(&src.Spec)
+			}
+
+			assertFieldError(t, tc.expectErrorMsg, src.Validate(context.Background()))
+		})
+	}
+}
+
+func tAzureServiceBusTopicSourceSpec() AzureServiceBusTopicSourceSpec {
+	return AzureServiceBusTopicSourceSpec{
+		SourceSpec: tSourceSpec(),
+		TopicID:    tAzureNamespacedResourceID("Microsoft.ServiceBus", "topics", "test-topic"),
+		Auth:       tAzureServicePrincipalAuth(),
+	}
+}
@@ -30,11 +30,13 @@ func (s *CloudEventsSource) Validate(ctx context.Context) *apis.FieldError {
 
 // Validate CloudEventsSource spec
 func (s *CloudEventsSourceSpec) Validate(ctx context.Context) *apis.FieldError {
-	if s.Credentials == nil {
-		return nil
+	errs := s.SourceSpec.Validate(ctx)
+
+	if s.Credentials != nil {
+		errs = errs.Also(s.Credentials.Validate(ctx).ViaField("credentials"))
 	}
 
-	return s.Credentials.Validate(ctx).ViaField("credentials")
+	return errs
 }
 
 func (c *HTTPCredentials) Validate(ctx context.Context) *apis.FieldError {
@@ -47,5 +49,12 @@ func (c *HTTPCredentials) Validate(ctx context.Context) *apis.FieldError {
 		}
 	}
 
+	for i, ba := range c.BasicAuths {
+		if ba.Username == "" {
+			errs = errs.Also(apis.ErrMissingField("username").ViaFieldIndex("basicAuths", i))
+		}
+		errs = errs.Also(ba.Password.ValidateRequired(ctx).ViaField("password").ViaFieldIndex("basicAuths", i))
+	}
+
 	return errs
 }
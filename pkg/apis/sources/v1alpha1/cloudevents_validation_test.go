@@ -0,0 +1,74 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+func TestCloudEventsSourceValidate(t *testing.T) {
+	testCases := map[string]struct {
+		modify         func(*CloudEventsSourceSpec)
+		expectErrorMsg string
+	}{
+		"Valid": {},
+		"Without credentials": {
+			modify: func(s *CloudEventsSourceSpec) { s.Credentials = nil },
+		},
+		"Missing username": {
+			modify:         func(s *CloudEventsSourceSpec) { s.Credentials.BasicAuths[0].Username = "" },
+			expectErrorMsg: "missing field(s): spec.credentials.basicAuths[0].username",
+		},
+		"Missing password": {
+			modify:         func(s *CloudEventsSourceSpec) { s.Credentials.BasicAuths[0].Password = v1alpha1.ValueFromField{} },
+			expectErrorMsg: "expected exactly one, got neither: spec.credentials.basicAuths[0].password.value, spec.credentials.basicAuths[0].password.valueFromSecret",
+		},
+		"Missing sink": {
+			modify:         func(s *CloudEventsSourceSpec) { s.Sink = duckv1.Destination{} },
+			expectErrorMsg: "expected at least one, got none: spec.sink.ref, spec.sink.uri",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			src := &CloudEventsSource{Spec: tCloudEventsSourceSpec()}
+			if tc.modify != nil {
+				tc.modify(&src.Spec)
+			}
+
+			assertFieldError(t, tc.expectErrorMsg, src.Validate(context.Background()))
+		})
+	}
+}
+
+func tCloudEventsSourceSpec() CloudEventsSourceSpec {
+	return CloudEventsSourceSpec{
+		SourceSpec: tSourceSpec(),
+		Credentials: &HTTPCredentials{
+			BasicAuths: []HTTPBasicAuth{{
+				Username: "test-user",
+				Password: tSecretValue(),
+			}},
+		},
+	}
+}
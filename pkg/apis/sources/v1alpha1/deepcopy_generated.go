@@ -26,6 +26,7 @@ import (
 	commonv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	pkgapis "knative.dev/pkg/apis"
+	v1 "knative.dev/pkg/apis/duck/v1"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -647,6 +648,11 @@ func (in *AWSDynamoDBSourceSpec) DeepCopyInto(out *AWSDynamoDBSourceSpec) {
 	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
 	out.ARN = in.ARN
 	in.Auth.DeepCopyInto(&out.Auth)
+	if in.InitialPosition != nil {
+		in, out := &in.InitialPosition, &out.InitialPosition
+		*out = new(AWSDynamoDBInitialPosition)
+		**out = **in
+	}
 	if in.AdapterOverrides != nil {
 		in, out := &in.AdapterOverrides, &out.AdapterOverrides
 		*out = new(commonv1alpha1.AdapterOverrides)
@@ -874,6 +880,36 @@ func (in *AWSKinesisSource) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSKinesisSourceConsumerOptions) DeepCopyInto(out *AWSKinesisSourceConsumerOptions) {
+	*out = *in
+	if in.InitialPosition != nil {
+		in, out := &in.InitialPosition, &out.InitialPosition
+		*out = new(AWSKinesisInitialPosition)
+		**out = **in
+	}
+	if in.InitialTimestamp != nil {
+		in, out := &in.InitialTimestamp, &out.InitialTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.ShardDiscoveryInterval != nil {
+		in, out := &in.ShardDiscoveryInterval, &out.ShardDiscoveryInterval
+		*out = new(apis.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSKinesisSourceConsumerOptions.
+func (in *AWSKinesisSourceConsumerOptions) DeepCopy() *AWSKinesisSourceConsumerOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSKinesisSourceConsumerOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AWSKinesisSourceList) DeepCopyInto(out *AWSKinesisSourceList) {
 	*out = *in
@@ -913,6 +949,11 @@ func (in *AWSKinesisSourceSpec) DeepCopyInto(out *AWSKinesisSourceSpec) {
 	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
 	out.ARN = in.ARN
 	in.Auth.DeepCopyInto(&out.Auth)
+	if in.ConsumerOptions != nil {
+		in, out := &in.ConsumerOptions, &out.ConsumerOptions
+		*out = new(AWSKinesisSourceConsumerOptions)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AdapterOverrides != nil {
 		in, out := &in.AdapterOverrides, &out.AdapterOverrides
 		*out = new(commonv1alpha1.AdapterOverrides)
@@ -1326,6 +1367,37 @@ func (in *AWSSQSSource) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSSQSSourceDeliveryOptions) DeepCopyInto(out *AWSSQSSourceDeliveryOptions) {
+	*out = *in
+	if in.RetryBackoff != nil {
+		in, out := &in.RetryBackoff, &out.RetryBackoff
+		*out = new(apis.Duration)
+		**out = **in
+	}
+	if in.MaxReceiveCount != nil {
+		in, out := &in.MaxReceiveCount, &out.MaxReceiveCount
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DeadLetterSink != nil {
+		in, out := &in.DeadLetterSink, &out.DeadLetterSink
+		*out = new(v1.Destination)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSSQSSourceDeliveryOptions.
+func (in *AWSSQSSourceDeliveryOptions) DeepCopy() *AWSSQSSourceDeliveryOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSSQSSourceDeliveryOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AWSSQSSourceList) DeepCopyInto(out *AWSSQSSourceList) {
 	*out = *in
@@ -1367,6 +1439,16 @@ func (in *AWSSQSSourceReceiveOptions) DeepCopyInto(out *AWSSQSSourceReceiveOptio
 		*out = new(apis.Duration)
 		**out = **in
 	}
+	if in.ProcessingWorkers != nil {
+		in, out := &in.ProcessingWorkers, &out.ProcessingWorkers
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PreserveGroupOrder != nil {
+		in, out := &in.PreserveGroupOrder, &out.PreserveGroupOrder
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -1390,6 +1472,11 @@ func (in *AWSSQSSourceSpec) DeepCopyInto(out *AWSSQSSourceSpec) {
 		*out = new(AWSSQSSourceReceiveOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DeliveryOptions != nil {
+		in, out := &in.DeliveryOptions, &out.DeliveryOptions
+		*out = new(AWSSQSSourceDeliveryOptions)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.MessageProcessor != nil {
 		in, out := &in.MessageProcessor, &out.MessageProcessor
 		*out = new(string)
@@ -1419,6 +1506,28 @@ func (in *AWSSQSSourceSpec) DeepCopy() *AWSSQSSourceSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSSQSSourceStatus) DeepCopyInto(out *AWSSQSSourceStatus) {
+	*out = *in
+	in.Status.DeepCopyInto(&out.Status)
+	if in.DeadLetterSinkURI != nil {
+		in, out := &in.DeadLetterSinkURI, &out.DeadLetterSinkURI
+		*out = new(pkgapis.URL)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSSQSSourceStatus.
+func (in *AWSSQSSourceStatus) DeepCopy() *AWSSQSSourceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSSQSSourceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AWSSecurityCredentials) DeepCopyInto(out *AWSSecurityCredentials) {
 	*out = *in
@@ -1884,6 +1993,48 @@ func (in *AzureEventGridSourceStatus) DeepCopy() *AzureEventGridSourceStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureEventHubBlobCheckpointStore) DeepCopyInto(out *AzureEventHubBlobCheckpointStore) {
+	*out = *in
+	if in.AccountKey != nil {
+		in, out := &in.AccountKey, &out.AccountKey
+		*out = new(commonv1alpha1.ValueFromField)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureEventHubBlobCheckpointStore.
+func (in *AzureEventHubBlobCheckpointStore) DeepCopy() *AzureEventHubBlobCheckpointStore {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureEventHubBlobCheckpointStore)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureEventHubCheckpointStore) DeepCopyInto(out *AzureEventHubCheckpointStore) {
+	*out = *in
+	if in.AzureBlob != nil {
+		in, out := &in.AzureBlob, &out.AzureBlob
+		*out = new(AzureEventHubBlobCheckpointStore)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureEventHubCheckpointStore.
+func (in *AzureEventHubCheckpointStore) DeepCopy() *AzureEventHubCheckpointStore {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureEventHubCheckpointStore)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AzureEventHubSource) DeepCopyInto(out *AzureEventHubSource) {
 	*out = *in
@@ -1912,6 +2063,36 @@ func (in *AzureEventHubSource) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureEventHubSourceConsumerOptions) DeepCopyInto(out *AzureEventHubSourceConsumerOptions) {
+	*out = *in
+	if in.StartPosition != nil {
+		in, out := &in.StartPosition, &out.StartPosition
+		*out = new(AzureEventHubStartPosition)
+		**out = **in
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CheckpointStore != nil {
+		in, out := &in.CheckpointStore, &out.CheckpointStore
+		*out = new(AzureEventHubCheckpointStore)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureEventHubSourceConsumerOptions.
+func (in *AzureEventHubSourceConsumerOptions) DeepCopy() *AzureEventHubSourceConsumerOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureEventHubSourceConsumerOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AzureEventHubSourceList) DeepCopyInto(out *AzureEventHubSourceList) {
 	*out = *in
@@ -1951,6 +2132,16 @@ func (in *AzureEventHubSourceSpec) DeepCopyInto(out *AzureEventHubSourceSpec) {
 	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
 	out.EventHubID = in.EventHubID
 	in.Auth.DeepCopyInto(&out.Auth)
+	if in.ConsumerGroup != nil {
+		in, out := &in.ConsumerGroup, &out.ConsumerGroup
+		*out = new(string)
+		**out = **in
+	}
+	if in.ConsumerOptions != nil {
+		in, out := &in.ConsumerOptions, &out.ConsumerOptions
+		*out = new(AzureEventHubSourceConsumerOptions)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AdapterOverrides != nil {
 		in, out := &in.AdapterOverrides, &out.AdapterOverrides
 		*out = new(commonv1alpha1.AdapterOverrides)
@@ -2239,6 +2430,26 @@ func (in *AzureServiceBusQueueSourceSpec) DeepCopyInto(out *AzureServiceBusQueue
 	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
 	out.QueueID = in.QueueID
 	in.Auth.DeepCopyInto(&out.Auth)
+	if in.SessionsEnabled != nil {
+		in, out := &in.SessionsEnabled, &out.SessionsEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MaxConcurrency != nil {
+		in, out := &in.MaxConcurrency, &out.MaxConcurrency
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PrefetchCount != nil {
+		in, out := &in.PrefetchCount, &out.PrefetchCount
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Settlement != nil {
+		in, out := &in.Settlement, &out.Settlement
+		*out = new(AzureServiceBusSettlement)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AdapterOverrides != nil {
 		in, out := &in.AdapterOverrides, &out.AdapterOverrides
 		*out = new(commonv1alpha1.AdapterOverrides)
@@ -2257,6 +2468,32 @@ func (in *AzureServiceBusQueueSourceSpec) DeepCopy() *AzureServiceBusQueueSource
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureServiceBusSettlement) DeepCopyInto(out *AzureServiceBusSettlement) {
+	*out = *in
+	if in.OnFailure != nil {
+		in, out := &in.OnFailure, &out.OnFailure
+		*out = new(AzureServiceBusSettlementAction)
+		**out = **in
+	}
+	if in.MaxDeliveryAttempts != nil {
+		in, out := &in.MaxDeliveryAttempts, &out.MaxDeliveryAttempts
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureServiceBusSettlement.
+func (in *AzureServiceBusSettlement) DeepCopy() *AzureServiceBusSettlement {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureServiceBusSettlement)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AzureServiceBusTopicSource) DeepCopyInto(out *AzureServiceBusTopicSource) {
 	*out = *in
@@ -2324,6 +2561,26 @@ func (in *AzureServiceBusTopicSourceSpec) DeepCopyInto(out *AzureServiceBusTopic
 	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
 	out.TopicID = in.TopicID
 	in.Auth.DeepCopyInto(&out.Auth)
+	if in.SessionsEnabled != nil {
+		in, out := &in.SessionsEnabled, &out.SessionsEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MaxConcurrency != nil {
+		in, out := &in.MaxConcurrency, &out.MaxConcurrency
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PrefetchCount != nil {
+		in, out := &in.PrefetchCount, &out.PrefetchCount
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Settlement != nil {
+		in, out := &in.Settlement, &out.Settlement
+		*out = new(AzureServiceBusSettlement)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AdapterOverrides != nil {
 		in, out := &in.AdapterOverrides, &out.AdapterOverrides
 		*out = new(commonv1alpha1.AdapterOverrides)
@@ -3513,6 +3770,124 @@ func (in *IBMMQSourceSpec) DeepCopy() *IBMMQSourceSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaSource) DeepCopyInto(out *KafkaSource) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSource.
+func (in *KafkaSource) DeepCopy() *KafkaSource {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaSource) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaSourceList) DeepCopyInto(out *KafkaSourceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KafkaSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSourceList.
+func (in *KafkaSourceList) DeepCopy() *KafkaSourceList {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaSourceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaSourceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaSourceSpec) DeepCopyInto(out *KafkaSourceSpec) {
+	*out = *in
+	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
+	if in.BootstrapServers != nil {
+		in, out := &in.BootstrapServers, &out.BootstrapServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Topics != nil {
+		in, out := &in.Topics, &out.Topics
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.InitialOffset != nil {
+		in, out := &in.InitialOffset, &out.InitialOffset
+		*out = new(KafkaSourceInitialOffset)
+		**out = **in
+	}
+	if in.SASLUsername != nil {
+		in, out := &in.SASLUsername, &out.SASLUsername
+		*out = new(string)
+		**out = **in
+	}
+	if in.SASLPassword != nil {
+		in, out := &in.SASLPassword, &out.SASLPassword
+		*out = new(commonv1alpha1.ValueFromField)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecurityProtocol != nil {
+		in, out := &in.SecurityProtocol, &out.SecurityProtocol
+		*out = new(string)
+		**out = **in
+	}
+	if in.SASLMechanisms != nil {
+		in, out := &in.SASLMechanisms, &out.SASLMechanisms
+		*out = new(string)
+		**out = **in
+	}
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(commonv1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSourceSpec.
+func (in *KafkaSourceSpec) DeepCopy() *KafkaSourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaSourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Keystore) DeepCopyInto(out *Keystore) {
 	*out = *in
@@ -3777,6 +4152,13 @@ func (in *SalesforceSourceSpec) DeepCopyInto(out *SalesforceSourceSpec) {
 		**out = **in
 	}
 	in.Subscription.DeepCopyInto(&out.Subscription)
+	if in.Subscriptions != nil {
+		in, out := &in.Subscriptions, &out.Subscriptions
+		*out = make([]SalesforceSubscription, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.AdapterOverrides != nil {
 		in, out := &in.AdapterOverrides, &out.AdapterOverrides
 		*out = new(commonv1alpha1.AdapterOverrides)
@@ -3803,6 +4185,11 @@ func (in *SalesforceSubscription) DeepCopyInto(out *SalesforceSubscription) {
 		*out = new(int)
 		**out = **in
 	}
+	if in.EventType != nil {
+		in, out := &in.EventType, &out.EventType
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -4014,6 +4401,109 @@ func (in *TwilioSourceSpec) DeepCopy() *TwilioSourceSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookExtensionMapping) DeepCopyInto(out *WebhookExtensionMapping) {
+	*out = *in
+	in.WebhookValueSource.DeepCopyInto(&out.WebhookValueSource)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookExtensionMapping.
+func (in *WebhookExtensionMapping) DeepCopy() *WebhookExtensionMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookExtensionMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookMapping) DeepCopyInto(out *WebhookMapping) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(WebhookValueSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Subject != nil {
+		in, out := &in.Subject, &out.Subject
+		*out = new(WebhookValueSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(WebhookValueSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Extensions != nil {
+		in, out := &in.Extensions, &out.Extensions
+		*out = make([]WebhookExtensionMapping, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookMapping.
+func (in *WebhookMapping) DeepCopy() *WebhookMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookSignature) DeepCopyInto(out *WebhookSignature) {
+	*out = *in
+	in.Secret.DeepCopyInto(&out.Secret)
+	if in.Prefix != nil {
+		in, out := &in.Prefix, &out.Prefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.Encoding != nil {
+		in, out := &in.Encoding, &out.Encoding
+		*out = new(WebhookSignatureEncoding)
+		**out = **in
+	}
+	if in.Timestamp != nil {
+		in, out := &in.Timestamp, &out.Timestamp
+		*out = new(WebhookSignatureTimestamp)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookSignature.
+func (in *WebhookSignature) DeepCopy() *WebhookSignature {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookSignature)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookSignatureTimestamp) DeepCopyInto(out *WebhookSignatureTimestamp) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookSignatureTimestamp.
+func (in *WebhookSignatureTimestamp) DeepCopy() *WebhookSignatureTimestamp {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookSignatureTimestamp)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WebhookSource) DeepCopyInto(out *WebhookSource) {
 	*out = *in
@@ -4094,6 +4584,16 @@ func (in *WebhookSourceSpec) DeepCopyInto(out *WebhookSourceSpec) {
 		*out = new(commonv1alpha1.ValueFromField)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Signature != nil {
+		in, out := &in.Signature, &out.Signature
+		*out = new(WebhookSignature)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Mapping != nil {
+		in, out := &in.Mapping, &out.Mapping
+		*out = new(WebhookMapping)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.CORSAllowOrigin != nil {
 		in, out := &in.CORSAllowOrigin, &out.CORSAllowOrigin
 		*out = new(string)
@@ -4117,6 +4617,47 @@ func (in *WebhookSourceSpec) DeepCopy() *WebhookSourceSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookValueSource) DeepCopyInto(out *WebhookValueSource) {
+	*out = *in
+	if in.Header != nil {
+		in, out := &in.Header, &out.Header
+		*out = new(string)
+		**out = **in
+	}
+	if in.Query != nil {
+		in, out := &in.Query, &out.Query
+		*out = new(string)
+		**out = **in
+	}
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Method != nil {
+		in, out := &in.Method, &out.Method
+		*out = new(bool)
+		**out = **in
+	}
+	if in.BodyPath != nil {
+		in, out := &in.BodyPath, &out.BodyPath
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookValueSource.
+func (in *WebhookValueSource) DeepCopy() *WebhookValueSource {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookValueSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ZendeskSource) DeepCopyInto(out *ZendeskSource) {
 	*out = *in
@@ -0,0 +1,63 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"knative.dev/pkg/apis"
+)
+
+// Validate verifies that no more than one of a Pub/Sub topic or a project is
+// set.
+func (s *GoogleCloudSourcePubSubSpec) Validate(_ context.Context) *apis.FieldError {
+	switch {
+	case s.Topic != nil && s.Project != nil:
+		return apis.ErrMultipleOneOf("topic", "project")
+	case s.Topic != nil:
+		return validateGCloudResourceName(*s.Topic, "topics").ViaField("topic")
+	case s.Project != nil && *s.Project == "":
+		return apis.ErrMissingField("project")
+	}
+
+	return nil
+}
+
+// ValidateRequired verifies that exactly one of a Pub/Sub topic or a project
+// is set.
+func (s *GoogleCloudSourcePubSubSpec) ValidateRequired(ctx context.Context) *apis.FieldError {
+	if s.Topic == nil && s.Project == nil {
+		return apis.ErrMissingOneOf("topic", "project")
+	}
+
+	return s.Validate(ctx)
+}
+
+// validateGCloudResourceName verifies that the given resource name is set and
+// refers to a resource of the given collection.
+func validateGCloudResourceName(n GCloudResourceName, collection string) *apis.FieldError {
+	if n == (GCloudResourceName{}) {
+		return apis.ErrMissingField(apis.CurrentField)
+	}
+
+	if n.Collection != collection {
+		return apis.ErrInvalidValue(n.String(), apis.CurrentField,
+			"resource name must refer to a resource of the "+collection+" collection")
+	}
+
+	return nil
+}
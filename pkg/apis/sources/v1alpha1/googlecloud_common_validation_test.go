@@ -0,0 +1,90 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"knative.dev/pkg/apis"
+	"knative.dev/pkg/ptr"
+)
+
+func TestGoogleCloudSourcePubSubSpecValidate(t *testing.T) {
+	testCases := map[string]struct {
+		spec           GoogleCloudSourcePubSubSpec
+		required       bool
+		expectErrorMsg string
+	}{
+		"Empty, optional": {
+			spec: GoogleCloudSourcePubSubSpec{},
+		},
+		"Empty, required": {
+			spec:           GoogleCloudSourcePubSubSpec{},
+			required:       true,
+			expectErrorMsg: "expected exactly one, got neither: pubsub.project, pubsub.topic",
+		},
+		"Topic": {
+			spec:     GoogleCloudSourcePubSubSpec{Topic: tGCloudResourceName("topics", "test-topic")},
+			required: true,
+		},
+		"Project": {
+			spec:     GoogleCloudSourcePubSubSpec{Project: ptr.String("test-project")},
+			required: true,
+		},
+		"Topic and project": {
+			spec: GoogleCloudSourcePubSubSpec{
+				Topic:   tGCloudResourceName("topics", "test-topic"),
+				Project: ptr.String("test-project"),
+			},
+			expectErrorMsg: "expected exactly one, got both: pubsub.project, pubsub.topic",
+		},
+		"Empty project": {
+			spec:           GoogleCloudSourcePubSubSpec{Project: ptr.String("")},
+			expectErrorMsg: "missing field(s): pubsub.project",
+		},
+		"Topic of wrong collection": {
+			spec: GoogleCloudSourcePubSubSpec{Topic: tGCloudResourceName("subscriptions", "test-sub")},
+			expectErrorMsg: "invalid value: projects/test-project/subscriptions/test-sub: pubsub.topic\n" +
+				"resource name must refer to a resource of the topics collection",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			var err *apis.FieldError
+			if tc.required {
+				err = tc.spec.ValidateRequired(context.Background())
+			} else {
+				err = tc.spec.Validate(context.Background())
+			}
+
+			assertFieldError(t, tc.expectErrorMsg, err.ViaField("pubsub"))
+		})
+	}
+}
+
+// tGCloudResourceName returns the name of a Google Cloud resource of the
+// given collection.
+func tGCloudResourceName(collection, resource string) *GCloudResourceName {
+	return &GCloudResourceName{
+		Project:    "test-project",
+		Collection: collection,
+		Resource:   resource,
+	}
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/ptr"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+func TestGoogleCloudAuditLogsSourceValidate(t *testing.T) {
+	testCases := map[string]struct {
+		modify         func(*GoogleCloudAuditLogsSourceSpec)
+		expectErrorMsg string
+	}{
+		"Valid": {},
+		"Missing service name": {
+			modify:         func(s *GoogleCloudAuditLogsSourceSpec) { s.ServiceName = "" },
+			expectErrorMsg: "missing field(s): spec.serviceName",
+		},
+		"Missing method name": {
+			modify:         func(s *GoogleCloudAuditLogsSourceSpec) { s.MethodName = "" },
+			expectErrorMsg: "missing field(s): spec.methodName",
+		},
+		"Missing Pub/Sub settings": {
+			modify:         func(s *GoogleCloudAuditLogsSourceSpec) { s.PubSub = GoogleCloudSourcePubSubSpec{} },
+			expectErrorMsg: "expected exactly one, got neither: spec.pubsub.project, spec.pubsub.topic",
+		},
+		"Invalid Pub/Sub settings": {
+			modify:         func(s *GoogleCloudAuditLogsSourceSpec) { s.PubSub.Topic = tGCloudResourceName("topics", "test-topic") },
+			expectErrorMsg: "expected exactly one, got both: spec.pubsub.project, spec.pubsub.topic",
+		},
+		"Missing service account key": {
+			modify:         func(s *GoogleCloudAuditLogsSourceSpec) { s.ServiceAccountKey = v1alpha1.ValueFromField{} },
+			expectErrorMsg: "expected exactly one, got neither: spec.serviceAccountKey.value, spec.serviceAccountKey.valueFromSecret",
+		},
+		"Missing sink": {
+			modify:         func(s *GoogleCloudAuditLogsSourceSpec) { s.Sink = duckv1.Destination{} },
+			expectErrorMsg: "expected at least one, got none: spec.sink.ref, spec.sink.uri",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			src := &GoogleCloudAuditLogsSource{Spec: tGoogleCloudAuditLogsSourceSpec()}
+			if tc.modify != nil {
+				tc.modify(&src.Spec)
+			}
+
+			assertFieldError(t, tc.expectErrorMsg, src.Validate(context.Background()))
+		})
+	}
+}
+
+func tGoogleCloudAuditLogsSourceSpec() GoogleCloudAuditLogsSourceSpec {
+	return GoogleCloudAuditLogsSourceSpec{
+		SourceSpec:  tSourceSpec(),
+		ServiceName: "pubsub.googleapis.com",
+		MethodName:  "google.pubsub.v1.Publisher.CreateTopic",
+		PubSub: GoogleCloudSourcePubSubSpec{
+			Project: ptr.String("test-project"),
+		},
+		ServiceAccountKey: tSecretValue(),
+	}
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/ptr"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+func TestGoogleCloudBillingSourceValidate(t *testing.T) {
+	testCases := map[string]struct {
+		modify         func(*GoogleCloudBillingSourceSpec)
+		expectErrorMsg string
+	}{
+		"Valid": {},
+		"Missing billing account ID": {
+			modify:         func(s *GoogleCloudBillingSourceSpec) { s.BillingAccountID = "" },
+			expectErrorMsg: "missing field(s): spec.billingAccountId",
+		},
+		"Missing budget ID": {
+			modify:         func(s *GoogleCloudBillingSourceSpec) { s.BudgetID = "" },
+			expectErrorMsg: "missing field(s): spec.budgetId",
+		},
+		"Missing Pub/Sub settings": {
+			modify:         func(s *GoogleCloudBillingSourceSpec) { s.PubSub = GoogleCloudSourcePubSubSpec{} },
+			expectErrorMsg: "expected exactly one, got neither: spec.pubsub.project, spec.pubsub.topic",
+		},
+		"Missing service account key": {
+			modify:         func(s *GoogleCloudBillingSourceSpec) { s.ServiceAccountKey = v1alpha1.ValueFromField{} },
+			expectErrorMsg: "expected exactly one, got neither: spec.serviceAccountKey.value, spec.serviceAccountKey.valueFromSecret",
+		},
+		"Missing sink": {
+			modify:         func(s *GoogleCloudBillingSourceSpec) { s.Sink = duckv1.Destination{} },
+			expectErrorMsg: "expected at least one, got none: spec.sink.ref, spec.sink.uri",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			src := &GoogleCloudBillingSource{Spec: tGoogleCloudBillingSourceSpec()}
+			if tc.modify != nil {
+				tc.modify(&src.Spec)
+			}
+
+			assertFieldError(t, tc.expectErrorMsg, src.Validate(context.Background()))
+		})
+	}
+}
+
+func tGoogleCloudBillingSourceSpec() GoogleCloudBillingSourceSpec {
+	return GoogleCloudBillingSourceSpec{
+		SourceSpec:       tSourceSpec(),
+		BillingAccountID: "000000-000000-000000",
+		BudgetID:         "00000000-0000-0000-0000-000000000000",
+		PubSub: GoogleCloudSourcePubSubSpec{
+			Project: ptr.String("test-project"),
+		},
+		ServiceAccountKey: tSecretValue(),
+	}
+}
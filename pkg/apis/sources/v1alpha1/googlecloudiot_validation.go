@@ -0,0 +1,41 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"knative.dev/pkg/apis"
+)
+
+// Validate implements apis.Validatable
+func (s *GoogleCloudIoTSource) Validate(ctx context.Context) *apis.FieldError {
+	return s.Spec.Validate(ctx).ViaField("spec")
+}
+
+// Validate GoogleCloudIoTSource spec
+func (s *GoogleCloudIoTSourceSpec) Validate(ctx context.Context) *apis.FieldError {
+	errs := s.SourceSpec.Validate(ctx)
+
+	if s.Registry == (GCloudIoTResourceName{}) {
+		errs = errs.Also(apis.ErrMissingField("registry"))
+	}
+
+	errs = errs.Also(s.PubSub.Validate(ctx).ViaField("pubsub"))
+
+	return errs.Also(s.ServiceAccountKey.ValidateRequired(ctx).ViaField("serviceAccountKey"))
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/ptr"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+func TestGoogleCloudIoTSourceValidate(t *testing.T) {
+	testCases := map[string]struct {
+		modify         func(*GoogleCloudIoTSourceSpec)
+		expectErrorMsg string
+	}{
+		"Valid": {},
+		"Without Pub/Sub settings": {
+			modify: func(s *GoogleCloudIoTSourceSpec) { s.PubSub = GoogleCloudSourcePubSubSpec{} },
+		},
+		"Missing registry": {
+			modify:         func(s *GoogleCloudIoTSourceSpec) { s.Registry = GCloudIoTResourceName{} },
+			expectErrorMsg: "missing field(s): spec.registry",
+		},
+		"Invalid Pub/Sub settings": {
+			modify:         func(s *GoogleCloudIoTSourceSpec) { s.PubSub.Topic = tGCloudResourceName("topics", "test-topic") },
+			expectErrorMsg: "expected exactly one, got both: spec.pubsub.project, spec.pubsub.topic",
+		},
+		"Missing service account key": {
+			modify:         func(s *GoogleCloudIoTSourceSpec) { s.ServiceAccountKey = v1alpha1.ValueFromField{} },
+			expectErrorMsg: "expected exactly one, got neither: spec.serviceAccountKey.value, spec.serviceAccountKey.valueFromSecret",
+		},
+		"Missing sink": {
+			modify:         func(s *GoogleCloudIoTSourceSpec) { s.Sink = duckv1.Destination{} },
+			expectErrorMsg: "expected at least one, got none: spec.sink.ref, spec.sink.uri",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			src := &GoogleCloudIoTSource{Spec: tGoogleCloudIoTSourceSpec()}
+			if tc.modify != nil {
+				tc.modify(&src.Spec)
+			}
+
+			assertFieldError(t, tc.expectErrorMsg, src.Validate(context.Background()))
+		})
+	}
+}
+
+func tGoogleCloudIoTSourceSpec() GoogleCloudIoTSourceSpec {
+	return GoogleCloudIoTSourceSpec{
+		SourceSpec: tSourceSpec(),
+		Registry: GCloudIoTResourceName{
+			Project:    "test-project",
+			Location:   "us-central1",
+			Collection: "registries",
+			Resource:   "test-registry",
+		},
+		PubSub: GoogleCloudSourcePubSubSpec{
+			Project: ptr.String("test-project"),
+		},
+		ServiceAccountKey: tSecretValue(),
+	}
+}
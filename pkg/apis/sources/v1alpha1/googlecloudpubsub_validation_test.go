@@ -0,0 +1,72 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+func TestGoogleCloudPubSubSourceValidate(t *testing.T) {
+	testCases := map[string]struct {
+		modify         func(*GoogleCloudPubSubSourceSpec)
+		expectErrorMsg string
+	}{
+		"Valid": {},
+		"Missing topic": {
+			modify:         func(s *GoogleCloudPubSubSourceSpec) { s.Topic = GCloudResourceName{} },
+			expectErrorMsg: "missing field(s): spec.topic",
+		},
+		"Topic of wrong collection": {
+			modify: func(s *GoogleCloudPubSubSourceSpec) { s.Topic = *tGCloudResourceName("subscriptions", "test-sub") },
+			expectErrorMsg: "invalid value: projects/test-project/subscriptions/test-sub: spec.topic\n" +
+				"resource name must refer to a resource of the topics collection",
+		},
+		"Missing service account key": {
+			modify:         func(s *GoogleCloudPubSubSourceSpec) { s.ServiceAccountKey = v1alpha1.ValueFromField{} },
+			expectErrorMsg: "expected exactly one, got neither: spec.serviceAccountKey.value, spec.serviceAccountKey.valueFromSecret",
+		},
+		"Missing sink": {
+			modify:         func(s *GoogleCloudPubSubSourceSpec) { s.Sink = duckv1.Destination{} },
+			expectErrorMsg: "expected at least one, got none: spec.sink.ref, spec.sink.uri",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			src := &GoogleCloudPubSubSource{Spec: tGoogleCloudPubSubSourceSpec()}
+			if tc.modify != nil {
+				tc.modify(&src.Spec)
+			}
+
+			assertFieldError(t, tc.expectErrorMsg, src.Validate(context.Background()))
+		})
+	}
+}
+
+func tGoogleCloudPubSubSourceSpec() GoogleCloudPubSubSourceSpec {
+	return GoogleCloudPubSubSourceSpec{
+		SourceSpec:        tSourceSpec(),
+		Topic:             *tGCloudResourceName("topics", "test-topic"),
+		ServiceAccountKey: tSecretValue(),
+	}
+}
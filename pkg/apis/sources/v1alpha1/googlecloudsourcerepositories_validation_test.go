@@ -0,0 +1,79 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/ptr"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+func TestGoogleCloudSourceRepositoriesSourceValidate(t *testing.T) {
+	testCases := map[string]struct {
+		modify         func(*GoogleCloudSourceRepositoriesSourceSpec)
+		expectErrorMsg string
+	}{
+		"Valid": {},
+		"Missing repository": {
+			modify:         func(s *GoogleCloudSourceRepositoriesSourceSpec) { s.Repository = GCloudResourceName{} },
+			expectErrorMsg: "missing field(s): spec.repository",
+		},
+		"Repository of wrong collection": {
+			modify: func(s *GoogleCloudSourceRepositoriesSourceSpec) {
+				s.Repository = *tGCloudResourceName("topics", "test-topic")
+			},
+			expectErrorMsg: "invalid value: projects/test-project/topics/test-topic: spec.repository\n" +
+				"resource name must refer to a resource of the repos collection",
+		},
+		"Invalid Pub/Sub settings": {
+			modify:         func(s *GoogleCloudSourceRepositoriesSourceSpec) { s.PubSub.Project = ptr.String("") },
+			expectErrorMsg: "missing field(s): spec.pubsub.project",
+		},
+		"Missing service account key": {
+			modify:         func(s *GoogleCloudSourceRepositoriesSourceSpec) { s.ServiceAccountKey = v1alpha1.ValueFromField{} },
+			expectErrorMsg: "expected exactly one, got neither: spec.serviceAccountKey.value, spec.serviceAccountKey.valueFromSecret",
+		},
+		"Missing sink": {
+			modify:         func(s *GoogleCloudSourceRepositoriesSourceSpec) { s.Sink = duckv1.Destination{} },
+			expectErrorMsg: "expected at least one, got none: spec.sink.ref, spec.sink.uri",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			src := &GoogleCloudSourceRepositoriesSource{Spec: tGoogleCloudSourceRepositoriesSourceSpec()}
+			if tc.modify != nil {
+				tc.modify(&src.Spec)
+			}
+
+			assertFieldError(t, tc.expectErrorMsg, src.Validate(context.Background()))
+		})
+	}
+}
+
+func tGoogleCloudSourceRepositoriesSourceSpec() GoogleCloudSourceRepositoriesSourceSpec {
+	return GoogleCloudSourceRepositoriesSourceSpec{
+		SourceSpec:        tSourceSpec(),
+		Repository:        *tGCloudResourceName("repos", "test-repo"),
+		ServiceAccountKey: tSecretValue(),
+	}
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+func TestGoogleCloudStorageSourceValidate(t *testing.T) {
+	testCases := map[string]struct {
+		modify         func(*GoogleCloudStorageSourceSpec)
+		expectErrorMsg string
+	}{
+		"Valid": {},
+		"Missing bucket": {
+			modify:         func(s *GoogleCloudStorageSourceSpec) { s.Bucket = "" },
+			expectErrorMsg: "missing field(s): spec.bucket",
+		},
+		"Missing Pub/Sub settings": {
+			modify:         func(s *GoogleCloudStorageSourceSpec) { s.PubSub = GoogleCloudSourcePubSubSpec{} },
+			expectErrorMsg: "expected exactly one, got neither: spec.pubsub.project, spec.pubsub.topic",
+		},
+		"Missing service account key": {
+			modify:         func(s *GoogleCloudStorageSourceSpec) { s.ServiceAccountKey = v1alpha1.ValueFromField{} },
+			expectErrorMsg: "expected exactly one, got neither: spec.serviceAccountKey.value, spec.serviceAccountKey.valueFromSecret",
+		},
+		"Missing sink": {
+			modify:         func(s *GoogleCloudStorageSourceSpec) { s.Sink = duckv1.Destination{} },
+			expectErrorMsg: "expected at least one, got none: spec.sink.ref, spec.sink.uri",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			src := &GoogleCloudStorageSource{Spec: tGoogleCloudStorageSourceSpec()}
+			if tc.modify != nil {
+				tc.modify(&src.Spec)
+			}
+
+			assertFieldError(t, tc.expectErrorMsg, src.Validate(context.Background()))
+		})
+	}
+}
+
+func tGoogleCloudStorageSourceSpec() GoogleCloudStorageSourceSpec {
+	return GoogleCloudStorageSourceSpec{
+		SourceSpec: tSourceSpec(),
+		Bucket:     "test-bucket",
+		PubSub: GoogleCloudSourcePubSubSpec{
+			Topic: tGCloudResourceName("topics", "test-topic"),
+		},
+		ServiceAccountKey: tSecretValue(),
+	}
+}
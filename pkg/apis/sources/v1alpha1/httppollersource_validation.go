@@ -0,0 +1,50 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	"knative.dev/pkg/apis"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+// Validate implements apis.Validatable
+func (s *HTTPPollerSource) Validate(ctx context.Context) *apis.FieldError {
+	return s.Spec.Validate(ctx).ViaField("spec")
+}
+
+// Validate HTTPPollerSource spec
+func (s *HTTPPollerSourceSpec) Validate(ctx context.Context) *apis.FieldError {
+	errs := s.SourceSpec.Validate(ctx)
+
+	if s.EventType == "" {
+		errs = errs.Also(apis.ErrMissingField("eventType"))
+	}
+
+	errs = errs.Also(v1alpha1.ValidateURL(&s.Endpoint).ViaField("endpoint"))
+
+	if s.Method == "" {
+		errs = errs.Also(apis.ErrMissingField("method"))
+	}
+
+	errs = errs.Also(s.BasicAuthPassword.Validate(ctx).ViaField("basicAuthPassword"))
+
+	return errs.Also(v1alpha1.ValidatePositiveDuration(time.Duration(s.Interval)).ViaField("interval"))
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	tmapis "github.com/triggermesh/triggermesh/pkg/apis"
+)
+
+func TestHTTPPollerSourceValidate(t *testing.T) {
+	testCases := map[string]struct {
+		modify         func(*HTTPPollerSourceSpec)
+		expectErrorMsg string
+	}{
+		"Valid": {},
+		"Missing event type": {
+			modify:         func(s *HTTPPollerSourceSpec) { s.EventType = "" },
+			expectErrorMsg: "missing field(s): spec.eventType",
+		},
+		"Missing endpoint": {
+			modify:         func(s *HTTPPollerSourceSpec) { s.Endpoint = apis.URL{} },
+			expectErrorMsg: "missing field(s): spec.endpoint",
+		},
+		"Relative endpoint": {
+			modify: func(s *HTTPPollerSourceSpec) { s.Endpoint = apis.URL{Path: "/poll"} },
+			expectErrorMsg: "invalid value: /poll: spec.endpoint\n" +
+				"URL must be absolute and include a host",
+		},
+		"Missing method": {
+			modify:         func(s *HTTPPollerSourceSpec) { s.Method = "" },
+			expectErrorMsg: "missing field(s): spec.method",
+		},
+		"Incomplete password reference": {
+			modify: func(s *HTTPPollerSourceSpec) {
+				p := tSecretValue()
+				p.ValueFromSecret.Key = ""
+				s.BasicAuthPassword = &p
+			},
+			expectErrorMsg: "missing field(s): spec.basicAuthPassword.valueFromSecret.key",
+		},
+		"Missing interval": {
+			modify: func(s *HTTPPollerSourceSpec) { s.Interval = 0 },
+			expectErrorMsg: "invalid value: 0s: spec.interval\n" +
+				"duration must be greater than zero",
+		},
+		"Missing sink": {
+			modify:         func(s *HTTPPollerSourceSpec) { s.Sink = duckv1.Destination{} },
+			expectErrorMsg: "expected at least one, got none: spec.sink.ref, spec.sink.uri",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			src := &HTTPPollerSource{Spec: tHTTPPollerSourceSpec()}
+			if tc.modify != nil {
+				tc.modify(&src.Spec)
+			}
+
+			assertFieldError(t, tc.expectErrorMsg, src.Validate(context.Background()))
+		})
+	}
+}
+
+func tHTTPPollerSourceSpec() HTTPPollerSourceSpec {
+	return HTTPPollerSourceSpec{
+		SourceSpec: tSourceSpec(),
+		EventType:  "com.example.poll",
+		Endpoint:   *apis.HTTP("example.com"),
+		Method:     "GET",
+		Interval:   tmapis.Duration(time.Minute),
+	}
+}
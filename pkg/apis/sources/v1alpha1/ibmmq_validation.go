@@ -0,0 +1,74 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"knative.dev/pkg/apis"
+)
+
+// Validate implements apis.Validatable
+func (s *IBMMQSource) Validate(ctx context.Context) *apis.FieldError {
+	return s.Spec.Validate(ctx).ViaField("spec")
+}
+
+// Validate IBMMQSource spec
+func (s *IBMMQSourceSpec) Validate(ctx context.Context) *apis.FieldError {
+	errs := s.SourceSpec.Validate(ctx)
+
+	if s.ConnectionName == "" {
+		errs = errs.Also(apis.ErrMissingField("connectionName"))
+	}
+	if s.QueueManager == "" {
+		errs = errs.Also(apis.ErrMissingField("queueManager"))
+	}
+	if s.QueueName == "" {
+		errs = errs.Also(apis.ErrMissingField("queueName"))
+	}
+	if s.ChannelName == "" {
+		errs = errs.Also(apis.ErrMissingField("channelName"))
+	}
+
+	if s.Delivery.Retry < 0 {
+		errs = errs.Also(apis.ErrOutOfBoundsValue(s.Delivery.Retry, 0, "+Inf", "retry").ViaField("delivery"))
+	}
+	if s.Delivery.BackoffDelay < 0 {
+		errs = errs.Also(apis.ErrOutOfBoundsValue(s.Delivery.BackoffDelay, 0, "+Inf", "backoffDelay").ViaField("delivery"))
+	}
+
+	return errs.Also(s.Auth.Validate(ctx).ViaField("credentials"))
+}
+
+// Validate verifies that the credentials and the TLS key repository are
+// complete.
+func (c *Credentials) Validate(ctx context.Context) *apis.FieldError {
+	errs := c.User.Validate(ctx).ViaField("username").
+		Also(c.Password.Validate(ctx).ViaField("password"))
+
+	if c.TLS != nil {
+		if c.TLS.Cipher == "" {
+			errs = errs.Also(apis.ErrMissingField("cipher").ViaField("tls"))
+		}
+		errs = errs.Also(c.TLS.KeyRepository.KeyDatabase.ValidateRequired(ctx).
+			ViaField("tls", "keyRepository", "keyDatabase"))
+		errs = errs.Also(c.TLS.KeyRepository.PasswordStash.ValidateRequired(ctx).
+			ViaField("tls", "keyRepository", "passwordStash"))
+	}
+
+	return errs
+}
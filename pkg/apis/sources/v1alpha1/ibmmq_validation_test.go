@@ -0,0 +1,99 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+func TestIBMMQSourceValidate(t *testing.T) {
+	testCases := map[string]struct {
+		modify         func(*IBMMQSourceSpec)
+		expectErrorMsg string
+	}{
+		"Valid": {},
+		"Missing connection name": {
+			modify:         func(s *IBMMQSourceSpec) { s.ConnectionName = "" },
+			expectErrorMsg: "missing field(s): spec.connectionName",
+		},
+		"Missing queue manager and name": {
+			modify: func(s *IBMMQSourceSpec) {
+				s.QueueManager = ""
+				s.QueueName = ""
+			},
+			expectErrorMsg: "missing field(s): spec.queueManager, spec.queueName",
+		},
+		"Missing channel name": {
+			modify:         func(s *IBMMQSourceSpec) { s.ChannelName = "" },
+			expectErrorMsg: "missing field(s): spec.channelName",
+		},
+		"Negative retry": {
+			modify:         func(s *IBMMQSourceSpec) { s.Delivery.Retry = -1 },
+			expectErrorMsg: "expected 0 <= -1 <= +Inf: spec.delivery.retry",
+		},
+		"Username from value and secret": {
+			modify:         func(s *IBMMQSourceSpec) { s.Auth.User.ValueFromSecret = tSecretValue().ValueFromSecret },
+			expectErrorMsg: "expected exactly one, got both: spec.credentials.username.value, spec.credentials.username.valueFromSecret",
+		},
+		"TLS without key repository": {
+			modify: func(s *IBMMQSourceSpec) {
+				s.Auth.TLS = &TLSSpec{
+					Cipher: "TLS_RSA_WITH_AES_128_CBC_SHA256",
+					KeyRepository: Keystore{
+						KeyDatabase: tSecretValue(),
+					},
+				}
+			},
+			expectErrorMsg: "expected exactly one, got neither: spec.credentials.tls.keyRepository.passwordStash.value, spec.credentials.tls.keyRepository.passwordStash.valueFromSecret",
+		},
+		"Missing sink": {
+			modify:         func(s *IBMMQSourceSpec) { s.Sink = duckv1.Destination{} },
+			expectErrorMsg: "expected at least one, got none: spec.sink.ref, spec.sink.uri",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			src := &IBMMQSource{Spec: tIBMMQSourceSpec()}
+			if tc.modify != nil {
+				tc.modify(&src.Spec)
+			}
+
+			assertFieldError(t, tc.expectErrorMsg, src.Validate(context.Background()))
+		})
+	}
+}
+
+func tIBMMQSourceSpec() IBMMQSourceSpec {
+	return IBMMQSourceSpec{
+		SourceSpec:     tSourceSpec(),
+		ConnectionName: "mq.example.com(1414)",
+		QueueManager:   "QM1",
+		QueueName:      "DEV.QUEUE.1",
+		ChannelName:    "DEV.APP.SVRCONN",
+		Auth: Credentials{
+			User:     v1alpha1.ValueFromField{Value: "app"},
+			Password: tSecretValue(),
+		},
+	}
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+// Managed event types
+const (
+	// KafkaSourceEventType is the type of events wrapping Kafka messages
+	// which aren't encoded as CloudEvents.
+	KafkaSourceEventType = "io.triggermesh.kafka.message"
+)
+
+// GetEventTypes implements EventSource.
+func (*KafkaSource) GetEventTypes() []string {
+	return []string{
+		KafkaSourceEventType,
+	}
+}
+
+// GetGroupVersionKind implements kmeta.OwnerRefable.
+func (*KafkaSource) GetGroupVersionKind() schema.GroupVersionKind {
+	return SchemeGroupVersion.WithKind("KafkaSource")
+}
+
+// GetConditionSet implements duckv1.KRShaped.
+func (*KafkaSource) GetConditionSet() apis.ConditionSet {
+	return v1alpha1.DefaultConditionSet
+}
+
+// GetStatus implements duckv1.KRShaped.
+func (s *KafkaSource) GetStatus() *duckv1.Status {
+	return &s.Status.Status
+}
+
+// GetSink implements EventSender.
+func (s *KafkaSource) GetSink() *duckv1.Destination {
+	return &s.Spec.Sink
+}
+
+// GetStatusManager implements Reconcilable.
+func (s *KafkaSource) GetStatusManager() *v1alpha1.StatusManager {
+	return &v1alpha1.StatusManager{
+		ConditionSet: s.GetConditionSet(),
+		Status:       &s.Status,
+	}
+}
+
+// AsEventSource implements EventSource.
+func (s *KafkaSource) AsEventSource() string {
+	return KafkaSourceName(s.Spec.BootstrapServers)
+}
+
+// KafkaSourceName returns the 'source' attribute of events wrapping Kafka
+// messages consumed from the given bootstrap servers.
+func KafkaSourceName(bootstrapServers []string) string {
+	return "kafka://" + strings.Join(bootstrapServers, ",")
+}
+
+// GetAdapterOverrides implements AdapterConfigurable.
+func (s *KafkaSource) GetAdapterOverrides() *v1alpha1.AdapterOverrides {
+	return s.Spec.AdapterOverrides
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+// +genclient
+// +genreconciler
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// KafkaSource is the Schema for the event source.
+type KafkaSource struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   KafkaSourceSpec `json:"spec,omitempty"`
+	Status v1alpha1.Status `json:"status,omitempty"`
+}
+
+// Check the interfaces the event source should be implementing.
+var (
+	_ v1alpha1.Reconcilable        = (*KafkaSource)(nil)
+	_ v1alpha1.AdapterConfigurable = (*KafkaSource)(nil)
+	_ v1alpha1.EventSource         = (*KafkaSource)(nil)
+	_ v1alpha1.EventSender         = (*KafkaSource)(nil)
+)
+
+// KafkaSourceSpec defines the desired state of the event source.
+type KafkaSourceSpec struct {
+	// inherits duck/v1 SourceSpec, which currently provides:
+	// * Sink - a reference to an object that will resolve to a domain name or
+	//   a URI directly to use as the sink.
+	// * CloudEventOverrides - defines overrides to control the output format
+	//   and modifications of the event sent to the sink.
+	duckv1.SourceSpec `json:",inline"`
+
+	// BootstrapServers holds the addresses of the Kafka bootstrap servers.
+	BootstrapServers []string `json:"bootstrapServers"`
+
+	// Topics from which messages are consumed.
+	Topics []string `json:"topics"`
+
+	// ID of the consumer group the source joins. The partitions of the
+	// consumed topics are distributed between all members of the group.
+	GroupID string `json:"groupID"`
+
+	// Offset from which messages are consumed when the consumer group has
+	// no committed offset for a partition. Defaults to 'latest'.
+	// +optional
+	InitialOffset *KafkaSourceInitialOffset `json:"initialOffset,omitempty"`
+
+	// SASLUsername is the user name used for SASL authentication.
+	// +optional
+	SASLUsername *string `json:"username,omitempty"`
+
+	// SASLPassword is the password used for SASL authentication.
+	// +optional
+	SASLPassword *v1alpha1.ValueFromField `json:"password,omitempty"`
+
+	// SecurityProtocol allows the user to set the security protocol.
+	// +optional
+	SecurityProtocol *string `json:"securityProtocol,omitempty"`
+
+	// SASLMechanisms allows the assignment of specific SASL mechanisms.
+	// +optional
+	SASLMechanisms *string `json:"saslMechanism,omitempty"`
+
+	// Adapter spec overrides parameters.
+	// +optional
+	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
+}
+
+// KafkaSourceInitialOffset is the offset from which messages are consumed
+// in partitions without a committed offset.
+type KafkaSourceInitialOffset string
+
+// Supported initial offsets.
+const (
+	KafkaSourceInitialOffsetEarliest KafkaSourceInitialOffset = "earliest"
+	KafkaSourceInitialOffsetLatest   KafkaSourceInitialOffset = "latest"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// KafkaSourceList is a list of event source instances.
+type KafkaSourceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []KafkaSource `json:"items"`
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"strings"
+
+	"knative.dev/pkg/apis"
+)
+
+// Validate implements apis.Validatable
+func (s *KafkaSource) Validate(ctx context.Context) *apis.FieldError {
+	return s.Spec.Validate(ctx).ViaField("spec")
+}
+
+// Validate KafkaSource spec
+func (s *KafkaSourceSpec) Validate(ctx context.Context) *apis.FieldError {
+	errs := s.SourceSpec.Validate(ctx)
+
+	if len(s.BootstrapServers) == 0 {
+		errs = errs.Also(apis.ErrMissingField("bootstrapServers"))
+	}
+	if len(s.Topics) == 0 {
+		errs = errs.Also(apis.ErrMissingField("topics"))
+	}
+	for i, t := range s.Topics {
+		if t == "" {
+			errs = errs.Also(apis.ErrInvalidArrayValue(t, "topics", i))
+		}
+	}
+	if s.GroupID == "" {
+		errs = errs.Also(apis.ErrMissingField("groupID"))
+	}
+
+	if s.SASLUsername != nil && !s.SASLPassword.IsSet() {
+		errs = errs.Also(apis.ErrMissingField("password"))
+	}
+	errs = errs.Also(s.SASLPassword.Validate(ctx).ViaField("password"))
+
+	if s.SecurityProtocol != nil {
+		errs = errs.Also(validateKafkaSecurityProtocol(*s.SecurityProtocol).ViaField("securityProtocol"))
+	}
+	if s.SASLMechanisms != nil {
+		errs = errs.Also(validateKafkaSASLMechanism(*s.SASLMechanisms).ViaField("saslMechanism"))
+	}
+
+	return errs
+}
+
+// validateKafkaSecurityProtocol verifies that the given security protocol is
+// supported by the Kafka client.
+func validateKafkaSecurityProtocol(p string) *apis.FieldError {
+	switch strings.ToLower(p) {
+	case "plaintext", "ssl", "sasl_plaintext", "sasl_ssl":
+		return nil
+	}
+
+	return apis.ErrInvalidValue(p, apis.CurrentField,
+		"supported protocols are plaintext, ssl, sasl_plaintext and sasl_ssl")
+}
+
+// validateKafkaSASLMechanism verifies that the given SASL mechanism is
+// supported by the Kafka client.
+func validateKafkaSASLMechanism(m string) *apis.FieldError {
+	switch m {
+	case "GSSAPI", "PLAIN", "SCRAM-SHA-256", "SCRAM-SHA-512", "OAUTHBEARER":
+		return nil
+	}
+
+	return apis.ErrInvalidValue(m, apis.CurrentField,
+		"supported mechanisms are GSSAPI, PLAIN, SCRAM-SHA-256, SCRAM-SHA-512 and OAUTHBEARER")
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/ptr"
+)
+
+func TestKafkaSourceValidate(t *testing.T) {
+	testCases := map[string]struct {
+		modify         func(*KafkaSourceSpec)
+		expectErrorMsg string
+	}{
+		"Valid": {},
+		"SASL authentication": {
+			modify: func(s *KafkaSourceSpec) {
+				pwd := tSecretValue()
+				s.SASLUsername = ptr.String("test-user")
+				s.SASLPassword = &pwd
+				s.SecurityProtocol = ptr.String("SASL_SSL")
+				s.SASLMechanisms = ptr.String("SCRAM-SHA-512")
+			},
+		},
+		"Missing bootstrap servers": {
+			modify:         func(s *KafkaSourceSpec) { s.BootstrapServers = nil },
+			expectErrorMsg: "missing field(s): spec.bootstrapServers",
+		},
+		"Missing topics": {
+			modify:         func(s *KafkaSourceSpec) { s.Topics = nil },
+			expectErrorMsg: "missing field(s): spec.topics",
+		},
+		"Empty topic": {
+			modify:         func(s *KafkaSourceSpec) { s.Topics = append(s.Topics, "") },
+			expectErrorMsg: "invalid value: : spec.topics[1]",
+		},
+		"Missing group ID": {
+			modify:         func(s *KafkaSourceSpec) { s.GroupID = "" },
+			expectErrorMsg: "missing field(s): spec.groupID",
+		},
+		"Username without password": {
+			modify:         func(s *KafkaSourceSpec) { s.SASLUsername = ptr.String("test-user") },
+			expectErrorMsg: "missing field(s): spec.password",
+		},
+		"Unsupported security protocol": {
+			modify: func(s *KafkaSourceSpec) { s.SecurityProtocol = ptr.String("tls") },
+			expectErrorMsg: "invalid value: tls: spec.securityProtocol\n" +
+				"supported protocols are plaintext, ssl, sasl_plaintext and sasl_ssl",
+		},
+		"Unsupported SASL mechanism": {
+			modify: func(s *KafkaSourceSpec) { s.SASLMechanisms = ptr.String("scram") },
+			expectErrorMsg: "invalid value: scram: spec.saslMechanism\n" +
+				"supported mechanisms are GSSAPI, PLAIN, SCRAM-SHA-256, SCRAM-SHA-512 and OAUTHBEARER",
+		},
+		"Missing sink": {
+			modify:         func(s *KafkaSourceSpec) { s.Sink = duckv1.Destination{} },
+			expectErrorMsg: "expected at least one, got none: spec.sink.ref, spec.sink.uri",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			src := &KafkaSource{Spec: tKafkaSourceSpec()}
+			if tc.modify != nil {
+				tc.modify(&src.Spec)
+			}
+
+			assertFieldError(t, tc.expectErrorMsg, src.Validate(context.Background()))
+		})
+	}
+}
+
+func tKafkaSourceSpec() KafkaSourceSpec {
+	return KafkaSourceSpec{
+		SourceSpec:       tSourceSpec(),
+		BootstrapServers: []string{"kafka.example.com:9092"},
+		Topics:           []string{"test-topic"},
+		GroupID:          "test-group",
+	}
+}
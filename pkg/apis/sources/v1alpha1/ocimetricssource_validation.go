@@ -0,0 +1,79 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"knative.dev/pkg/apis"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+// Validate implements apis.Validatable
+func (s *OCIMetricsSource) Validate(ctx context.Context) *apis.FieldError {
+	return s.Spec.Validate(ctx).ViaField("spec")
+}
+
+// Validate OCIMetricsSource spec
+func (s *OCIMetricsSourceSpec) Validate(ctx context.Context) *apis.FieldError {
+	errs := s.SourceSpec.Validate(ctx)
+
+	errs = errs.Also(s.OracleAPIPrivateKey.ValidateRequired(ctx).ViaField("oracleApiPrivateKey"))
+	errs = errs.Also(s.OracleAPIPrivateKeyPassphrase.ValidateRequired(ctx).ViaField("oracleApiPrivateKeyPassphrase"))
+	errs = errs.Also(s.OracleAPIPrivateKeyFingerprint.ValidateRequired(ctx).ViaField("oracleApiPrivateKeyFingerprint"))
+
+	if s.Tenancy == "" {
+		errs = errs.Also(apis.ErrMissingField("oracleTenancy"))
+	}
+	if s.User == "" {
+		errs = errs.Also(apis.ErrMissingField("oracleUser"))
+	}
+	if s.Region == "" {
+		errs = errs.Also(apis.ErrMissingField("oracleRegion"))
+	}
+
+	if s.PollingFrequency != nil {
+		errs = errs.Also(v1alpha1.ValidateDuration(*s.PollingFrequency).ViaField("metricsPollingFrequency"))
+	}
+
+	if len(s.Metrics) == 0 {
+		errs = errs.Also(apis.ErrMissingField("metrics"))
+	}
+	for i, m := range s.Metrics {
+		errs = errs.Also(m.validate().ViaFieldIndex("metrics", i))
+	}
+
+	return errs
+}
+
+// validate verifies that the metric query is complete.
+func (m *OCIMetrics) validate() *apis.FieldError {
+	var errs *apis.FieldError
+
+	if m.Name == "" {
+		errs = errs.Also(apis.ErrMissingField("name"))
+	}
+	if m.MetricsNamespace == "" {
+		errs = errs.Also(apis.ErrMissingField("metricsNamespace"))
+	}
+	if m.MetricsQuery == "" {
+		errs = errs.Also(apis.ErrMissingField("metricsQuery"))
+	}
+
+	return errs
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/ptr"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+func TestOCIMetricsSourceValidate(t *testing.T) {
+	testCases := map[string]struct {
+		modify         func(*OCIMetricsSourceSpec)
+		expectErrorMsg string
+	}{
+		"Valid": {},
+		"Missing private key": {
+			modify:         func(s *OCIMetricsSourceSpec) { s.OracleAPIPrivateKey = v1alpha1.ValueFromField{} },
+			expectErrorMsg: "expected exactly one, got neither: spec.oracleApiPrivateKey.value, spec.oracleApiPrivateKey.valueFromSecret",
+		},
+		"Missing tenancy, user and region": {
+			modify: func(s *OCIMetricsSourceSpec) {
+				s.Tenancy = ""
+				s.User = ""
+				s.Region = ""
+			},
+			expectErrorMsg: "missing field(s): spec.oracleRegion, spec.oracleTenancy, spec.oracleUser",
+		},
+		"Unparsable polling frequency": {
+			modify: func(s *OCIMetricsSourceSpec) { s.PollingFrequency = ptr.String("5 minutes") },
+			expectErrorMsg: "invalid value: 5 minutes: spec.metricsPollingFrequency\n" +
+				"time: unknown unit \" minutes\" in duration \"5 minutes\"",
+		},
+		"Missing metrics": {
+			modify:         func(s *OCIMetricsSourceSpec) { s.Metrics = nil },
+			expectErrorMsg: "missing field(s): spec.metrics",
+		},
+		"Incomplete metric": {
+			modify:         func(s *OCIMetricsSourceSpec) { s.Metrics[0].MetricsQuery = "" },
+			expectErrorMsg: "missing field(s): spec.metrics[0].metricsQuery",
+		},
+		"Missing sink": {
+			modify:         func(s *OCIMetricsSourceSpec) { s.Sink = duckv1.Destination{} },
+			expectErrorMsg: "expected at least one, got none: spec.sink.ref, spec.sink.uri",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			src := &OCIMetricsSource{Spec: tOCIMetricsSourceSpec()}
+			if tc.modify != nil {
+				tc.modify(&src.Spec)
+			}
+
+			assertFieldError(t, tc.expectErrorMsg, src.Validate(context.Background()))
+		})
+	}
+}
+
+func tOCIMetricsSourceSpec() OCIMetricsSourceSpec {
+	return OCIMetricsSourceSpec{
+		SourceSpec:                     tSourceSpec(),
+		OracleAPIPrivateKey:            tSecretValue(),
+		OracleAPIPrivateKeyPassphrase:  tSecretValue(),
+		OracleAPIPrivateKeyFingerprint: tSecretValue(),
+		Tenancy:                        "ocid1.tenancy.oc1..test",
+		User:                           "ocid1.user.oc1..test",
+		Region:                         "us-ashburn-1",
+		PollingFrequency:               ptr.String("5m"),
+		Metrics: []OCIMetrics{{
+			Name:             "cpu",
+			MetricsNamespace: "oci_computeagent",
+			MetricsQuery:     "CpuUtilization[1m].mean()",
+		}},
+	}
+}
@@ -65,6 +65,7 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&GoogleCloudStorageSource{}, &GoogleCloudStorageSourceList{},
 		&HTTPPollerSource{}, &HTTPPollerSourceList{},
 		&IBMMQSource{}, &IBMMQSourceList{},
+		&KafkaSource{}, &KafkaSourceList{},
 		&OCIMetricsSource{}, &OCIMetricsSourceList{},
 		&SalesforceSource{}, &SalesforceSourceList{},
 		&SlackSource{}, &SlackSourceList{},
@@ -55,19 +55,61 @@ func (s *SalesforceSource) GetStatusManager() *v1alpha1.StatusManager {
 
 // AsEventSource implements EventSource.
 func (s *SalesforceSource) AsEventSource() string {
-	channel := s.Spec.Subscription.Channel
-	if channel[0] != '/' {
+	var channel string
+	if subs := s.GetSubscriptions(); len(subs) > 0 {
+		channel = subs[0].Channel
+	}
+	if channel == "" || channel[0] != '/' {
 		channel = "/" + channel
 	}
 	return "io.triggermesh.salesforce" + channel
 }
 
+// Supported event types
+const (
+	SalesforceSourceGenericEventType = "com.salesforce.stream.message"
+)
+
 // GetEventTypes returns the event types generated by the source.
 func (s *SalesforceSource) GetEventTypes() []string {
-	return []string{"com.salesforce.stream.message"}
+	var types []string
+
+	seen := make(map[string]struct{})
+	for _, sub := range s.GetSubscriptions() {
+		typ := SalesforceSourceGenericEventType
+		if sub.EventType != nil {
+			typ = *sub.EventType
+		}
+
+		if _, ok := seen[typ]; ok {
+			continue
+		}
+		seen[typ] = struct{}{}
+		types = append(types, typ)
+	}
+
+	if len(types) == 0 {
+		types = append(types, SalesforceSourceGenericEventType)
+	}
+
+	return types
+}
+
+// GetSubscriptions returns all the channel subscriptions of the source.
+func (s *SalesforceSource) GetSubscriptions() []SalesforceSubscription {
+	subs := make([]SalesforceSubscription, 0, len(s.Spec.Subscriptions)+1)
+	if s.Spec.Subscription.Channel != "" {
+		subs = append(subs, s.Spec.Subscription)
+	}
+	return append(subs, s.Spec.Subscriptions...)
 }
 
 // GetAdapterOverrides implements AdapterConfigurable.
 func (s *SalesforceSource) GetAdapterOverrides() *v1alpha1.AdapterOverrides {
 	return s.Spec.AdapterOverrides
 }
+
+// WritesCheckpoints implements CheckpointWriter.
+func (s *SalesforceSource) WritesCheckpoints() bool {
+	return true
+}
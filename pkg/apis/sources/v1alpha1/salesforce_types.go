@@ -42,6 +42,7 @@ var (
 	_ v1alpha1.AdapterConfigurable = (*SalesforceSource)(nil)
 	_ v1alpha1.EventSource         = (*SalesforceSource)(nil)
 	_ v1alpha1.EventSender         = (*SalesforceSource)(nil)
+	_ v1alpha1.CheckpointWriter    = (*SalesforceSource)(nil)
 )
 
 // SalesforceSourceSpec defines the desired state of the event source.
@@ -56,8 +57,14 @@ type SalesforceSourceSpec struct {
 	APIVersion *string `json:"apiVersion"`
 
 	// Subscription to a Salesforce channel
+	// +optional
 	Subscription SalesforceSubscription `json:"subscription"`
 
+	// Subscriptions to additional Salesforce channels. At least one
+	// subscription must be set, either here or in Subscription.
+	// +optional
+	Subscriptions []SalesforceSubscription `json:"subscriptions,omitempty"`
+
 	// Adapter spec overrides parameters.
 	// +optional
 	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
@@ -65,8 +72,16 @@ type SalesforceSourceSpec struct {
 
 // SalesforceSubscription to connect to.
 type SalesforceSubscription struct {
-	Channel  string `json:"channel"`
-	ReplayID *int   `json:"replayID,omitempty"`
+	Channel string `json:"channel"`
+	// Replay ID from which events are received when no replay ID was
+	// previously recorded for the channel. Defaults to -1 (new events
+	// only).
+	// +optional
+	ReplayID *int `json:"replayID,omitempty"`
+	// CloudEvent type of the events received from the channel. Defaults
+	// to "com.salesforce.stream.message".
+	// +optional
+	EventType *string `json:"eventType,omitempty"`
 }
 
 // SalesforceAuth contains Salesforce credentials.
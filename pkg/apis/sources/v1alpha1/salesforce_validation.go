@@ -0,0 +1,92 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"knative.dev/pkg/apis"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+// Validate implements apis.Validatable
+func (s *SalesforceSource) Validate(ctx context.Context) *apis.FieldError {
+	return s.Spec.Validate(ctx).ViaField("spec")
+}
+
+// Validate SalesforceSource spec
+func (s *SalesforceSourceSpec) Validate(ctx context.Context) *apis.FieldError {
+	errs := s.SourceSpec.Validate(ctx)
+
+	errs = errs.Also(s.Auth.Validate(ctx).ViaField("auth"))
+
+	if s.Subscription == (SalesforceSubscription{}) && len(s.Subscriptions) == 0 {
+		errs = errs.Also(apis.ErrMissingOneOf("subscription", "subscriptions"))
+	}
+
+	channels := make(map[string]struct{}, len(s.Subscriptions)+1)
+
+	if s.Subscription != (SalesforceSubscription{}) {
+		errs = errs.Also(s.Subscription.Validate(ctx).ViaField("subscription"))
+		channels[s.Subscription.Channel] = struct{}{}
+	}
+
+	for i, sub := range s.Subscriptions {
+		errs = errs.Also(sub.Validate(ctx).ViaFieldIndex("subscriptions", i))
+
+		if _, isDuplicate := channels[sub.Channel]; isDuplicate && sub.Channel != "" {
+			errs = errs.Also(apis.ErrGeneric("channel is subscribed more than once", "channel").
+				ViaFieldIndex("subscriptions", i))
+		}
+		channels[sub.Channel] = struct{}{}
+	}
+
+	return errs
+}
+
+// Validate verifies that the OAuth JWT credentials are complete.
+func (a *SalesforceAuth) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	if a.ClientID == "" {
+		errs = errs.Also(apis.ErrMissingField("clientID"))
+	}
+	errs = errs.Also(v1alpha1.ValidateURLString(a.Server).ViaField("server"))
+	if a.User == "" {
+		errs = errs.Also(apis.ErrMissingField("user"))
+	}
+
+	return errs.Also(a.CertKey.ValidateRequired(ctx).ViaField("certKey"))
+}
+
+// Validate verifies that a channel is set, and that the replay ID is either
+// a stored event or one of the special values -1 (new events) and -2 (all
+// stored events).
+func (s *SalesforceSubscription) Validate(_ context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	if s.Channel == "" {
+		errs = errs.Also(apis.ErrMissingField("channel"))
+	}
+
+	if s.ReplayID != nil && *s.ReplayID < -2 {
+		errs = errs.Also(apis.ErrOutOfBoundsValue(*s.ReplayID, -2, "+Inf", "replayID"))
+	}
+
+	return errs
+}
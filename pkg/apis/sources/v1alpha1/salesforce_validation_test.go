@@ -0,0 +1,121 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/ptr"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+func TestSalesforceSourceValidate(t *testing.T) {
+	testCases := map[string]struct {
+		modify         func(*SalesforceSourceSpec)
+		expectErrorMsg string
+	}{
+		"Valid": {},
+		"Multiple subscriptions": {
+			modify: func(s *SalesforceSourceSpec) {
+				replayID := -2
+				s.Subscription = SalesforceSubscription{}
+				s.Subscriptions = []SalesforceSubscription{{
+					Channel: "/data/ChangeEvents",
+				}, {
+					Channel:  "/event/Test__e",
+					ReplayID: &replayID,
+				}}
+			},
+		},
+		"Missing subscription": {
+			modify:         func(s *SalesforceSourceSpec) { s.Subscription = SalesforceSubscription{} },
+			expectErrorMsg: "expected exactly one, got neither: spec.subscription, spec.subscriptions",
+		},
+		"Subscription without channel": {
+			modify: func(s *SalesforceSourceSpec) {
+				s.Subscription.Channel = ""
+				s.Subscription.EventType = ptr.String("com.example.event")
+			},
+			expectErrorMsg: "missing field(s): spec.subscription.channel",
+		},
+		"Invalid replay ID": {
+			modify: func(s *SalesforceSourceSpec) {
+				replayID := -3
+				s.Subscription.ReplayID = &replayID
+			},
+			expectErrorMsg: "expected -2 <= -3 <= +Inf: spec.subscription.replayID",
+		},
+		"Duplicate channel": {
+			modify: func(s *SalesforceSourceSpec) {
+				s.Subscriptions = []SalesforceSubscription{{
+					Channel: "/data/ChangeEvents",
+				}}
+			},
+			expectErrorMsg: "channel is subscribed more than once: spec.subscriptions[0].channel",
+		},
+		"Relative server URL": {
+			modify: func(s *SalesforceSourceSpec) { s.Auth.Server = "login.salesforce.com" },
+			expectErrorMsg: "invalid value: login.salesforce.com: spec.auth.server\n" +
+				"URL must be absolute and include a host",
+		},
+		"Missing client ID and user": {
+			modify: func(s *SalesforceSourceSpec) {
+				s.Auth.ClientID = ""
+				s.Auth.User = ""
+			},
+			expectErrorMsg: "missing field(s): spec.auth.clientID, spec.auth.user",
+		},
+		"Missing certificate key": {
+			modify:         func(s *SalesforceSourceSpec) { s.Auth.CertKey = v1alpha1.ValueFromField{} },
+			expectErrorMsg: "expected exactly one, got neither: spec.auth.certKey.value, spec.auth.certKey.valueFromSecret",
+		},
+		"Missing sink": {
+			modify:         func(s *SalesforceSourceSpec) { s.Sink = duckv1.Destination{} },
+			expectErrorMsg: "expected at least one, got none: spec.sink.ref, spec.sink.uri",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			src := &SalesforceSource{Spec: tSalesforceSourceSpec()}
+			if tc.modify != nil {
+				tc.modify(&src.Spec)
+			}
+
+			assertFieldError(t, tc.expectErrorMsg, src.Validate(context.Background()))
+		})
+	}
+}
+
+func tSalesforceSourceSpec() SalesforceSourceSpec {
+	return SalesforceSourceSpec{
+		SourceSpec: tSourceSpec(),
+		Auth: SalesforceAuth{
+			ClientID: "test-client",
+			Server:   "https://login.salesforce.com",
+			User:     "user@example.com",
+			CertKey:  tSecretValue(),
+		},
+		Subscription: SalesforceSubscription{
+			Channel: "/data/ChangeEvents",
+		},
+	}
+}
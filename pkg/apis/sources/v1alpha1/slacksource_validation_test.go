@@ -0,0 +1,69 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+func TestSlackSourceValidate(t *testing.T) {
+	testCases := map[string]struct {
+		modify         func(*SlackSourceSpec)
+		expectErrorMsg string
+	}{
+		"Valid": {},
+		"Signing secret": {
+			modify: func(s *SlackSourceSpec) {
+				secret := tSecretValue()
+				s.SigningSecret = &secret
+			},
+		},
+		"Signing secret from value and secret": {
+			modify: func(s *SlackSourceSpec) {
+				secret := tSecretValue()
+				secret.Value = "s3cr3t"
+				s.SigningSecret = &secret
+			},
+			expectErrorMsg: "expected exactly one, got both: spec.signingSecret.value, spec.signingSecret.valueFromSecret",
+		},
+		"Missing sink": {
+			modify:         func(s *SlackSourceSpec) { s.Sink = duckv1.Destination{} },
+			expectErrorMsg: "expected at least one, got none: spec.sink.ref, spec.sink.uri",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			src := &SlackSource{Spec: tSlackSourceSpec()}
+			if tc.modify != nil {
+				tc.modify(&src.Spec)
+			}
+
+			assertFieldError(t, tc.expectErrorMsg, src.Validate(context.Background()))
+		})
+	}
+}
+
+func tSlackSourceSpec() SlackSourceSpec {
+	return SlackSourceSpec{
+		SourceSpec: tSourceSpec(),
+	}
+}
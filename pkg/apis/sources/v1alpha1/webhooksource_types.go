@@ -20,6 +20,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 
+	"github.com/triggermesh/triggermesh/pkg/apis"
 	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
 )
 
@@ -70,6 +71,15 @@ type WebhookSourceSpec struct {
 	// +optional
 	BasicAuthPassword *v1alpha1.ValueFromField `json:"basicAuthPassword,omitempty"`
 
+	// Verification of the HMAC signature of request bodies, as performed
+	// by webhook providers which sign their requests with a shared secret.
+	// +optional
+	Signature *WebhookSignature `json:"signature,omitempty"`
+
+	// Extraction of request metadata into attributes of ingested events.
+	// +optional
+	Mapping *WebhookMapping `json:"mapping,omitempty"`
+
 	// Specifies the CORS Origin to use in pre-flight headers.
 	// +optional
 	CORSAllowOrigin *string `json:"corsAllowOrigin,omitempty"`
@@ -79,6 +89,118 @@ type WebhookSourceSpec struct {
 	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
 }
 
+// WebhookSignature contains the parameters used to verify the HMAC signature
+// of requests.
+type WebhookSignature struct {
+	// Name of the HTTP header which contains the signature.
+	Header string `json:"header"`
+
+	// Hash algorithm used to compute the HMAC signature.
+	Algorithm WebhookSignatureAlgorithm `json:"algorithm"`
+
+	// Secret key shared with the webhook provider.
+	Secret v1alpha1.ValueFromField `json:"secret"`
+
+	// Prefix which precedes the signature in the header value, e.g. "sha256=".
+	// +optional
+	Prefix *string `json:"prefix,omitempty"`
+
+	// Encoding of the signature in the header value. Defaults to "hex".
+	// +optional
+	Encoding *WebhookSignatureEncoding `json:"encoding,omitempty"`
+
+	// Protection against replayed requests. When set, the signed content
+	// is the request timestamp and the body separated by a dot.
+	// +optional
+	Timestamp *WebhookSignatureTimestamp `json:"timestamp,omitempty"`
+}
+
+// WebhookSignatureAlgorithm is the hash algorithm of a HMAC signature.
+type WebhookSignatureAlgorithm string
+
+// Supported signature algorithms.
+const (
+	WebhookSignatureAlgorithmSHA1   WebhookSignatureAlgorithm = "sha1"
+	WebhookSignatureAlgorithmSHA256 WebhookSignatureAlgorithm = "sha256"
+)
+
+// WebhookSignatureEncoding is the encoding of a HMAC signature.
+type WebhookSignatureEncoding string
+
+// Supported signature encodings.
+const (
+	WebhookSignatureEncodingHex    WebhookSignatureEncoding = "hex"
+	WebhookSignatureEncodingBase64 WebhookSignatureEncoding = "base64"
+)
+
+// WebhookSignatureTimestamp contains the parameters used to reject replayed
+// requests.
+type WebhookSignatureTimestamp struct {
+	// Name of the HTTP header which contains the time at which the request
+	// was signed, in seconds since the Unix epoch.
+	Header string `json:"header"`
+
+	// Maximum difference between the request timestamp and the time of
+	// reception.
+	Tolerance apis.Duration `json:"tolerance"`
+}
+
+// WebhookMapping defines how attributes of ingested events are derived from
+// HTTP requests. Attributes which value can not be found in a request retain
+// their default value.
+type WebhookMapping struct {
+	// Source of the CloudEvents 'type' attribute, overriding EventType.
+	// +optional
+	Type *WebhookValueSource `json:"type,omitempty"`
+
+	// Source of the CloudEvents 'subject' attribute.
+	// +optional
+	Subject *WebhookValueSource `json:"subject,omitempty"`
+
+	// Source of the CloudEvents 'id' attribute.
+	// +optional
+	ID *WebhookValueSource `json:"id,omitempty"`
+
+	// CloudEvents extensions to set on ingested events.
+	// +optional
+	Extensions []WebhookExtensionMapping `json:"extensions,omitempty"`
+}
+
+// WebhookExtensionMapping defines a CloudEvents extension derived from HTTP
+// requests.
+type WebhookExtensionMapping struct {
+	// Name of the CloudEvents extension.
+	Name string `json:"name"`
+
+	// Source of the extension value.
+	WebhookValueSource `json:",inline"`
+}
+
+// WebhookValueSource is the location of a value within a HTTP request.
+// Exactly one field should be set.
+type WebhookValueSource struct {
+	// Name of a HTTP header.
+	// +optional
+	Header *string `json:"header,omitempty"`
+
+	// Name of a URL query parameter.
+	// +optional
+	Query *string `json:"query,omitempty"`
+
+	// Whether the value is the path of the request URL.
+	// +optional
+	Path *bool `json:"path,omitempty"`
+
+	// Whether the value is the method of the request.
+	// +optional
+	Method *bool `json:"method,omitempty"`
+
+	// Path of a value within a JSON request body, in GJSON syntax.
+	// https://github.com/tidwall/gjson/blob/master/SYNTAX.md
+	// +optional
+	BodyPath *string `json:"bodyPath,omitempty"`
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // WebhookSourceList contains a list of event sources.
@@ -0,0 +1,192 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"knative.dev/pkg/apis"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+// CloudEvents attribute names consist of lower-case letters and digits.
+// https://github.com/cloudevents/spec/blob/v1.0.2/cloudevents/spec.md#attribute-naming-convention
+var ceAttributeNameRegexp = regexp.MustCompile(`^[a-z0-9]+$`)
+
+// Context attributes defined by the CloudEvents specification, which can not
+// be overwritten by extensions.
+// https://github.com/cloudevents/spec/blob/v1.0.2/cloudevents/spec.md#context-attributes
+var ceContextAttributes = map[string]struct{}{
+	"id":              {},
+	"source":          {},
+	"specversion":     {},
+	"type":            {},
+	"datacontenttype": {},
+	"dataschema":      {},
+	"subject":         {},
+	"time":            {},
+}
+
+// Validate implements apis.Validatable
+func (s *WebhookSource) Validate(ctx context.Context) *apis.FieldError {
+	return s.Spec.Validate(ctx).ViaField("spec")
+}
+
+// Validate WebhookSource spec
+func (s *WebhookSourceSpec) Validate(ctx context.Context) *apis.FieldError {
+	errs := s.SourceSpec.Validate(ctx)
+
+	if s.EventType == "" {
+		errs = errs.Also(apis.ErrMissingField("eventType"))
+	}
+
+	if s.BasicAuthUsername != nil && !s.BasicAuthPassword.IsSet() {
+		errs = errs.Also(apis.ErrMissingField("basicAuthPassword"))
+	}
+	errs = errs.Also(s.BasicAuthPassword.Validate(ctx).ViaField("basicAuthPassword"))
+
+	if s.Signature != nil {
+		errs = errs.Also(s.Signature.Validate(ctx).ViaField("signature"))
+	}
+
+	if s.Mapping != nil {
+		errs = errs.Also(s.Mapping.Validate(ctx).ViaField("mapping"))
+	}
+
+	return errs
+}
+
+// Validate verifies that the parameters of the HMAC signature are complete
+// and supported.
+func (s *WebhookSignature) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	if s.Header == "" {
+		errs = errs.Also(apis.ErrMissingField("header"))
+	}
+
+	switch s.Algorithm {
+	case WebhookSignatureAlgorithmSHA1, WebhookSignatureAlgorithmSHA256:
+	case "":
+		errs = errs.Also(apis.ErrMissingField("algorithm"))
+	default:
+		errs = errs.Also(apis.ErrInvalidValue(s.Algorithm, "algorithm"))
+	}
+
+	errs = errs.Also(s.Secret.ValidateRequired(ctx).ViaField("secret"))
+
+	if s.Encoding != nil {
+		switch *s.Encoding {
+		case WebhookSignatureEncodingHex, WebhookSignatureEncodingBase64:
+		default:
+			errs = errs.Also(apis.ErrInvalidValue(*s.Encoding, "encoding"))
+		}
+	}
+
+	if t := s.Timestamp; t != nil {
+		if t.Header == "" {
+			errs = errs.Also(apis.ErrMissingField("header").ViaField("timestamp"))
+		}
+		errs = errs.Also(v1alpha1.ValidatePositiveDuration(time.Duration(t.Tolerance)).
+			ViaField("timestamp", "tolerance"))
+	}
+
+	return errs
+}
+
+// Validate verifies that each mapped attribute has exactly one source, and
+// that the names of extensions are valid CloudEvents attribute names which do
+// not collide with context attributes.
+func (m *WebhookMapping) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	if m.Type != nil {
+		errs = errs.Also(m.Type.Validate(ctx).ViaField("type"))
+	}
+	if m.Subject != nil {
+		errs = errs.Also(m.Subject.Validate(ctx).ViaField("subject"))
+	}
+	if m.ID != nil {
+		errs = errs.Also(m.ID.Validate(ctx).ViaField("id"))
+	}
+
+	names := make(map[string]struct{}, len(m.Extensions))
+
+	for i, ext := range m.Extensions {
+		var extErrs *apis.FieldError
+
+		switch _, isDuplicate := names[ext.Name]; {
+		case ext.Name == "":
+			extErrs = extErrs.Also(apis.ErrMissingField("name"))
+		case !ceAttributeNameRegexp.MatchString(ext.Name):
+			extErrs = extErrs.Also(apis.ErrInvalidValue(ext.Name, "name",
+				"extension names must consist of lower-case letters and digits"))
+		case isContextAttribute(ext.Name):
+			extErrs = extErrs.Also(apis.ErrInvalidValue(ext.Name, "name",
+				"extension names must not be CloudEvents context attributes"))
+		case isDuplicate:
+			extErrs = extErrs.Also(apis.ErrGeneric("extension is mapped more than once", "name"))
+		}
+		names[ext.Name] = struct{}{}
+
+		extErrs = extErrs.Also(ext.WebhookValueSource.Validate(ctx))
+
+		errs = errs.Also(extErrs.ViaFieldIndex("extensions", i))
+	}
+
+	return errs
+}
+
+// isContextAttribute returns whether the given name is a context attribute
+// defined by the CloudEvents specification.
+func isContextAttribute(name string) bool {
+	_, ok := ceContextAttributes[name]
+	return ok
+}
+
+// Validate verifies that exactly one location is set.
+func (v *WebhookValueSource) Validate(_ context.Context) *apis.FieldError {
+	var set []string
+
+	if v.Header != nil {
+		set = append(set, "header")
+	}
+	if v.Query != nil {
+		set = append(set, "query")
+	}
+	if v.Path != nil {
+		set = append(set, "path")
+	}
+	if v.Method != nil {
+		set = append(set, "method")
+	}
+	if v.BodyPath != nil {
+		set = append(set, "bodyPath")
+	}
+
+	switch {
+	case len(set) == 0:
+		return apis.ErrMissingOneOf("header", "query", "path", "method", "bodyPath")
+	case len(set) > 1:
+		return apis.ErrMultipleOneOf(set...)
+	}
+
+	return nil
+}
@@ -0,0 +1,197 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/ptr"
+
+	tmapis "github.com/triggermesh/triggermesh/pkg/apis"
+)
+
+func TestWebhookSourceValidate(t *testing.T) {
+	testCases := map[string]struct {
+		modify         func(*WebhookSourceSpec)
+		expectErrorMsg string
+	}{
+		"Valid": {},
+		"Missing event type": {
+			modify:         func(s *WebhookSourceSpec) { s.EventType = "" },
+			expectErrorMsg: "missing field(s): spec.eventType",
+		},
+		"Username without password": {
+			modify:         func(s *WebhookSourceSpec) { s.BasicAuthUsername = ptr.String("test-user") },
+			expectErrorMsg: "missing field(s): spec.basicAuthPassword",
+		},
+		"Signature": {
+			modify: func(s *WebhookSourceSpec) {
+				s.Signature = &WebhookSignature{
+					Header:    "X-Hub-Signature-256",
+					Algorithm: WebhookSignatureAlgorithmSHA256,
+					Secret:    tSecretValue(),
+					Timestamp: &WebhookSignatureTimestamp{
+						Header:    "X-Timestamp",
+						Tolerance: tmapis.Duration(5 * time.Minute),
+					},
+				}
+			},
+		},
+		"Unsupported signature algorithm": {
+			modify: func(s *WebhookSourceSpec) {
+				s.Signature = &WebhookSignature{
+					Header:    "X-Hub-Signature",
+					Algorithm: "md5",
+					Secret:    tSecretValue(),
+				}
+			},
+			expectErrorMsg: "invalid value: md5: spec.signature.algorithm",
+		},
+		"Unsupported signature encoding": {
+			modify: func(s *WebhookSourceSpec) {
+				enc := WebhookSignatureEncoding("base32")
+				s.Signature = &WebhookSignature{
+					Header:    "X-Hub-Signature",
+					Algorithm: WebhookSignatureAlgorithmSHA1,
+					Secret:    tSecretValue(),
+					Encoding:  &enc,
+				}
+			},
+			expectErrorMsg: "invalid value: base32: spec.signature.encoding",
+		},
+		"Incomplete signature timestamp": {
+			modify: func(s *WebhookSourceSpec) {
+				s.Signature = &WebhookSignature{
+					Header:    "X-Hub-Signature",
+					Algorithm: WebhookSignatureAlgorithmSHA1,
+					Secret:    tSecretValue(),
+					Timestamp: &WebhookSignatureTimestamp{
+						Tolerance: tmapis.Duration(time.Minute),
+					},
+				}
+			},
+			expectErrorMsg: "missing field(s): spec.signature.timestamp.header",
+		},
+		"Missing signature secret": {
+			modify: func(s *WebhookSourceSpec) {
+				s.Signature = &WebhookSignature{
+					Header:    "X-Hub-Signature",
+					Algorithm: WebhookSignatureAlgorithmSHA1,
+				}
+			},
+			expectErrorMsg: "expected exactly one, got neither: spec.signature.secret.value, spec.signature.secret.valueFromSecret",
+		},
+		"Mapping": {
+			modify: func(s *WebhookSourceSpec) {
+				s.Mapping = &WebhookMapping{
+					Type:    &WebhookValueSource{Header: ptr.String("X-Event-Type")},
+					Subject: &WebhookValueSource{BodyPath: ptr.String("$.repository.name")},
+					Extensions: []WebhookExtensionMapping{{
+						Name:               "tenant",
+						WebhookValueSource: WebhookValueSource{Query: ptr.String("tenant")},
+					}, {
+						Name:               "method",
+						WebhookValueSource: WebhookValueSource{Method: ptr.Bool(true)},
+					}},
+				}
+			},
+		},
+		"Mapping without value source": {
+			modify: func(s *WebhookSourceSpec) {
+				s.Mapping = &WebhookMapping{
+					ID: &WebhookValueSource{},
+				}
+			},
+			expectErrorMsg: "expected exactly one, got neither: spec.mapping.id.bodyPath, spec.mapping.id.header, spec.mapping.id.method, spec.mapping.id.path, spec.mapping.id.query",
+		},
+		"Mapping with multiple value sources": {
+			modify: func(s *WebhookSourceSpec) {
+				s.Mapping = &WebhookMapping{
+					Type: &WebhookValueSource{
+						Header: ptr.String("X-Event-Type"),
+						Path:   ptr.Bool(true),
+					},
+				}
+			},
+			expectErrorMsg: "expected exactly one, got both: spec.mapping.type.header, spec.mapping.type.path",
+		},
+		"Invalid extension name": {
+			modify: func(s *WebhookSourceSpec) {
+				s.Mapping = &WebhookMapping{
+					Extensions: []WebhookExtensionMapping{{
+						Name:               "Tenant-ID",
+						WebhookValueSource: WebhookValueSource{Query: ptr.String("tenant")},
+					}},
+				}
+			},
+			expectErrorMsg: "invalid value: Tenant-ID: spec.mapping.extensions[0].name\n" +
+				"extension names must consist of lower-case letters and digits",
+		},
+		"Extension name of a context attribute": {
+			modify: func(s *WebhookSourceSpec) {
+				s.Mapping = &WebhookMapping{
+					Extensions: []WebhookExtensionMapping{{
+						Name:               "subject",
+						WebhookValueSource: WebhookValueSource{Path: ptr.Bool(true)},
+					}},
+				}
+			},
+			expectErrorMsg: "invalid value: subject: spec.mapping.extensions[0].name\n" +
+				"extension names must not be CloudEvents context attributes",
+		},
+		"Duplicate extension": {
+			modify: func(s *WebhookSourceSpec) {
+				s.Mapping = &WebhookMapping{
+					Extensions: []WebhookExtensionMapping{{
+						Name:               "tenant",
+						WebhookValueSource: WebhookValueSource{Query: ptr.String("tenant")},
+					}, {
+						Name:               "tenant",
+						WebhookValueSource: WebhookValueSource{Header: ptr.String("X-Tenant")},
+					}},
+				}
+			},
+			expectErrorMsg: "extension is mapped more than once: spec.mapping.extensions[1].name",
+		},
+		"Missing sink": {
+			modify:         func(s *WebhookSourceSpec) { s.Sink = duckv1.Destination{} },
+			expectErrorMsg: "expected at least one, got none: spec.sink.ref, spec.sink.uri",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			src := &WebhookSource{Spec: tWebhookSourceSpec()}
+			if tc.modify != nil {
+				tc.modify(&src.Spec)
+			}
+
+			assertFieldError(t, tc.expectErrorMsg, src.Validate(context.Background()))
+		})
+	}
+}
+
+func tWebhookSourceSpec() WebhookSourceSpec {
+	return WebhookSourceSpec{
+		SourceSpec: tSourceSpec(),
+		EventType:  "com.example.webhook",
+	}
+}
@@ -0,0 +1,47 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"knative.dev/pkg/apis"
+)
+
+// Validate implements apis.Validatable
+func (s *ZendeskSource) Validate(ctx context.Context) *apis.FieldError {
+	return s.Spec.Validate(ctx).ViaField("spec")
+}
+
+// Validate ZendeskSource spec
+func (s *ZendeskSourceSpec) Validate(ctx context.Context) *apis.FieldError {
+	errs := s.SourceSpec.Validate(ctx)
+
+	errs = errs.Also(s.Token.ValidateRequired(ctx).ViaField("token"))
+
+	if s.Email == "" {
+		errs = errs.Also(apis.ErrMissingField("email"))
+	}
+	if s.Subdomain == "" {
+		errs = errs.Also(apis.ErrMissingField("subdomain"))
+	}
+	if s.WebhookUsername == "" {
+		errs = errs.Also(apis.ErrMissingField("webhookUsername"))
+	}
+
+	return errs.Also(s.WebhookPassword.ValidateRequired(ctx).ViaField("webhookPassword"))
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+func TestZendeskSourceValidate(t *testing.T) {
+	testCases := map[string]struct {
+		modify         func(*ZendeskSourceSpec)
+		expectErrorMsg string
+	}{
+		"Valid": {},
+		"Missing token": {
+			modify:         func(s *ZendeskSourceSpec) { s.Token = v1alpha1.ValueFromField{} },
+			expectErrorMsg: "expected exactly one, got neither: spec.token.value, spec.token.valueFromSecret",
+		},
+		"Missing email and subdomain": {
+			modify: func(s *ZendeskSourceSpec) {
+				s.Email = ""
+				s.Subdomain = ""
+			},
+			expectErrorMsg: "missing field(s): spec.email, spec.subdomain",
+		},
+		"Missing webhook credentials": {
+			modify: func(s *ZendeskSourceSpec) {
+				s.WebhookUsername = ""
+				s.WebhookPassword = v1alpha1.ValueFromField{}
+			},
+			expectErrorMsg: "expected exactly one, got neither: spec.webhookPassword.value, spec.webhookPassword.valueFromSecret\n" +
+				"missing field(s): spec.webhookUsername",
+		},
+		"Missing sink": {
+			modify:         func(s *ZendeskSourceSpec) { s.Sink = duckv1.Destination{} },
+			expectErrorMsg: "expected at least one, got none: spec.sink.ref, spec.sink.uri",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			src := &ZendeskSource{Spec: tZendeskSourceSpec()}
+			if tc.modify != nil {
+				tc.modify(&src.Spec)
+			}
+
+			assertFieldError(t, tc.expectErrorMsg, src.Validate(context.Background()))
+		})
+	}
+}
+
+func tZendeskSourceSpec() ZendeskSourceSpec {
+	return ZendeskSourceSpec{
+		SourceSpec:      tSourceSpec(),
+		Token:           tSecretValue(),
+		Email:           "user@example.com",
+		Subdomain:       "example",
+		WebhookUsername: "zendesk",
+		WebhookPassword: tSecretValue(),
+	}
+}
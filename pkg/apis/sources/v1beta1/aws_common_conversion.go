@@ -0,0 +1,61 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	sourcesv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+)
+
+// convertTo copies the attributes of an AWSAuth to its v1alpha1 counterpart.
+func (a *AWSAuth) convertTo(sink *sourcesv1alpha1.AWSAuth) {
+	if a.Credentials != nil {
+		sink.Credentials = &sourcesv1alpha1.AWSSecurityCredentials{}
+		a.Credentials.convertTo(sink.Credentials)
+	}
+	sink.EksIAMRole = a.EksIAMRole
+}
+
+// convertFrom copies the attributes of a v1alpha1 AWSAuth.
+func (a *AWSAuth) convertFrom(source *sourcesv1alpha1.AWSAuth) {
+	if source.Credentials != nil {
+		a.Credentials = &AWSSecurityCredentials{}
+		a.Credentials.convertFrom(source.Credentials)
+	}
+	a.EksIAMRole = source.EksIAMRole
+}
+
+// convertTo copies the attributes of an AWSSecurityCredentials to its v1alpha1 counterpart.
+func (c *AWSSecurityCredentials) convertTo(sink *sourcesv1alpha1.AWSSecurityCredentials) {
+	sink.AccessKeyID = c.AccessKeyID
+	sink.SecretAccessKey = c.SecretAccessKey
+}
+
+// convertFrom copies the attributes of a v1alpha1 AWSSecurityCredentials.
+func (c *AWSSecurityCredentials) convertFrom(source *sourcesv1alpha1.AWSSecurityCredentials) {
+	c.AccessKeyID = source.AccessKeyID
+	c.SecretAccessKey = source.SecretAccessKey
+}
+
+// convertTo copies the attributes of an AWSEndpoint to its v1alpha1 counterpart.
+func (e *AWSEndpoint) convertTo(sink *sourcesv1alpha1.AWSEndpoint) {
+	sink.URL = e.URL
+}
+
+// convertFrom copies the attributes of a v1alpha1 AWSEndpoint.
+func (e *AWSEndpoint) convertFrom(source *sourcesv1alpha1.AWSEndpoint) {
+	e.URL = source.URL
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+
+	"knative.dev/pkg/apis"
+
+	sourcesv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+)
+
+// ConvertTo implements apis.Convertible.
+func (s *AWSCloudWatchSource) ConvertTo(_ context.Context, to apis.Convertible) error {
+	switch sink := to.(type) {
+	case *sourcesv1alpha1.AWSCloudWatchSource:
+		sink.ObjectMeta = s.ObjectMeta
+		s.Spec.convertTo(&sink.Spec)
+		sink.Status = s.Status
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", sink)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *AWSCloudWatchSource) ConvertFrom(_ context.Context, from apis.Convertible) error {
+	switch source := from.(type) {
+	case *sourcesv1alpha1.AWSCloudWatchSource:
+		s.ObjectMeta = source.ObjectMeta
+		s.Spec.convertFrom(&source.Spec)
+		s.Status = source.Status
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", source)
+	}
+}
+
+// convertTo copies the attributes of an AWSCloudWatchSourceSpec to its v1alpha1 counterpart.
+func (s *AWSCloudWatchSourceSpec) convertTo(sink *sourcesv1alpha1.AWSCloudWatchSourceSpec) {
+	sink.SourceSpec = s.SourceSpec
+	sink.Region = s.Region
+	sink.PollingInterval = s.PollingInterval
+	if s.MetricQueries != nil {
+		sink.MetricQueries = make([]sourcesv1alpha1.AWSCloudWatchMetricQuery, len(s.MetricQueries))
+		for i := range s.MetricQueries {
+			s.MetricQueries[i].convertTo(&sink.MetricQueries[i])
+		}
+	}
+	s.Auth.convertTo(&sink.Auth)
+	sink.AdapterOverrides = s.AdapterOverrides
+}
+
+// convertFrom copies the attributes of a v1alpha1 AWSCloudWatchSourceSpec.
+func (s *AWSCloudWatchSourceSpec) convertFrom(source *sourcesv1alpha1.AWSCloudWatchSourceSpec) {
+	s.SourceSpec = source.SourceSpec
+	s.Region = source.Region
+	s.PollingInterval = source.PollingInterval
+	if source.MetricQueries != nil {
+		s.MetricQueries = make([]AWSCloudWatchMetricQuery, len(source.MetricQueries))
+		for i := range source.MetricQueries {
+			s.MetricQueries[i].convertFrom(&source.MetricQueries[i])
+		}
+	}
+	s.Auth.convertFrom(&source.Auth)
+	s.AdapterOverrides = source.AdapterOverrides
+}
+
+// convertTo copies the attributes of an AWSCloudWatchMetricQuery to its v1alpha1 counterpart.
+func (q *AWSCloudWatchMetricQuery) convertTo(sink *sourcesv1alpha1.AWSCloudWatchMetricQuery) {
+	sink.Name = q.Name
+	sink.Expression = q.Expression
+	if q.Metric != nil {
+		sink.Metric = &sourcesv1alpha1.AWSCloudWatchMetricStat{}
+		q.Metric.convertTo(sink.Metric)
+	}
+}
+
+// convertFrom copies the attributes of a v1alpha1 AWSCloudWatchMetricQuery.
+func (q *AWSCloudWatchMetricQuery) convertFrom(source *sourcesv1alpha1.AWSCloudWatchMetricQuery) {
+	q.Name = source.Name
+	q.Expression = source.Expression
+	if source.Metric != nil {
+		q.Metric = &AWSCloudWatchMetricStat{}
+		q.Metric.convertFrom(source.Metric)
+	}
+}
+
+// convertTo copies the attributes of an AWSCloudWatchMetricStat to its v1alpha1 counterpart.
+func (m *AWSCloudWatchMetricStat) convertTo(sink *sourcesv1alpha1.AWSCloudWatchMetricStat) {
+	m.Metric.convertTo(&sink.Metric)
+	sink.Period = m.Period
+	sink.Stat = m.Stat
+	sink.Unit = m.Unit
+}
+
+// convertFrom copies the attributes of a v1alpha1 AWSCloudWatchMetricStat.
+func (m *AWSCloudWatchMetricStat) convertFrom(source *sourcesv1alpha1.AWSCloudWatchMetricStat) {
+	m.Metric.convertFrom(&source.Metric)
+	m.Period = source.Period
+	m.Stat = source.Stat
+	m.Unit = source.Unit
+}
+
+// convertTo copies the attributes of an AWSCloudWatchMetric to its v1alpha1 counterpart.
+func (a *AWSCloudWatchMetric) convertTo(sink *sourcesv1alpha1.AWSCloudWatchMetric) {
+	if a.Dimensions != nil {
+		sink.Dimensions = make([]sourcesv1alpha1.AWSCloudWatchMetricDimension, len(a.Dimensions))
+		for i := range a.Dimensions {
+			a.Dimensions[i].convertTo(&sink.Dimensions[i])
+		}
+	}
+	sink.MetricName = a.MetricName
+	sink.Namespace = a.Namespace
+}
+
+// convertFrom copies the attributes of a v1alpha1 AWSCloudWatchMetric.
+func (a *AWSCloudWatchMetric) convertFrom(source *sourcesv1alpha1.AWSCloudWatchMetric) {
+	if source.Dimensions != nil {
+		a.Dimensions = make([]AWSCloudWatchMetricDimension, len(source.Dimensions))
+		for i := range source.Dimensions {
+			a.Dimensions[i].convertFrom(&source.Dimensions[i])
+		}
+	}
+	a.MetricName = source.MetricName
+	a.Namespace = source.Namespace
+}
+
+// convertTo copies the attributes of an AWSCloudWatchMetricDimension to its v1alpha1 counterpart.
+func (a *AWSCloudWatchMetricDimension) convertTo(sink *sourcesv1alpha1.AWSCloudWatchMetricDimension) {
+	sink.Name = a.Name
+	sink.Value = a.Value
+}
+
+// convertFrom copies the attributes of a v1alpha1 AWSCloudWatchMetricDimension.
+func (a *AWSCloudWatchMetricDimension) convertFrom(source *sourcesv1alpha1.AWSCloudWatchMetricDimension) {
+	a.Name = source.Name
+	a.Value = source.Value
+}
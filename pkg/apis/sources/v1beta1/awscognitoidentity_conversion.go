@@ -0,0 +1,68 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+
+	"knative.dev/pkg/apis"
+
+	sourcesv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+)
+
+// ConvertTo implements apis.Convertible.
+func (s *AWSCognitoIdentitySource) ConvertTo(_ context.Context, to apis.Convertible) error {
+	switch sink := to.(type) {
+	case *sourcesv1alpha1.AWSCognitoIdentitySource:
+		sink.ObjectMeta = s.ObjectMeta
+		s.Spec.convertTo(&sink.Spec)
+		sink.Status = s.Status
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", sink)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *AWSCognitoIdentitySource) ConvertFrom(_ context.Context, from apis.Convertible) error {
+	switch source := from.(type) {
+	case *sourcesv1alpha1.AWSCognitoIdentitySource:
+		s.ObjectMeta = source.ObjectMeta
+		s.Spec.convertFrom(&source.Spec)
+		s.Status = source.Status
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", source)
+	}
+}
+
+// convertTo copies the attributes of an AWSCognitoIdentitySourceSpec to its v1alpha1 counterpart.
+func (s *AWSCognitoIdentitySourceSpec) convertTo(sink *sourcesv1alpha1.AWSCognitoIdentitySourceSpec) {
+	sink.SourceSpec = s.SourceSpec
+	sink.ARN = s.ARN
+	s.Auth.convertTo(&sink.Auth)
+	sink.AdapterOverrides = s.AdapterOverrides
+}
+
+// convertFrom copies the attributes of a v1alpha1 AWSCognitoIdentitySourceSpec.
+func (s *AWSCognitoIdentitySourceSpec) convertFrom(source *sourcesv1alpha1.AWSCognitoIdentitySourceSpec) {
+	s.SourceSpec = source.SourceSpec
+	s.ARN = source.ARN
+	s.Auth.convertFrom(&source.Auth)
+	s.AdapterOverrides = source.AdapterOverrides
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	"github.com/triggermesh/triggermesh/pkg/apis"
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AWSDynamoDBSource is the Schema for the event source.
+type AWSDynamoDBSource struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AWSDynamoDBSourceSpec `json:"spec,omitempty"`
+	Status v1alpha1.Status       `json:"status,omitempty"`
+}
+
+// AWSDynamoDBSourceSpec defines the desired state of the event source.
+type AWSDynamoDBSourceSpec struct {
+	duckv1.SourceSpec `json:",inline"`
+
+	// Table ARN
+	// https://docs.aws.amazon.com/IAM/latest/UserGuide/list_amazondynamodb.html#amazondynamodb-resources-for-iam-policies
+	ARN apis.ARN `json:"arn"`
+
+	// Authentication method to interact with the Amazon DynamoDB API.
+	Auth AWSAuth `json:"auth"`
+
+	// Position in a shard of the table's stream from which records are
+	// read when no checkpoint was previously recorded for that shard.
+	// Defaults to LATEST.
+	// +optional
+	InitialPosition *AWSDynamoDBInitialPosition `json:"initialPosition,omitempty"`
+
+	// Adapter spec overrides parameters.
+	// +optional
+	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
+}
+
+// AWSDynamoDBInitialPosition is a position in a shard of a DynamoDB stream.
+// https://docs.aws.amazon.com/amazondynamodb/latest/APIReference/API_streams_GetShardIterator.html
+type AWSDynamoDBInitialPosition string
+
+// Supported initial positions in a shard of a DynamoDB stream.
+const (
+	// Oldest record in the shard.
+	AWSDynamoDBInitialPositionTrimHorizon AWSDynamoDBInitialPosition = "TRIM_HORIZON"
+	// Most recent record in the shard.
+	AWSDynamoDBInitialPositionLatest AWSDynamoDBInitialPosition = "LATEST"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AWSDynamoDBSourceList contains a list of event sources.
+type AWSDynamoDBSourceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AWSDynamoDBSource `json:"items"`
+}
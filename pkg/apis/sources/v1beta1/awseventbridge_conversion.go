@@ -0,0 +1,118 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+
+	"knative.dev/pkg/apis"
+
+	sourcesv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+)
+
+// ConvertTo implements apis.Convertible.
+func (s *AWSEventBridgeSource) ConvertTo(_ context.Context, to apis.Convertible) error {
+	switch sink := to.(type) {
+	case *sourcesv1alpha1.AWSEventBridgeSource:
+		sink.ObjectMeta = s.ObjectMeta
+		s.Spec.convertTo(&sink.Spec)
+		s.Status.convertTo(&sink.Status)
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", sink)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *AWSEventBridgeSource) ConvertFrom(_ context.Context, from apis.Convertible) error {
+	switch source := from.(type) {
+	case *sourcesv1alpha1.AWSEventBridgeSource:
+		s.ObjectMeta = source.ObjectMeta
+		s.Spec.convertFrom(&source.Spec)
+		s.Status.convertFrom(&source.Status)
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", source)
+	}
+}
+
+// convertTo copies the attributes of an AWSEventBridgeSourceSpec to its v1alpha1 counterpart.
+func (s *AWSEventBridgeSourceSpec) convertTo(sink *sourcesv1alpha1.AWSEventBridgeSourceSpec) {
+	sink.SourceSpec = s.SourceSpec
+	sink.ARN = s.ARN
+	sink.EventPattern = s.EventPattern
+	if s.Destination != nil {
+		sink.Destination = &sourcesv1alpha1.AWSEventBridgeSourceDestination{}
+		s.Destination.convertTo(sink.Destination)
+	}
+	s.Auth.convertTo(&sink.Auth)
+	sink.AdapterOverrides = s.AdapterOverrides
+}
+
+// convertFrom copies the attributes of a v1alpha1 AWSEventBridgeSourceSpec.
+func (s *AWSEventBridgeSourceSpec) convertFrom(source *sourcesv1alpha1.AWSEventBridgeSourceSpec) {
+	s.SourceSpec = source.SourceSpec
+	s.ARN = source.ARN
+	s.EventPattern = source.EventPattern
+	if source.Destination != nil {
+		s.Destination = &AWSEventBridgeSourceDestination{}
+		s.Destination.convertFrom(source.Destination)
+	}
+	s.Auth.convertFrom(&source.Auth)
+	s.AdapterOverrides = source.AdapterOverrides
+}
+
+// convertTo copies the attributes of an AWSEventBridgeSourceDestination to its v1alpha1 counterpart.
+func (a *AWSEventBridgeSourceDestination) convertTo(sink *sourcesv1alpha1.AWSEventBridgeSourceDestination) {
+	if a.SQS != nil {
+		sink.SQS = &sourcesv1alpha1.AWSEventBridgeSourceDestinationSQS{}
+		a.SQS.convertTo(sink.SQS)
+	}
+}
+
+// convertFrom copies the attributes of a v1alpha1 AWSEventBridgeSourceDestination.
+func (a *AWSEventBridgeSourceDestination) convertFrom(source *sourcesv1alpha1.AWSEventBridgeSourceDestination) {
+	if source.SQS != nil {
+		a.SQS = &AWSEventBridgeSourceDestinationSQS{}
+		a.SQS.convertFrom(source.SQS)
+	}
+}
+
+// convertTo copies the attributes of an AWSEventBridgeSourceDestinationSQS to its v1alpha1 counterpart.
+func (a *AWSEventBridgeSourceDestinationSQS) convertTo(sink *sourcesv1alpha1.AWSEventBridgeSourceDestinationSQS) {
+	sink.QueueARN = a.QueueARN
+}
+
+// convertFrom copies the attributes of a v1alpha1 AWSEventBridgeSourceDestinationSQS.
+func (a *AWSEventBridgeSourceDestinationSQS) convertFrom(source *sourcesv1alpha1.AWSEventBridgeSourceDestinationSQS) {
+	a.QueueARN = source.QueueARN
+}
+
+// convertTo copies the attributes of an AWSEventBridgeSourceStatus to its v1alpha1 counterpart.
+func (s *AWSEventBridgeSourceStatus) convertTo(sink *sourcesv1alpha1.AWSEventBridgeSourceStatus) {
+	sink.Status = s.Status
+	sink.EventRuleARN = s.EventRuleARN
+	sink.QueueARN = s.QueueARN
+}
+
+// convertFrom copies the attributes of a v1alpha1 AWSEventBridgeSourceStatus.
+func (s *AWSEventBridgeSourceStatus) convertFrom(source *sourcesv1alpha1.AWSEventBridgeSourceStatus) {
+	s.Status = source.Status
+	s.EventRuleARN = source.EventRuleARN
+	s.QueueARN = source.QueueARN
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+
+	"knative.dev/pkg/apis"
+
+	sourcesv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+)
+
+// ConvertTo implements apis.Convertible.
+func (s *AWSKinesisSource) ConvertTo(_ context.Context, to apis.Convertible) error {
+	switch sink := to.(type) {
+	case *sourcesv1alpha1.AWSKinesisSource:
+		sink.ObjectMeta = s.ObjectMeta
+		s.Spec.convertTo(&sink.Spec)
+		sink.Status = s.Status
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", sink)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *AWSKinesisSource) ConvertFrom(_ context.Context, from apis.Convertible) error {
+	switch source := from.(type) {
+	case *sourcesv1alpha1.AWSKinesisSource:
+		s.ObjectMeta = source.ObjectMeta
+		s.Spec.convertFrom(&source.Spec)
+		s.Status = source.Status
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", source)
+	}
+}
+
+// convertTo copies the attributes of an AWSKinesisSourceSpec to its v1alpha1 counterpart.
+func (s *AWSKinesisSourceSpec) convertTo(sink *sourcesv1alpha1.AWSKinesisSourceSpec) {
+	sink.SourceSpec = s.SourceSpec
+	sink.ARN = s.ARN
+	s.Auth.convertTo(&sink.Auth)
+	if s.ConsumerOptions != nil {
+		sink.ConsumerOptions = &sourcesv1alpha1.AWSKinesisSourceConsumerOptions{}
+		s.ConsumerOptions.convertTo(sink.ConsumerOptions)
+	}
+	sink.AdapterOverrides = s.AdapterOverrides
+}
+
+// convertFrom copies the attributes of a v1alpha1 AWSKinesisSourceSpec.
+func (s *AWSKinesisSourceSpec) convertFrom(source *sourcesv1alpha1.AWSKinesisSourceSpec) {
+	s.SourceSpec = source.SourceSpec
+	s.ARN = source.ARN
+	s.Auth.convertFrom(&source.Auth)
+	if source.ConsumerOptions != nil {
+		s.ConsumerOptions = &AWSKinesisSourceConsumerOptions{}
+		s.ConsumerOptions.convertFrom(source.ConsumerOptions)
+	}
+	s.AdapterOverrides = source.AdapterOverrides
+}
+
+// convertTo copies the attributes of an AWSKinesisSourceConsumerOptions to its v1alpha1 counterpart.
+func (o *AWSKinesisSourceConsumerOptions) convertTo(sink *sourcesv1alpha1.AWSKinesisSourceConsumerOptions) {
+	sink.InitialPosition = (*sourcesv1alpha1.AWSKinesisInitialPosition)(o.InitialPosition)
+	sink.InitialTimestamp = o.InitialTimestamp
+	sink.ShardDiscoveryInterval = o.ShardDiscoveryInterval
+}
+
+// convertFrom copies the attributes of a v1alpha1 AWSKinesisSourceConsumerOptions.
+func (o *AWSKinesisSourceConsumerOptions) convertFrom(source *sourcesv1alpha1.AWSKinesisSourceConsumerOptions) {
+	o.InitialPosition = (*AWSKinesisInitialPosition)(source.InitialPosition)
+	o.InitialTimestamp = source.InitialTimestamp
+	o.ShardDiscoveryInterval = source.ShardDiscoveryInterval
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	"github.com/triggermesh/triggermesh/pkg/apis"
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AWSKinesisSource is the Schema for the event source.
+type AWSKinesisSource struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AWSKinesisSourceSpec `json:"spec,omitempty"`
+	Status v1alpha1.Status      `json:"status,omitempty"`
+}
+
+// AWSKinesisSourceSpec defines the desired state of the event source.
+type AWSKinesisSourceSpec struct {
+	duckv1.SourceSpec `json:",inline"`
+
+	// Stream ARN
+	// https://docs.aws.amazon.com/IAM/latest/UserGuide/list_amazonkinesis.html#amazonkinesis-resources-for-iam-policies
+	ARN apis.ARN `json:"arn"`
+
+	// Authentication method to interact with the Amazon Kinesis API.
+	Auth AWSAuth `json:"auth"`
+
+	// Options which control the consumption of records from the stream.
+	// +optional
+	ConsumerOptions *AWSKinesisSourceConsumerOptions `json:"consumerOptions,omitempty"`
+
+	// Adapter spec overrides parameters.
+	// +optional
+	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
+}
+
+// AWSKinesisSourceConsumerOptions defines how records are consumed from the
+// shards of a Kinesis stream.
+type AWSKinesisSourceConsumerOptions struct {
+	// Position in a shard from which records are read when no checkpoint
+	// was previously recorded for that shard. Defaults to LATEST.
+	// +optional
+	InitialPosition *AWSKinesisInitialPosition `json:"initialPosition,omitempty"`
+
+	// Time from which records are read when the initial position is
+	// AT_TIMESTAMP.
+	// +optional
+	InitialTimestamp *metav1.Time `json:"initialTimestamp,omitempty"`
+
+	// Interval at which the shards of the stream are listed, in order to
+	// discover shards created by resharding operations. Defaults to 30s.
+	// +optional
+	ShardDiscoveryInterval *apis.Duration `json:"shardDiscoveryInterval,omitempty"`
+}
+
+// AWSKinesisInitialPosition is a position in a Kinesis shard.
+// https://docs.aws.amazon.com/kinesis/latest/APIReference/API_GetShardIterator.html
+type AWSKinesisInitialPosition string
+
+// Supported initial positions in a Kinesis shard.
+const (
+	// Oldest record in the shard.
+	AWSKinesisInitialPositionTrimHorizon AWSKinesisInitialPosition = "TRIM_HORIZON"
+	// Most recent record in the shard.
+	AWSKinesisInitialPositionLatest AWSKinesisInitialPosition = "LATEST"
+	// Record which was written at, or right after, a given timestamp.
+	AWSKinesisInitialPositionAtTimestamp AWSKinesisInitialPosition = "AT_TIMESTAMP"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AWSKinesisSourceList contains a list of event sources.
+type AWSKinesisSourceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AWSKinesisSource `json:"items"`
+}
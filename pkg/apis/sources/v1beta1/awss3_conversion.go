@@ -0,0 +1,116 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+
+	"knative.dev/pkg/apis"
+
+	sourcesv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+)
+
+// ConvertTo implements apis.Convertible.
+func (s *AWSS3Source) ConvertTo(_ context.Context, to apis.Convertible) error {
+	switch sink := to.(type) {
+	case *sourcesv1alpha1.AWSS3Source:
+		sink.ObjectMeta = s.ObjectMeta
+		s.Spec.convertTo(&sink.Spec)
+		s.Status.convertTo(&sink.Status)
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", sink)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *AWSS3Source) ConvertFrom(_ context.Context, from apis.Convertible) error {
+	switch source := from.(type) {
+	case *sourcesv1alpha1.AWSS3Source:
+		s.ObjectMeta = source.ObjectMeta
+		s.Spec.convertFrom(&source.Spec)
+		s.Status.convertFrom(&source.Status)
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", source)
+	}
+}
+
+// convertTo copies the attributes of an AWSS3SourceSpec to its v1alpha1 counterpart.
+func (s *AWSS3SourceSpec) convertTo(sink *sourcesv1alpha1.AWSS3SourceSpec) {
+	sink.SourceSpec = s.SourceSpec
+	sink.ARN = s.ARN
+	sink.EventTypes = s.EventTypes
+	if s.Destination != nil {
+		sink.Destination = &sourcesv1alpha1.AWSS3SourceDestination{}
+		s.Destination.convertTo(sink.Destination)
+	}
+	s.Auth.convertTo(&sink.Auth)
+	sink.AdapterOverrides = s.AdapterOverrides
+}
+
+// convertFrom copies the attributes of a v1alpha1 AWSS3SourceSpec.
+func (s *AWSS3SourceSpec) convertFrom(source *sourcesv1alpha1.AWSS3SourceSpec) {
+	s.SourceSpec = source.SourceSpec
+	s.ARN = source.ARN
+	s.EventTypes = source.EventTypes
+	if source.Destination != nil {
+		s.Destination = &AWSS3SourceDestination{}
+		s.Destination.convertFrom(source.Destination)
+	}
+	s.Auth.convertFrom(&source.Auth)
+	s.AdapterOverrides = source.AdapterOverrides
+}
+
+// convertTo copies the attributes of an AWSS3SourceDestination to its v1alpha1 counterpart.
+func (a *AWSS3SourceDestination) convertTo(sink *sourcesv1alpha1.AWSS3SourceDestination) {
+	if a.SQS != nil {
+		sink.SQS = &sourcesv1alpha1.AWSS3SourceDestinationSQS{}
+		a.SQS.convertTo(sink.SQS)
+	}
+}
+
+// convertFrom copies the attributes of a v1alpha1 AWSS3SourceDestination.
+func (a *AWSS3SourceDestination) convertFrom(source *sourcesv1alpha1.AWSS3SourceDestination) {
+	if source.SQS != nil {
+		a.SQS = &AWSS3SourceDestinationSQS{}
+		a.SQS.convertFrom(source.SQS)
+	}
+}
+
+// convertTo copies the attributes of an AWSS3SourceDestinationSQS to its v1alpha1 counterpart.
+func (a *AWSS3SourceDestinationSQS) convertTo(sink *sourcesv1alpha1.AWSS3SourceDestinationSQS) {
+	sink.QueueARN = a.QueueARN
+}
+
+// convertFrom copies the attributes of a v1alpha1 AWSS3SourceDestinationSQS.
+func (a *AWSS3SourceDestinationSQS) convertFrom(source *sourcesv1alpha1.AWSS3SourceDestinationSQS) {
+	a.QueueARN = source.QueueARN
+}
+
+// convertTo copies the attributes of an AWSS3SourceStatus to its v1alpha1 counterpart.
+func (s *AWSS3SourceStatus) convertTo(sink *sourcesv1alpha1.AWSS3SourceStatus) {
+	sink.Status = s.Status
+	sink.QueueARN = s.QueueARN
+}
+
+// convertFrom copies the attributes of a v1alpha1 AWSS3SourceStatus.
+func (s *AWSS3SourceStatus) convertFrom(source *sourcesv1alpha1.AWSS3SourceStatus) {
+	s.Status = source.Status
+	s.QueueARN = source.QueueARN
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+
+	"knative.dev/pkg/apis"
+
+	sourcesv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+)
+
+// ConvertTo implements apis.Convertible.
+func (s *AWSSQSSource) ConvertTo(_ context.Context, to apis.Convertible) error {
+	switch sink := to.(type) {
+	case *sourcesv1alpha1.AWSSQSSource:
+		sink.ObjectMeta = s.ObjectMeta
+		s.Spec.convertTo(&sink.Spec)
+		s.Status.convertTo(&sink.Status)
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", sink)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *AWSSQSSource) ConvertFrom(_ context.Context, from apis.Convertible) error {
+	switch source := from.(type) {
+	case *sourcesv1alpha1.AWSSQSSource:
+		s.ObjectMeta = source.ObjectMeta
+		s.Spec.convertFrom(&source.Spec)
+		s.Status.convertFrom(&source.Status)
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", source)
+	}
+}
+
+// convertTo copies the attributes of an AWSSQSSourceSpec to its v1alpha1 counterpart.
+func (s *AWSSQSSourceSpec) convertTo(sink *sourcesv1alpha1.AWSSQSSourceSpec) {
+	sink.SourceSpec = s.SourceSpec
+	sink.ARN = s.ARN
+	if s.ReceiveOptions != nil {
+		sink.ReceiveOptions = &sourcesv1alpha1.AWSSQSSourceReceiveOptions{}
+		s.ReceiveOptions.convertTo(sink.ReceiveOptions)
+	}
+	if s.DeliveryOptions != nil {
+		sink.DeliveryOptions = &sourcesv1alpha1.AWSSQSSourceDeliveryOptions{}
+		s.DeliveryOptions.convertTo(sink.DeliveryOptions)
+	}
+	sink.MessageProcessor = s.MessageProcessor
+	s.Auth.convertTo(&sink.Auth)
+	if s.Endpoint != nil {
+		sink.Endpoint = &sourcesv1alpha1.AWSEndpoint{}
+		s.Endpoint.convertTo(sink.Endpoint)
+	}
+	sink.AdapterOverrides = s.AdapterOverrides
+}
+
+// convertFrom copies the attributes of a v1alpha1 AWSSQSSourceSpec.
+func (s *AWSSQSSourceSpec) convertFrom(source *sourcesv1alpha1.AWSSQSSourceSpec) {
+	s.SourceSpec = source.SourceSpec
+	s.ARN = source.ARN
+	if source.ReceiveOptions != nil {
+		s.ReceiveOptions = &AWSSQSSourceReceiveOptions{}
+		s.ReceiveOptions.convertFrom(source.ReceiveOptions)
+	}
+	if source.DeliveryOptions != nil {
+		s.DeliveryOptions = &AWSSQSSourceDeliveryOptions{}
+		s.DeliveryOptions.convertFrom(source.DeliveryOptions)
+	}
+	s.MessageProcessor = source.MessageProcessor
+	s.Auth.convertFrom(&source.Auth)
+	if source.Endpoint != nil {
+		s.Endpoint = &AWSEndpoint{}
+		s.Endpoint.convertFrom(source.Endpoint)
+	}
+	s.AdapterOverrides = source.AdapterOverrides
+}
+
+// convertTo copies the attributes of an AWSSQSSourceReceiveOptions to its v1alpha1 counterpart.
+func (o *AWSSQSSourceReceiveOptions) convertTo(sink *sourcesv1alpha1.AWSSQSSourceReceiveOptions) {
+	sink.VisibilityTimeout = o.VisibilityTimeout
+	sink.ProcessingWorkers = o.ProcessingWorkers
+	sink.PreserveGroupOrder = o.PreserveGroupOrder
+}
+
+// convertFrom copies the attributes of a v1alpha1 AWSSQSSourceReceiveOptions.
+func (o *AWSSQSSourceReceiveOptions) convertFrom(source *sourcesv1alpha1.AWSSQSSourceReceiveOptions) {
+	o.VisibilityTimeout = source.VisibilityTimeout
+	o.ProcessingWorkers = source.ProcessingWorkers
+	o.PreserveGroupOrder = source.PreserveGroupOrder
+}
+
+// convertTo copies the attributes of an AWSSQSSourceDeliveryOptions to its v1alpha1 counterpart.
+func (o *AWSSQSSourceDeliveryOptions) convertTo(sink *sourcesv1alpha1.AWSSQSSourceDeliveryOptions) {
+	sink.RetryBackoff = o.RetryBackoff
+	sink.MaxReceiveCount = o.MaxReceiveCount
+	sink.DeadLetterSink = o.DeadLetterSink
+}
+
+// convertFrom copies the attributes of a v1alpha1 AWSSQSSourceDeliveryOptions.
+func (o *AWSSQSSourceDeliveryOptions) convertFrom(source *sourcesv1alpha1.AWSSQSSourceDeliveryOptions) {
+	o.RetryBackoff = source.RetryBackoff
+	o.MaxReceiveCount = source.MaxReceiveCount
+	o.DeadLetterSink = source.DeadLetterSink
+}
+
+// convertTo copies the attributes of an AWSSQSSourceStatus to its v1alpha1 counterpart.
+func (s *AWSSQSSourceStatus) convertTo(sink *sourcesv1alpha1.AWSSQSSourceStatus) {
+	sink.Status = s.Status
+	sink.DeadLetterSinkURI = s.DeadLetterSinkURI
+}
+
+// convertFrom copies the attributes of a v1alpha1 AWSSQSSourceStatus.
+func (s *AWSSQSSourceStatus) convertFrom(source *sourcesv1alpha1.AWSSQSSourceStatus) {
+	s.Status = source.Status
+	s.DeadLetterSinkURI = source.DeadLetterSinkURI
+}
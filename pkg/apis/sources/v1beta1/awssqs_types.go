@@ -0,0 +1,146 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	pkgapis "knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	"github.com/triggermesh/triggermesh/pkg/apis"
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AWSSQSSource is the Schema for the event source.
+type AWSSQSSource struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AWSSQSSourceSpec   `json:"spec,omitempty"`
+	Status AWSSQSSourceStatus `json:"status,omitempty"`
+}
+
+// AWSSQSSourceSpec defines the desired state of the event source.
+type AWSSQSSourceSpec struct {
+	duckv1.SourceSpec `json:",inline"`
+
+	// Queue ARN
+	// https://docs.aws.amazon.com/IAM/latest/UserGuide/list_amazonsqs.html#amazonsqs-resources-for-iam-policies
+	ARN apis.ARN `json:"arn"`
+
+	// Options that control the behavior of message receivers.
+	// +optional
+	ReceiveOptions *AWSSQSSourceReceiveOptions `json:"receiveOptions,omitempty"`
+
+	// Options that control the handling of messages which events could not
+	// be delivered to the sink.
+	// +optional
+	DeliveryOptions *AWSSQSSourceDeliveryOptions `json:"deliveryOptions,omitempty"`
+
+	// Name of the message processor to use for converting SQS messages to CloudEvents.
+	// Supported values are "default" and "s3".
+	// +optional
+	MessageProcessor *string `json:"messageProcessor,omitempty"`
+
+	// Authentication method to interact with the Amazon SQS API.
+	Auth AWSAuth `json:"auth"`
+
+	// Customizations of the AWS REST API endpoint.
+	// +optional
+	Endpoint *AWSEndpoint `json:"endpoint,omitempty"`
+
+	// Adapter spec overrides parameters.
+	// +optional
+	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
+}
+
+// AWSSQSSourceReceiveOptions defines options that control the behavior of
+// Amazon SQS message receivers.
+type AWSSQSSourceReceiveOptions struct {
+	// Period of time during which Amazon SQS prevents other consumers from
+	// receiving and processing a message that has been received via ReceiveMessage.
+	// Expressed as a duration string, which format is documented at https://pkg.go.dev/time#ParseDuration.
+	//
+	// If not defined, the overall visibility timeout for the queue is used.
+	//
+	// For more details, please refer to the Amazon SQS Developer Guide at
+	// https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-visibility-timeout.html.
+	//
+	// +optional
+	VisibilityTimeout *apis.Duration `json:"visibilityTimeout,omitempty"`
+
+	// Number of workers which process received messages concurrently.
+	// Defaults to a multiple of the number of CPUs available to the adapter.
+	// +optional
+	ProcessingWorkers *int32 `json:"processingWorkers,omitempty"`
+
+	// Process messages which share a MessageGroupId sequentially, in the
+	// order they were received. Only relevant for FIFO queues, which
+	// messages of distinct groups are still processed concurrently.
+	// +optional
+	PreserveGroupOrder *bool `json:"preserveGroupOrder,omitempty"`
+}
+
+// AWSSQSSourceDeliveryOptions defines options that control the handling of
+// Amazon SQS messages which events could not be delivered to the sink. Such
+// messages are never deleted from the queue, unless they could be forwarded
+// to the dead-letter sink.
+type AWSSQSSourceDeliveryOptions struct {
+	// Base delay before a message which events could not be delivered
+	// becomes visible again for redelivery. The delay is multiplied by the
+	// number of times the message was received, up to 12 hours.
+	// Expressed as a duration string, which format is documented at https://pkg.go.dev/time#ParseDuration.
+	//
+	// If not defined, the message becomes visible again after its current
+	// visibility timeout expires.
+	//
+	// +optional
+	RetryBackoff *apis.Duration `json:"retryBackoff,omitempty"`
+
+	// Number of times a message must have been received before it is
+	// forwarded to the dead-letter sink and deleted from the queue.
+	// Should be lower than the maxReceiveCount of the queue's redrive
+	// policy, if any.
+	// +optional
+	MaxReceiveCount *int32 `json:"maxReceiveCount,omitempty"`
+
+	// Destination of messages which were received maxReceiveCount times.
+	// The reason of the last delivery failure is set in the
+	// "deadletterreason" extension of the event.
+	// +optional
+	DeadLetterSink *duckv1.Destination `json:"deadLetterSink,omitempty"`
+}
+
+// AWSSQSSourceStatus defines the observed state of the event source.
+type AWSSQSSourceStatus struct {
+	v1alpha1.Status `json:",inline"`
+
+	// DeadLetterSinkURI is the resolved URI of the dead-letter sink.
+	// +optional
+	DeadLetterSinkURI *pkgapis.URL `json:"deadLetterSinkUri,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AWSSQSSourceList contains a list of event sources.
+type AWSSQSSourceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AWSSQSSource `json:"items"`
+}
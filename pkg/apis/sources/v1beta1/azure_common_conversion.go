@@ -0,0 +1,85 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	sourcesv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+)
+
+// convertTo copies the attributes of an AzureAuth to its v1alpha1 counterpart.
+func (a *AzureAuth) convertTo(sink *sourcesv1alpha1.AzureAuth) {
+	if a.ServicePrincipal != nil {
+		sink.ServicePrincipal = &sourcesv1alpha1.AzureServicePrincipal{}
+		a.ServicePrincipal.convertTo(sink.ServicePrincipal)
+	}
+	if a.SASToken != nil {
+		sink.SASToken = &sourcesv1alpha1.AzureSASToken{}
+		a.SASToken.convertTo(sink.SASToken)
+	}
+}
+
+// convertFrom copies the attributes of a v1alpha1 AzureAuth.
+func (a *AzureAuth) convertFrom(source *sourcesv1alpha1.AzureAuth) {
+	if source.ServicePrincipal != nil {
+		a.ServicePrincipal = &AzureServicePrincipal{}
+		a.ServicePrincipal.convertFrom(source.ServicePrincipal)
+	}
+	if source.SASToken != nil {
+		a.SASToken = &AzureSASToken{}
+		a.SASToken.convertFrom(source.SASToken)
+	}
+}
+
+// convertTo copies the attributes of an AzureServicePrincipal to its v1alpha1 counterpart.
+func (p *AzureServicePrincipal) convertTo(sink *sourcesv1alpha1.AzureServicePrincipal) {
+	sink.TenantID = p.TenantID
+	sink.ClientID = p.ClientID
+	sink.ClientSecret = p.ClientSecret
+}
+
+// convertFrom copies the attributes of a v1alpha1 AzureServicePrincipal.
+func (p *AzureServicePrincipal) convertFrom(source *sourcesv1alpha1.AzureServicePrincipal) {
+	p.TenantID = source.TenantID
+	p.ClientID = source.ClientID
+	p.ClientSecret = source.ClientSecret
+}
+
+// convertTo copies the attributes of an AzureSASToken to its v1alpha1 counterpart.
+func (t *AzureSASToken) convertTo(sink *sourcesv1alpha1.AzureSASToken) {
+	sink.KeyName = t.KeyName
+	sink.KeyValue = t.KeyValue
+	sink.ConnectionString = t.ConnectionString
+}
+
+// convertFrom copies the attributes of a v1alpha1 AzureSASToken.
+func (t *AzureSASToken) convertFrom(source *sourcesv1alpha1.AzureSASToken) {
+	t.KeyName = source.KeyName
+	t.KeyValue = source.KeyValue
+	t.ConnectionString = source.ConnectionString
+}
+
+// convertTo copies the attributes of an AzureServiceBusSettlement to its v1alpha1 counterpart.
+func (s *AzureServiceBusSettlement) convertTo(sink *sourcesv1alpha1.AzureServiceBusSettlement) {
+	sink.OnFailure = (*sourcesv1alpha1.AzureServiceBusSettlementAction)(s.OnFailure)
+	sink.MaxDeliveryAttempts = s.MaxDeliveryAttempts
+}
+
+// convertFrom copies the attributes of a v1alpha1 AzureServiceBusSettlement.
+func (s *AzureServiceBusSettlement) convertFrom(source *sourcesv1alpha1.AzureServiceBusSettlement) {
+	s.OnFailure = (*AzureServiceBusSettlementAction)(source.OnFailure)
+	s.MaxDeliveryAttempts = source.MaxDeliveryAttempts
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+
+	"knative.dev/pkg/apis"
+
+	sourcesv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+)
+
+// ConvertTo implements apis.Convertible.
+func (s *AzureActivityLogsSource) ConvertTo(_ context.Context, to apis.Convertible) error {
+	switch sink := to.(type) {
+	case *sourcesv1alpha1.AzureActivityLogsSource:
+		sink.ObjectMeta = s.ObjectMeta
+		s.Spec.convertTo(&sink.Spec)
+		s.Status.convertTo(&sink.Status)
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", sink)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *AzureActivityLogsSource) ConvertFrom(_ context.Context, from apis.Convertible) error {
+	switch source := from.(type) {
+	case *sourcesv1alpha1.AzureActivityLogsSource:
+		s.ObjectMeta = source.ObjectMeta
+		s.Spec.convertFrom(&source.Spec)
+		s.Status.convertFrom(&source.Status)
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", source)
+	}
+}
+
+// convertTo copies the attributes of an AzureActivityLogsSourceSpec to its v1alpha1 counterpart.
+func (s *AzureActivityLogsSourceSpec) convertTo(sink *sourcesv1alpha1.AzureActivityLogsSourceSpec) {
+	sink.SourceSpec = s.SourceSpec
+	sink.SubscriptionID = s.SubscriptionID
+	s.Destination.convertTo(&sink.Destination)
+	sink.Categories = s.Categories
+	s.Auth.convertTo(&sink.Auth)
+	sink.AdapterOverrides = s.AdapterOverrides
+}
+
+// convertFrom copies the attributes of a v1alpha1 AzureActivityLogsSourceSpec.
+func (s *AzureActivityLogsSourceSpec) convertFrom(source *sourcesv1alpha1.AzureActivityLogsSourceSpec) {
+	s.SourceSpec = source.SourceSpec
+	s.SubscriptionID = source.SubscriptionID
+	s.Destination.convertFrom(&source.Destination)
+	s.Categories = source.Categories
+	s.Auth.convertFrom(&source.Auth)
+	s.AdapterOverrides = source.AdapterOverrides
+}
+
+// convertTo copies the attributes of an AzureActivityLogsSourceDestination to its v1alpha1 counterpart.
+func (a *AzureActivityLogsSourceDestination) convertTo(sink *sourcesv1alpha1.AzureActivityLogsSourceDestination) {
+	a.EventHubs.convertTo(&sink.EventHubs)
+}
+
+// convertFrom copies the attributes of a v1alpha1 AzureActivityLogsSourceDestination.
+func (a *AzureActivityLogsSourceDestination) convertFrom(source *sourcesv1alpha1.AzureActivityLogsSourceDestination) {
+	a.EventHubs.convertFrom(&source.EventHubs)
+}
+
+// convertTo copies the attributes of an AzureActivityLogsSourceDestinationEventHubs to its v1alpha1 counterpart.
+func (a *AzureActivityLogsSourceDestinationEventHubs) convertTo(sink *sourcesv1alpha1.AzureActivityLogsSourceDestinationEventHubs) {
+	sink.NamespaceID = a.NamespaceID
+	sink.HubName = a.HubName
+	sink.SASPolicy = a.SASPolicy
+}
+
+// convertFrom copies the attributes of a v1alpha1 AzureActivityLogsSourceDestinationEventHubs.
+func (a *AzureActivityLogsSourceDestinationEventHubs) convertFrom(source *sourcesv1alpha1.AzureActivityLogsSourceDestinationEventHubs) {
+	a.NamespaceID = source.NamespaceID
+	a.HubName = source.HubName
+	a.SASPolicy = source.SASPolicy
+}
+
+// convertTo copies the attributes of an AzureActivityLogsSourceStatus to its v1alpha1 counterpart.
+func (s *AzureActivityLogsSourceStatus) convertTo(sink *sourcesv1alpha1.AzureActivityLogsSourceStatus) {
+	sink.Status = s.Status
+}
+
+// convertFrom copies the attributes of a v1alpha1 AzureActivityLogsSourceStatus.
+func (s *AzureActivityLogsSourceStatus) convertFrom(source *sourcesv1alpha1.AzureActivityLogsSourceStatus) {
+	s.Status = source.Status
+}
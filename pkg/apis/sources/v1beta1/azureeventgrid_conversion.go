@@ -0,0 +1,108 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+
+	"knative.dev/pkg/apis"
+
+	sourcesv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+)
+
+// ConvertTo implements apis.Convertible.
+func (s *AzureEventGridSource) ConvertTo(_ context.Context, to apis.Convertible) error {
+	switch sink := to.(type) {
+	case *sourcesv1alpha1.AzureEventGridSource:
+		sink.ObjectMeta = s.ObjectMeta
+		s.Spec.convertTo(&sink.Spec)
+		s.Status.convertTo(&sink.Status)
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", sink)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *AzureEventGridSource) ConvertFrom(_ context.Context, from apis.Convertible) error {
+	switch source := from.(type) {
+	case *sourcesv1alpha1.AzureEventGridSource:
+		s.ObjectMeta = source.ObjectMeta
+		s.Spec.convertFrom(&source.Spec)
+		s.Status.convertFrom(&source.Status)
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", source)
+	}
+}
+
+// convertTo copies the attributes of an AzureEventGridSourceSpec to its v1alpha1 counterpart.
+func (s *AzureEventGridSourceSpec) convertTo(sink *sourcesv1alpha1.AzureEventGridSourceSpec) {
+	sink.SourceSpec = s.SourceSpec
+	sink.Scope = s.Scope
+	sink.EventTypes = s.EventTypes
+	s.Endpoint.convertTo(&sink.Endpoint)
+	s.Auth.convertTo(&sink.Auth)
+	sink.AdapterOverrides = s.AdapterOverrides
+}
+
+// convertFrom copies the attributes of a v1alpha1 AzureEventGridSourceSpec.
+func (s *AzureEventGridSourceSpec) convertFrom(source *sourcesv1alpha1.AzureEventGridSourceSpec) {
+	s.SourceSpec = source.SourceSpec
+	s.Scope = source.Scope
+	s.EventTypes = source.EventTypes
+	s.Endpoint.convertFrom(&source.Endpoint)
+	s.Auth.convertFrom(&source.Auth)
+	s.AdapterOverrides = source.AdapterOverrides
+}
+
+// convertTo copies the attributes of an AzureEventGridSourceEndpoint to its v1alpha1 counterpart.
+func (a *AzureEventGridSourceEndpoint) convertTo(sink *sourcesv1alpha1.AzureEventGridSourceEndpoint) {
+	a.EventHubs.convertTo(&sink.EventHubs)
+}
+
+// convertFrom copies the attributes of a v1alpha1 AzureEventGridSourceEndpoint.
+func (a *AzureEventGridSourceEndpoint) convertFrom(source *sourcesv1alpha1.AzureEventGridSourceEndpoint) {
+	a.EventHubs.convertFrom(&source.EventHubs)
+}
+
+// convertTo copies the attributes of an AzureEventGridSourceDestinationEventHubs to its v1alpha1 counterpart.
+func (a *AzureEventGridSourceDestinationEventHubs) convertTo(sink *sourcesv1alpha1.AzureEventGridSourceDestinationEventHubs) {
+	sink.NamespaceID = a.NamespaceID
+	sink.HubName = a.HubName
+}
+
+// convertFrom copies the attributes of a v1alpha1 AzureEventGridSourceDestinationEventHubs.
+func (a *AzureEventGridSourceDestinationEventHubs) convertFrom(source *sourcesv1alpha1.AzureEventGridSourceDestinationEventHubs) {
+	a.NamespaceID = source.NamespaceID
+	a.HubName = source.HubName
+}
+
+// convertTo copies the attributes of an AzureEventGridSourceStatus to its v1alpha1 counterpart.
+func (s *AzureEventGridSourceStatus) convertTo(sink *sourcesv1alpha1.AzureEventGridSourceStatus) {
+	sink.Status = s.Status
+	sink.EventSubscriptionID = s.EventSubscriptionID
+	sink.EventHubID = s.EventHubID
+}
+
+// convertFrom copies the attributes of a v1alpha1 AzureEventGridSourceStatus.
+func (s *AzureEventGridSourceStatus) convertFrom(source *sourcesv1alpha1.AzureEventGridSourceStatus) {
+	s.Status = source.Status
+	s.EventSubscriptionID = source.EventSubscriptionID
+	s.EventHubID = source.EventHubID
+}
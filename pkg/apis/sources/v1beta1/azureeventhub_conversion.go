@@ -0,0 +1,128 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+
+	"knative.dev/pkg/apis"
+
+	sourcesv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+)
+
+// ConvertTo implements apis.Convertible.
+func (s *AzureEventHubSource) ConvertTo(_ context.Context, to apis.Convertible) error {
+	switch sink := to.(type) {
+	case *sourcesv1alpha1.AzureEventHubSource:
+		sink.ObjectMeta = s.ObjectMeta
+		s.Spec.convertTo(&sink.Spec)
+		sink.Status = s.Status
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", sink)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *AzureEventHubSource) ConvertFrom(_ context.Context, from apis.Convertible) error {
+	switch source := from.(type) {
+	case *sourcesv1alpha1.AzureEventHubSource:
+		s.ObjectMeta = source.ObjectMeta
+		s.Spec.convertFrom(&source.Spec)
+		s.Status = source.Status
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", source)
+	}
+}
+
+// convertTo copies the attributes of an AzureEventHubSourceSpec to its v1alpha1 counterpart.
+func (s *AzureEventHubSourceSpec) convertTo(sink *sourcesv1alpha1.AzureEventHubSourceSpec) {
+	sink.SourceSpec = s.SourceSpec
+	sink.EventHubID = s.EventHubID
+	s.Auth.convertTo(&sink.Auth)
+	sink.ConsumerGroup = s.ConsumerGroup
+	if s.ConsumerOptions != nil {
+		sink.ConsumerOptions = &sourcesv1alpha1.AzureEventHubSourceConsumerOptions{}
+		s.ConsumerOptions.convertTo(sink.ConsumerOptions)
+	}
+	sink.AdapterOverrides = s.AdapterOverrides
+}
+
+// convertFrom copies the attributes of a v1alpha1 AzureEventHubSourceSpec.
+func (s *AzureEventHubSourceSpec) convertFrom(source *sourcesv1alpha1.AzureEventHubSourceSpec) {
+	s.SourceSpec = source.SourceSpec
+	s.EventHubID = source.EventHubID
+	s.Auth.convertFrom(&source.Auth)
+	s.ConsumerGroup = source.ConsumerGroup
+	if source.ConsumerOptions != nil {
+		s.ConsumerOptions = &AzureEventHubSourceConsumerOptions{}
+		s.ConsumerOptions.convertFrom(source.ConsumerOptions)
+	}
+	s.AdapterOverrides = source.AdapterOverrides
+}
+
+// convertTo copies the attributes of an AzureEventHubSourceConsumerOptions to its v1alpha1 counterpart.
+func (o *AzureEventHubSourceConsumerOptions) convertTo(sink *sourcesv1alpha1.AzureEventHubSourceConsumerOptions) {
+	sink.StartPosition = (*sourcesv1alpha1.AzureEventHubStartPosition)(o.StartPosition)
+	sink.StartTime = o.StartTime
+	if o.CheckpointStore != nil {
+		sink.CheckpointStore = &sourcesv1alpha1.AzureEventHubCheckpointStore{}
+		o.CheckpointStore.convertTo(sink.CheckpointStore)
+	}
+}
+
+// convertFrom copies the attributes of a v1alpha1 AzureEventHubSourceConsumerOptions.
+func (o *AzureEventHubSourceConsumerOptions) convertFrom(source *sourcesv1alpha1.AzureEventHubSourceConsumerOptions) {
+	o.StartPosition = (*AzureEventHubStartPosition)(source.StartPosition)
+	o.StartTime = source.StartTime
+	if source.CheckpointStore != nil {
+		o.CheckpointStore = &AzureEventHubCheckpointStore{}
+		o.CheckpointStore.convertFrom(source.CheckpointStore)
+	}
+}
+
+// convertTo copies the attributes of an AzureEventHubCheckpointStore to its v1alpha1 counterpart.
+func (a *AzureEventHubCheckpointStore) convertTo(sink *sourcesv1alpha1.AzureEventHubCheckpointStore) {
+	if a.AzureBlob != nil {
+		sink.AzureBlob = &sourcesv1alpha1.AzureEventHubBlobCheckpointStore{}
+		a.AzureBlob.convertTo(sink.AzureBlob)
+	}
+}
+
+// convertFrom copies the attributes of a v1alpha1 AzureEventHubCheckpointStore.
+func (a *AzureEventHubCheckpointStore) convertFrom(source *sourcesv1alpha1.AzureEventHubCheckpointStore) {
+	if source.AzureBlob != nil {
+		a.AzureBlob = &AzureEventHubBlobCheckpointStore{}
+		a.AzureBlob.convertFrom(source.AzureBlob)
+	}
+}
+
+// convertTo copies the attributes of an AzureEventHubBlobCheckpointStore to its v1alpha1 counterpart.
+func (s *AzureEventHubBlobCheckpointStore) convertTo(sink *sourcesv1alpha1.AzureEventHubBlobCheckpointStore) {
+	sink.AccountName = s.AccountName
+	sink.ContainerName = s.ContainerName
+	sink.AccountKey = s.AccountKey
+}
+
+// convertFrom copies the attributes of a v1alpha1 AzureEventHubBlobCheckpointStore.
+func (s *AzureEventHubBlobCheckpointStore) convertFrom(source *sourcesv1alpha1.AzureEventHubBlobCheckpointStore) {
+	s.AccountName = source.AccountName
+	s.ContainerName = source.ContainerName
+	s.AccountKey = source.AccountKey
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+	sourcesv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AzureEventHubSource is the Schema for the event source.
+type AzureEventHubSource struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AzureEventHubSourceSpec `json:"spec,omitempty"`
+	Status v1alpha1.Status         `json:"status,omitempty"`
+}
+
+// AzureEventHubSourceSpec defines the desired state of the event source.
+type AzureEventHubSourceSpec struct {
+	duckv1.SourceSpec `json:",inline"`
+
+	// Resource ID of the Event Hubs instance.
+	//
+	// Expected format:
+	// - /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.EventHub/namespaces/{namespaceName}/eventhubs/{eventHubName}
+	EventHubID sourcesv1alpha1.AzureResourceID `json:"eventHubID"`
+
+	// Authentication method to interact with the Azure Event Hubs API.
+	Auth AzureAuth `json:"auth"`
+
+	// Consumer group from which events are read. Defaults to the default
+	// consumer group of the Event Hubs instance ("$Default").
+	// +optional
+	ConsumerGroup *string `json:"consumerGroup,omitempty"`
+
+	// Options which control the consumption of events from the partitions
+	// of the Event Hubs instance.
+	// +optional
+	ConsumerOptions *AzureEventHubSourceConsumerOptions `json:"consumerOptions,omitempty"`
+
+	// Adapter spec overrides parameters.
+	// +optional
+	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
+}
+
+// AzureEventHubSourceConsumerOptions defines how events are consumed from the
+// partitions of an Event Hubs instance.
+type AzureEventHubSourceConsumerOptions struct {
+	// Position in a partition from which events are read when no
+	// checkpoint was previously recorded for that partition. Defaults to
+	// "latest".
+	// +optional
+	StartPosition *AzureEventHubStartPosition `json:"startPosition,omitempty"`
+
+	// Time from which events are read when the start position is
+	// "enqueuedTime".
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// Store in which the positions of the last events read from each
+	// partition are persisted. Defaults to Kubernetes ConfigMap objects.
+	// +optional
+	CheckpointStore *AzureEventHubCheckpointStore `json:"checkpointStore,omitempty"`
+}
+
+// AzureEventHubStartPosition is a position in an Event Hubs partition.
+type AzureEventHubStartPosition string
+
+// Supported start positions in an Event Hubs partition.
+const (
+	// Oldest event retained in the partition.
+	AzureEventHubStartPositionEarliest AzureEventHubStartPosition = "earliest"
+	// Events enqueued after the start of the consumer.
+	AzureEventHubStartPositionLatest AzureEventHubStartPosition = "latest"
+	// Events enqueued at, or after, a given time.
+	AzureEventHubStartPositionEnqueuedTime AzureEventHubStartPosition = "enqueuedTime"
+)
+
+// AzureEventHubCheckpointStore is a store for checkpoints and partition
+// leases. Only one store can be set.
+type AzureEventHubCheckpointStore struct {
+	// Container of an Azure Storage account.
+	// +optional
+	AzureBlob *AzureEventHubBlobCheckpointStore `json:"azureBlob,omitempty"`
+}
+
+// AzureEventHubBlobCheckpointStore persists checkpoints and partition leases
+// as blobs in a container of an Azure Storage account.
+type AzureEventHubBlobCheckpointStore struct {
+	// Name of the Storage account.
+	AccountName string `json:"accountName"`
+	// Name of the blob container.
+	ContainerName string `json:"containerName"`
+	// Access key of the Storage account. When omitted, the credentials of
+	// the Service Principal set in the source's authentication method are
+	// used instead.
+	// +optional
+	AccountKey *v1alpha1.ValueFromField `json:"accountKey,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AzureEventHubSourceList contains a list of event sources.
+type AzureEventHubSourceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AzureEventHubSource `json:"items"`
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+
+	"knative.dev/pkg/apis"
+
+	sourcesv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+)
+
+// ConvertTo implements apis.Convertible.
+func (s *AzureQueueStorageSource) ConvertTo(_ context.Context, to apis.Convertible) error {
+	switch sink := to.(type) {
+	case *sourcesv1alpha1.AzureQueueStorageSource:
+		sink.ObjectMeta = s.ObjectMeta
+		s.Spec.convertTo(&sink.Spec)
+		sink.Status = s.Status
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", sink)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *AzureQueueStorageSource) ConvertFrom(_ context.Context, from apis.Convertible) error {
+	switch source := from.(type) {
+	case *sourcesv1alpha1.AzureQueueStorageSource:
+		s.ObjectMeta = source.ObjectMeta
+		s.Spec.convertFrom(&source.Spec)
+		s.Status = source.Status
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", source)
+	}
+}
+
+// convertTo copies the attributes of an AzureQueueStorageSourceSpec to its v1alpha1 counterpart.
+func (s *AzureQueueStorageSourceSpec) convertTo(sink *sourcesv1alpha1.AzureQueueStorageSourceSpec) {
+	sink.SourceSpec = s.SourceSpec
+	sink.AccountName = s.AccountName
+	sink.QueueName = s.QueueName
+	sink.AccountKey = s.AccountKey
+	sink.AdapterOverrides = s.AdapterOverrides
+}
+
+// convertFrom copies the attributes of a v1alpha1 AzureQueueStorageSourceSpec.
+func (s *AzureQueueStorageSourceSpec) convertFrom(source *sourcesv1alpha1.AzureQueueStorageSourceSpec) {
+	s.SourceSpec = source.SourceSpec
+	s.AccountName = source.AccountName
+	s.QueueName = source.QueueName
+	s.AccountKey = source.AccountKey
+	s.AdapterOverrides = source.AdapterOverrides
+}
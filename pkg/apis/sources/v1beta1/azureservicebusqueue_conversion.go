@@ -0,0 +1,82 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+
+	"knative.dev/pkg/apis"
+
+	sourcesv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+)
+
+// ConvertTo implements apis.Convertible.
+func (s *AzureServiceBusQueueSource) ConvertTo(_ context.Context, to apis.Convertible) error {
+	switch sink := to.(type) {
+	case *sourcesv1alpha1.AzureServiceBusQueueSource:
+		sink.ObjectMeta = s.ObjectMeta
+		s.Spec.convertTo(&sink.Spec)
+		sink.Status = s.Status
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", sink)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *AzureServiceBusQueueSource) ConvertFrom(_ context.Context, from apis.Convertible) error {
+	switch source := from.(type) {
+	case *sourcesv1alpha1.AzureServiceBusQueueSource:
+		s.ObjectMeta = source.ObjectMeta
+		s.Spec.convertFrom(&source.Spec)
+		s.Status = source.Status
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", source)
+	}
+}
+
+// convertTo copies the attributes of an AzureServiceBusQueueSourceSpec to its v1alpha1 counterpart.
+func (s *AzureServiceBusQueueSourceSpec) convertTo(sink *sourcesv1alpha1.AzureServiceBusQueueSourceSpec) {
+	sink.SourceSpec = s.SourceSpec
+	sink.QueueID = s.QueueID
+	s.Auth.convertTo(&sink.Auth)
+	sink.SessionsEnabled = s.SessionsEnabled
+	sink.MaxConcurrency = s.MaxConcurrency
+	sink.PrefetchCount = s.PrefetchCount
+	if s.Settlement != nil {
+		sink.Settlement = &sourcesv1alpha1.AzureServiceBusSettlement{}
+		s.Settlement.convertTo(sink.Settlement)
+	}
+	sink.AdapterOverrides = s.AdapterOverrides
+}
+
+// convertFrom copies the attributes of a v1alpha1 AzureServiceBusQueueSourceSpec.
+func (s *AzureServiceBusQueueSourceSpec) convertFrom(source *sourcesv1alpha1.AzureServiceBusQueueSourceSpec) {
+	s.SourceSpec = source.SourceSpec
+	s.QueueID = source.QueueID
+	s.Auth.convertFrom(&source.Auth)
+	s.SessionsEnabled = source.SessionsEnabled
+	s.MaxConcurrency = source.MaxConcurrency
+	s.PrefetchCount = source.PrefetchCount
+	if source.Settlement != nil {
+		s.Settlement = &AzureServiceBusSettlement{}
+		s.Settlement.convertFrom(source.Settlement)
+	}
+	s.AdapterOverrides = source.AdapterOverrides
+}
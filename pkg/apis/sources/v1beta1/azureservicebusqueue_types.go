@@ -0,0 +1,83 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+	sourcesv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AzureServiceBusQueueSource is the Schema for the event source.
+type AzureServiceBusQueueSource struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AzureServiceBusQueueSourceSpec `json:"spec,omitempty"`
+	Status v1alpha1.Status                `json:"status,omitempty"`
+}
+
+// AzureServiceBusQueueSourceSpec defines the desired state of the event source.
+type AzureServiceBusQueueSourceSpec struct {
+	duckv1.SourceSpec `json:",inline"`
+
+	// The resource ID the Service Bus Queue to subscribe to.
+	//
+	// Expected format:
+	// - /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.ServiceBus/namespaces/{namespaceName}/queues/{queueName}
+	QueueID sourcesv1alpha1.AzureResourceID `json:"queueID"`
+
+	// Authentication method to interact with Azure Service Bus.
+	Auth AzureAuth `json:"auth"`
+
+	// Whether the Service Bus Queue requires sessions. Messages which belong
+	// to the same session are processed sequentially, in order.
+	// +optional
+	SessionsEnabled *bool `json:"sessionsEnabled,omitempty"`
+
+	// Maximum number of messages processed concurrently. When sessions are
+	// enabled, maximum number of sessions processed concurrently.
+	// Defaults to 1.
+	// +optional
+	MaxConcurrency *int32 `json:"maxConcurrency,omitempty"`
+
+	// Maximum number of messages received from Service Bus in a single
+	// batch. Defaults to 100.
+	// +optional
+	PrefetchCount *int32 `json:"prefetchCount,omitempty"`
+
+	// Settlement of messages which could not be delivered to the sink.
+	// +optional
+	Settlement *AzureServiceBusSettlement `json:"settlement,omitempty"`
+
+	// Adapter spec overrides parameters.
+	// +optional
+	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AzureServiceBusQueueSourceList contains a list of event sources.
+type AzureServiceBusQueueSourceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AzureServiceBusQueueSource `json:"items"`
+}
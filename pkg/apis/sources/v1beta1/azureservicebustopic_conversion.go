@@ -0,0 +1,94 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+
+	"knative.dev/pkg/apis"
+
+	sourcesv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+)
+
+// ConvertTo implements apis.Convertible.
+func (s *AzureServiceBusTopicSource) ConvertTo(_ context.Context, to apis.Convertible) error {
+	switch sink := to.(type) {
+	case *sourcesv1alpha1.AzureServiceBusTopicSource:
+		sink.ObjectMeta = s.ObjectMeta
+		s.Spec.convertTo(&sink.Spec)
+		s.Status.convertTo(&sink.Status)
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", sink)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *AzureServiceBusTopicSource) ConvertFrom(_ context.Context, from apis.Convertible) error {
+	switch source := from.(type) {
+	case *sourcesv1alpha1.AzureServiceBusTopicSource:
+		s.ObjectMeta = source.ObjectMeta
+		s.Spec.convertFrom(&source.Spec)
+		s.Status.convertFrom(&source.Status)
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", source)
+	}
+}
+
+// convertTo copies the attributes of an AzureServiceBusTopicSourceSpec to its v1alpha1 counterpart.
+func (s *AzureServiceBusTopicSourceSpec) convertTo(sink *sourcesv1alpha1.AzureServiceBusTopicSourceSpec) {
+	sink.SourceSpec = s.SourceSpec
+	sink.TopicID = s.TopicID
+	s.Auth.convertTo(&sink.Auth)
+	sink.SessionsEnabled = s.SessionsEnabled
+	sink.MaxConcurrency = s.MaxConcurrency
+	sink.PrefetchCount = s.PrefetchCount
+	if s.Settlement != nil {
+		sink.Settlement = &sourcesv1alpha1.AzureServiceBusSettlement{}
+		s.Settlement.convertTo(sink.Settlement)
+	}
+	sink.AdapterOverrides = s.AdapterOverrides
+}
+
+// convertFrom copies the attributes of a v1alpha1 AzureServiceBusTopicSourceSpec.
+func (s *AzureServiceBusTopicSourceSpec) convertFrom(source *sourcesv1alpha1.AzureServiceBusTopicSourceSpec) {
+	s.SourceSpec = source.SourceSpec
+	s.TopicID = source.TopicID
+	s.Auth.convertFrom(&source.Auth)
+	s.SessionsEnabled = source.SessionsEnabled
+	s.MaxConcurrency = source.MaxConcurrency
+	s.PrefetchCount = source.PrefetchCount
+	if source.Settlement != nil {
+		s.Settlement = &AzureServiceBusSettlement{}
+		s.Settlement.convertFrom(source.Settlement)
+	}
+	s.AdapterOverrides = source.AdapterOverrides
+}
+
+// convertTo copies the attributes of an AzureServiceBusTopicSourceStatus to its v1alpha1 counterpart.
+func (s *AzureServiceBusTopicSourceStatus) convertTo(sink *sourcesv1alpha1.AzureServiceBusTopicSourceStatus) {
+	sink.Status = s.Status
+	sink.SubscriptionID = s.SubscriptionID
+}
+
+// convertFrom copies the attributes of a v1alpha1 AzureServiceBusTopicSourceStatus.
+func (s *AzureServiceBusTopicSourceStatus) convertFrom(source *sourcesv1alpha1.AzureServiceBusTopicSourceStatus) {
+	s.Status = source.Status
+	s.SubscriptionID = source.SubscriptionID
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+	sourcesv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AzureServiceBusTopicSource is the Schema for the event source.
+type AzureServiceBusTopicSource struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AzureServiceBusTopicSourceSpec   `json:"spec,omitempty"`
+	Status AzureServiceBusTopicSourceStatus `json:"status,omitempty"`
+}
+
+// AzureServiceBusTopicSourceSpec defines the desired state of the event source.
+type AzureServiceBusTopicSourceSpec struct {
+	duckv1.SourceSpec `json:",inline"`
+
+	// The resource ID the Service Bus Topic to subscribe to.
+	//
+	// Expected format:
+	// - /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.ServiceBus/namespaces/{namespaceName}/topics/{topicName}
+	TopicID sourcesv1alpha1.AzureResourceID `json:"topicID"`
+
+	// Authentication method to interact with the Azure REST API.
+	// This event source only supports the ServicePrincipal authentication.
+	Auth AzureAuth `json:"auth"`
+
+	// Whether the Service Bus Subscription requires sessions. Messages which belong
+	// to the same session are processed sequentially, in order.
+	// +optional
+	SessionsEnabled *bool `json:"sessionsEnabled,omitempty"`
+
+	// Maximum number of messages processed concurrently. When sessions are
+	// enabled, maximum number of sessions processed concurrently.
+	// Defaults to 1.
+	// +optional
+	MaxConcurrency *int32 `json:"maxConcurrency,omitempty"`
+
+	// Maximum number of messages received from Service Bus in a single
+	// batch. Defaults to 100.
+	// +optional
+	PrefetchCount *int32 `json:"prefetchCount,omitempty"`
+
+	// Settlement of messages which could not be delivered to the sink.
+	// +optional
+	Settlement *AzureServiceBusSettlement `json:"settlement,omitempty"`
+
+	// Adapter spec overrides parameters.
+	// +optional
+	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
+}
+
+// AzureServiceBusTopicSourceStatus defines the observed state of the event source.
+type AzureServiceBusTopicSourceStatus struct {
+	v1alpha1.Status `json:",inline"`
+
+	// Resource ID of the Service Bus Subscription that is currently used
+	// by the event source for consuming events from the configured Service
+	// Bus Topic.
+	SubscriptionID *sourcesv1alpha1.AzureResourceID `json:"subscriptionID,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AzureServiceBusTopicSourceList contains a list of event sources.
+type AzureServiceBusTopicSourceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AzureServiceBusTopicSource `json:"items"`
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+
+	"knative.dev/pkg/apis"
+
+	sourcesv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+)
+
+// ConvertTo implements apis.Convertible.
+func (s *CloudEventsSource) ConvertTo(_ context.Context, to apis.Convertible) error {
+	switch sink := to.(type) {
+	case *sourcesv1alpha1.CloudEventsSource:
+		sink.ObjectMeta = s.ObjectMeta
+		s.Spec.convertTo(&sink.Spec)
+		sink.Status = s.Status
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", sink)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *CloudEventsSource) ConvertFrom(_ context.Context, from apis.Convertible) error {
+	switch source := from.(type) {
+	case *sourcesv1alpha1.CloudEventsSource:
+		s.ObjectMeta = source.ObjectMeta
+		s.Spec.convertFrom(&source.Spec)
+		s.Status = source.Status
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", source)
+	}
+}
+
+// convertTo copies the attributes of a CloudEventsSourceSpec to its v1alpha1 counterpart.
+func (s *CloudEventsSourceSpec) convertTo(sink *sourcesv1alpha1.CloudEventsSourceSpec) {
+	sink.SourceSpec = s.SourceSpec
+	if s.Credentials != nil {
+		sink.Credentials = &sourcesv1alpha1.HTTPCredentials{}
+		s.Credentials.convertTo(sink.Credentials)
+	}
+	sink.Path = s.Path
+	if s.RateLimiter != nil {
+		sink.RateLimiter = &sourcesv1alpha1.RateLimiter{}
+		s.RateLimiter.convertTo(sink.RateLimiter)
+	}
+}
+
+// convertFrom copies the attributes of a v1alpha1 CloudEventsSourceSpec.
+func (s *CloudEventsSourceSpec) convertFrom(source *sourcesv1alpha1.CloudEventsSourceSpec) {
+	s.SourceSpec = source.SourceSpec
+	if source.Credentials != nil {
+		s.Credentials = &HTTPCredentials{}
+		s.Credentials.convertFrom(source.Credentials)
+	}
+	s.Path = source.Path
+	if source.RateLimiter != nil {
+		s.RateLimiter = &RateLimiter{}
+		s.RateLimiter.convertFrom(source.RateLimiter)
+	}
+}
+
+// convertTo copies the attributes of a HTTPCredentials to its v1alpha1 counterpart.
+func (c *HTTPCredentials) convertTo(sink *sourcesv1alpha1.HTTPCredentials) {
+	if c.BasicAuths != nil {
+		sink.BasicAuths = make([]sourcesv1alpha1.HTTPBasicAuth, len(c.BasicAuths))
+		for i := range c.BasicAuths {
+			c.BasicAuths[i].convertTo(&sink.BasicAuths[i])
+		}
+	}
+}
+
+// convertFrom copies the attributes of a v1alpha1 HTTPCredentials.
+func (c *HTTPCredentials) convertFrom(source *sourcesv1alpha1.HTTPCredentials) {
+	if source.BasicAuths != nil {
+		c.BasicAuths = make([]HTTPBasicAuth, len(source.BasicAuths))
+		for i := range source.BasicAuths {
+			c.BasicAuths[i].convertFrom(&source.BasicAuths[i])
+		}
+	}
+}
+
+// convertTo copies the attributes of a HTTPBasicAuth to its v1alpha1 counterpart.
+func (h *HTTPBasicAuth) convertTo(sink *sourcesv1alpha1.HTTPBasicAuth) {
+	sink.Username = h.Username
+	sink.Password = h.Password
+}
+
+// convertFrom copies the attributes of a v1alpha1 HTTPBasicAuth.
+func (h *HTTPBasicAuth) convertFrom(source *sourcesv1alpha1.HTTPBasicAuth) {
+	h.Username = source.Username
+	h.Password = source.Password
+}
+
+// convertTo copies the attributes of a RateLimiter to its v1alpha1 counterpart.
+func (r *RateLimiter) convertTo(sink *sourcesv1alpha1.RateLimiter) {
+	sink.RequestsPerSecond = r.RequestsPerSecond
+}
+
+// convertFrom copies the attributes of a v1alpha1 RateLimiter.
+func (r *RateLimiter) convertFrom(source *sourcesv1alpha1.RateLimiter) {
+	r.RequestsPerSecond = source.RequestsPerSecond
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"math/rand"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/stretchr/testify/require"
+
+	"k8s.io/apimachinery/pkg/api/apitesting/fuzzer"
+	metafuzzer "k8s.io/apimachinery/pkg/apis/meta/fuzzer"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+
+	"knative.dev/pkg/apis"
+	pkgfuzzer "knative.dev/pkg/apis/testing/fuzzer"
+	"knative.dev/pkg/apis/testing/roundtrip"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+)
+
+func TestRoundTripTypesViaHub(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(v1alpha1.AddToScheme(scheme))
+	utilruntime.Must(AddToScheme(scheme))
+
+	hubs := runtime.NewScheme()
+	utilruntime.Must(AddToScheme(hubs))
+
+	roundtrip.ExternalTypesViaHub(t, scheme, hubs, pkgfuzzer.Funcs)
+}
+
+// TestRoundTripTypesFromHub applies the round-trip test to all v1beta1 Kinds,
+// which are converted to the v1alpha1 storage version when persisted:
+//
+//	v1beta1 -> v1alpha1 -> v1beta1
+func TestRoundTripTypesFromHub(t *testing.T) {
+	const fuzzIters = 20
+
+	scheme := runtime.NewScheme()
+	utilruntime.Must(v1alpha1.AddToScheme(scheme))
+	utilruntime.Must(AddToScheme(scheme))
+
+	f := fuzzer.FuzzerFor(
+		fuzzer.MergeFuzzerFuncs(metafuzzer.Funcs, pkgfuzzer.Funcs),
+		rand.NewSource(rand.Int63()), //nolint:gosec
+		serializer.NewCodecFactory(scheme),
+	)
+
+	ctx := context.Background()
+
+	for gvk := range scheme.AllKnownTypes() {
+		if gvk.GroupVersion() != SchemeGroupVersion {
+			continue
+		}
+
+		hub, ok := newObject(t, scheme, gvk).(apis.Convertible)
+		if !ok {
+			continue
+		}
+		alphaGVK := v1alpha1.SchemeGroupVersion.WithKind(gvk.Kind)
+		if !scheme.Recognizes(alphaGVK) {
+			continue
+		}
+
+		//nolint:scopelint
+		t.Run(gvk.Kind, func(t *testing.T) {
+			for i := 0; i < fuzzIters; i++ {
+				f.Fuzz(hub)
+
+				stored := newObject(t, scheme, alphaGVK).(apis.Convertible)
+				require.NoError(t, hub.ConvertTo(ctx, stored), "Conversion to v1alpha1 failed")
+
+				got := newObject(t, scheme, gvk).(apis.Convertible)
+				require.NoError(t, got.ConvertFrom(ctx, stored), "Conversion from v1alpha1 failed")
+
+				// TypeMeta is not carried over by conversions
+				got.(runtime.Object).GetObjectKind().SetGroupVersionKind(gvk)
+				hub.(runtime.Object).GetObjectKind().SetGroupVersionKind(gvk)
+
+				if diff := cmp.Diff(hub, got, cmpopts.IgnoreUnexported(url.Userinfo{})); diff != "" {
+					t.Fatal("Round trip through v1alpha1 produced a diff (-want, +got):", diff)
+				}
+			}
+		})
+	}
+}
+
+// newObject returns a new instance of the given Kind.
+func newObject(t *testing.T, scheme *runtime.Scheme, gvk schema.GroupVersionKind) runtime.Object {
+	t.Helper()
+
+	obj, err := scheme.New(gvk)
+	require.NoError(t, err)
+	return obj
+}
@@ -0,0 +1,33 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	sourcesv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+)
+
+// convertTo copies the attributes of a GoogleCloudSourcePubSubSpec to its v1alpha1 counterpart.
+func (s *GoogleCloudSourcePubSubSpec) convertTo(sink *sourcesv1alpha1.GoogleCloudSourcePubSubSpec) {
+	sink.Topic = s.Topic
+	sink.Project = s.Project
+}
+
+// convertFrom copies the attributes of a v1alpha1 GoogleCloudSourcePubSubSpec.
+func (s *GoogleCloudSourcePubSubSpec) convertFrom(source *sourcesv1alpha1.GoogleCloudSourcePubSubSpec) {
+	s.Topic = source.Topic
+	s.Project = source.Project
+}
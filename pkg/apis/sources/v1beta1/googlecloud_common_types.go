@@ -0,0 +1,48 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	sourcesv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+)
+
+// GoogleCloudSourcePubSubSpec defines the attributes related to the
+// configuration of Pub/Sub resources.
+type GoogleCloudSourcePubSubSpec struct {
+	// Optional: no more than one of the following may be specified.
+
+	// Full resource name of the Pub/Sub topic where messages/notifications
+	// originating from the configured Google Cloud resource are sent to,
+	// before being retrieved by this event source. If not supplied, a
+	// topic is created on behalf of the user, in the GCP project
+	// referenced by the Project attribute.
+	//
+	// The expected format is described at https://cloud.google.com/pubsub/docs/admin#resource_names:
+	//   "projects/{project_name}/topics/{topic_name}"
+	//
+	// +optional
+	Topic *sourcesv1alpha1.GCloudResourceName `json:"topic,omitempty"`
+
+	// Name of the GCP project where Pub/Sub resources associated with the
+	// configured Google Cloud resource are to be created.
+	//
+	// Mutually exclusive with Topic which, if supplied, already contains
+	// the project name.
+	//
+	// +optional
+	Project *string `json:"project,omitempty"`
+}
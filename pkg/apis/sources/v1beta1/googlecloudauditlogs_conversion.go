@@ -0,0 +1,90 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+
+	"knative.dev/pkg/apis"
+
+	sourcesv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+)
+
+// ConvertTo implements apis.Convertible.
+func (s *GoogleCloudAuditLogsSource) ConvertTo(_ context.Context, to apis.Convertible) error {
+	switch sink := to.(type) {
+	case *sourcesv1alpha1.GoogleCloudAuditLogsSource:
+		sink.ObjectMeta = s.ObjectMeta
+		s.Spec.convertTo(&sink.Spec)
+		s.Status.convertTo(&sink.Status)
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", sink)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *GoogleCloudAuditLogsSource) ConvertFrom(_ context.Context, from apis.Convertible) error {
+	switch source := from.(type) {
+	case *sourcesv1alpha1.GoogleCloudAuditLogsSource:
+		s.ObjectMeta = source.ObjectMeta
+		s.Spec.convertFrom(&source.Spec)
+		s.Status.convertFrom(&source.Status)
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", source)
+	}
+}
+
+// convertTo copies the attributes of a GoogleCloudAuditLogsSourceSpec to its v1alpha1 counterpart.
+func (s *GoogleCloudAuditLogsSourceSpec) convertTo(sink *sourcesv1alpha1.GoogleCloudAuditLogsSourceSpec) {
+	sink.SourceSpec = s.SourceSpec
+	sink.ServiceName = s.ServiceName
+	sink.MethodName = s.MethodName
+	sink.ResourceName = s.ResourceName
+	s.PubSub.convertTo(&sink.PubSub)
+	sink.ServiceAccountKey = s.ServiceAccountKey
+	sink.AdapterOverrides = s.AdapterOverrides
+}
+
+// convertFrom copies the attributes of a v1alpha1 GoogleCloudAuditLogsSourceSpec.
+func (s *GoogleCloudAuditLogsSourceSpec) convertFrom(source *sourcesv1alpha1.GoogleCloudAuditLogsSourceSpec) {
+	s.SourceSpec = source.SourceSpec
+	s.ServiceName = source.ServiceName
+	s.MethodName = source.MethodName
+	s.ResourceName = source.ResourceName
+	s.PubSub.convertFrom(&source.PubSub)
+	s.ServiceAccountKey = source.ServiceAccountKey
+	s.AdapterOverrides = source.AdapterOverrides
+}
+
+// convertTo copies the attributes of a GoogleCloudAuditLogsSourceStatus to its v1alpha1 counterpart.
+func (s *GoogleCloudAuditLogsSourceStatus) convertTo(sink *sourcesv1alpha1.GoogleCloudAuditLogsSourceStatus) {
+	sink.Status = s.Status
+	sink.AuditLogsSink = s.AuditLogsSink
+	sink.Topic = s.Topic
+	sink.Subscription = s.Subscription
+}
+
+// convertFrom copies the attributes of a v1alpha1 GoogleCloudAuditLogsSourceStatus.
+func (s *GoogleCloudAuditLogsSourceStatus) convertFrom(source *sourcesv1alpha1.GoogleCloudAuditLogsSourceStatus) {
+	s.Status = source.Status
+	s.AuditLogsSink = source.AuditLogsSink
+	s.Topic = source.Topic
+	s.Subscription = source.Subscription
+}
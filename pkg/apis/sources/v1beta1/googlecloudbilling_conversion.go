@@ -0,0 +1,86 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+
+	"knative.dev/pkg/apis"
+
+	sourcesv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+)
+
+// ConvertTo implements apis.Convertible.
+func (s *GoogleCloudBillingSource) ConvertTo(_ context.Context, to apis.Convertible) error {
+	switch sink := to.(type) {
+	case *sourcesv1alpha1.GoogleCloudBillingSource:
+		sink.ObjectMeta = s.ObjectMeta
+		s.Spec.convertTo(&sink.Spec)
+		s.Status.convertTo(&sink.Status)
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", sink)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *GoogleCloudBillingSource) ConvertFrom(_ context.Context, from apis.Convertible) error {
+	switch source := from.(type) {
+	case *sourcesv1alpha1.GoogleCloudBillingSource:
+		s.ObjectMeta = source.ObjectMeta
+		s.Spec.convertFrom(&source.Spec)
+		s.Status.convertFrom(&source.Status)
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", source)
+	}
+}
+
+// convertTo copies the attributes of a GoogleCloudBillingSourceSpec to its v1alpha1 counterpart.
+func (s *GoogleCloudBillingSourceSpec) convertTo(sink *sourcesv1alpha1.GoogleCloudBillingSourceSpec) {
+	sink.SourceSpec = s.SourceSpec
+	sink.BillingAccountID = s.BillingAccountID
+	sink.BudgetID = s.BudgetID
+	s.PubSub.convertTo(&sink.PubSub)
+	sink.ServiceAccountKey = s.ServiceAccountKey
+	sink.AdapterOverrides = s.AdapterOverrides
+}
+
+// convertFrom copies the attributes of a v1alpha1 GoogleCloudBillingSourceSpec.
+func (s *GoogleCloudBillingSourceSpec) convertFrom(source *sourcesv1alpha1.GoogleCloudBillingSourceSpec) {
+	s.SourceSpec = source.SourceSpec
+	s.BillingAccountID = source.BillingAccountID
+	s.BudgetID = source.BudgetID
+	s.PubSub.convertFrom(&source.PubSub)
+	s.ServiceAccountKey = source.ServiceAccountKey
+	s.AdapterOverrides = source.AdapterOverrides
+}
+
+// convertTo copies the attributes of a GoogleCloudBillingSourceStatus to its v1alpha1 counterpart.
+func (s *GoogleCloudBillingSourceStatus) convertTo(sink *sourcesv1alpha1.GoogleCloudBillingSourceStatus) {
+	sink.Status = s.Status
+	sink.Topic = s.Topic
+	sink.Subscription = s.Subscription
+}
+
+// convertFrom copies the attributes of a v1alpha1 GoogleCloudBillingSourceStatus.
+func (s *GoogleCloudBillingSourceStatus) convertFrom(source *sourcesv1alpha1.GoogleCloudBillingSourceStatus) {
+	s.Status = source.Status
+	s.Topic = source.Topic
+	s.Subscription = source.Subscription
+}
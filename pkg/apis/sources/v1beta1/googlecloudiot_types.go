@@ -0,0 +1,76 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+	sourcesv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// GoogleCloudIoTSource is the Schema for the event source.
+type GoogleCloudIoTSource struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GoogleCloudIoTSourceSpec   `json:"spec,omitempty"`
+	Status GoogleCloudIoTSourceStatus `json:"status,omitempty"`
+}
+
+// GoogleCloudIoTSourceSpec defines the desired state of the event source.
+type GoogleCloudIoTSourceSpec struct {
+	duckv1.SourceSpec `json:",inline"`
+
+	// Resource name of the Cloud IoT Registry to receive messages from.
+	Registry sourcesv1alpha1.GCloudIoTResourceName `json:"registry"`
+
+	// Settings related to the Pub/Sub resources associated with the Cloud IoT Registry.
+	PubSub GoogleCloudSourcePubSubSpec `json:"pubsub"`
+
+	// Service account key in JSON format.
+	// https://cloud.google.com/iam/docs/creating-managing-service-account-keys
+	ServiceAccountKey v1alpha1.ValueFromField `json:"serviceAccountKey"`
+
+	// Adapter spec overrides parameters.
+	// +optional
+	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
+}
+
+// GoogleCloudIoTSourceStatus defines the observed state of the event source.
+type GoogleCloudIoTSourceStatus struct {
+	v1alpha1.Status `json:",inline"`
+
+	// Resource name of the target Pub/Sub topic.
+	Topic *sourcesv1alpha1.GCloudResourceName `json:"topic,omitempty"`
+
+	// Resource name of the managed Pub/Sub subscription associated with
+	// the managed topic.
+	Subscription *sourcesv1alpha1.GCloudResourceName `json:"subscription,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// GoogleCloudIoTSourceList contains a list of event sources.
+type GoogleCloudIoTSourceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GoogleCloudIoTSource `json:"items"`
+}
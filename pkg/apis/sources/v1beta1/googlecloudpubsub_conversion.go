@@ -0,0 +1,82 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+
+	"knative.dev/pkg/apis"
+
+	sourcesv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+)
+
+// ConvertTo implements apis.Convertible.
+func (s *GoogleCloudPubSubSource) ConvertTo(_ context.Context, to apis.Convertible) error {
+	switch sink := to.(type) {
+	case *sourcesv1alpha1.GoogleCloudPubSubSource:
+		sink.ObjectMeta = s.ObjectMeta
+		s.Spec.convertTo(&sink.Spec)
+		s.Status.convertTo(&sink.Status)
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", sink)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *GoogleCloudPubSubSource) ConvertFrom(_ context.Context, from apis.Convertible) error {
+	switch source := from.(type) {
+	case *sourcesv1alpha1.GoogleCloudPubSubSource:
+		s.ObjectMeta = source.ObjectMeta
+		s.Spec.convertFrom(&source.Spec)
+		s.Status.convertFrom(&source.Status)
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", source)
+	}
+}
+
+// convertTo copies the attributes of a GoogleCloudPubSubSourceSpec to its v1alpha1 counterpart.
+func (s *GoogleCloudPubSubSourceSpec) convertTo(sink *sourcesv1alpha1.GoogleCloudPubSubSourceSpec) {
+	sink.SourceSpec = s.SourceSpec
+	sink.Topic = s.Topic
+	sink.SubscriptionID = s.SubscriptionID
+	sink.ServiceAccountKey = s.ServiceAccountKey
+	sink.AdapterOverrides = s.AdapterOverrides
+}
+
+// convertFrom copies the attributes of a v1alpha1 GoogleCloudPubSubSourceSpec.
+func (s *GoogleCloudPubSubSourceSpec) convertFrom(source *sourcesv1alpha1.GoogleCloudPubSubSourceSpec) {
+	s.SourceSpec = source.SourceSpec
+	s.Topic = source.Topic
+	s.SubscriptionID = source.SubscriptionID
+	s.ServiceAccountKey = source.ServiceAccountKey
+	s.AdapterOverrides = source.AdapterOverrides
+}
+
+// convertTo copies the attributes of a GoogleCloudPubSubSourceStatus to its v1alpha1 counterpart.
+func (s *GoogleCloudPubSubSourceStatus) convertTo(sink *sourcesv1alpha1.GoogleCloudPubSubSourceStatus) {
+	sink.Status = s.Status
+	sink.Subscription = s.Subscription
+}
+
+// convertFrom copies the attributes of a v1alpha1 GoogleCloudPubSubSourceStatus.
+func (s *GoogleCloudPubSubSourceStatus) convertFrom(source *sourcesv1alpha1.GoogleCloudPubSubSourceStatus) {
+	s.Status = source.Status
+	s.Subscription = source.Subscription
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+	sourcesv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// GoogleCloudPubSubSource is the Schema for the event source.
+type GoogleCloudPubSubSource struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GoogleCloudPubSubSourceSpec   `json:"spec,omitempty"`
+	Status GoogleCloudPubSubSourceStatus `json:"status,omitempty"`
+}
+
+// GoogleCloudPubSubSourceSpec defines the desired state of the event source.
+type GoogleCloudPubSubSourceSpec struct {
+	duckv1.SourceSpec `json:",inline"`
+
+	// Full resource name of the Pub/Sub topic to subscribe to, in the
+	// format "projects/{project_name}/topics/{topic_name}".
+	Topic sourcesv1alpha1.GCloudResourceName `json:"topic"`
+
+	// ID of the subscription to use to pull messages from the topic.
+	//
+	// If supplied, this subscription must 1) exist and 2) belong to the
+	// provided topic. Otherwise, a pull subscription to that topic is
+	// created on behalf of the user.
+	//
+	// +optional
+	SubscriptionID *string `json:"subscriptionID,omitempty"`
+
+	// Service account key in JSON format.
+	// https://cloud.google.com/iam/docs/creating-managing-service-account-keys
+	ServiceAccountKey v1alpha1.ValueFromField `json:"serviceAccountKey"`
+
+	// Adapter spec overrides parameters.
+	// +optional
+	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
+}
+
+// GoogleCloudPubSubSourceStatus defines the observed state of the event source.
+type GoogleCloudPubSubSourceStatus struct {
+	v1alpha1.Status `json:",inline"`
+	Subscription    *sourcesv1alpha1.GCloudResourceName `json:"subscription,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// GoogleCloudPubSubSourceList contains a list of event sources.
+type GoogleCloudPubSubSourceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GoogleCloudPubSubSource `json:"items"`
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+
+	"knative.dev/pkg/apis"
+
+	sourcesv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+)
+
+// ConvertTo implements apis.Convertible.
+func (s *GoogleCloudStorageSource) ConvertTo(_ context.Context, to apis.Convertible) error {
+	switch sink := to.(type) {
+	case *sourcesv1alpha1.GoogleCloudStorageSource:
+		sink.ObjectMeta = s.ObjectMeta
+		s.Spec.convertTo(&sink.Spec)
+		s.Status.convertTo(&sink.Status)
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", sink)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *GoogleCloudStorageSource) ConvertFrom(_ context.Context, from apis.Convertible) error {
+	switch source := from.(type) {
+	case *sourcesv1alpha1.GoogleCloudStorageSource:
+		s.ObjectMeta = source.ObjectMeta
+		s.Spec.convertFrom(&source.Spec)
+		s.Status.convertFrom(&source.Status)
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", source)
+	}
+}
+
+// convertTo copies the attributes of a GoogleCloudStorageSourceSpec to its v1alpha1 counterpart.
+func (s *GoogleCloudStorageSourceSpec) convertTo(sink *sourcesv1alpha1.GoogleCloudStorageSourceSpec) {
+	sink.SourceSpec = s.SourceSpec
+	sink.Bucket = s.Bucket
+	s.PubSub.convertTo(&sink.PubSub)
+	sink.EventTypes = s.EventTypes
+	sink.ServiceAccountKey = s.ServiceAccountKey
+	sink.AdapterOverrides = s.AdapterOverrides
+}
+
+// convertFrom copies the attributes of a v1alpha1 GoogleCloudStorageSourceSpec.
+func (s *GoogleCloudStorageSourceSpec) convertFrom(source *sourcesv1alpha1.GoogleCloudStorageSourceSpec) {
+	s.SourceSpec = source.SourceSpec
+	s.Bucket = source.Bucket
+	s.PubSub.convertFrom(&source.PubSub)
+	s.EventTypes = source.EventTypes
+	s.ServiceAccountKey = source.ServiceAccountKey
+	s.AdapterOverrides = source.AdapterOverrides
+}
+
+// convertTo copies the attributes of a GoogleCloudStorageSourceStatus to its v1alpha1 counterpart.
+func (s *GoogleCloudStorageSourceStatus) convertTo(sink *sourcesv1alpha1.GoogleCloudStorageSourceStatus) {
+	sink.Status = s.Status
+	sink.NotificationID = s.NotificationID
+	sink.Topic = s.Topic
+	sink.Subscription = s.Subscription
+}
+
+// convertFrom copies the attributes of a v1alpha1 GoogleCloudStorageSourceStatus.
+func (s *GoogleCloudStorageSourceStatus) convertFrom(source *sourcesv1alpha1.GoogleCloudStorageSourceStatus) {
+	s.Status = source.Status
+	s.NotificationID = source.NotificationID
+	s.Topic = source.Topic
+	s.Subscription = source.Subscription
+}
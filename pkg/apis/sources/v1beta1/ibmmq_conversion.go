@@ -0,0 +1,140 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+
+	"knative.dev/pkg/apis"
+
+	sourcesv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+)
+
+// ConvertTo implements apis.Convertible.
+func (s *IBMMQSource) ConvertTo(_ context.Context, to apis.Convertible) error {
+	switch sink := to.(type) {
+	case *sourcesv1alpha1.IBMMQSource:
+		sink.ObjectMeta = s.ObjectMeta
+		s.Spec.convertTo(&sink.Spec)
+		sink.Status = s.Status
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", sink)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *IBMMQSource) ConvertFrom(_ context.Context, from apis.Convertible) error {
+	switch source := from.(type) {
+	case *sourcesv1alpha1.IBMMQSource:
+		s.ObjectMeta = source.ObjectMeta
+		s.Spec.convertFrom(&source.Spec)
+		s.Status = source.Status
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", source)
+	}
+}
+
+// convertTo copies the attributes of an IBMMQSourceSpec to its v1alpha1 counterpart.
+func (s *IBMMQSourceSpec) convertTo(sink *sourcesv1alpha1.IBMMQSourceSpec) {
+	sink.SourceSpec = s.SourceSpec
+	sink.ConnectionName = s.ConnectionName
+	sink.QueueManager = s.QueueManager
+	sink.QueueName = s.QueueName
+	sink.ChannelName = s.ChannelName
+	s.Delivery.convertTo(&sink.Delivery)
+	s.Auth.convertTo(&sink.Auth)
+	sink.AdapterOverrides = s.AdapterOverrides
+}
+
+// convertFrom copies the attributes of a v1alpha1 IBMMQSourceSpec.
+func (s *IBMMQSourceSpec) convertFrom(source *sourcesv1alpha1.IBMMQSourceSpec) {
+	s.SourceSpec = source.SourceSpec
+	s.ConnectionName = source.ConnectionName
+	s.QueueManager = source.QueueManager
+	s.QueueName = source.QueueName
+	s.ChannelName = source.ChannelName
+	s.Delivery.convertFrom(&source.Delivery)
+	s.Auth.convertFrom(&source.Auth)
+	s.AdapterOverrides = source.AdapterOverrides
+}
+
+// convertTo copies the attributes of a Delivery to its v1alpha1 counterpart.
+func (d *Delivery) convertTo(sink *sourcesv1alpha1.Delivery) {
+	sink.DeadLetterQueue = d.DeadLetterQueue
+	sink.Retry = d.Retry
+	sink.DeadLetterQueueManager = d.DeadLetterQueueManager
+	sink.BackoffDelay = d.BackoffDelay
+}
+
+// convertFrom copies the attributes of a v1alpha1 Delivery.
+func (d *Delivery) convertFrom(source *sourcesv1alpha1.Delivery) {
+	d.DeadLetterQueue = source.DeadLetterQueue
+	d.Retry = source.Retry
+	d.DeadLetterQueueManager = source.DeadLetterQueueManager
+	d.BackoffDelay = source.BackoffDelay
+}
+
+// convertTo copies the attributes of a Credentials to its v1alpha1 counterpart.
+func (c *Credentials) convertTo(sink *sourcesv1alpha1.Credentials) {
+	sink.User = c.User
+	sink.Password = c.Password
+	if c.TLS != nil {
+		sink.TLS = &sourcesv1alpha1.TLSSpec{}
+		c.TLS.convertTo(sink.TLS)
+	}
+}
+
+// convertFrom copies the attributes of a v1alpha1 Credentials.
+func (c *Credentials) convertFrom(source *sourcesv1alpha1.Credentials) {
+	c.User = source.User
+	c.Password = source.Password
+	if source.TLS != nil {
+		c.TLS = &TLSSpec{}
+		c.TLS.convertFrom(source.TLS)
+	}
+}
+
+// convertTo copies the attributes of a TLSSpec to its v1alpha1 counterpart.
+func (s *TLSSpec) convertTo(sink *sourcesv1alpha1.TLSSpec) {
+	sink.Cipher = s.Cipher
+	sink.ClientAuthRequired = s.ClientAuthRequired
+	sink.CertLabel = s.CertLabel
+	s.KeyRepository.convertTo(&sink.KeyRepository)
+}
+
+// convertFrom copies the attributes of a v1alpha1 TLSSpec.
+func (s *TLSSpec) convertFrom(source *sourcesv1alpha1.TLSSpec) {
+	s.Cipher = source.Cipher
+	s.ClientAuthRequired = source.ClientAuthRequired
+	s.CertLabel = source.CertLabel
+	s.KeyRepository.convertFrom(&source.KeyRepository)
+}
+
+// convertTo copies the attributes of a Keystore to its v1alpha1 counterpart.
+func (k *Keystore) convertTo(sink *sourcesv1alpha1.Keystore) {
+	sink.KeyDatabase = k.KeyDatabase
+	sink.PasswordStash = k.PasswordStash
+}
+
+// convertFrom copies the attributes of a v1alpha1 Keystore.
+func (k *Keystore) convertFrom(source *sourcesv1alpha1.Keystore) {
+	k.KeyDatabase = source.KeyDatabase
+	k.PasswordStash = source.PasswordStash
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+
+	"knative.dev/pkg/apis"
+
+	sourcesv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+)
+
+// ConvertTo implements apis.Convertible.
+func (s *OCIMetricsSource) ConvertTo(_ context.Context, to apis.Convertible) error {
+	switch sink := to.(type) {
+	case *sourcesv1alpha1.OCIMetricsSource:
+		sink.ObjectMeta = s.ObjectMeta
+		s.Spec.convertTo(&sink.Spec)
+		sink.Status = s.Status
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", sink)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *OCIMetricsSource) ConvertFrom(_ context.Context, from apis.Convertible) error {
+	switch source := from.(type) {
+	case *sourcesv1alpha1.OCIMetricsSource:
+		s.ObjectMeta = source.ObjectMeta
+		s.Spec.convertFrom(&source.Spec)
+		s.Status = source.Status
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", source)
+	}
+}
+
+// convertTo copies the attributes of an OCIMetricsSourceSpec to its v1alpha1 counterpart.
+func (s *OCIMetricsSourceSpec) convertTo(sink *sourcesv1alpha1.OCIMetricsSourceSpec) {
+	sink.SourceSpec = s.SourceSpec
+	sink.OracleAPIPrivateKey = s.OracleAPIPrivateKey
+	sink.OracleAPIPrivateKeyPassphrase = s.OracleAPIPrivateKeyPassphrase
+	sink.OracleAPIPrivateKeyFingerprint = s.OracleAPIPrivateKeyFingerprint
+	sink.Tenancy = s.Tenancy
+	sink.User = s.User
+	sink.Region = s.Region
+	sink.PollingFrequency = s.PollingFrequency
+	if s.Metrics != nil {
+		sink.Metrics = make([]sourcesv1alpha1.OCIMetrics, len(s.Metrics))
+		for i := range s.Metrics {
+			s.Metrics[i].convertTo(&sink.Metrics[i])
+		}
+	}
+	sink.AdapterOverrides = s.AdapterOverrides
+}
+
+// convertFrom copies the attributes of a v1alpha1 OCIMetricsSourceSpec.
+func (s *OCIMetricsSourceSpec) convertFrom(source *sourcesv1alpha1.OCIMetricsSourceSpec) {
+	s.SourceSpec = source.SourceSpec
+	s.OracleAPIPrivateKey = source.OracleAPIPrivateKey
+	s.OracleAPIPrivateKeyPassphrase = source.OracleAPIPrivateKeyPassphrase
+	s.OracleAPIPrivateKeyFingerprint = source.OracleAPIPrivateKeyFingerprint
+	s.Tenancy = source.Tenancy
+	s.User = source.User
+	s.Region = source.Region
+	s.PollingFrequency = source.PollingFrequency
+	if source.Metrics != nil {
+		s.Metrics = make([]OCIMetrics, len(source.Metrics))
+		for i := range source.Metrics {
+			s.Metrics[i].convertFrom(&source.Metrics[i])
+		}
+	}
+	s.AdapterOverrides = source.AdapterOverrides
+}
+
+// convertTo copies the attributes of an OCIMetrics to its v1alpha1 counterpart.
+func (m *OCIMetrics) convertTo(sink *sourcesv1alpha1.OCIMetrics) {
+	sink.Name = m.Name
+	sink.MetricsNamespace = m.MetricsNamespace
+	sink.MetricsQuery = m.MetricsQuery
+	sink.Compartment = m.Compartment
+}
+
+// convertFrom copies the attributes of a v1alpha1 OCIMetrics.
+func (m *OCIMetrics) convertFrom(source *sourcesv1alpha1.OCIMetrics) {
+	m.Name = source.Name
+	m.MetricsNamespace = source.MetricsNamespace
+	m.MetricsQuery = source.MetricsQuery
+	m.Compartment = source.Compartment
+}
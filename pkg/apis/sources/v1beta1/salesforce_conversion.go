@@ -0,0 +1,112 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+
+	"knative.dev/pkg/apis"
+
+	sourcesv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+)
+
+// ConvertTo implements apis.Convertible.
+func (s *SalesforceSource) ConvertTo(_ context.Context, to apis.Convertible) error {
+	switch sink := to.(type) {
+	case *sourcesv1alpha1.SalesforceSource:
+		sink.ObjectMeta = s.ObjectMeta
+		s.Spec.convertTo(&sink.Spec)
+		sink.Status = s.Status
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", sink)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *SalesforceSource) ConvertFrom(_ context.Context, from apis.Convertible) error {
+	switch source := from.(type) {
+	case *sourcesv1alpha1.SalesforceSource:
+		s.ObjectMeta = source.ObjectMeta
+		s.Spec.convertFrom(&source.Spec)
+		s.Status = source.Status
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", source)
+	}
+}
+
+// convertTo copies the attributes of a SalesforceSourceSpec to its v1alpha1 counterpart.
+func (s *SalesforceSourceSpec) convertTo(sink *sourcesv1alpha1.SalesforceSourceSpec) {
+	sink.SourceSpec = s.SourceSpec
+	s.Auth.convertTo(&sink.Auth)
+	sink.APIVersion = s.APIVersion
+	s.Subscription.convertTo(&sink.Subscription)
+	if s.Subscriptions != nil {
+		sink.Subscriptions = make([]sourcesv1alpha1.SalesforceSubscription, len(s.Subscriptions))
+		for i := range s.Subscriptions {
+			s.Subscriptions[i].convertTo(&sink.Subscriptions[i])
+		}
+	}
+	sink.AdapterOverrides = s.AdapterOverrides
+}
+
+// convertFrom copies the attributes of a v1alpha1 SalesforceSourceSpec.
+func (s *SalesforceSourceSpec) convertFrom(source *sourcesv1alpha1.SalesforceSourceSpec) {
+	s.SourceSpec = source.SourceSpec
+	s.Auth.convertFrom(&source.Auth)
+	s.APIVersion = source.APIVersion
+	s.Subscription.convertFrom(&source.Subscription)
+	if source.Subscriptions != nil {
+		s.Subscriptions = make([]SalesforceSubscription, len(source.Subscriptions))
+		for i := range source.Subscriptions {
+			s.Subscriptions[i].convertFrom(&source.Subscriptions[i])
+		}
+	}
+	s.AdapterOverrides = source.AdapterOverrides
+}
+
+// convertTo copies the attributes of a SalesforceSubscription to its v1alpha1 counterpart.
+func (s *SalesforceSubscription) convertTo(sink *sourcesv1alpha1.SalesforceSubscription) {
+	sink.Channel = s.Channel
+	sink.ReplayID = s.ReplayID
+	sink.EventType = s.EventType
+}
+
+// convertFrom copies the attributes of a v1alpha1 SalesforceSubscription.
+func (s *SalesforceSubscription) convertFrom(source *sourcesv1alpha1.SalesforceSubscription) {
+	s.Channel = source.Channel
+	s.ReplayID = source.ReplayID
+	s.EventType = source.EventType
+}
+
+// convertTo copies the attributes of a SalesforceAuth to its v1alpha1 counterpart.
+func (a *SalesforceAuth) convertTo(sink *sourcesv1alpha1.SalesforceAuth) {
+	sink.ClientID = a.ClientID
+	sink.Server = a.Server
+	sink.User = a.User
+	sink.CertKey = a.CertKey
+}
+
+// convertFrom copies the attributes of a v1alpha1 SalesforceAuth.
+func (a *SalesforceAuth) convertFrom(source *sourcesv1alpha1.SalesforceAuth) {
+	a.ClientID = source.ClientID
+	a.Server = source.Server
+	a.User = source.User
+	a.CertKey = source.CertKey
+}
@@ -0,0 +1,202 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+
+	"knative.dev/pkg/apis"
+
+	sourcesv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+)
+
+// ConvertTo implements apis.Convertible.
+func (s *WebhookSource) ConvertTo(_ context.Context, to apis.Convertible) error {
+	switch sink := to.(type) {
+	case *sourcesv1alpha1.WebhookSource:
+		sink.ObjectMeta = s.ObjectMeta
+		s.Spec.convertTo(&sink.Spec)
+		sink.Status = s.Status
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", sink)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *WebhookSource) ConvertFrom(_ context.Context, from apis.Convertible) error {
+	switch source := from.(type) {
+	case *sourcesv1alpha1.WebhookSource:
+		s.ObjectMeta = source.ObjectMeta
+		s.Spec.convertFrom(&source.Spec)
+		s.Status = source.Status
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", source)
+	}
+}
+
+// convertTo copies the attributes of a WebhookSourceSpec to its v1alpha1 counterpart.
+func (s *WebhookSourceSpec) convertTo(sink *sourcesv1alpha1.WebhookSourceSpec) {
+	sink.SourceSpec = s.SourceSpec
+	sink.EventType = s.EventType
+	sink.EventSource = s.EventSource
+	sink.BasicAuthUsername = s.BasicAuthUsername
+	sink.BasicAuthPassword = s.BasicAuthPassword
+	if s.Signature != nil {
+		sink.Signature = &sourcesv1alpha1.WebhookSignature{}
+		s.Signature.convertTo(sink.Signature)
+	}
+	if s.Mapping != nil {
+		sink.Mapping = &sourcesv1alpha1.WebhookMapping{}
+		s.Mapping.convertTo(sink.Mapping)
+	}
+	sink.CORSAllowOrigin = s.CORSAllowOrigin
+	sink.AdapterOverrides = s.AdapterOverrides
+}
+
+// convertFrom copies the attributes of a v1alpha1 WebhookSourceSpec.
+func (s *WebhookSourceSpec) convertFrom(source *sourcesv1alpha1.WebhookSourceSpec) {
+	s.SourceSpec = source.SourceSpec
+	s.EventType = source.EventType
+	s.EventSource = source.EventSource
+	s.BasicAuthUsername = source.BasicAuthUsername
+	s.BasicAuthPassword = source.BasicAuthPassword
+	if source.Signature != nil {
+		s.Signature = &WebhookSignature{}
+		s.Signature.convertFrom(source.Signature)
+	}
+	if source.Mapping != nil {
+		s.Mapping = &WebhookMapping{}
+		s.Mapping.convertFrom(source.Mapping)
+	}
+	s.CORSAllowOrigin = source.CORSAllowOrigin
+	s.AdapterOverrides = source.AdapterOverrides
+}
+
+// convertTo copies the attributes of a WebhookSignature to its v1alpha1 counterpart.
+func (s *WebhookSignature) convertTo(sink *sourcesv1alpha1.WebhookSignature) {
+	sink.Header = s.Header
+	sink.Algorithm = sourcesv1alpha1.WebhookSignatureAlgorithm(s.Algorithm)
+	sink.Secret = s.Secret
+	sink.Prefix = s.Prefix
+	sink.Encoding = (*sourcesv1alpha1.WebhookSignatureEncoding)(s.Encoding)
+	if s.Timestamp != nil {
+		sink.Timestamp = &sourcesv1alpha1.WebhookSignatureTimestamp{}
+		s.Timestamp.convertTo(sink.Timestamp)
+	}
+}
+
+// convertFrom copies the attributes of a v1alpha1 WebhookSignature.
+func (s *WebhookSignature) convertFrom(source *sourcesv1alpha1.WebhookSignature) {
+	s.Header = source.Header
+	s.Algorithm = WebhookSignatureAlgorithm(source.Algorithm)
+	s.Secret = source.Secret
+	s.Prefix = source.Prefix
+	s.Encoding = (*WebhookSignatureEncoding)(source.Encoding)
+	if source.Timestamp != nil {
+		s.Timestamp = &WebhookSignatureTimestamp{}
+		s.Timestamp.convertFrom(source.Timestamp)
+	}
+}
+
+// convertTo copies the attributes of a WebhookSignatureTimestamp to its v1alpha1 counterpart.
+func (w *WebhookSignatureTimestamp) convertTo(sink *sourcesv1alpha1.WebhookSignatureTimestamp) {
+	sink.Header = w.Header
+	sink.Tolerance = w.Tolerance
+}
+
+// convertFrom copies the attributes of a v1alpha1 WebhookSignatureTimestamp.
+func (w *WebhookSignatureTimestamp) convertFrom(source *sourcesv1alpha1.WebhookSignatureTimestamp) {
+	w.Header = source.Header
+	w.Tolerance = source.Tolerance
+}
+
+// convertTo copies the attributes of a WebhookMapping to its v1alpha1 counterpart.
+func (m *WebhookMapping) convertTo(sink *sourcesv1alpha1.WebhookMapping) {
+	if m.Type != nil {
+		sink.Type = &sourcesv1alpha1.WebhookValueSource{}
+		m.Type.convertTo(sink.Type)
+	}
+	if m.Subject != nil {
+		sink.Subject = &sourcesv1alpha1.WebhookValueSource{}
+		m.Subject.convertTo(sink.Subject)
+	}
+	if m.ID != nil {
+		sink.ID = &sourcesv1alpha1.WebhookValueSource{}
+		m.ID.convertTo(sink.ID)
+	}
+	if m.Extensions != nil {
+		sink.Extensions = make([]sourcesv1alpha1.WebhookExtensionMapping, len(m.Extensions))
+		for i := range m.Extensions {
+			m.Extensions[i].convertTo(&sink.Extensions[i])
+		}
+	}
+}
+
+// convertFrom copies the attributes of a v1alpha1 WebhookMapping.
+func (m *WebhookMapping) convertFrom(source *sourcesv1alpha1.WebhookMapping) {
+	if source.Type != nil {
+		m.Type = &WebhookValueSource{}
+		m.Type.convertFrom(source.Type)
+	}
+	if source.Subject != nil {
+		m.Subject = &WebhookValueSource{}
+		m.Subject.convertFrom(source.Subject)
+	}
+	if source.ID != nil {
+		m.ID = &WebhookValueSource{}
+		m.ID.convertFrom(source.ID)
+	}
+	if source.Extensions != nil {
+		m.Extensions = make([]WebhookExtensionMapping, len(source.Extensions))
+		for i := range source.Extensions {
+			m.Extensions[i].convertFrom(&source.Extensions[i])
+		}
+	}
+}
+
+// convertTo copies the attributes of a WebhookExtensionMapping to its v1alpha1 counterpart.
+func (w *WebhookExtensionMapping) convertTo(sink *sourcesv1alpha1.WebhookExtensionMapping) {
+	sink.Name = w.Name
+	w.WebhookValueSource.convertTo(&sink.WebhookValueSource)
+}
+
+// convertFrom copies the attributes of a v1alpha1 WebhookExtensionMapping.
+func (w *WebhookExtensionMapping) convertFrom(source *sourcesv1alpha1.WebhookExtensionMapping) {
+	w.Name = source.Name
+	w.WebhookValueSource.convertFrom(&source.WebhookValueSource)
+}
+
+// convertTo copies the attributes of a WebhookValueSource to its v1alpha1 counterpart.
+func (v *WebhookValueSource) convertTo(sink *sourcesv1alpha1.WebhookValueSource) {
+	sink.Header = v.Header
+	sink.Query = v.Query
+	sink.Path = v.Path
+	sink.Method = v.Method
+	sink.BodyPath = v.BodyPath
+}
+
+// convertFrom copies the attributes of a v1alpha1 WebhookValueSource.
+func (v *WebhookValueSource) convertFrom(source *sourcesv1alpha1.WebhookValueSource) {
+	v.Header = source.Header
+	v.Query = source.Query
+	v.Path = source.Path
+	v.Method = source.Method
+	v.BodyPath = source.BodyPath
+}
@@ -0,0 +1,201 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	"github.com/triggermesh/triggermesh/pkg/apis"
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// WebhookSource is the schema for the event source.
+type WebhookSource struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   WebhookSourceSpec `json:"spec,omitempty"`
+	Status v1alpha1.Status   `json:"status,omitempty"`
+}
+
+// WebhookSourceSpec defines the desired state of the event source.
+type WebhookSourceSpec struct {
+	// inherits duck/v1 SourceSpec, which currently provides:
+	// * Sink - a reference to an object that will resolve to a domain name or
+	//   a URI directly to use as the sink.
+	// * CloudEventOverrides - defines overrides to control the output format
+	//   and modifications of the event sent to the sink.
+	duckv1.SourceSpec `json:",inline"`
+
+	// Value of the CloudEvents 'type' attribute to set on ingested events.
+	// https://github.com/cloudevents/spec/blob/v1.0.1/spec.md#type
+	EventType string `json:"eventType"`
+
+	// Value of the CloudEvents 'source' attribute to set on ingested events.
+	// https://github.com/cloudevents/spec/blob/v1.0.1/spec.md#source-1
+	// +optional
+	EventSource *string `json:"eventSource,omitempty"`
+
+	// User name HTTP clients must set to authenticate with the webhook using HTTP Basic authentication.
+	// +optional
+	BasicAuthUsername *string `json:"basicAuthUsername,omitempty"`
+
+	// Password HTTP clients must set to authenticate with the webhook using HTTP Basic authentication.
+	// +optional
+	BasicAuthPassword *v1alpha1.ValueFromField `json:"basicAuthPassword,omitempty"`
+
+	// Verification of the HMAC signature of request bodies, as performed
+	// by webhook providers which sign their requests with a shared secret.
+	// +optional
+	Signature *WebhookSignature `json:"signature,omitempty"`
+
+	// Extraction of request metadata into attributes of ingested events.
+	// +optional
+	Mapping *WebhookMapping `json:"mapping,omitempty"`
+
+	// Specifies the CORS Origin to use in pre-flight headers.
+	// +optional
+	CORSAllowOrigin *string `json:"corsAllowOrigin,omitempty"`
+
+	// Adapter spec overrides parameters.
+	// +optional
+	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
+}
+
+// WebhookSignature contains the parameters used to verify the HMAC signature
+// of requests.
+type WebhookSignature struct {
+	// Name of the HTTP header which contains the signature.
+	Header string `json:"header"`
+
+	// Hash algorithm used to compute the HMAC signature.
+	Algorithm WebhookSignatureAlgorithm `json:"algorithm"`
+
+	// Secret key shared with the webhook provider.
+	Secret v1alpha1.ValueFromField `json:"secret"`
+
+	// Prefix which precedes the signature in the header value, e.g. "sha256=".
+	// +optional
+	Prefix *string `json:"prefix,omitempty"`
+
+	// Encoding of the signature in the header value. Defaults to "hex".
+	// +optional
+	Encoding *WebhookSignatureEncoding `json:"encoding,omitempty"`
+
+	// Protection against replayed requests. When set, the signed content
+	// is the request timestamp and the body separated by a dot.
+	// +optional
+	Timestamp *WebhookSignatureTimestamp `json:"timestamp,omitempty"`
+}
+
+// WebhookSignatureAlgorithm is the hash algorithm of a HMAC signature.
+type WebhookSignatureAlgorithm string
+
+// Supported signature algorithms.
+const (
+	WebhookSignatureAlgorithmSHA1   WebhookSignatureAlgorithm = "sha1"
+	WebhookSignatureAlgorithmSHA256 WebhookSignatureAlgorithm = "sha256"
+)
+
+// WebhookSignatureEncoding is the encoding of a HMAC signature.
+type WebhookSignatureEncoding string
+
+// Supported signature encodings.
+const (
+	WebhookSignatureEncodingHex    WebhookSignatureEncoding = "hex"
+	WebhookSignatureEncodingBase64 WebhookSignatureEncoding = "base64"
+)
+
+// WebhookSignatureTimestamp contains the parameters used to reject replayed
+// requests.
+type WebhookSignatureTimestamp struct {
+	// Name of the HTTP header which contains the time at which the request
+	// was signed, in seconds since the Unix epoch.
+	Header string `json:"header"`
+
+	// Maximum difference between the request timestamp and the time of
+	// reception.
+	Tolerance apis.Duration `json:"tolerance"`
+}
+
+// WebhookMapping defines how attributes of ingested events are derived from
+// HTTP requests. Attributes which value can not be found in a request retain
+// their default value.
+type WebhookMapping struct {
+	// Source of the CloudEvents 'type' attribute, overriding EventType.
+	// +optional
+	Type *WebhookValueSource `json:"type,omitempty"`
+
+	// Source of the CloudEvents 'subject' attribute.
+	// +optional
+	Subject *WebhookValueSource `json:"subject,omitempty"`
+
+	// Source of the CloudEvents 'id' attribute.
+	// +optional
+	ID *WebhookValueSource `json:"id,omitempty"`
+
+	// CloudEvents extensions to set on ingested events.
+	// +optional
+	Extensions []WebhookExtensionMapping `json:"extensions,omitempty"`
+}
+
+// WebhookExtensionMapping defines a CloudEvents extension derived from HTTP
+// requests.
+type WebhookExtensionMapping struct {
+	// Name of the CloudEvents extension.
+	Name string `json:"name"`
+
+	// Source of the extension value.
+	WebhookValueSource `json:",inline"`
+}
+
+// WebhookValueSource is the location of a value within a HTTP request.
+// Exactly one field should be set.
+type WebhookValueSource struct {
+	// Name of a HTTP header.
+	// +optional
+	Header *string `json:"header,omitempty"`
+
+	// Name of a URL query parameter.
+	// +optional
+	Query *string `json:"query,omitempty"`
+
+	// Whether the value is the path of the request URL.
+	// +optional
+	Path *bool `json:"path,omitempty"`
+
+	// Whether the value is the method of the request.
+	// +optional
+	Method *bool `json:"method,omitempty"`
+
+	// Path of a value within a JSON request body, in GJSON syntax.
+	// https://github.com/tidwall/gjson/blob/master/SYNTAX.md
+	// +optional
+	BodyPath *string `json:"bodyPath,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// WebhookSourceList contains a list of event sources.
+type WebhookSourceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []WebhookSource `json:"items"`
+}
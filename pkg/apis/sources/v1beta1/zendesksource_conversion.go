@@ -0,0 +1,84 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+
+	"knative.dev/pkg/apis"
+
+	sourcesv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+)
+
+// ConvertTo implements apis.Convertible.
+func (s *ZendeskSource) ConvertTo(_ context.Context, to apis.Convertible) error {
+	switch sink := to.(type) {
+	case *sourcesv1alpha1.ZendeskSource:
+		sink.ObjectMeta = s.ObjectMeta
+		s.Spec.convertTo(&sink.Spec)
+		s.Status.convertTo(&sink.Status)
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", sink)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *ZendeskSource) ConvertFrom(_ context.Context, from apis.Convertible) error {
+	switch source := from.(type) {
+	case *sourcesv1alpha1.ZendeskSource:
+		s.ObjectMeta = source.ObjectMeta
+		s.Spec.convertFrom(&source.Spec)
+		s.Status.convertFrom(&source.Status)
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", source)
+	}
+}
+
+// convertTo copies the attributes of a ZendeskSourceSpec to its v1alpha1 counterpart.
+func (s *ZendeskSourceSpec) convertTo(sink *sourcesv1alpha1.ZendeskSourceSpec) {
+	sink.SourceSpec = s.SourceSpec
+	sink.Token = s.Token
+	sink.Email = s.Email
+	sink.WebhookPassword = s.WebhookPassword
+	sink.WebhookUsername = s.WebhookUsername
+	sink.Subdomain = s.Subdomain
+	sink.AdapterOverrides = s.AdapterOverrides
+}
+
+// convertFrom copies the attributes of a v1alpha1 ZendeskSourceSpec.
+func (s *ZendeskSourceSpec) convertFrom(source *sourcesv1alpha1.ZendeskSourceSpec) {
+	s.SourceSpec = source.SourceSpec
+	s.Token = source.Token
+	s.Email = source.Email
+	s.WebhookPassword = source.WebhookPassword
+	s.WebhookUsername = source.WebhookUsername
+	s.Subdomain = source.Subdomain
+	s.AdapterOverrides = source.AdapterOverrides
+}
+
+// convertTo copies the attributes of a ZendeskSourceStatus to its v1alpha1 counterpart.
+func (s *ZendeskSourceStatus) convertTo(sink *sourcesv1alpha1.ZendeskSourceStatus) {
+	sink.Status = s.Status
+}
+
+// convertFrom copies the attributes of a v1alpha1 ZendeskSourceStatus.
+func (s *ZendeskSourceStatus) convertFrom(source *sourcesv1alpha1.ZendeskSourceStatus) {
+	s.Status = source.Status
+}
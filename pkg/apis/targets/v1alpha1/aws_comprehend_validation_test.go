@@ -0,0 +1,74 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+)
+
+func TestAWSComprehendTargetValidate(t *testing.T) {
+	testCases := map[string]struct {
+		modify         func(*AWSComprehendTargetSpec)
+		expectErrorMsg string
+	}{
+		"Valid": {},
+		"Missing region": {
+			modify:         func(s *AWSComprehendTargetSpec) { s.Region = "" },
+			expectErrorMsg: "missing field(s): spec.region",
+		},
+		"Missing language": {
+			modify:         func(s *AWSComprehendTargetSpec) { s.Language = "" },
+			expectErrorMsg: "missing field(s): spec.language",
+		},
+		"Missing API key": {
+			modify:         func(s *AWSComprehendTargetSpec) { s.AWSApiKey = SecretValueFromSource{} },
+			expectErrorMsg: "missing field(s): spec.awsApiKey.secretKeyRef",
+		},
+		"Incomplete API secret": {
+			modify:         func(s *AWSComprehendTargetSpec) { s.AWSApiSecret.SecretKeyRef.Key = "" },
+			expectErrorMsg: "missing field(s): spec.awsApiSecret.secretKeyRef.key",
+		},
+		"Unsupported payload policy": {
+			modify: func(s *AWSComprehendTargetSpec) {
+				s.EventOptions = &EventOptions{PayloadPolicy: payloadPolicy("sometimes")}
+			},
+			expectErrorMsg: "invalid value: sometimes: spec.eventOptions.payloadPolicy",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			trg := &AWSComprehendTarget{Spec: tAWSComprehendTargetSpec()}
+			if tc.modify != nil {
+				tc.modify(&trg.Spec)
+			}
+
+			assertFieldError(t, tc.expectErrorMsg, trg.Validate(context.Background()))
+		})
+	}
+}
+
+func tAWSComprehendTargetSpec() AWSComprehendTargetSpec {
+	return AWSComprehendTargetSpec{
+		AWSApiKey:    tSecretValueFromSource(),
+		AWSApiSecret: tSecretValueFromSource(),
+		Region:       "us-east-1",
+		Language:     "en",
+	}
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+)
+
+func TestAWSEventBridgeTargetValidate(t *testing.T) {
+	testCases := map[string]struct {
+		modify         func(*AWSEventBridgeTargetSpec)
+		expectErrorMsg string
+	}{
+		"Valid": {},
+		"Missing ARN": {
+			modify:         func(s *AWSEventBridgeTargetSpec) { s.ARN = "" },
+			expectErrorMsg: "missing field(s): spec.arn",
+		},
+		"Invalid ARN": {
+			modify: func(s *AWSEventBridgeTargetSpec) { s.ARN = "arn:aws:sns:us-east-1:123456789012:test-topic" },
+			expectErrorMsg: "invalid value: arn:aws:sns:us-east-1:123456789012:test-topic: spec.arn\n" +
+				"ARN must refer to a resource of the events service",
+		},
+		"Missing API key": {
+			modify:         func(s *AWSEventBridgeTargetSpec) { s.AWSApiKey = SecretValueFromSource{} },
+			expectErrorMsg: "missing field(s): spec.awsApiKey.secretKeyRef",
+		},
+		"Incomplete API secret": {
+			modify:         func(s *AWSEventBridgeTargetSpec) { s.AWSApiSecret.SecretKeyRef.Key = "" },
+			expectErrorMsg: "missing field(s): spec.awsApiSecret.secretKeyRef.key",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			trg := &AWSEventBridgeTarget{Spec: tAWSEventBridgeTargetSpec()}
+			if tc.modify != nil {
+				tc.modify(&trg.Spec)
+			}
+
+			assertFieldError(t, tc.expectErrorMsg, trg.Validate(context.Background()))
+		})
+	}
+}
+
+func tAWSEventBridgeTargetSpec() AWSEventBridgeTargetSpec {
+	return AWSEventBridgeTargetSpec{
+		AWSApiKey:    tSecretValueFromSource(),
+		AWSApiSecret: tSecretValueFromSource(),
+		ARN:          "arn:aws:events:us-east-1:123456789012:event-bus/default",
+	}
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+)
+
+func TestAWSLambdaTargetValidate(t *testing.T) {
+	testCases := map[string]struct {
+		modify         func(*AWSLambdaTargetSpec)
+		expectErrorMsg string
+	}{
+		"Valid": {},
+		"Missing ARN": {
+			modify:         func(s *AWSLambdaTargetSpec) { s.ARN = "" },
+			expectErrorMsg: "missing field(s): spec.arn",
+		},
+		"Invalid ARN": {
+			modify: func(s *AWSLambdaTargetSpec) { s.ARN = "arn:aws:lambda:us-east-1:123456789012:layer:test-layer" },
+			expectErrorMsg: "invalid value: arn:aws:lambda:us-east-1:123456789012:layer:test-layer: spec.arn\n" +
+				"resource of the ARN must be of the form function:<name>",
+		},
+		"Missing API key": {
+			modify:         func(s *AWSLambdaTargetSpec) { s.AWSApiKey = SecretValueFromSource{} },
+			expectErrorMsg: "missing field(s): spec.awsApiKey.secretKeyRef",
+		},
+		"Incomplete API secret": {
+			modify:         func(s *AWSLambdaTargetSpec) { s.AWSApiSecret.SecretKeyRef.Key = "" },
+			expectErrorMsg: "missing field(s): spec.awsApiSecret.secretKeyRef.key",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			trg := &AWSLambdaTarget{Spec: tAWSLambdaTargetSpec()}
+			if tc.modify != nil {
+				tc.modify(&trg.Spec)
+			}
+
+			assertFieldError(t, tc.expectErrorMsg, trg.Validate(context.Background()))
+		})
+	}
+}
+
+func tAWSLambdaTargetSpec() AWSLambdaTargetSpec {
+	return AWSLambdaTargetSpec{
+		AWSApiKey:    tSecretValueFromSource(),
+		AWSApiSecret: tSecretValueFromSource(),
+		ARN:          "arn:aws:lambda:us-east-1:123456789012:function:test-function",
+	}
+}
@@ -19,6 +19,7 @@ package v1alpha1
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/triggermesh/triggermesh/pkg/apis"
 	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
 )
 
@@ -60,11 +61,58 @@ type AWSS3TargetSpec struct {
 	// When this property is true, only the CloudEvent data is included.
 	DiscardCEContext bool `json:"discardCloudEventContext"`
 
+	// Buffering of events into objects which contain multiple events.
+	// When this property is not set (default), each event is written to its own object.
+	// +optional
+	Batching *AWSS3TargetBatching `json:"batching,omitempty"`
+
 	// Adapter spec overrides parameters.
 	// +optional
 	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
 }
 
+// AWSS3TargetBatching defines how events are buffered and written to S3 as
+// batched objects.
+type AWSS3TargetBatching struct {
+	// Format of the objects written to the bucket.
+	Format AWSS3TargetBatchFormat `json:"format"`
+
+	// Maximum number of events written to a single object. Defaults to 1000.
+	// +optional
+	MaxEvents *int32 `json:"maxEvents,omitempty"`
+
+	// Maximum size, in bytes and before compression, of the events written
+	// to a single object. Defaults to 5MiB.
+	// +optional
+	MaxBytes *int64 `json:"maxBytes,omitempty"`
+
+	// Maximum duration events are buffered for before being written.
+	// Defaults to 30s.
+	//
+	// Events are only acknowledged once the object they were buffered into
+	// has been written, so this duration should remain lower than the
+	// request timeout of event senders.
+	// +optional
+	FlushInterval *apis.Duration `json:"flushInterval,omitempty"`
+
+	// Prefix of the keys of written objects, as a Go template.
+	// The template is rendered with the time of the write operation, in
+	// UTC, as the '.Time' field (e.g. 'dt={{ .Time.Format "2006-01-02" }}/').
+	// +optional
+	KeyPrefix *string `json:"keyPrefix,omitempty"`
+}
+
+// AWSS3TargetBatchFormat is the format of batched objects.
+type AWSS3TargetBatchFormat string
+
+// Supported formats of batched objects.
+const (
+	// Newline-delimited JSON, compressed with gzip.
+	AWSS3TargetBatchFormatNDJSON AWSS3TargetBatchFormat = "ndjson"
+	// Apache Parquet.
+	AWSS3TargetBatchFormatParquet AWSS3TargetBatchFormat = "parquet"
+)
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // AWSS3TargetList is a list of AWSS3Target resources
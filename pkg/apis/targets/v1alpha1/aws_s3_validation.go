@@ -0,0 +1,76 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"text/template"
+	"time"
+
+	"knative.dev/pkg/apis"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+// Validate implements apis.Validatable
+func (t *AWSS3Target) Validate(ctx context.Context) *apis.FieldError {
+	return t.Spec.Validate(ctx).ViaField("spec")
+}
+
+// Validate AWSS3Target spec
+func (s *AWSS3TargetSpec) Validate(ctx context.Context) *apis.FieldError {
+	errs := validateAWSCredentials(ctx, &s.AWSApiKey, &s.AWSApiSecret)
+
+	errs = errs.Also(validateARN(s.ARN, "s3", "").ViaField("arn"))
+
+	return errs.Also(s.Batching.Validate(ctx).ViaField("batching"))
+}
+
+// Validate verifies that the batching parameters are consistent.
+func (b *AWSS3TargetBatching) Validate(_ context.Context) *apis.FieldError {
+	if b == nil {
+		return nil
+	}
+
+	var errs *apis.FieldError
+
+	switch b.Format {
+	case AWSS3TargetBatchFormatNDJSON, AWSS3TargetBatchFormatParquet:
+	case "":
+		errs = errs.Also(apis.ErrMissingField("format"))
+	default:
+		errs = errs.Also(apis.ErrInvalidValue(b.Format, "format"))
+	}
+
+	if b.MaxEvents != nil && *b.MaxEvents < 1 {
+		errs = errs.Also(apis.ErrOutOfBoundsValue(*b.MaxEvents, 1, "+Inf", "maxEvents"))
+	}
+	if b.MaxBytes != nil && *b.MaxBytes < 1 {
+		errs = errs.Also(apis.ErrOutOfBoundsValue(*b.MaxBytes, 1, "+Inf", "maxBytes"))
+	}
+	if b.FlushInterval != nil {
+		errs = errs.Also(v1alpha1.ValidatePositiveDuration(time.Duration(*b.FlushInterval)).ViaField("flushInterval"))
+	}
+
+	if b.KeyPrefix != nil {
+		if _, err := template.New("keyPrefix").Parse(*b.KeyPrefix); err != nil {
+			errs = errs.Also(apis.ErrInvalidValue(*b.KeyPrefix, "keyPrefix", err.Error()))
+		}
+	}
+
+	return errs
+}
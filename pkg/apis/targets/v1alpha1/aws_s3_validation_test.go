@@ -0,0 +1,119 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"knative.dev/pkg/ptr"
+
+	tmapis "github.com/triggermesh/triggermesh/pkg/apis"
+)
+
+func TestAWSS3TargetValidate(t *testing.T) {
+	testCases := map[string]struct {
+		modify         func(*AWSS3TargetSpec)
+		expectErrorMsg string
+	}{
+		"Valid": {},
+		"Missing ARN": {
+			modify:         func(s *AWSS3TargetSpec) { s.ARN = "" },
+			expectErrorMsg: "missing field(s): spec.arn",
+		},
+		"Invalid ARN": {
+			modify: func(s *AWSS3TargetSpec) { s.ARN = "arn:aws:sqs:us-east-1:123456789012:test-queue" },
+			expectErrorMsg: "invalid value: arn:aws:sqs:us-east-1:123456789012:test-queue: spec.arn\n" +
+				"ARN must refer to a resource of the s3 service",
+		},
+		"Batching": {
+			modify: func(s *AWSS3TargetSpec) {
+				s.Batching = &AWSS3TargetBatching{
+					Format:    AWSS3TargetBatchFormatParquet,
+					MaxEvents: ptr.Int32(1000),
+					KeyPrefix: ptr.String(`dt={{ .Time.Format "2006-01-02" }}/`),
+				}
+			},
+		},
+		"Batching without format": {
+			modify:         func(s *AWSS3TargetSpec) { s.Batching = &AWSS3TargetBatching{} },
+			expectErrorMsg: "missing field(s): spec.batching.format",
+		},
+		"Unsupported batch format": {
+			modify:         func(s *AWSS3TargetSpec) { s.Batching = &AWSS3TargetBatching{Format: "csv"} },
+			expectErrorMsg: "invalid value: csv: spec.batching.format",
+		},
+		"Zero max events": {
+			modify: func(s *AWSS3TargetSpec) {
+				s.Batching = &AWSS3TargetBatching{
+					Format:    AWSS3TargetBatchFormatNDJSON,
+					MaxEvents: ptr.Int32(0),
+				}
+			},
+			expectErrorMsg: "expected 1 <= 0 <= +Inf: spec.batching.maxEvents",
+		},
+		"Zero flush interval": {
+			modify: func(s *AWSS3TargetSpec) {
+				d := tmapis.Duration(0)
+				s.Batching = &AWSS3TargetBatching{
+					Format:        AWSS3TargetBatchFormatNDJSON,
+					FlushInterval: &d,
+				}
+			},
+			expectErrorMsg: "invalid value: 0s: spec.batching.flushInterval\n" +
+				"duration must be greater than zero",
+		},
+		"Unparsable key prefix": {
+			modify: func(s *AWSS3TargetSpec) {
+				s.Batching = &AWSS3TargetBatching{
+					Format:    AWSS3TargetBatchFormatNDJSON,
+					KeyPrefix: ptr.String("{{ .Time"),
+				}
+			},
+			expectErrorMsg: "invalid value: {{ .Time: spec.batching.keyPrefix\n" +
+				"template: keyPrefix:1: unclosed action",
+		},
+		"Missing API key": {
+			modify:         func(s *AWSS3TargetSpec) { s.AWSApiKey = SecretValueFromSource{} },
+			expectErrorMsg: "missing field(s): spec.awsApiKey.secretKeyRef",
+		},
+		"Incomplete API secret": {
+			modify:         func(s *AWSS3TargetSpec) { s.AWSApiSecret.SecretKeyRef.Key = "" },
+			expectErrorMsg: "missing field(s): spec.awsApiSecret.secretKeyRef.key",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			trg := &AWSS3Target{Spec: tAWSS3TargetSpec()}
+			if tc.modify != nil {
+				tc.modify(&trg.Spec)
+			}
+
+			assertFieldError(t, tc.expectErrorMsg, trg.Validate(context.Background()))
+		})
+	}
+}
+
+func tAWSS3TargetSpec() AWSS3TargetSpec {
+	return AWSS3TargetSpec{
+		AWSApiKey:    tSecretValueFromSource(),
+		AWSApiSecret: tSecretValueFromSource(),
+		ARN:          "arn:aws:s3:::test-bucket",
+	}
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+func TestAzureAuthValidate(t *testing.T) {
+	testCases := map[string]struct {
+		auth           AzureAuth
+		expectErrorMsg string
+	}{
+		"No method": {
+			auth: AzureAuth{},
+		},
+		"Service principal": {
+			auth: tAzureServicePrincipalAuth(),
+		},
+		"SAS token": {
+			auth: AzureAuth{
+				SASToken: &AzureSASToken{
+					KeyName:  v1alpha1.ValueFromField{Value: "RootManageSharedAccessKey"},
+					KeyValue: tSecretValue(),
+				},
+			},
+		},
+		"Both methods": {
+			auth: AzureAuth{
+				ServicePrincipal: tAzureServicePrincipalAuth().ServicePrincipal,
+				SASToken:         &AzureSASToken{ConnectionString: tSecretValue()},
+			},
+			expectErrorMsg: "expected exactly one, got both: auth.sasToken, auth.servicePrincipal",
+		},
+		"Incomplete service principal": {
+			auth: AzureAuth{
+				ServicePrincipal: &AzureServicePrincipal{
+					ClientID:     tSecretValue(),
+					ClientSecret: tSecretValue(),
+				},
+			},
+			expectErrorMsg: "expected exactly one, got neither: " +
+				"auth.servicePrincipal.tenantID.value, auth.servicePrincipal.tenantID.valueFromSecret",
+		},
+		"Empty SAS token": {
+			auth:           AzureAuth{SASToken: &AzureSASToken{}},
+			expectErrorMsg: "expected exactly one, got neither: auth.sasToken.connectionString, auth.sasToken.keyName",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			assertFieldError(t, tc.expectErrorMsg, tc.auth.Validate(context.Background()).ViaField("auth"))
+		})
+	}
+}
+
+// tAzureServicePrincipalAuth returns an AzureAuth which uses a service
+// principal.
+func tAzureServicePrincipalAuth() AzureAuth {
+	return AzureAuth{
+		ServicePrincipal: &AzureServicePrincipal{
+			TenantID:     tSecretValue(),
+			ClientID:     tSecretValue(),
+			ClientSecret: tSecretValue(),
+		},
+	}
+}
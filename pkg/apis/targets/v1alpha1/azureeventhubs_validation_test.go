@@ -0,0 +1,80 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+)
+
+func TestAzureEventHubsTargetValidate(t *testing.T) {
+	testCases := map[string]struct {
+		modify         func(*AzureEventHubsTargetSpec)
+		expectErrorMsg string
+	}{
+		"Valid": {},
+		"Without auth": {
+			modify: func(s *AzureEventHubsTargetSpec) { s.Auth = AzureAuth{} },
+		},
+		"Missing event hub ID": {
+			modify:         func(s *AzureEventHubsTargetSpec) { s.EventHubID = EventHubResourceID{} },
+			expectErrorMsg: "missing field(s): spec.eventHubID",
+		},
+		"Incomplete event hub ID": {
+			modify:         func(s *AzureEventHubsTargetSpec) { s.EventHubID.EventHub = "" },
+			expectErrorMsg: "missing field(s): spec.eventHubID",
+		},
+		"Incomplete SAS token": {
+			modify: func(s *AzureEventHubsTargetSpec) {
+				s.Auth = AzureAuth{
+					SASToken: &AzureSASToken{KeyValue: tSecretValue()},
+				}
+			},
+			expectErrorMsg: "expected exactly one, got neither: spec.auth.sasToken.keyName.value, spec.auth.sasToken.keyName.valueFromSecret",
+		},
+		"Unsupported payload policy": {
+			modify: func(s *AzureEventHubsTargetSpec) {
+				s.EventOptions = &EventOptions{PayloadPolicy: payloadPolicy("sometimes")}
+			},
+			expectErrorMsg: "invalid value: sometimes: spec.eventOptions.payloadPolicy",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			trg := &AzureEventHubsTarget{Spec: tAzureEventHubsTargetSpec()}
+			if tc.modify != nil {
+				tc.modify(&trg.Spec)
+			}
+
+			assertFieldError(t, tc.expectErrorMsg, trg.Validate(context.Background()))
+		})
+	}
+}
+
+func tAzureEventHubsTargetSpec() AzureEventHubsTargetSpec {
+	return AzureEventHubsTargetSpec{
+		Auth: tAzureServicePrincipalAuth(),
+		EventHubID: EventHubResourceID{
+			SubscriptionID: "00000000-0000-0000-0000-000000000000",
+			ResourceGroup:  "test-rg",
+			Namespace:      "test-ns",
+			EventHub:       "test-hub",
+		},
+	}
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"knative.dev/pkg/apis"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+func TestCloudEventsTargetValidate(t *testing.T) {
+	testCases := map[string]struct {
+		modify         func(*CloudEventsTargetSpec)
+		expectErrorMsg string
+	}{
+		"Valid": {},
+		"Without credentials": {
+			modify: func(s *CloudEventsTargetSpec) { s.Credentials = nil },
+		},
+		"Missing endpoint": {
+			modify:         func(s *CloudEventsTargetSpec) { s.Endpoint = apis.URL{} },
+			expectErrorMsg: "missing field(s): spec.endpoint",
+		},
+		"Relative endpoint": {
+			modify: func(s *CloudEventsTargetSpec) { s.Endpoint = apis.URL{Path: "/events"} },
+			expectErrorMsg: "invalid value: /events: spec.endpoint\n" +
+				"URL must be absolute and include a host",
+		},
+		"Missing username": {
+			modify:         func(s *CloudEventsTargetSpec) { s.Credentials.BasicAuth.Username = "" },
+			expectErrorMsg: "missing field(s): spec.credentials.basicAuth.username",
+		},
+		"Missing password": {
+			modify:         func(s *CloudEventsTargetSpec) { s.Credentials.BasicAuth.Password = v1alpha1.ValueFromField{} },
+			expectErrorMsg: "expected exactly one, got neither: spec.credentials.basicAuth.password.value, spec.credentials.basicAuth.password.valueFromSecret",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			trg := &CloudEventsTarget{Spec: tCloudEventsTargetSpec()}
+			if tc.modify != nil {
+				tc.modify(&trg.Spec)
+			}
+
+			assertFieldError(t, tc.expectErrorMsg, trg.Validate(context.Background()))
+		})
+	}
+}
+
+func tCloudEventsTargetSpec() CloudEventsTargetSpec {
+	return CloudEventsTargetSpec{
+		Endpoint: *apis.HTTP("example.com"),
+		Credentials: &CloudEventsCredentials{
+			BasicAuth: HTTPBasicAuth{
+				Username: "test-user",
+				Password: tSecretValue(),
+			},
+		},
+	}
+}
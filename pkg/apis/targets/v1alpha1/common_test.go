@@ -0,0 +1,73 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"knative.dev/pkg/apis"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+const (
+	tSecretName = "test-secret"
+	tSecretKey  = "test-key"
+)
+
+// tSecretValueFromSource returns a SecretValueFromSource which references a
+// key of a Secret.
+func tSecretValueFromSource() SecretValueFromSource {
+	return SecretValueFromSource{
+		SecretKeyRef: tSecretKeySelector(),
+	}
+}
+
+// tSecretValue returns a ValueFromField which references a key of a Secret.
+func tSecretValue() v1alpha1.ValueFromField {
+	return v1alpha1.ValueFromField{
+		ValueFromSecret: tSecretKeySelector(),
+	}
+}
+
+func tSecretKeySelector() *corev1.SecretKeySelector {
+	return &corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{
+			Name: tSecretName,
+		},
+		Key: tSecretKey,
+	}
+}
+
+// assertFieldError asserts that the given error matches the expected error
+// message, or is nil if the expected message is empty.
+func assertFieldError(t *testing.T, expectMsg string, err *apis.FieldError) {
+	t.Helper()
+
+	if expectMsg == "" {
+		assert.Nil(t, err)
+		return
+	}
+
+	if assert.NotNil(t, err) {
+		assert.Equal(t, expectMsg, err.Error())
+	}
+}
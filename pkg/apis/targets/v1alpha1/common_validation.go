@@ -0,0 +1,128 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+
+	"knative.dev/pkg/apis"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/targets/adapter/cloudevents"
+)
+
+// Validate verifies that the reference to a Secret, if set, is complete.
+func (s *SecretValueFromSource) Validate(_ context.Context) *apis.FieldError {
+	if s == nil || s.SecretKeyRef == nil {
+		return nil
+	}
+
+	return v1alpha1.ValidateSecretKeySelector(s.SecretKeyRef).ViaField("secretKeyRef")
+}
+
+// ValidateRequired verifies that a reference to a Secret is set and complete.
+func (s *SecretValueFromSource) ValidateRequired(ctx context.Context) *apis.FieldError {
+	if !s.IsSet() {
+		return apis.ErrMissingField("secretKeyRef")
+	}
+
+	return s.Validate(ctx)
+}
+
+// IsSet returns whether a reference to a Secret is set.
+func (s *SecretValueFromSource) IsSet() bool {
+	return s != nil && s.SecretKeyRef != nil
+}
+
+// Validate verifies that the payload policy, if set, is supported.
+func (o *EventOptions) Validate(_ context.Context) *apis.FieldError {
+	if o == nil || o.PayloadPolicy == nil {
+		return nil
+	}
+
+	switch *o.PayloadPolicy {
+	case cloudevents.PayloadPolicyAlways,
+		cloudevents.PayloadPolicyErrors,
+		cloudevents.PayloadPolicyNever,
+		// spelling documented in the CRD schemas, handled like "errors"
+		// by the replier
+		"error":
+		return nil
+	}
+
+	return apis.ErrInvalidValue(*o.PayloadPolicy, "payloadPolicy")
+}
+
+// validateAWSCredentials verifies that the given AWS API key and secret are
+// set.
+func validateAWSCredentials(ctx context.Context, key, secret *SecretValueFromSource) *apis.FieldError {
+	return key.ValidateRequired(ctx).ViaField("awsApiKey").
+		Also(secret.ValidateRequired(ctx).ViaField("awsApiSecret"))
+}
+
+// validateARN verifies that the given string is a valid ARN which refers to a
+// resource of the given AWS service, and that the resource part of the ARN
+// starts with the given prefix.
+func validateARN(arnStr, service, resourcePrefix string) *apis.FieldError {
+	if arnStr == "" {
+		return apis.ErrMissingField(apis.CurrentField)
+	}
+
+	a, err := arn.Parse(arnStr)
+	if err != nil {
+		return apis.ErrInvalidValue(arnStr, apis.CurrentField, err.Error())
+	}
+
+	if a.Service != service {
+		return apis.ErrInvalidValue(arnStr, apis.CurrentField,
+			"ARN must refer to a resource of the "+service+" service")
+	}
+
+	if len(a.Resource) <= len(resourcePrefix) || !strings.HasPrefix(a.Resource, resourcePrefix) {
+		return apis.ErrInvalidValue(arnStr, apis.CurrentField,
+			"resource of the ARN must be of the form "+resourcePrefix+"<name>")
+	}
+
+	return nil
+}
+
+// validateKafkaSecurityProtocol verifies that the given security protocol is
+// supported by the Kafka client.
+func validateKafkaSecurityProtocol(p string) *apis.FieldError {
+	switch strings.ToLower(p) {
+	case "plaintext", "ssl", "sasl_plaintext", "sasl_ssl":
+		return nil
+	}
+
+	return apis.ErrInvalidValue(p, apis.CurrentField,
+		"supported protocols are plaintext, ssl, sasl_plaintext and sasl_ssl")
+}
+
+// validateKafkaSASLMechanism verifies that the given SASL mechanism is
+// supported by the Kafka client.
+func validateKafkaSASLMechanism(m string) *apis.FieldError {
+	switch m {
+	case "GSSAPI", "PLAIN", "SCRAM-SHA-256", "SCRAM-SHA-512", "OAUTHBEARER":
+		return nil
+	}
+
+	return apis.ErrInvalidValue(m, apis.CurrentField,
+		"supported mechanisms are GSSAPI, PLAIN, SCRAM-SHA-256, SCRAM-SHA-512 and OAUTHBEARER")
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"knative.dev/pkg/apis"
+
+	"github.com/triggermesh/triggermesh/pkg/targets/adapter/cloudevents"
+)
+
+func TestSecretValueFromSourceValidate(t *testing.T) {
+	testCases := map[string]struct {
+		val            *SecretValueFromSource
+		required       bool
+		expectErrorMsg string
+	}{
+		"Nil, optional": {
+			val: nil,
+		},
+		"Nil, required": {
+			val:            nil,
+			required:       true,
+			expectErrorMsg: "missing field(s): token.secretKeyRef",
+		},
+		"Secret": {
+			val:      &SecretValueFromSource{SecretKeyRef: tSecretKeySelector()},
+			required: true,
+		},
+		"Secret without name": {
+			val: &SecretValueFromSource{
+				SecretKeyRef: &corev1.SecretKeySelector{Key: tSecretKey},
+			},
+			expectErrorMsg: "missing field(s): token.secretKeyRef.name",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			var err *apis.FieldError
+			if tc.required {
+				err = tc.val.ValidateRequired(context.Background())
+			} else {
+				err = tc.val.Validate(context.Background())
+			}
+
+			assertFieldError(t, tc.expectErrorMsg, err.ViaField("token"))
+		})
+	}
+}
+
+func TestEventOptionsValidate(t *testing.T) {
+	testCases := map[string]struct {
+		policy         *cloudevents.PayloadPolicy
+		expectErrorMsg string
+	}{
+		"No policy": {
+			policy: nil,
+		},
+		"Supported policy": {
+			policy: payloadPolicy(cloudevents.PayloadPolicyErrors),
+		},
+		"Policy spelled as in CRD schemas": {
+			policy: payloadPolicy("error"),
+		},
+		"Unsupported policy": {
+			policy:         payloadPolicy("sometimes"),
+			expectErrorMsg: "invalid value: sometimes: eventOptions.payloadPolicy",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			o := &EventOptions{PayloadPolicy: tc.policy}
+			assertFieldError(t, tc.expectErrorMsg, o.Validate(context.Background()).ViaField("eventOptions"))
+		})
+	}
+}
+
+func TestValidateARN(t *testing.T) {
+	testCases := map[string]struct {
+		arn            string
+		expectErrorMsg string
+	}{
+		"Valid ARN": {
+			arn: "arn:aws:lambda:us-east-1:123456789012:function:test-function",
+		},
+		"Empty ARN": {
+			arn:            "",
+			expectErrorMsg: "missing field(s): arn",
+		},
+		"Unparsable ARN": {
+			arn:            "test-function",
+			expectErrorMsg: "invalid value: test-function: arn\narn: invalid prefix",
+		},
+		"Wrong service": {
+			arn: "arn:aws:sqs:us-east-1:123456789012:test-queue",
+			expectErrorMsg: "invalid value: arn:aws:sqs:us-east-1:123456789012:test-queue: arn\n" +
+				"ARN must refer to a resource of the lambda service",
+		},
+		"Wrong resource type": {
+			arn: "arn:aws:lambda:us-east-1:123456789012:layer:test-layer",
+			expectErrorMsg: "invalid value: arn:aws:lambda:us-east-1:123456789012:layer:test-layer: arn\n" +
+				"resource of the ARN must be of the form function:<name>",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			assertFieldError(t, tc.expectErrorMsg, validateARN(tc.arn, "lambda", "function:").ViaField("arn"))
+		})
+	}
+}
+
+func payloadPolicy(p cloudevents.PayloadPolicy) *cloudevents.PayloadPolicy {
+	return &p
+}
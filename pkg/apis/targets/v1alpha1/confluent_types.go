@@ -74,11 +74,61 @@ type ConfluentTargetSpec struct {
 	// When this property is true, only the CloudEvent data is included.
 	DiscardCEContext bool `json:"discardCloudEventContext"`
 
+	// Content mode of the messages sent to Kafka, as defined by the
+	// CloudEvents Kafka protocol binding. In 'structured' mode (default),
+	// the value of messages contains the entire CloudEvent, or only its data
+	// when discardCloudEventContext is true. In 'binary' mode, the value of
+	// messages contains the CloudEvent data, and its context attributes are
+	// set as message headers.
+	// +optional
+	ContentMode *ConfluentTargetContentMode `json:"contentMode,omitempty"`
+
+	// Source of the key of the messages sent to Kafka. Messages which share
+	// the same key are written to the same partition, which preserves their
+	// ordering. When this property is not set (default), messages are keyed
+	// by CloudEvent ID.
+	// +optional
+	MessageKey *ConfluentTargetMessageKey `json:"messageKey,omitempty"`
+
+	// Partitioner used to assign keyed messages to partitions, as supported
+	// by librdkafka (e.g. 'murmur2_random' for compatibility with the Java
+	// client). Defaults to 'consistent_random'.
+	// +optional
+	Partitioner *string `json:"partitioner,omitempty"`
+
+	// Whether to acknowledge events as soon as they are queued for delivery
+	// to Kafka, instead of once their delivery has been confirmed. Delivery
+	// failures are then only reported in the logs of the adapter.
+	// +optional
+	AsyncDelivery *bool `json:"asyncDelivery,omitempty"`
+
 	// Adapter spec overrides parameters.
 	// +optional
 	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
 }
 
+// ConfluentTargetContentMode is the content mode of Kafka messages.
+type ConfluentTargetContentMode string
+
+// Supported content modes of Kafka messages.
+const (
+	ConfluentTargetContentModeStructured ConfluentTargetContentMode = "structured"
+	ConfluentTargetContentModeBinary     ConfluentTargetContentMode = "binary"
+)
+
+// ConfluentTargetMessageKey defines the source of the key of Kafka messages.
+// Only one of its properties may be set.
+type ConfluentTargetMessageKey struct {
+	// Name of a CloudEvent context attribute or extension.
+	// +optional
+	Attribute *string `json:"attribute,omitempty"`
+
+	// Path of a value within the CloudEvent data, in GJSON syntax.
+	// https://github.com/tidwall/gjson/blob/master/SYNTAX.md
+	// +optional
+	DataPath *string `json:"dataPath,omitempty"`
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // ConfluentTargetList is a list of event target instances.
@@ -0,0 +1,60 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"knative.dev/pkg/apis"
+)
+
+// Validate implements apis.Validatable
+func (t *ConfluentTarget) Validate(ctx context.Context) *apis.FieldError {
+	return t.Spec.Validate(ctx).ViaField("spec")
+}
+
+// Validate ConfluentTarget spec
+func (s *ConfluentTargetSpec) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	if s.SASLUsername == "" {
+		errs = errs.Also(apis.ErrMissingField("username"))
+	}
+	errs = errs.Also(s.SASLPassword.ValidateRequired(ctx).ViaField("password"))
+
+	if s.Topic == "" {
+		errs = errs.Also(apis.ErrMissingField("topic"))
+	}
+
+	if len(s.BootstrapServers) == 0 {
+		errs = errs.Also(apis.ErrMissingField("bootstrapServers"))
+	}
+	for i, srv := range s.BootstrapServers {
+		if srv == "" {
+			errs = errs.Also(apis.ErrInvalidArrayValue(srv, "bootstrapServers", i))
+		}
+	}
+
+	if s.SecurityProtocol != "" {
+		errs = errs.Also(validateKafkaSecurityProtocol(s.SecurityProtocol).ViaField("securityProtocol"))
+	}
+	if s.SASLMechanisms != "" {
+		errs = errs.Also(validateKafkaSASLMechanism(s.SASLMechanisms).ViaField("saslMechanism"))
+	}
+
+	return errs
+}
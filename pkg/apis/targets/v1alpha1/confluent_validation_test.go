@@ -0,0 +1,80 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+)
+
+func TestConfluentTargetValidate(t *testing.T) {
+	testCases := map[string]struct {
+		modify         func(*ConfluentTargetSpec)
+		expectErrorMsg string
+	}{
+		"Valid": {},
+		"Missing username": {
+			modify:         func(s *ConfluentTargetSpec) { s.SASLUsername = "" },
+			expectErrorMsg: "missing field(s): spec.username",
+		},
+		"Missing password": {
+			modify:         func(s *ConfluentTargetSpec) { s.SASLPassword = SecretValueFromSource{} },
+			expectErrorMsg: "missing field(s): spec.password.secretKeyRef",
+		},
+		"Missing topic": {
+			modify:         func(s *ConfluentTargetSpec) { s.Topic = "" },
+			expectErrorMsg: "missing field(s): spec.topic",
+		},
+		"Missing bootstrap servers": {
+			modify:         func(s *ConfluentTargetSpec) { s.BootstrapServers = nil },
+			expectErrorMsg: "missing field(s): spec.bootstrapServers",
+		},
+		"Unsupported security protocol": {
+			modify: func(s *ConfluentTargetSpec) { s.SecurityProtocol = "tls" },
+			expectErrorMsg: "invalid value: tls: spec.securityProtocol\n" +
+				"supported protocols are plaintext, ssl, sasl_plaintext and sasl_ssl",
+		},
+		"Unsupported SASL mechanism": {
+			modify: func(s *ConfluentTargetSpec) { s.SASLMechanisms = "plain" },
+			expectErrorMsg: "invalid value: plain: spec.saslMechanism\n" +
+				"supported mechanisms are GSSAPI, PLAIN, SCRAM-SHA-256, SCRAM-SHA-512 and OAUTHBEARER",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			trg := &ConfluentTarget{Spec: tConfluentTargetSpec()}
+			if tc.modify != nil {
+				tc.modify(&trg.Spec)
+			}
+
+			assertFieldError(t, tc.expectErrorMsg, trg.Validate(context.Background()))
+		})
+	}
+}
+
+func tConfluentTargetSpec() ConfluentTargetSpec {
+	return ConfluentTargetSpec{
+		SASLUsername:     "test-user",
+		SASLPassword:     tSecretValueFromSource(),
+		Topic:            "test-topic",
+		BootstrapServers: []string{"pkc-test.us-east-1.aws.confluent.cloud:9092"},
+		SecurityProtocol: "SASL_SSL",
+		SASLMechanisms:   "PLAIN",
+	}
+}
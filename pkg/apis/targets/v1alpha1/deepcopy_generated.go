@@ -22,6 +22,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	apis "github.com/triggermesh/triggermesh/pkg/apis"
 	commonv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
 	cloudevents "github.com/triggermesh/triggermesh/pkg/targets/adapter/cloudevents"
 	v1 "k8s.io/api/core/v1"
@@ -481,6 +482,42 @@ func (in *AWSS3Target) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSS3TargetBatching) DeepCopyInto(out *AWSS3TargetBatching) {
+	*out = *in
+	if in.MaxEvents != nil {
+		in, out := &in.MaxEvents, &out.MaxEvents
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxBytes != nil {
+		in, out := &in.MaxBytes, &out.MaxBytes
+		*out = new(int64)
+		**out = **in
+	}
+	if in.FlushInterval != nil {
+		in, out := &in.FlushInterval, &out.FlushInterval
+		*out = new(apis.Duration)
+		**out = **in
+	}
+	if in.KeyPrefix != nil {
+		in, out := &in.KeyPrefix, &out.KeyPrefix
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSS3TargetBatching.
+func (in *AWSS3TargetBatching) DeepCopy() *AWSS3TargetBatching {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSS3TargetBatching)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AWSS3TargetList) DeepCopyInto(out *AWSS3TargetList) {
 	*out = *in
@@ -519,6 +556,11 @@ func (in *AWSS3TargetSpec) DeepCopyInto(out *AWSS3TargetSpec) {
 	*out = *in
 	in.AWSApiKey.DeepCopyInto(&out.AWSApiKey)
 	in.AWSApiSecret.DeepCopyInto(&out.AWSApiSecret)
+	if in.Batching != nil {
+		in, out := &in.Batching, &out.Batching
+		*out = new(AWSS3TargetBatching)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AdapterOverrides != nil {
 		in, out := &in.AdapterOverrides, &out.AdapterOverrides
 		*out = new(commonv1alpha1.AdapterOverrides)
@@ -1118,6 +1160,32 @@ func (in *ConfluentTargetList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfluentTargetMessageKey) DeepCopyInto(out *ConfluentTargetMessageKey) {
+	*out = *in
+	if in.Attribute != nil {
+		in, out := &in.Attribute, &out.Attribute
+		*out = new(string)
+		**out = **in
+	}
+	if in.DataPath != nil {
+		in, out := &in.DataPath, &out.DataPath
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfluentTargetMessageKey.
+func (in *ConfluentTargetMessageKey) DeepCopy() *ConfluentTargetMessageKey {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfluentTargetMessageKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConfluentTargetSpec) DeepCopyInto(out *ConfluentTargetSpec) {
 	*out = *in
@@ -1137,6 +1205,26 @@ func (in *ConfluentTargetSpec) DeepCopyInto(out *ConfluentTargetSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ContentMode != nil {
+		in, out := &in.ContentMode, &out.ContentMode
+		*out = new(ConfluentTargetContentMode)
+		**out = **in
+	}
+	if in.MessageKey != nil {
+		in, out := &in.MessageKey, &out.MessageKey
+		*out = new(ConfluentTargetMessageKey)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Partitioner != nil {
+		in, out := &in.Partitioner, &out.Partitioner
+		*out = new(string)
+		**out = **in
+	}
+	if in.AsyncDelivery != nil {
+		in, out := &in.AsyncDelivery, &out.AsyncDelivery
+		*out = new(bool)
+		**out = **in
+	}
 	if in.AdapterOverrides != nil {
 		in, out := &in.AdapterOverrides, &out.AdapterOverrides
 		*out = new(commonv1alpha1.AdapterOverrides)
@@ -1345,6 +1433,37 @@ func (in *ElasticsearchTarget) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ElasticsearchTargetBulk) DeepCopyInto(out *ElasticsearchTargetBulk) {
+	*out = *in
+	if in.MaxActions != nil {
+		in, out := &in.MaxActions, &out.MaxActions
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxBytes != nil {
+		in, out := &in.MaxBytes, &out.MaxBytes
+		*out = new(int64)
+		**out = **in
+	}
+	if in.FlushInterval != nil {
+		in, out := &in.FlushInterval, &out.FlushInterval
+		*out = new(apis.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticsearchTargetBulk.
+func (in *ElasticsearchTargetBulk) DeepCopy() *ElasticsearchTargetBulk {
+	if in == nil {
+		return nil
+	}
+	out := new(ElasticsearchTargetBulk)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ElasticsearchTargetList) DeepCopyInto(out *ElasticsearchTargetList) {
 	*out = *in
@@ -1382,6 +1501,21 @@ func (in *ElasticsearchTargetList) DeepCopyObject() runtime.Object {
 func (in *ElasticsearchTargetSpec) DeepCopyInto(out *ElasticsearchTargetSpec) {
 	*out = *in
 	in.Connection.DeepCopyInto(&out.Connection)
+	if in.DocumentIDPath != nil {
+		in, out := &in.DocumentIDPath, &out.DocumentIDPath
+		*out = new(string)
+		**out = **in
+	}
+	if in.Pipeline != nil {
+		in, out := &in.Pipeline, &out.Pipeline
+		*out = new(string)
+		**out = **in
+	}
+	if in.Bulk != nil {
+		in, out := &in.Bulk, &out.Bulk
+		*out = new(ElasticsearchTargetBulk)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.EventOptions != nil {
 		in, out := &in.EventOptions, &out.EventOptions
 		*out = new(EventOptions)
@@ -2215,6 +2349,11 @@ func (in *InfraTargetScript) DeepCopyInto(out *InfraTargetScript) {
 		*out = new(int)
 		**out = **in
 	}
+	if in.Engine != nil {
+		in, out := &in.Engine, &out.Engine
+		*out = new(ScriptEngine)
+		**out = **in
+	}
 	return
 }
 
@@ -2246,6 +2385,7 @@ func (in *InfraTargetSpec) DeepCopyInto(out *InfraTargetSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
 	if in.AdapterOverrides != nil {
 		in, out := &in.AdapterOverrides, &out.AdapterOverrides
 		*out = new(commonv1alpha1.AdapterOverrides)
@@ -2277,6 +2417,11 @@ func (in *InfraTargetState) DeepCopyInto(out *InfraTargetState) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(apis.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -19,6 +19,7 @@ package v1alpha1
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/triggermesh/triggermesh/pkg/apis"
 	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
 )
 
@@ -51,8 +52,27 @@ type ElasticsearchTargetSpec struct {
 	Connection Connection `json:"connection"`
 
 	// IndexName to write to.
+	// The name may contain placeholders enclosed in curly braces, which are
+	// rendered for each event: either a date pattern composed of the tokens
+	// yyyy, yy, MM, dd, HH, mm and ss, rendered with the time of the event,
+	// or the name of a CloudEvent context attribute or extension
+	// (e.g. 'events-{type}-{yyyy.MM.dd}').
 	IndexName string `json:"indexName"`
 
+	// Path of the ID of indexed documents within the event data, in GJSON
+	// syntax. When not set (default), the ID of the event is used.
+	// https://github.com/tidwall/gjson/blob/master/SYNTAX.md
+	// +optional
+	DocumentIDPath *string `json:"documentIDPath,omitempty"`
+
+	// Name of the ingest pipeline used to pre-process indexed documents.
+	// +optional
+	Pipeline *string `json:"pipeline,omitempty"`
+
+	// Thresholds of the requests sent to the Bulk API.
+	// +optional
+	Bulk *ElasticsearchTargetBulk `json:"bulk,omitempty"`
+
 	// Whether to omit CloudEvent context attributes in documents created in Elasticsearch.
 	// When this property is false (default), the entire CloudEvent payload is included.
 	// When this property is true, only the CloudEvent data is included.
@@ -84,6 +104,29 @@ type Connection struct {
 	APIKey *SecretValueFromSource `json:"apiKey,omitempty"`
 }
 
+// ElasticsearchTargetBulk defines the thresholds which trigger the sending of
+// a request to the Bulk API.
+type ElasticsearchTargetBulk struct {
+	// Maximum number of documents indexed in a single request.
+	// Defaults to 500.
+	// +optional
+	MaxActions *int32 `json:"maxActions,omitempty"`
+
+	// Maximum size, in bytes, of the body of a single request.
+	// Defaults to 5MiB.
+	// +optional
+	MaxBytes *int64 `json:"maxBytes,omitempty"`
+
+	// Maximum duration documents are buffered for before being indexed.
+	// Defaults to 1s.
+	//
+	// Events are only acknowledged once their document has been indexed,
+	// so this duration should remain lower than the request timeout of
+	// event senders.
+	// +optional
+	FlushInterval *apis.Duration `json:"flushInterval,omitempty"`
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // ElasticsearchTargetList is a list of event target instances.
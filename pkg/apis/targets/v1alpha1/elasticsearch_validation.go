@@ -0,0 +1,79 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	"knative.dev/pkg/apis"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+// Validate implements apis.Validatable
+func (t *ElasticsearchTarget) Validate(ctx context.Context) *apis.FieldError {
+	return t.Spec.Validate(ctx).ViaField("spec")
+}
+
+// Validate ElasticsearchTarget spec
+func (s *ElasticsearchTargetSpec) Validate(ctx context.Context) *apis.FieldError {
+	errs := s.Connection.Validate(ctx).ViaField("connection")
+
+	if s.IndexName == "" {
+		errs = errs.Also(apis.ErrMissingField("indexName"))
+	}
+
+	if s.Bulk != nil && s.Bulk.FlushInterval != nil {
+		errs = errs.Also(v1alpha1.ValidatePositiveDuration(time.Duration(*s.Bulk.FlushInterval)).
+			ViaField("bulk", "flushInterval"))
+	}
+
+	return errs.Also(s.EventOptions.Validate(ctx).ViaField("eventOptions"))
+}
+
+// Validate verifies that the connection contains at least one address and
+// exactly one authentication method.
+func (c *Connection) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	if len(c.Addresses) == 0 {
+		errs = errs.Also(apis.ErrMissingField("addresses"))
+	}
+	for i, addr := range c.Addresses {
+		errs = errs.Also(v1alpha1.ValidateURLString(addr).ViaFieldIndex("addresses", i))
+	}
+
+	hasBasicAuth := c.Username != nil || c.Password.IsSet()
+
+	switch {
+	case hasBasicAuth && c.APIKey.IsSet():
+		errs = errs.Also(apis.ErrGeneric("expected either a username and password or an API key, got both",
+			"username", "password", "apiKey"))
+	case hasBasicAuth:
+		if c.Username == nil || *c.Username == "" {
+			errs = errs.Also(apis.ErrMissingField("username"))
+		}
+		errs = errs.Also(c.Password.ValidateRequired(ctx).ViaField("password"))
+	case c.APIKey.IsSet():
+		errs = errs.Also(c.APIKey.Validate(ctx).ViaField("apiKey"))
+	default:
+		errs = errs.Also(apis.ErrMissingOneOf("username", "apiKey"))
+	}
+
+	return errs
+}
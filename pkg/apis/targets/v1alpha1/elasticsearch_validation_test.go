@@ -0,0 +1,109 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"knative.dev/pkg/ptr"
+
+	tmapis "github.com/triggermesh/triggermesh/pkg/apis"
+)
+
+func TestElasticsearchTargetValidate(t *testing.T) {
+	testCases := map[string]struct {
+		modify         func(*ElasticsearchTargetSpec)
+		expectErrorMsg string
+	}{
+		"Valid": {},
+		"Basic authentication": {
+			modify: func(s *ElasticsearchTargetSpec) {
+				s.Connection.APIKey = nil
+				s.Connection.Username = ptr.String("elastic")
+				s.Connection.Password = &SecretValueFromSource{SecretKeyRef: tSecretKeySelector()}
+			},
+		},
+		"Missing addresses": {
+			modify:         func(s *ElasticsearchTargetSpec) { s.Connection.Addresses = nil },
+			expectErrorMsg: "missing field(s): spec.connection.addresses",
+		},
+		"Relative address": {
+			modify: func(s *ElasticsearchTargetSpec) {
+				s.Connection.Addresses = append(s.Connection.Addresses, "elasticsearch:9200")
+			},
+			expectErrorMsg: "invalid value: elasticsearch:9200: spec.connection.addresses[1]\n" +
+				"URL must be absolute and include a host",
+		},
+		"Missing authentication": {
+			modify:         func(s *ElasticsearchTargetSpec) { s.Connection.APIKey = nil },
+			expectErrorMsg: "expected exactly one, got neither: spec.connection.apiKey, spec.connection.username",
+		},
+		"Username and API key": {
+			modify:         func(s *ElasticsearchTargetSpec) { s.Connection.Username = ptr.String("elastic") },
+			expectErrorMsg: "expected either a username and password or an API key, got both: spec.connection.apiKey, spec.connection.password, spec.connection.username",
+		},
+		"Username without password": {
+			modify: func(s *ElasticsearchTargetSpec) {
+				s.Connection.APIKey = nil
+				s.Connection.Username = ptr.String("elastic")
+			},
+			expectErrorMsg: "missing field(s): spec.connection.password.secretKeyRef",
+		},
+		"Missing index name": {
+			modify:         func(s *ElasticsearchTargetSpec) { s.IndexName = "" },
+			expectErrorMsg: "missing field(s): spec.indexName",
+		},
+		"Negative bulk flush interval": {
+			modify: func(s *ElasticsearchTargetSpec) {
+				d := tmapis.Duration(-time.Second)
+				s.Bulk = &ElasticsearchTargetBulk{FlushInterval: &d}
+			},
+			expectErrorMsg: "invalid value: -1s: spec.bulk.flushInterval\n" +
+				"duration must be greater than zero",
+		},
+		"Unsupported payload policy": {
+			modify: func(s *ElasticsearchTargetSpec) {
+				s.EventOptions = &EventOptions{PayloadPolicy: payloadPolicy("sometimes")}
+			},
+			expectErrorMsg: "invalid value: sometimes: spec.eventOptions.payloadPolicy",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			trg := &ElasticsearchTarget{Spec: tElasticsearchTargetSpec()}
+			if tc.modify != nil {
+				tc.modify(&trg.Spec)
+			}
+
+			assertFieldError(t, tc.expectErrorMsg, trg.Validate(context.Background()))
+		})
+	}
+}
+
+func tElasticsearchTargetSpec() ElasticsearchTargetSpec {
+	return ElasticsearchTargetSpec{
+		Connection: Connection{
+			Addresses: []string{"https://elasticsearch.example.com:9200"},
+			APIKey:    &SecretValueFromSource{SecretKeyRef: tSecretKeySelector()},
+		},
+		IndexName: "test-index",
+	}
+}
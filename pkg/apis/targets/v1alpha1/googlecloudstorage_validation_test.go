@@ -0,0 +1,64 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+)
+
+func TestGoogleCloudStorageTargetValidate(t *testing.T) {
+	testCases := map[string]struct {
+		modify         func(*GoogleCloudStorageTargetSpec)
+		expectErrorMsg string
+	}{
+		"Valid": {},
+		"Missing credentials": {
+			modify:         func(s *GoogleCloudStorageTargetSpec) { s.Credentials = SecretValueFromSource{} },
+			expectErrorMsg: "missing field(s): spec.credentialsJson.secretKeyRef",
+		},
+		"Missing bucket name": {
+			modify:         func(s *GoogleCloudStorageTargetSpec) { s.BucketName = "" },
+			expectErrorMsg: "missing field(s): spec.bucketName",
+		},
+		"Unsupported payload policy": {
+			modify: func(s *GoogleCloudStorageTargetSpec) {
+				s.EventOptions = &EventOptions{PayloadPolicy: payloadPolicy("sometimes")}
+			},
+			expectErrorMsg: "invalid value: sometimes: spec.eventOptions.payloadPolicy",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			trg := &GoogleCloudStorageTarget{Spec: tGoogleCloudStorageTargetSpec()}
+			if tc.modify != nil {
+				tc.modify(&trg.Spec)
+			}
+
+			assertFieldError(t, tc.expectErrorMsg, trg.Validate(context.Background()))
+		})
+	}
+}
+
+func tGoogleCloudStorageTargetSpec() GoogleCloudStorageTargetSpec {
+	return GoogleCloudStorageTargetSpec{
+		Credentials: tSecretValueFromSource(),
+		BucketName:  "test-bucket",
+	}
+}
@@ -0,0 +1,58 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+)
+
+func TestGoogleSheetTargetValidate(t *testing.T) {
+	testCases := map[string]struct {
+		modify         func(*GoogleSheetTargetSpec)
+		expectErrorMsg string
+	}{
+		"Valid": {},
+		"Missing service account": {
+			modify:         func(s *GoogleSheetTargetSpec) { s.GoogleServiceAccount = SecretValueFromSource{} },
+			expectErrorMsg: "missing field(s): spec.googleServiceAccount.secretKeyRef",
+		},
+		"Missing ID": {
+			modify:         func(s *GoogleSheetTargetSpec) { s.ID = "" },
+			expectErrorMsg: "missing field(s): spec.id",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			trg := &GoogleSheetTarget{Spec: tGoogleSheetTargetSpec()}
+			if tc.modify != nil {
+				tc.modify(&trg.Spec)
+			}
+
+			assertFieldError(t, tc.expectErrorMsg, trg.Validate(context.Background()))
+		})
+	}
+}
+
+func tGoogleSheetTargetSpec() GoogleSheetTargetSpec {
+	return GoogleSheetTargetSpec{
+		GoogleServiceAccount: tSecretValueFromSource(),
+		ID:                   "test-sheet",
+	}
+}
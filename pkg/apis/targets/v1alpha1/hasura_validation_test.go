@@ -0,0 +1,72 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestHasuraTargetValidate(t *testing.T) {
+	testCases := map[string]struct {
+		modify         func(*HasuraTargetSpec)
+		expectErrorMsg string
+	}{
+		"Valid": {},
+		"Without tokens": {
+			modify: func(s *HasuraTargetSpec) { s.AdminToken = nil },
+		},
+		"Missing endpoint": {
+			modify:         func(s *HasuraTargetSpec) { s.Endpoint = "" },
+			expectErrorMsg: "missing field(s): spec.endpoint",
+		},
+		"Relative endpoint": {
+			modify: func(s *HasuraTargetSpec) { s.Endpoint = "hasura.example.com" },
+			expectErrorMsg: "invalid value: hasura.example.com: spec.endpoint\n" +
+				"URL must be absolute and include a host",
+		},
+		"Incomplete JWT reference": {
+			modify: func(s *HasuraTargetSpec) {
+				s.JwtToken = &SecretValueFromSource{
+					SecretKeyRef: &corev1.SecretKeySelector{Key: tSecretKey},
+				}
+			},
+			expectErrorMsg: "missing field(s): spec.jwt.secretKeyRef.name",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			trg := &HasuraTarget{Spec: tHasuraTargetSpec()}
+			if tc.modify != nil {
+				tc.modify(&trg.Spec)
+			}
+
+			assertFieldError(t, tc.expectErrorMsg, trg.Validate(context.Background()))
+		})
+	}
+}
+
+func tHasuraTargetSpec() HasuraTargetSpec {
+	return HasuraTargetSpec{
+		Endpoint:   "https://hasura.example.com",
+		AdminToken: &SecretValueFromSource{SecretKeyRef: tSecretKeySelector()},
+	}
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"knative.dev/pkg/apis"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+// Validate implements apis.Validatable
+func (t *HTTPTarget) Validate(ctx context.Context) *apis.FieldError {
+	return t.Spec.Validate(ctx).ViaField("spec")
+}
+
+// Validate HTTPTarget spec
+func (s *HTTPTargetSpec) Validate(ctx context.Context) *apis.FieldError {
+	errs := v1alpha1.ValidateURL(&s.Endpoint).ViaField("endpoint")
+
+	if s.Method == "" {
+		errs = errs.Also(apis.ErrMissingField("method"))
+	}
+
+	errs = errs.Also(s.BasicAuthPassword.Validate(ctx).ViaField("basicAuthPassword"))
+
+	hasOAuth := s.OAuthClientID != nil || s.OAuthClientSecret.IsSet() || s.OAuthTokenURL != nil
+	if hasOAuth {
+		if s.OAuthClientID == nil || *s.OAuthClientID == "" {
+			errs = errs.Also(apis.ErrMissingField("oauthClientID"))
+		}
+		errs = errs.Also(s.OAuthClientSecret.ValidateRequired(ctx).ViaField("oauthClientSecret"))
+
+		var tokenURL string
+		if s.OAuthTokenURL != nil {
+			tokenURL = *s.OAuthTokenURL
+		}
+		errs = errs.Also(v1alpha1.ValidateURLString(tokenURL).ViaField("oauthTokenURL"))
+	}
+
+	return errs
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"knative.dev/pkg/apis"
+	"knative.dev/pkg/ptr"
+)
+
+func TestHTTPTargetValidate(t *testing.T) {
+	testCases := map[string]struct {
+		modify         func(*HTTPTargetSpec)
+		expectErrorMsg string
+	}{
+		"Valid": {},
+		"OAuth": {
+			modify: func(s *HTTPTargetSpec) {
+				s.OAuthClientID = ptr.String("test-client")
+				s.OAuthClientSecret = tSecretValueFromSource()
+				s.OAuthTokenURL = ptr.String("https://auth.example.com/token")
+			},
+		},
+		"Missing endpoint": {
+			modify:         func(s *HTTPTargetSpec) { s.Endpoint = apis.URL{} },
+			expectErrorMsg: "missing field(s): spec.endpoint",
+		},
+		"Missing method": {
+			modify:         func(s *HTTPTargetSpec) { s.Method = "" },
+			expectErrorMsg: "missing field(s): spec.method",
+		},
+		"Incomplete password reference": {
+			modify:         func(s *HTTPTargetSpec) { s.BasicAuthPassword.SecretKeyRef = &corev1.SecretKeySelector{} },
+			expectErrorMsg: "missing field(s): spec.basicAuthPassword.secretKeyRef.key, spec.basicAuthPassword.secretKeyRef.name",
+		},
+		"Incomplete OAuth": {
+			modify:         func(s *HTTPTargetSpec) { s.OAuthClientID = ptr.String("test-client") },
+			expectErrorMsg: "missing field(s): spec.oauthClientSecret.secretKeyRef, spec.oauthTokenURL",
+		},
+		"Relative OAuth token URL": {
+			modify: func(s *HTTPTargetSpec) {
+				s.OAuthClientID = ptr.String("test-client")
+				s.OAuthClientSecret = tSecretValueFromSource()
+				s.OAuthTokenURL = ptr.String("/token")
+			},
+			expectErrorMsg: "invalid value: /token: spec.oauthTokenURL\n" +
+				"URL must be absolute and include a host",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			trg := &HTTPTarget{Spec: tHTTPTargetSpec()}
+			if tc.modify != nil {
+				tc.modify(&trg.Spec)
+			}
+
+			assertFieldError(t, tc.expectErrorMsg, trg.Validate(context.Background()))
+		})
+	}
+}
+
+func tHTTPTargetSpec() HTTPTargetSpec {
+	return HTTPTargetSpec{
+		Endpoint: *apis.HTTP("example.com"),
+		Method:   "POST",
+	}
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"knative.dev/pkg/apis"
+)
+
+// Validate implements apis.Validatable
+func (t *IBMMQTarget) Validate(ctx context.Context) *apis.FieldError {
+	return t.Spec.Validate(ctx).ViaField("spec")
+}
+
+// Validate IBMMQTarget spec
+func (s *IBMMQTargetSpec) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	if s.ConnectionName == "" {
+		errs = errs.Also(apis.ErrMissingField("connectionName"))
+	}
+	if s.QueueManager == "" {
+		errs = errs.Also(apis.ErrMissingField("queueManager"))
+	}
+	if s.QueueName == "" {
+		errs = errs.Also(apis.ErrMissingField("queueName"))
+	}
+	if s.ChannelName == "" {
+		errs = errs.Also(apis.ErrMissingField("channelName"))
+	}
+
+	errs = errs.Also(s.Auth.Validate(ctx).ViaField("credentials"))
+
+	return errs.Also(s.EventOptions.Validate(ctx).ViaField("eventOptions"))
+}
+
+// Validate verifies that the credentials and the TLS key repository are
+// complete.
+func (c *Credentials) Validate(ctx context.Context) *apis.FieldError {
+	errs := c.User.Validate(ctx).ViaField("username").
+		Also(c.Password.Validate(ctx).ViaField("password"))
+
+	if c.TLS != nil {
+		if c.TLS.Cipher == "" {
+			errs = errs.Also(apis.ErrMissingField("cipher").ViaField("tls"))
+		}
+		errs = errs.Also(c.TLS.KeyRepository.KeyDatabase.ValidateRequired(ctx).
+			ViaField("tls", "keyRepository", "keyDatabase"))
+		errs = errs.Also(c.TLS.KeyRepository.PasswordStash.ValidateRequired(ctx).
+			ViaField("tls", "keyRepository", "passwordStash"))
+	}
+
+	return errs
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+func TestIBMMQTargetValidate(t *testing.T) {
+	testCases := map[string]struct {
+		modify         func(*IBMMQTargetSpec)
+		expectErrorMsg string
+	}{
+		"Valid": {},
+		"Missing connection name": {
+			modify:         func(s *IBMMQTargetSpec) { s.ConnectionName = "" },
+			expectErrorMsg: "missing field(s): spec.connectionName",
+		},
+		"Missing queue manager, queue and channel": {
+			modify: func(s *IBMMQTargetSpec) {
+				s.QueueManager = ""
+				s.QueueName = ""
+				s.ChannelName = ""
+			},
+			expectErrorMsg: "missing field(s): spec.channelName, spec.queueManager, spec.queueName",
+		},
+		"TLS without cipher": {
+			modify: func(s *IBMMQTargetSpec) {
+				s.Auth.TLS = &TLSSpec{
+					KeyRepository: Keystore{
+						KeyDatabase:   tSecretValue(),
+						PasswordStash: tSecretValue(),
+					},
+				}
+			},
+			expectErrorMsg: "missing field(s): spec.credentials.tls.cipher",
+		},
+		"Unsupported payload policy": {
+			modify:         func(s *IBMMQTargetSpec) { s.EventOptions = &EventOptions{PayloadPolicy: payloadPolicy("sometimes")} },
+			expectErrorMsg: "invalid value: sometimes: spec.eventOptions.payloadPolicy",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			trg := &IBMMQTarget{Spec: tIBMMQTargetSpec()}
+			if tc.modify != nil {
+				tc.modify(&trg.Spec)
+			}
+
+			assertFieldError(t, tc.expectErrorMsg, trg.Validate(context.Background()))
+		})
+	}
+}
+
+func tIBMMQTargetSpec() IBMMQTargetSpec {
+	return IBMMQTargetSpec{
+		ConnectionName: "mq.example.com(1414)",
+		QueueManager:   "QM1",
+		QueueName:      "DEV.QUEUE.1",
+		ChannelName:    "DEV.APP.SVRCONN",
+		Auth: Credentials{
+			User:     v1alpha1.ValueFromField{Value: "app"},
+			Password: tSecretValue(),
+		},
+	}
+}
@@ -31,7 +31,10 @@ func (*InfraTarget) GetGroupVersionKind() schema.GroupVersionKind {
 }
 
 // GetConditionSet implements duckv1.KRShaped.
-func (*InfraTarget) GetConditionSet() apis.ConditionSet {
+func (t *InfraTarget) GetConditionSet() apis.ConditionSet {
+	if t.Spec.Sink.Ref != nil || t.Spec.Sink.URI != nil {
+		return v1alpha1.EventSenderConditionSet
+	}
 	return v1alpha1.DefaultConditionSet
 }
 
@@ -48,6 +51,11 @@ func (t *InfraTarget) GetStatusManager() *v1alpha1.StatusManager {
 	}
 }
 
+// GetSink implements EventSender.
+func (t *InfraTarget) GetSink() *duckv1.Destination {
+	return &t.Spec.Sink
+}
+
 // GetAdapterOverrides implements AdapterConfigurable.
 func (t *InfraTarget) GetAdapterOverrides() *v1alpha1.AdapterOverrides {
 	return t.Spec.AdapterOverrides
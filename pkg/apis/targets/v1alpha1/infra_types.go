@@ -19,6 +19,9 @@ package v1alpha1
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	"github.com/triggermesh/triggermesh/pkg/apis"
 	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
 )
 
@@ -39,6 +42,7 @@ type InfraTarget struct {
 var (
 	_ v1alpha1.Reconcilable        = (*InfraTarget)(nil)
 	_ v1alpha1.AdapterConfigurable = (*InfraTarget)(nil)
+	_ v1alpha1.EventSender         = (*InfraTarget)(nil)
 )
 
 // InfraTargetSpec defines the desired state of the event target.
@@ -52,6 +56,10 @@ type InfraTargetSpec struct {
 	// TypeLoopProtection protect against infinite loops when the cloudevent type does not change.
 	TypeLoopProtection *bool `json:"typeLoopProtection,omitempty"`
 
+	// Support sending to an event sink instead of replying. Required for
+	// scripts which produce more than one event per invocation.
+	duckv1.SourceSpec `json:",inline"`
+
 	// Adapter spec overrides parameters.
 	// +optional
 	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
@@ -65,8 +73,24 @@ type InfraTargetScript struct {
 	// Timeout is the script execution time after which
 	// it will be halted.
 	Timeout *int `json:"timeout,omitempty"`
+
+	// Engine is the JavaScript engine which executes the script.
+	// Defaults to "otto".
+	// +optional
+	Engine *ScriptEngine `json:"engine,omitempty"`
 }
 
+// ScriptEngine is the JavaScript engine used to execute scripts.
+type ScriptEngine string
+
+const (
+	// ScriptEngineOtto executes scripts using the otto ES5 interpreter.
+	ScriptEngineOtto ScriptEngine = "otto"
+	// ScriptEngineGoja executes scripts using the goja ECMAScript 5.1+
+	// engine, which supports most ES6 features and CommonJS modules.
+	ScriptEngineGoja ScriptEngine = "goja"
+)
+
 // HeaderPolicy is the action to take on stateful headers
 type HeaderPolicy string
 
@@ -91,6 +115,11 @@ type InfraTargetState struct {
 	// this component is part of, and should be taken into account
 	// when storing variables in the state store.
 	Bridge *string `json:"bridge,omitempty"`
+
+	// TTL is the default lifetime of the keys written by scripts to the
+	// state store. Keys do not expire by default.
+	// +optional
+	TTL *apis.Duration `json:"ttl,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -0,0 +1,63 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	"knative.dev/pkg/apis"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+// Validate implements apis.Validatable
+func (t *InfraTarget) Validate(ctx context.Context) *apis.FieldError {
+	return t.Spec.Validate(ctx).ViaField("spec")
+}
+
+// Validate InfraTarget spec
+func (s *InfraTargetSpec) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	if sc := s.Script; sc != nil {
+		if sc.Code == "" {
+			errs = errs.Also(apis.ErrMissingField("code").ViaField("script"))
+		}
+		if sc.Timeout != nil && *sc.Timeout <= 0 {
+			errs = errs.Also(apis.ErrOutOfBoundsValue(*sc.Timeout, 1, "+Inf", "timeout").ViaField("script"))
+		}
+		if sc.Engine != nil {
+			switch *sc.Engine {
+			case ScriptEngineOtto, ScriptEngineGoja:
+			default:
+				errs = errs.Also(apis.ErrInvalidValue(*sc.Engine, "engine").ViaField("script"))
+			}
+		}
+	}
+
+	if st := s.State; st != nil && st.TTL != nil {
+		errs = errs.Also(v1alpha1.ValidatePositiveDuration(time.Duration(*st.TTL)).ViaField("state", "ttl"))
+	}
+
+	// The sink is optional: without one, events are returned as replies.
+	if s.Sink.Ref != nil || s.Sink.URI != nil {
+		errs = errs.Also(s.Sink.Validate(ctx).ViaField("sink"))
+	}
+
+	return errs.Also(s.CloudEventOverrides.Validate(ctx).ViaField("ceOverrides"))
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/ptr"
+
+	tmapis "github.com/triggermesh/triggermesh/pkg/apis"
+)
+
+func TestInfraTargetValidate(t *testing.T) {
+	testCases := map[string]struct {
+		modify         func(*InfraTargetSpec)
+		expectErrorMsg string
+	}{
+		"Valid": {},
+		"State only": {
+			modify: func(s *InfraTargetSpec) {
+				s.Script = nil
+				s.State = &InfraTargetState{Bridge: ptr.String("test-bridge")}
+			},
+		},
+		"Sink": {
+			modify: func(s *InfraTargetSpec) { s.Sink = duckv1.Destination{URI: apis.HTTP("sink.example.com")} },
+		},
+		"Passthrough": {
+			modify: func(s *InfraTargetSpec) { s.Script = nil },
+		},
+		"Missing script code": {
+			modify:         func(s *InfraTargetSpec) { s.Script.Code = "" },
+			expectErrorMsg: "missing field(s): spec.script.code",
+		},
+		"Zero script timeout": {
+			modify: func(s *InfraTargetSpec) {
+				timeout := 0
+				s.Script.Timeout = &timeout
+			},
+			expectErrorMsg: "expected 1 <= 0 <= +Inf: spec.script.timeout",
+		},
+		"Unsupported script engine": {
+			modify: func(s *InfraTargetSpec) {
+				engine := ScriptEngine("v8")
+				s.Script.Engine = &engine
+			},
+			expectErrorMsg: "invalid value: v8: spec.script.engine",
+		},
+		"Negative state TTL": {
+			modify: func(s *InfraTargetSpec) {
+				d := tmapis.Duration(-time.Hour)
+				s.State = &InfraTargetState{TTL: &d}
+			},
+			expectErrorMsg: "invalid value: -1h0m0s: spec.state.ttl\n" +
+				"duration must be greater than zero",
+		},
+		"Relative sink URI": {
+			modify:         func(s *InfraTargetSpec) { s.Sink = duckv1.Destination{URI: &apis.URL{Path: "/events"}} },
+			expectErrorMsg: "invalid value: Relative URI is not allowed when Ref and [apiVersion, kind, name] is absent: spec.sink.uri",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			trg := &InfraTarget{Spec: tInfraTargetSpec()}
+			if tc.modify != nil {
+				tc.modify(&trg.Spec)
+			}
+
+			assertFieldError(t, tc.expectErrorMsg, trg.Validate(context.Background()))
+		})
+	}
+}
+
+func tInfraTargetSpec() InfraTargetSpec {
+	return InfraTargetSpec{
+		Script: &InfraTargetScript{
+			Code: "function handle(input) { return input; }",
+		},
+	}
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+)
+
+func TestJiraTargetValidate(t *testing.T) {
+	testCases := map[string]struct {
+		modify         func(*JiraTargetSpec)
+		expectErrorMsg string
+	}{
+		"Valid": {},
+		"Missing user": {
+			modify:         func(s *JiraTargetSpec) { s.Auth.User = "" },
+			expectErrorMsg: "missing field(s): spec.auth.user",
+		},
+		"Missing token": {
+			modify:         func(s *JiraTargetSpec) { s.Auth.Token = SecretValueFromSource{} },
+			expectErrorMsg: "missing field(s): spec.auth.token.secretKeyRef",
+		},
+		"Relative URL": {
+			modify: func(s *JiraTargetSpec) { s.URL = "example.atlassian.net" },
+			expectErrorMsg: "invalid value: example.atlassian.net: spec.url\n" +
+				"URL must be absolute and include a host",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			trg := &JiraTarget{Spec: tJiraTargetSpec()}
+			if tc.modify != nil {
+				tc.modify(&trg.Spec)
+			}
+
+			assertFieldError(t, tc.expectErrorMsg, trg.Validate(context.Background()))
+		})
+	}
+}
+
+func tJiraTargetSpec() JiraTargetSpec {
+	return JiraTargetSpec{
+		Auth: JiraAuth{
+			User:  "user@example.com",
+			Token: tSecretValueFromSource(),
+		},
+		URL: "https://example.atlassian.net",
+	}
+}
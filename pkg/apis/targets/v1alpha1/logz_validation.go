@@ -0,0 +1,44 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"strings"
+
+	"knative.dev/pkg/apis"
+)
+
+// Validate implements apis.Validatable
+func (t *LogzTarget) Validate(ctx context.Context) *apis.FieldError {
+	return t.Spec.Validate(ctx).ViaField("spec")
+}
+
+// Validate LogzTarget spec
+func (s *LogzTargetSpec) Validate(ctx context.Context) *apis.FieldError {
+	errs := s.ShippingToken.ValidateRequired(ctx).ViaField("shippingToken")
+
+	switch {
+	case s.LogsListenerURL == "":
+		errs = errs.Also(apis.ErrMissingField("logsListenerURL"))
+	case strings.Contains(s.LogsListenerURL, "://"):
+		errs = errs.Also(apis.ErrInvalidValue(s.LogsListenerURL, "logsListenerURL",
+			"listener must be a host name, not a URL"))
+	}
+
+	return errs.Also(s.EventOptions.Validate(ctx).ViaField("eventOptions"))
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+)
+
+func TestLogzTargetValidate(t *testing.T) {
+	testCases := map[string]struct {
+		modify         func(*LogzTargetSpec)
+		expectErrorMsg string
+	}{
+		"Valid": {},
+		"Missing shipping token": {
+			modify:         func(s *LogzTargetSpec) { s.ShippingToken = SecretValueFromSource{} },
+			expectErrorMsg: "missing field(s): spec.shippingToken.secretKeyRef",
+		},
+		"Missing listener": {
+			modify:         func(s *LogzTargetSpec) { s.LogsListenerURL = "" },
+			expectErrorMsg: "missing field(s): spec.logsListenerURL",
+		},
+		"Listener URL": {
+			modify: func(s *LogzTargetSpec) { s.LogsListenerURL = "https://listener.logz.io:8071" },
+			expectErrorMsg: "invalid value: https://listener.logz.io:8071: spec.logsListenerURL\n" +
+				"listener must be a host name, not a URL",
+		},
+		"Unsupported payload policy": {
+			modify:         func(s *LogzTargetSpec) { s.EventOptions = &EventOptions{PayloadPolicy: payloadPolicy("sometimes")} },
+			expectErrorMsg: "invalid value: sometimes: spec.eventOptions.payloadPolicy",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			trg := &LogzTarget{Spec: tLogzTargetSpec()}
+			if tc.modify != nil {
+				tc.modify(&trg.Spec)
+			}
+
+			assertFieldError(t, tc.expectErrorMsg, trg.Validate(context.Background()))
+		})
+	}
+}
+
+func tLogzTargetSpec() LogzTargetSpec {
+	return LogzTargetSpec{
+		ShippingToken:   tSecretValueFromSource(),
+		LogsListenerURL: "listener.logz.io",
+	}
+}
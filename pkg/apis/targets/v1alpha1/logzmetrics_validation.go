@@ -0,0 +1,72 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"knative.dev/pkg/apis"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+// Validate implements apis.Validatable
+func (t *LogzMetricsTarget) Validate(ctx context.Context) *apis.FieldError {
+	return t.Spec.Validate(ctx).ViaField("spec")
+}
+
+// Validate LogzMetricsTarget spec
+func (s *LogzMetricsTargetSpec) Validate(ctx context.Context) *apis.FieldError {
+	errs := s.Connection.Token.ValidateRequired(ctx).ViaField("connection", "token")
+	errs = errs.Also(v1alpha1.ValidateURLString(s.Connection.ListenerURL).ViaField("connection", "listenerURL"))
+
+	if len(s.Instruments) == 0 {
+		errs = errs.Also(apis.ErrMissingField("instruments"))
+	}
+	for i := range s.Instruments {
+		errs = errs.Also(s.Instruments[i].Validate(ctx).ViaFieldIndex("instruments", i))
+	}
+
+	return errs.Also(s.EventOptions.Validate(ctx).ViaField("eventOptions"))
+}
+
+// Validate verifies that the instrument has a name and supported kinds.
+func (i *Instrument) Validate(_ context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	if i.Name == "" {
+		errs = errs.Also(apis.ErrMissingField("name"))
+	}
+
+	switch i.Instrument {
+	case InstrumentKindHistogram, InstrumentKindCounter, InstrumentKindUpDownCounter:
+	case "":
+		errs = errs.Also(apis.ErrMissingField("instrument"))
+	default:
+		errs = errs.Also(apis.ErrInvalidValue(i.Instrument, "instrument"))
+	}
+
+	switch i.Number {
+	case NumberKindInt64, NumberKindFloat64:
+	case "":
+		errs = errs.Also(apis.ErrMissingField("number"))
+	default:
+		errs = errs.Also(apis.ErrInvalidValue(i.Number, "number"))
+	}
+
+	return errs
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+)
+
+func TestLogzMetricsTargetValidate(t *testing.T) {
+	testCases := map[string]struct {
+		modify         func(*LogzMetricsTargetSpec)
+		expectErrorMsg string
+	}{
+		"Valid": {},
+		"Missing token": {
+			modify:         func(s *LogzMetricsTargetSpec) { s.Connection.Token = SecretValueFromSource{} },
+			expectErrorMsg: "missing field(s): spec.connection.token.secretKeyRef",
+		},
+		"Missing listener URL": {
+			modify:         func(s *LogzMetricsTargetSpec) { s.Connection.ListenerURL = "" },
+			expectErrorMsg: "missing field(s): spec.connection.listenerURL",
+		},
+		"Missing instruments": {
+			modify:         func(s *LogzMetricsTargetSpec) { s.Instruments = nil },
+			expectErrorMsg: "missing field(s): spec.instruments",
+		},
+		"Incomplete instrument": {
+			modify: func(s *LogzMetricsTargetSpec) {
+				s.Instruments[0].Name = ""
+				s.Instruments[0].Number = ""
+			},
+			expectErrorMsg: "missing field(s): spec.instruments[0].name, spec.instruments[0].number",
+		},
+		"Unsupported instrument kind": {
+			modify:         func(s *LogzMetricsTargetSpec) { s.Instruments[0].Instrument = "Gauge" },
+			expectErrorMsg: "invalid value: Gauge: spec.instruments[0].instrument",
+		},
+		"Unsupported payload policy": {
+			modify: func(s *LogzMetricsTargetSpec) {
+				s.EventOptions = &EventOptions{PayloadPolicy: payloadPolicy("sometimes")}
+			},
+			expectErrorMsg: "invalid value: sometimes: spec.eventOptions.payloadPolicy",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			trg := &LogzMetricsTarget{Spec: tLogzMetricsTargetSpec()}
+			if tc.modify != nil {
+				tc.modify(&trg.Spec)
+			}
+
+			assertFieldError(t, tc.expectErrorMsg, trg.Validate(context.Background()))
+		})
+	}
+}
+
+func tLogzMetricsTargetSpec() LogzMetricsTargetSpec {
+	return LogzMetricsTargetSpec{
+		Connection: LogzMetricsConnection{
+			Token:       tSecretValueFromSource(),
+			ListenerURL: "https://listener.logz.io:8053",
+		},
+		Instruments: []Instrument{{
+			Name:       "requests",
+			Instrument: InstrumentKindCounter,
+			Number:     NumberKindInt64,
+		}},
+	}
+}
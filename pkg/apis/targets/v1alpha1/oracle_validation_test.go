@@ -0,0 +1,66 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+)
+
+func TestOracleTargetValidate(t *testing.T) {
+	testCases := map[string]struct {
+		modify         func(*OracleTargetSpec)
+		expectErrorMsg string
+	}{
+		"Valid": {},
+		"Missing fingerprint": {
+			modify:         func(s *OracleTargetSpec) { s.OracleAPIPrivateKeyFingerprint = SecretValueFromSource{} },
+			expectErrorMsg: "missing field(s): spec.oracleApiPrivateKeyFingerprint.secretKeyRef",
+		},
+		"Missing tenancy, user and region": {
+			modify: func(s *OracleTargetSpec) {
+				s.Tenancy = ""
+				s.User = ""
+				s.Region = ""
+			},
+			expectErrorMsg: "missing field(s): spec.oracleRegion, spec.oracleTenancy, spec.oracleUser",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			trg := &OracleTarget{Spec: tOracleTargetSpec()}
+			if tc.modify != nil {
+				tc.modify(&trg.Spec)
+			}
+
+			assertFieldError(t, tc.expectErrorMsg, trg.Validate(context.Background()))
+		})
+	}
+}
+
+func tOracleTargetSpec() OracleTargetSpec {
+	return OracleTargetSpec{
+		OracleAPIPrivateKey:            tSecretValueFromSource(),
+		OracleAPIPrivateKeyPassphrase:  tSecretValueFromSource(),
+		OracleAPIPrivateKeyFingerprint: tSecretValueFromSource(),
+		Tenancy:                        "ocid1.tenancy.oc1..test",
+		User:                           "ocid1.user.oc1..test",
+		Region:                         "us-ashburn-1",
+	}
+}
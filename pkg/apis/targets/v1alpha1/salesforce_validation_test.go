@@ -0,0 +1,76 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+)
+
+func TestSalesforceTargetValidate(t *testing.T) {
+	testCases := map[string]struct {
+		modify         func(*SalesforceTargetSpec)
+		expectErrorMsg string
+	}{
+		"Valid": {},
+		"Missing client ID and user": {
+			modify: func(s *SalesforceTargetSpec) {
+				s.Auth.ClientID = ""
+				s.Auth.User = ""
+			},
+			expectErrorMsg: "missing field(s): spec.auth.clientID, spec.auth.user",
+		},
+		"Relative server URL": {
+			modify: func(s *SalesforceTargetSpec) { s.Auth.Server = "login.salesforce.com" },
+			expectErrorMsg: "invalid value: login.salesforce.com: spec.auth.server\n" +
+				"URL must be absolute and include a host",
+		},
+		"Missing certificate key": {
+			modify:         func(s *SalesforceTargetSpec) { s.Auth.CertKey = SecretValueFromSource{} },
+			expectErrorMsg: "missing field(s): spec.auth.certKey.secretKeyRef",
+		},
+		"Unsupported payload policy": {
+			modify: func(s *SalesforceTargetSpec) {
+				s.EventOptions = &EventOptions{PayloadPolicy: payloadPolicy("sometimes")}
+			},
+			expectErrorMsg: "invalid value: sometimes: spec.eventOptions.payloadPolicy",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			trg := &SalesforceTarget{Spec: tSalesforceTargetSpec()}
+			if tc.modify != nil {
+				tc.modify(&trg.Spec)
+			}
+
+			assertFieldError(t, tc.expectErrorMsg, trg.Validate(context.Background()))
+		})
+	}
+}
+
+func tSalesforceTargetSpec() SalesforceTargetSpec {
+	return SalesforceTargetSpec{
+		Auth: SalesforceAuth{
+			ClientID: "test-client",
+			Server:   "https://login.salesforce.com",
+			User:     "user@example.com",
+			CertKey:  tSecretValueFromSource(),
+		},
+	}
+}
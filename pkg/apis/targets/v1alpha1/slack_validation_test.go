@@ -0,0 +1,53 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+)
+
+func TestSlackTargetValidate(t *testing.T) {
+	testCases := map[string]struct {
+		modify         func(*SlackTargetSpec)
+		expectErrorMsg string
+	}{
+		"Valid": {},
+		"Missing token": {
+			modify:         func(s *SlackTargetSpec) { s.Token = SecretValueFromSource{} },
+			expectErrorMsg: "missing field(s): spec.token.secretKeyRef",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			trg := &SlackTarget{Spec: tSlackTargetSpec()}
+			if tc.modify != nil {
+				tc.modify(&trg.Spec)
+			}
+
+			assertFieldError(t, tc.expectErrorMsg, trg.Validate(context.Background()))
+		})
+	}
+}
+
+func tSlackTargetSpec() SlackTargetSpec {
+	return SlackTargetSpec{
+		Token: tSecretValueFromSource(),
+	}
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"knative.dev/pkg/apis"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+func TestSplunkTargetValidate(t *testing.T) {
+	testCases := map[string]struct {
+		modify         func(*SplunkTargetSpec)
+		expectErrorMsg string
+	}{
+		"Valid": {},
+		"Missing endpoint": {
+			modify:         func(s *SplunkTargetSpec) { s.Endpoint = apis.URL{} },
+			expectErrorMsg: "missing field(s): spec.endpoint",
+		},
+		"Missing token": {
+			modify:         func(s *SplunkTargetSpec) { s.Token = v1alpha1.ValueFromField{} },
+			expectErrorMsg: "expected exactly one, got neither: spec.token.value, spec.token.valueFromSecret",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			trg := &SplunkTarget{Spec: tSplunkTargetSpec()}
+			if tc.modify != nil {
+				tc.modify(&trg.Spec)
+			}
+
+			assertFieldError(t, tc.expectErrorMsg, trg.Validate(context.Background()))
+		})
+	}
+}
+
+func tSplunkTargetSpec() SplunkTargetSpec {
+	return SplunkTargetSpec{
+		Endpoint: *apis.HTTP("splunk.example.com:8088"),
+		Token:    tSecretValue(),
+	}
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"knative.dev/pkg/ptr"
+)
+
+func TestTektonTargetValidate(t *testing.T) {
+	testCases := map[string]struct {
+		modify         func(*TektonTargetSpec)
+		expectErrorMsg string
+	}{
+		"Valid": {},
+		"Without reap policy": {
+			modify: func(s *TektonTargetSpec) { s.ReapPolicy = nil },
+		},
+		"Unparsable success age": {
+			modify: func(s *TektonTargetSpec) { s.ReapPolicy.ReapSuccessAge = ptr.String("1 day") },
+			expectErrorMsg: "invalid value: 1 day: spec.reapPolicy.success\n" +
+				"time: unknown unit \" day\" in duration \"1 day\"",
+		},
+		"Negative fail age": {
+			modify: func(s *TektonTargetSpec) { s.ReapPolicy.ReapFailAge = ptr.String("-1h") },
+			expectErrorMsg: "invalid value: -1h: spec.reapPolicy.fail\n" +
+				"duration must be greater than zero",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			trg := &TektonTarget{Spec: tTektonTargetSpec()}
+			if tc.modify != nil {
+				tc.modify(&trg.Spec)
+			}
+
+			assertFieldError(t, tc.expectErrorMsg, trg.Validate(context.Background()))
+		})
+	}
+}
+
+func tTektonTargetSpec() TektonTargetSpec {
+	return TektonTargetSpec{
+		ReapPolicy: &TektonTargetReapPolicy{
+			ReapSuccessAge: ptr.String("1h"),
+			ReapFailAge:    ptr.String("24h"),
+		},
+	}
+}
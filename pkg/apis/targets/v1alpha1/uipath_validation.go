@@ -0,0 +1,56 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"knative.dev/pkg/apis"
+)
+
+// Validate implements apis.Validatable
+func (t *UiPathTarget) Validate(ctx context.Context) *apis.FieldError {
+	return t.Spec.Validate(ctx).ViaField("spec")
+}
+
+// Validate UiPathTarget spec
+func (s *UiPathTargetSpec) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	errs = errs.Also(s.UserKey.ValidateRequired(ctx).ViaField("userKey"))
+
+	if s.RobotName == "" {
+		errs = errs.Also(apis.ErrMissingField("robotName"))
+	}
+	if s.ProcessName == "" {
+		errs = errs.Also(apis.ErrMissingField("processName"))
+	}
+	if s.TenantName == "" {
+		errs = errs.Also(apis.ErrMissingField("tenantName"))
+	}
+	if s.AccountLogicalName == "" {
+		errs = errs.Also(apis.ErrMissingField("accountLogicalName"))
+	}
+	if s.ClientID == "" {
+		errs = errs.Also(apis.ErrMissingField("clientID"))
+	}
+	if s.OrganizationUnitID == "" {
+		errs = errs.Also(apis.ErrMissingField("organizationUnitID"))
+	}
+
+	return errs
+}
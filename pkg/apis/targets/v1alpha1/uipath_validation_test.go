@@ -0,0 +1,75 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+)
+
+func TestUiPathTargetValidate(t *testing.T) {
+	testCases := map[string]struct {
+		modify         func(*UiPathTargetSpec)
+		expectErrorMsg string
+	}{
+		"Valid": {},
+		"Missing user key": {
+			modify:         func(s *UiPathTargetSpec) { s.UserKey = SecretValueFromSource{} },
+			expectErrorMsg: "missing field(s): spec.userKey.secretKeyRef",
+		},
+		"Missing robot and process": {
+			modify: func(s *UiPathTargetSpec) {
+				s.RobotName = ""
+				s.ProcessName = ""
+			},
+			expectErrorMsg: "missing field(s): spec.processName, spec.robotName",
+		},
+		"Missing account": {
+			modify: func(s *UiPathTargetSpec) {
+				s.TenantName = ""
+				s.AccountLogicalName = ""
+				s.ClientID = ""
+				s.OrganizationUnitID = ""
+			},
+			expectErrorMsg: "missing field(s): spec.accountLogicalName, spec.clientID, spec.organizationUnitID, spec.tenantName",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			trg := &UiPathTarget{Spec: tUiPathTargetSpec()}
+			if tc.modify != nil {
+				tc.modify(&trg.Spec)
+			}
+
+			assertFieldError(t, tc.expectErrorMsg, trg.Validate(context.Background()))
+		})
+	}
+}
+
+func tUiPathTargetSpec() UiPathTargetSpec {
+	return UiPathTargetSpec{
+		UserKey:            tSecretValueFromSource(),
+		RobotName:          "test-robot",
+		ProcessName:        "test-process",
+		TenantName:         "test-tenant",
+		AccountLogicalName: "test-account",
+		ClientID:           "test-client",
+		OrganizationUnitID: "1234",
+	}
+}
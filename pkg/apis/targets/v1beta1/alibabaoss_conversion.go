@@ -0,0 +1,84 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+
+	"knative.dev/pkg/apis"
+
+	targetsv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
+)
+
+// ConvertTo implements apis.Convertible.
+func (t *AlibabaOSSTarget) ConvertTo(_ context.Context, to apis.Convertible) error {
+	switch sink := to.(type) {
+	case *targetsv1alpha1.AlibabaOSSTarget:
+		sink.ObjectMeta = t.ObjectMeta
+		sink.Status = t.Status
+		if err := t.Spec.convertTo(&sink.Spec); err != nil {
+			return err.ViaField("spec")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", sink)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *AlibabaOSSTarget) ConvertFrom(_ context.Context, from apis.Convertible) error {
+	switch source := from.(type) {
+	case *targetsv1alpha1.AlibabaOSSTarget:
+		t.ObjectMeta = source.ObjectMeta
+		t.Spec.convertFrom(&source.Spec)
+		t.Status = source.Status
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", source)
+	}
+}
+
+// convertTo copies the attributes of an AlibabaOSSTargetSpec to its v1alpha1 counterpart.
+func (s *AlibabaOSSTargetSpec) convertTo(sink *targetsv1alpha1.AlibabaOSSTargetSpec) *apis.FieldError {
+	var errs *apis.FieldError
+
+	errs = errs.Also(convertSecretValueTo(&s.AccessKeyID, &sink.AccessKeyID).ViaField("accessKeyID"))
+	errs = errs.Also(convertSecretValueTo(&s.AccessKeySecret, &sink.AccessKeySecret).ViaField("accessKeySecret"))
+	sink.Endpoint = s.Endpoint
+	sink.Bucket = s.Bucket
+	if s.EventOptions != nil {
+		sink.EventOptions = &targetsv1alpha1.EventOptions{}
+		s.EventOptions.convertTo(sink.EventOptions)
+	}
+	sink.AdapterOverrides = s.AdapterOverrides
+
+	return errs
+}
+
+// convertFrom copies the attributes of a v1alpha1 AlibabaOSSTargetSpec.
+func (s *AlibabaOSSTargetSpec) convertFrom(source *targetsv1alpha1.AlibabaOSSTargetSpec) {
+	convertSecretValueFrom(&source.AccessKeyID, &s.AccessKeyID)
+	convertSecretValueFrom(&source.AccessKeySecret, &s.AccessKeySecret)
+	s.Endpoint = source.Endpoint
+	s.Bucket = source.Bucket
+	if source.EventOptions != nil {
+		s.EventOptions = &EventOptions{}
+		s.EventOptions.convertFrom(source.EventOptions)
+	}
+	s.AdapterOverrides = source.AdapterOverrides
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AWSKinesisTarget is the Schema for an AWS Kinesis Target.
+type AWSKinesisTarget struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AWSKinesisTargetSpec `json:"spec"`
+	Status v1alpha1.Status      `json:"status,omitempty"`
+}
+
+// AWSKinesisTargetSpec defines the desired state of the event target.
+type AWSKinesisTargetSpec struct {
+	// AWS account Key
+	AWSApiKey v1alpha1.ValueFromField `json:"awsApiKey"`
+
+	// AWS account secret key
+	AWSApiSecret v1alpha1.ValueFromField `json:"awsApiSecret"`
+
+	// Amazon Resource Name of the Kinesis stream.
+	// https://docs.aws.amazon.com/IAM/latest/UserGuide/list_amazonkinesis.html#amazonkinesis-resources-for-iam-policies
+	ARN string `json:"arn"`
+
+	// Kinesis Partition to publish the events to
+	Partition string `json:"partition"`
+
+	// Whether to omit CloudEvent context attributes in records created in Kinesis.
+	// When this property is false (default), the entire CloudEvent payload is included.
+	// When this property is true, only the CloudEvent data is included.
+	DiscardCloudEventContext bool `json:"discardCloudEventContext"`
+
+	// Adapter spec overrides parameters.
+	// +optional
+	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AWSKinesisTargetList is a list of event target instances.
+type AWSKinesisTargetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []AWSKinesisTarget `json:"items"`
+}
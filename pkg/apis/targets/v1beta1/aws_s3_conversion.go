@@ -0,0 +1,102 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+
+	"knative.dev/pkg/apis"
+
+	targetsv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
+)
+
+// ConvertTo implements apis.Convertible.
+func (t *AWSS3Target) ConvertTo(_ context.Context, to apis.Convertible) error {
+	switch sink := to.(type) {
+	case *targetsv1alpha1.AWSS3Target:
+		sink.ObjectMeta = t.ObjectMeta
+		sink.Status = t.Status
+		if err := t.Spec.convertTo(&sink.Spec); err != nil {
+			return err.ViaField("spec")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", sink)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *AWSS3Target) ConvertFrom(_ context.Context, from apis.Convertible) error {
+	switch source := from.(type) {
+	case *targetsv1alpha1.AWSS3Target:
+		t.ObjectMeta = source.ObjectMeta
+		t.Spec.convertFrom(&source.Spec)
+		t.Status = source.Status
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", source)
+	}
+}
+
+// convertTo copies the attributes of an AWSS3TargetSpec to its v1alpha1 counterpart.
+func (s *AWSS3TargetSpec) convertTo(sink *targetsv1alpha1.AWSS3TargetSpec) *apis.FieldError {
+	var errs *apis.FieldError
+
+	errs = errs.Also(convertSecretValueTo(&s.AWSApiKey, &sink.AWSApiKey).ViaField("awsApiKey"))
+	errs = errs.Also(convertSecretValueTo(&s.AWSApiSecret, &sink.AWSApiSecret).ViaField("awsApiSecret"))
+	sink.ARN = s.ARN
+	sink.DiscardCEContext = s.DiscardCloudEventContext
+	if s.Batching != nil {
+		sink.Batching = &targetsv1alpha1.AWSS3TargetBatching{}
+		s.Batching.convertTo(sink.Batching)
+	}
+	sink.AdapterOverrides = s.AdapterOverrides
+
+	return errs
+}
+
+// convertFrom copies the attributes of a v1alpha1 AWSS3TargetSpec.
+func (s *AWSS3TargetSpec) convertFrom(source *targetsv1alpha1.AWSS3TargetSpec) {
+	convertSecretValueFrom(&source.AWSApiKey, &s.AWSApiKey)
+	convertSecretValueFrom(&source.AWSApiSecret, &s.AWSApiSecret)
+	s.ARN = source.ARN
+	s.DiscardCloudEventContext = source.DiscardCEContext
+	if source.Batching != nil {
+		s.Batching = &AWSS3TargetBatching{}
+		s.Batching.convertFrom(source.Batching)
+	}
+	s.AdapterOverrides = source.AdapterOverrides
+}
+
+// convertTo copies the attributes of an AWSS3TargetBatching to its v1alpha1 counterpart.
+func (b *AWSS3TargetBatching) convertTo(sink *targetsv1alpha1.AWSS3TargetBatching) {
+	sink.Format = targetsv1alpha1.AWSS3TargetBatchFormat(b.Format)
+	sink.MaxEvents = b.MaxEvents
+	sink.MaxBytes = b.MaxBytes
+	sink.FlushInterval = b.FlushInterval
+	sink.KeyPrefix = b.KeyPrefix
+}
+
+// convertFrom copies the attributes of a v1alpha1 AWSS3TargetBatching.
+func (b *AWSS3TargetBatching) convertFrom(source *targetsv1alpha1.AWSS3TargetBatching) {
+	b.Format = AWSS3TargetBatchFormat(source.Format)
+	b.MaxEvents = source.MaxEvents
+	b.MaxBytes = source.MaxBytes
+	b.FlushInterval = source.FlushInterval
+	b.KeyPrefix = source.KeyPrefix
+}
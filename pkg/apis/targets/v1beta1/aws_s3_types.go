@@ -0,0 +1,114 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/triggermesh/triggermesh/pkg/apis"
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AWSS3Target is the Schema for an AWS s3 Target.
+type AWSS3Target struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AWSS3TargetSpec `json:"spec"`
+	Status v1alpha1.Status `json:"status,omitempty"`
+}
+
+// AWSS3TargetSpec holds the desired state of the even target.
+type AWSS3TargetSpec struct {
+	// AWS account Key
+	AWSApiKey v1alpha1.ValueFromField `json:"awsApiKey"`
+
+	// AWS account secret key
+	AWSApiSecret v1alpha1.ValueFromField `json:"awsApiSecret"`
+
+	// Amazon Resource Name of the S3 bucket.
+	// https://docs.aws.amazon.com/IAM/latest/UserGuide/list_amazons3.html#amazons3-resources-for-iam-policies
+	ARN string `json:"arn"`
+
+	// Whether to omit CloudEvent context attributes in objects created in S3.
+	// When this property is false (default), the entire CloudEvent payload is included.
+	// When this property is true, only the CloudEvent data is included.
+	DiscardCloudEventContext bool `json:"discardCloudEventContext"`
+
+	// Buffering of events into objects which contain multiple events.
+	// When this property is not set (default), each event is written to its own object.
+	// +optional
+	Batching *AWSS3TargetBatching `json:"batching,omitempty"`
+
+	// Adapter spec overrides parameters.
+	// +optional
+	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
+}
+
+// AWSS3TargetBatching defines how events are buffered and written to S3 as
+// batched objects.
+type AWSS3TargetBatching struct {
+	// Format of the objects written to the bucket.
+	Format AWSS3TargetBatchFormat `json:"format"`
+
+	// Maximum number of events written to a single object. Defaults to 1000.
+	// +optional
+	MaxEvents *int32 `json:"maxEvents,omitempty"`
+
+	// Maximum size, in bytes and before compression, of the events written
+	// to a single object. Defaults to 5MiB.
+	// +optional
+	MaxBytes *int64 `json:"maxBytes,omitempty"`
+
+	// Maximum duration events are buffered for before being written.
+	// Defaults to 30s.
+	//
+	// Events are only acknowledged once the object they were buffered into
+	// has been written, so this duration should remain lower than the
+	// request timeout of event senders.
+	// +optional
+	FlushInterval *apis.Duration `json:"flushInterval,omitempty"`
+
+	// Prefix of the keys of written objects, as a Go template.
+	// The template is rendered with the time of the write operation, in
+	// UTC, as the '.Time' field (e.g. 'dt={{ .Time.Format "2006-01-02" }}/').
+	// +optional
+	KeyPrefix *string `json:"keyPrefix,omitempty"`
+}
+
+// AWSS3TargetBatchFormat is the format of batched objects.
+type AWSS3TargetBatchFormat string
+
+// Supported formats of batched objects.
+const (
+	// Newline-delimited JSON, compressed with gzip.
+	AWSS3TargetBatchFormatNDJSON AWSS3TargetBatchFormat = "ndjson"
+	// Apache Parquet.
+	AWSS3TargetBatchFormatParquet AWSS3TargetBatchFormat = "parquet"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AWSS3TargetList is a list of AWSS3Target resources
+type AWSS3TargetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []AWSS3Target `json:"items"`
+}
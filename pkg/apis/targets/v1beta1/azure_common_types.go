@@ -0,0 +1,49 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+// AzureAuth contains multiple authentication methods for Azure services.
+type AzureAuth struct {
+	// Service principals provide a way to create a non-interactive account
+	// associated with your identity to which you grant only the privileges
+	// your app needs to run.
+	// See https://docs.microsoft.com/en-us/azure/active-directory/develop/app-objects-and-service-principals
+	ServicePrincipal *AzureServicePrincipal `json:"servicePrincipal,omitempty"`
+
+	// A shared access signature (SAS) provides secure delegated access to
+	// resources in a storage account.
+	// See https://docs.microsoft.com/en-us/azure/storage/common/storage-sas-overview
+	SASToken *AzureSASToken `json:"sasToken,omitempty"`
+}
+
+// AzureServicePrincipal represents an AAD Service Principal.
+type AzureServicePrincipal struct {
+	TenantID     v1alpha1.ValueFromField `json:"tenantID"`
+	ClientID     v1alpha1.ValueFromField `json:"clientID"`
+	ClientSecret v1alpha1.ValueFromField `json:"clientSecret"`
+}
+
+// AzureSASToken represents an Azure SAS token.
+type AzureSASToken struct {
+	KeyName          v1alpha1.ValueFromField `json:"keyName"`
+	KeyValue         v1alpha1.ValueFromField `json:"keyValue"`
+	ConnectionString v1alpha1.ValueFromField `json:"connectionString"`
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+
+	"knative.dev/pkg/apis"
+
+	targetsv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
+)
+
+// ConvertTo implements apis.Convertible.
+func (t *AzureEventHubsTarget) ConvertTo(_ context.Context, to apis.Convertible) error {
+	switch sink := to.(type) {
+	case *targetsv1alpha1.AzureEventHubsTarget:
+		sink.ObjectMeta = t.ObjectMeta
+		t.Spec.convertTo(&sink.Spec)
+		sink.Status = t.Status
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", sink)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *AzureEventHubsTarget) ConvertFrom(_ context.Context, from apis.Convertible) error {
+	switch source := from.(type) {
+	case *targetsv1alpha1.AzureEventHubsTarget:
+		t.ObjectMeta = source.ObjectMeta
+		t.Spec.convertFrom(&source.Spec)
+		t.Status = source.Status
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", source)
+	}
+}
+
+// convertTo copies the attributes of an AzureEventHubsTargetSpec to its v1alpha1 counterpart.
+func (s *AzureEventHubsTargetSpec) convertTo(sink *targetsv1alpha1.AzureEventHubsTargetSpec) {
+	s.Auth.convertTo(&sink.Auth)
+	sink.EventHubID = s.EventHubID
+	if s.EventOptions != nil {
+		sink.EventOptions = &targetsv1alpha1.EventOptions{}
+		s.EventOptions.convertTo(sink.EventOptions)
+	}
+	sink.DiscardCEContext = s.DiscardCloudEventContext
+	sink.AdapterOverrides = s.AdapterOverrides
+}
+
+// convertFrom copies the attributes of a v1alpha1 AzureEventHubsTargetSpec.
+func (s *AzureEventHubsTargetSpec) convertFrom(source *targetsv1alpha1.AzureEventHubsTargetSpec) {
+	s.Auth.convertFrom(&source.Auth)
+	s.EventHubID = source.EventHubID
+	if source.EventOptions != nil {
+		s.EventOptions = &EventOptions{}
+		s.EventOptions.convertFrom(source.EventOptions)
+	}
+	s.DiscardCloudEventContext = source.DiscardCEContext
+	s.AdapterOverrides = source.AdapterOverrides
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+
+	"knative.dev/pkg/apis"
+
+	targetsv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
+)
+
+// ConvertTo implements apis.Convertible.
+func (t *CloudEventsTarget) ConvertTo(_ context.Context, to apis.Convertible) error {
+	switch sink := to.(type) {
+	case *targetsv1alpha1.CloudEventsTarget:
+		sink.ObjectMeta = t.ObjectMeta
+		t.Spec.convertTo(&sink.Spec)
+		sink.Status = t.Status
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", sink)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *CloudEventsTarget) ConvertFrom(_ context.Context, from apis.Convertible) error {
+	switch source := from.(type) {
+	case *targetsv1alpha1.CloudEventsTarget:
+		t.ObjectMeta = source.ObjectMeta
+		t.Spec.convertFrom(&source.Spec)
+		t.Status = source.Status
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", source)
+	}
+}
+
+// convertTo copies the attributes of a CloudEventsTargetSpec to its v1alpha1 counterpart.
+func (s *CloudEventsTargetSpec) convertTo(sink *targetsv1alpha1.CloudEventsTargetSpec) {
+	if s.Credentials != nil {
+		sink.Credentials = &targetsv1alpha1.CloudEventsCredentials{}
+		s.Credentials.convertTo(sink.Credentials)
+	}
+	sink.Path = s.Path
+	sink.Endpoint = s.Endpoint
+	sink.AdapterOverrides = s.AdapterOverrides
+}
+
+// convertFrom copies the attributes of a v1alpha1 CloudEventsTargetSpec.
+func (s *CloudEventsTargetSpec) convertFrom(source *targetsv1alpha1.CloudEventsTargetSpec) {
+	if source.Credentials != nil {
+		s.Credentials = &CloudEventsCredentials{}
+		s.Credentials.convertFrom(source.Credentials)
+	}
+	s.Path = source.Path
+	s.Endpoint = source.Endpoint
+	s.AdapterOverrides = source.AdapterOverrides
+}
+
+// convertTo copies the attributes of a CloudEventsCredentials to its v1alpha1 counterpart.
+func (c *CloudEventsCredentials) convertTo(sink *targetsv1alpha1.CloudEventsCredentials) {
+	c.BasicAuth.convertTo(&sink.BasicAuth)
+}
+
+// convertFrom copies the attributes of a v1alpha1 CloudEventsCredentials.
+func (c *CloudEventsCredentials) convertFrom(source *targetsv1alpha1.CloudEventsCredentials) {
+	c.BasicAuth.convertFrom(&source.BasicAuth)
+}
+
+// convertTo copies the attributes of an HTTPBasicAuth to its v1alpha1 counterpart.
+func (h *HTTPBasicAuth) convertTo(sink *targetsv1alpha1.HTTPBasicAuth) {
+	sink.Username = h.Username
+	sink.Password = h.Password
+}
+
+// convertFrom copies the attributes of a v1alpha1 HTTPBasicAuth.
+func (h *HTTPBasicAuth) convertFrom(source *targetsv1alpha1.HTTPBasicAuth) {
+	h.Username = source.Username
+	h.Password = source.Password
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"knative.dev/pkg/apis"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+	targetsv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
+)
+
+// convertTo copies the attributes of an EventOptions to its v1alpha1 counterpart.
+func (o *EventOptions) convertTo(sink *targetsv1alpha1.EventOptions) {
+	sink.PayloadPolicy = o.PayloadPolicy
+}
+
+// convertFrom copies the attributes of a v1alpha1 EventOptions.
+func (o *EventOptions) convertFrom(source *targetsv1alpha1.EventOptions) {
+	o.PayloadPolicy = source.PayloadPolicy
+}
+
+// convertSecretValueTo converts a ValueFromField to the v1alpha1 representation
+// of a secret value, which only supports references to Kubernetes Secrets.
+// Because v1alpha1 is the storage version, the v1beta1 schemas of the targets
+// which use this conversion do not accept literal values either.
+func convertSecretValueTo(v *v1alpha1.ValueFromField, sink *targetsv1alpha1.SecretValueFromSource) *apis.FieldError {
+	if v.Value != "" {
+		return &apis.FieldError{
+			Message: "literal values can not be converted to v1alpha1",
+			Paths:   []string{"value"},
+			Details: "The value must be provided by a reference to a Kubernetes Secret (valueFromSecret).",
+		}
+	}
+
+	sink.SecretKeyRef = v.ValueFromSecret
+	return nil
+}
+
+// convertSecretValueFrom converts a v1alpha1 secret value to a ValueFromField.
+func convertSecretValueFrom(source *targetsv1alpha1.SecretValueFromSource, v *v1alpha1.ValueFromField) {
+	v.ValueFromSecret = source.SecretKeyRef
+}
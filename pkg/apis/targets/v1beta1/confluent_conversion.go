@@ -0,0 +1,112 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+
+	"knative.dev/pkg/apis"
+
+	targetsv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
+)
+
+// ConvertTo implements apis.Convertible.
+func (t *ConfluentTarget) ConvertTo(_ context.Context, to apis.Convertible) error {
+	switch sink := to.(type) {
+	case *targetsv1alpha1.ConfluentTarget:
+		sink.ObjectMeta = t.ObjectMeta
+		sink.Status = t.Status
+		if err := t.Spec.convertTo(&sink.Spec); err != nil {
+			return err.ViaField("spec")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", sink)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *ConfluentTarget) ConvertFrom(_ context.Context, from apis.Convertible) error {
+	switch source := from.(type) {
+	case *targetsv1alpha1.ConfluentTarget:
+		t.ObjectMeta = source.ObjectMeta
+		t.Spec.convertFrom(&source.Spec)
+		t.Status = source.Status
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", source)
+	}
+}
+
+// convertTo copies the attributes of a ConfluentTargetSpec to its v1alpha1 counterpart.
+func (s *ConfluentTargetSpec) convertTo(sink *targetsv1alpha1.ConfluentTargetSpec) *apis.FieldError {
+	var errs *apis.FieldError
+
+	sink.SASLUsername = s.SASLUsername
+	errs = errs.Also(convertSecretValueTo(&s.SASLPassword, &sink.SASLPassword).ViaField("password"))
+	sink.Topic = s.Topic
+	sink.TopicReplicationFactor = s.TopicReplicationFactor
+	sink.TopicPartitions = s.TopicPartitions
+	sink.BootstrapServers = s.BootstrapServers
+	sink.SecurityProtocol = s.SecurityProtocol
+	sink.SASLMechanisms = s.SASLMechanisms
+	sink.DiscardCEContext = s.DiscardCloudEventContext
+	sink.ContentMode = (*targetsv1alpha1.ConfluentTargetContentMode)(s.ContentMode)
+	if s.MessageKey != nil {
+		sink.MessageKey = &targetsv1alpha1.ConfluentTargetMessageKey{}
+		s.MessageKey.convertTo(sink.MessageKey)
+	}
+	sink.Partitioner = s.Partitioner
+	sink.AsyncDelivery = s.AsyncDelivery
+	sink.AdapterOverrides = s.AdapterOverrides
+
+	return errs
+}
+
+// convertFrom copies the attributes of a v1alpha1 ConfluentTargetSpec.
+func (s *ConfluentTargetSpec) convertFrom(source *targetsv1alpha1.ConfluentTargetSpec) {
+	s.SASLUsername = source.SASLUsername
+	convertSecretValueFrom(&source.SASLPassword, &s.SASLPassword)
+	s.Topic = source.Topic
+	s.TopicReplicationFactor = source.TopicReplicationFactor
+	s.TopicPartitions = source.TopicPartitions
+	s.BootstrapServers = source.BootstrapServers
+	s.SecurityProtocol = source.SecurityProtocol
+	s.SASLMechanisms = source.SASLMechanisms
+	s.DiscardCloudEventContext = source.DiscardCEContext
+	s.ContentMode = (*ConfluentTargetContentMode)(source.ContentMode)
+	if source.MessageKey != nil {
+		s.MessageKey = &ConfluentTargetMessageKey{}
+		s.MessageKey.convertFrom(source.MessageKey)
+	}
+	s.Partitioner = source.Partitioner
+	s.AsyncDelivery = source.AsyncDelivery
+	s.AdapterOverrides = source.AdapterOverrides
+}
+
+// convertTo copies the attributes of a ConfluentTargetMessageKey to its v1alpha1 counterpart.
+func (c *ConfluentTargetMessageKey) convertTo(sink *targetsv1alpha1.ConfluentTargetMessageKey) {
+	sink.Attribute = c.Attribute
+	sink.DataPath = c.DataPath
+}
+
+// convertFrom copies the attributes of a v1alpha1 ConfluentTargetMessageKey.
+func (c *ConfluentTargetMessageKey) convertFrom(source *targetsv1alpha1.ConfluentTargetMessageKey) {
+	c.Attribute = source.Attribute
+	c.DataPath = source.DataPath
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ConfluentTarget is the Schema for an ConfluentTarget.
+type ConfluentTarget struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ConfluentTargetSpec `json:"spec"`
+	Status v1alpha1.Status     `json:"status,omitempty"`
+}
+
+// ConfluentTargetSpec defines the desired state of the event target.
+type ConfluentTargetSpec struct {
+	// SASLUsername Confluent account User
+	SASLUsername string `json:"username"`
+
+	// SASLPassword Confluent account Password
+	SASLPassword v1alpha1.ValueFromField `json:"password"`
+
+	// Topic where messages are produced.
+	Topic string `json:"topic"`
+
+	// TopicReplicationFactor is the number of replicas for the topic.
+	// +optional
+	TopicReplicationFactor *int `json:"topicReplicationFactor"`
+
+	// TopicPartitions is the number of partitions for the topic.
+	// +optional
+	TopicPartitions *int `json:"topicPartitions"`
+
+	// BootstrapServers holds the name of the Kafka Bootstrap server.
+	BootstrapServers []string `json:"bootstrapServers"`
+
+	// SecurityProtocol allows the user to set the security protocol
+	SecurityProtocol string `json:"securityProtocol"`
+
+	// SASLMechanisms all the assignment of specific SASL mechanisms.
+	SASLMechanisms string `json:"saslMechanism"`
+
+	// Whether to omit CloudEvent context attributes in messages sent to Kafka.
+	// When this property is false (default), the entire CloudEvent payload is included.
+	// When this property is true, only the CloudEvent data is included.
+	DiscardCloudEventContext bool `json:"discardCloudEventContext"`
+
+	// Content mode of the messages sent to Kafka, as defined by the
+	// CloudEvents Kafka protocol binding. In 'structured' mode (default),
+	// the value of messages contains the entire CloudEvent, or only its data
+	// when discardCloudEventContext is true. In 'binary' mode, the value of
+	// messages contains the CloudEvent data, and its context attributes are
+	// set as message headers.
+	// +optional
+	ContentMode *ConfluentTargetContentMode `json:"contentMode,omitempty"`
+
+	// Source of the key of the messages sent to Kafka. Messages which share
+	// the same key are written to the same partition, which preserves their
+	// ordering. When this property is not set (default), messages are keyed
+	// by CloudEvent ID.
+	// +optional
+	MessageKey *ConfluentTargetMessageKey `json:"messageKey,omitempty"`
+
+	// Partitioner used to assign keyed messages to partitions, as supported
+	// by librdkafka (e.g. 'murmur2_random' for compatibility with the Java
+	// client). Defaults to 'consistent_random'.
+	// +optional
+	Partitioner *string `json:"partitioner,omitempty"`
+
+	// Whether to acknowledge events as soon as they are queued for delivery
+	// to Kafka, instead of once their delivery has been confirmed. Delivery
+	// failures are then only reported in the logs of the adapter.
+	// +optional
+	AsyncDelivery *bool `json:"asyncDelivery,omitempty"`
+
+	// Adapter spec overrides parameters.
+	// +optional
+	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
+}
+
+// ConfluentTargetContentMode is the content mode of Kafka messages.
+type ConfluentTargetContentMode string
+
+// Supported content modes of Kafka messages.
+const (
+	ConfluentTargetContentModeStructured ConfluentTargetContentMode = "structured"
+	ConfluentTargetContentModeBinary     ConfluentTargetContentMode = "binary"
+)
+
+// ConfluentTargetMessageKey defines the source of the key of Kafka messages.
+// Only one of its properties may be set.
+type ConfluentTargetMessageKey struct {
+	// Name of a CloudEvent context attribute or extension.
+	// +optional
+	Attribute *string `json:"attribute,omitempty"`
+
+	// Path of a value within the CloudEvent data, in GJSON syntax.
+	// https://github.com/tidwall/gjson/blob/master/SYNTAX.md
+	// +optional
+	DataPath *string `json:"dataPath,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ConfluentTargetList is a list of event target instances.
+type ConfluentTargetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []ConfluentTarget `json:"items"`
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+
+	"knative.dev/pkg/apis"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+	targetsv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
+)
+
+// ConvertTo implements apis.Convertible.
+func (t *ElasticsearchTarget) ConvertTo(_ context.Context, to apis.Convertible) error {
+	switch sink := to.(type) {
+	case *targetsv1alpha1.ElasticsearchTarget:
+		sink.ObjectMeta = t.ObjectMeta
+		sink.Status = t.Status
+		if err := t.Spec.convertTo(&sink.Spec); err != nil {
+			return err.ViaField("spec")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", sink)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *ElasticsearchTarget) ConvertFrom(_ context.Context, from apis.Convertible) error {
+	switch source := from.(type) {
+	case *targetsv1alpha1.ElasticsearchTarget:
+		t.ObjectMeta = source.ObjectMeta
+		t.Spec.convertFrom(&source.Spec)
+		t.Status = source.Status
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", source)
+	}
+}
+
+// convertTo copies the attributes of an ElasticsearchTargetSpec to its v1alpha1 counterpart.
+func (s *ElasticsearchTargetSpec) convertTo(sink *targetsv1alpha1.ElasticsearchTargetSpec) *apis.FieldError {
+	var errs *apis.FieldError
+
+	errs = errs.Also(s.Connection.convertTo(&sink.Connection).ViaField("connection"))
+	sink.IndexName = s.IndexName
+	sink.DocumentIDPath = s.DocumentIDPath
+	sink.Pipeline = s.Pipeline
+	if s.Bulk != nil {
+		sink.Bulk = &targetsv1alpha1.ElasticsearchTargetBulk{}
+		s.Bulk.convertTo(sink.Bulk)
+	}
+	sink.DiscardCEContext = s.DiscardCloudEventContext
+	if s.EventOptions != nil {
+		sink.EventOptions = &targetsv1alpha1.EventOptions{}
+		s.EventOptions.convertTo(sink.EventOptions)
+	}
+	sink.AdapterOverrides = s.AdapterOverrides
+
+	return errs
+}
+
+// convertFrom copies the attributes of a v1alpha1 ElasticsearchTargetSpec.
+func (s *ElasticsearchTargetSpec) convertFrom(source *targetsv1alpha1.ElasticsearchTargetSpec) {
+	s.Connection.convertFrom(&source.Connection)
+	s.IndexName = source.IndexName
+	s.DocumentIDPath = source.DocumentIDPath
+	s.Pipeline = source.Pipeline
+	if source.Bulk != nil {
+		s.Bulk = &ElasticsearchTargetBulk{}
+		s.Bulk.convertFrom(source.Bulk)
+	}
+	s.DiscardCloudEventContext = source.DiscardCEContext
+	if source.EventOptions != nil {
+		s.EventOptions = &EventOptions{}
+		s.EventOptions.convertFrom(source.EventOptions)
+	}
+	s.AdapterOverrides = source.AdapterOverrides
+}
+
+// convertTo copies the attributes of a Connection to its v1alpha1 counterpart.
+func (c *Connection) convertTo(sink *targetsv1alpha1.Connection) *apis.FieldError {
+	var errs *apis.FieldError
+
+	sink.Addresses = c.Addresses
+	sink.CACert = c.CACert
+	sink.SkipVerify = c.SkipVerify
+	sink.Username = c.Username
+	if c.Password != nil {
+		sink.Password = &targetsv1alpha1.SecretValueFromSource{}
+		errs = errs.Also(convertSecretValueTo(c.Password, sink.Password).ViaField("password"))
+	}
+	if c.APIKey != nil {
+		sink.APIKey = &targetsv1alpha1.SecretValueFromSource{}
+		errs = errs.Also(convertSecretValueTo(c.APIKey, sink.APIKey).ViaField("apiKey"))
+	}
+
+	return errs
+}
+
+// convertFrom copies the attributes of a v1alpha1 Connection.
+func (c *Connection) convertFrom(source *targetsv1alpha1.Connection) {
+	c.Addresses = source.Addresses
+	c.CACert = source.CACert
+	c.SkipVerify = source.SkipVerify
+	c.Username = source.Username
+	if source.Password != nil {
+		c.Password = &v1alpha1.ValueFromField{}
+		convertSecretValueFrom(source.Password, c.Password)
+	}
+	if source.APIKey != nil {
+		c.APIKey = &v1alpha1.ValueFromField{}
+		convertSecretValueFrom(source.APIKey, c.APIKey)
+	}
+}
+
+// convertTo copies the attributes of an ElasticsearchTargetBulk to its v1alpha1 counterpart.
+func (e *ElasticsearchTargetBulk) convertTo(sink *targetsv1alpha1.ElasticsearchTargetBulk) {
+	sink.MaxActions = e.MaxActions
+	sink.MaxBytes = e.MaxBytes
+	sink.FlushInterval = e.FlushInterval
+}
+
+// convertFrom copies the attributes of a v1alpha1 ElasticsearchTargetBulk.
+func (e *ElasticsearchTargetBulk) convertFrom(source *targetsv1alpha1.ElasticsearchTargetBulk) {
+	e.MaxActions = source.MaxActions
+	e.MaxBytes = source.MaxBytes
+	e.FlushInterval = source.FlushInterval
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/triggermesh/triggermesh/pkg/apis"
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ElasticsearchTarget is the Schema for an Elasticsearch Target.
+type ElasticsearchTarget struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ElasticsearchTargetSpec `json:"spec"`
+	Status v1alpha1.Status         `json:"status,omitempty"`
+}
+
+// ElasticsearchTargetSpec defines the desired state of the event target.
+type ElasticsearchTargetSpec struct {
+	// Connection information to elasticsearch.
+	// +optional
+	Connection Connection `json:"connection"`
+
+	// IndexName to write to.
+	// The name may contain placeholders enclosed in curly braces, which are
+	// rendered for each event: either a date pattern composed of the tokens
+	// yyyy, yy, MM, dd, HH, mm and ss, rendered with the time of the event,
+	// or the name of a CloudEvent context attribute or extension
+	// (e.g. 'events-{type}-{yyyy.MM.dd}').
+	IndexName string `json:"indexName"`
+
+	// Path of the ID of indexed documents within the event data, in GJSON
+	// syntax. When not set (default), the ID of the event is used.
+	// https://github.com/tidwall/gjson/blob/master/SYNTAX.md
+	// +optional
+	DocumentIDPath *string `json:"documentIDPath,omitempty"`
+
+	// Name of the ingest pipeline used to pre-process indexed documents.
+	// +optional
+	Pipeline *string `json:"pipeline,omitempty"`
+
+	// Thresholds of the requests sent to the Bulk API.
+	// +optional
+	Bulk *ElasticsearchTargetBulk `json:"bulk,omitempty"`
+
+	// Whether to omit CloudEvent context attributes in documents created in Elasticsearch.
+	// When this property is false (default), the entire CloudEvent payload is included.
+	// When this property is true, only the CloudEvent data is included.
+	DiscardCloudEventContext bool `json:"discardCloudEventContext"`
+
+	// EventOptions for targets.
+	EventOptions *EventOptions `json:"eventOptions,omitempty"`
+
+	// Adapter spec overrides parameters.
+	// +optional
+	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
+}
+
+// Connection contains connection and configuration parameters
+type Connection struct {
+	// Array of hostnames or IP addresses to connect the target to.
+	Addresses []string `json:"addresses,omitempty"`
+	// CA Certificate used to verify connection with the Elasticsearch instance.
+	CACert *string `json:"caCert,omitempty"`
+	// Skip verification of the SSL certificate during the connection.
+	SkipVerify *bool `json:"skipVerify,omitempty"`
+
+	// Elasticsearch account username.
+	Username *string `json:"username,omitempty"`
+	// Elasticsearch account password.
+	Password *v1alpha1.ValueFromField `json:"password,omitempty"`
+
+	// When informed supersedes username and password.
+	APIKey *v1alpha1.ValueFromField `json:"apiKey,omitempty"`
+}
+
+// ElasticsearchTargetBulk defines the thresholds which trigger the sending of
+// a request to the Bulk API.
+type ElasticsearchTargetBulk struct {
+	// Maximum number of documents indexed in a single request.
+	// Defaults to 500.
+	// +optional
+	MaxActions *int32 `json:"maxActions,omitempty"`
+
+	// Maximum size, in bytes, of the body of a single request.
+	// Defaults to 5MiB.
+	// +optional
+	MaxBytes *int64 `json:"maxBytes,omitempty"`
+
+	// Maximum duration documents are buffered for before being indexed.
+	// Defaults to 1s.
+	//
+	// Events are only acknowledged once their document has been indexed,
+	// so this duration should remain lower than the request timeout of
+	// event senders.
+	// +optional
+	FlushInterval *apis.Duration `json:"flushInterval,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ElasticsearchTargetList is a list of event target instances.
+type ElasticsearchTargetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []ElasticsearchTarget `json:"items"`
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+
+	"knative.dev/pkg/apis"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+	targetsv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
+)
+
+// ConvertTo implements apis.Convertible.
+func (t *HasuraTarget) ConvertTo(_ context.Context, to apis.Convertible) error {
+	switch sink := to.(type) {
+	case *targetsv1alpha1.HasuraTarget:
+		sink.ObjectMeta = t.ObjectMeta
+		sink.Status = t.Status
+		if err := t.Spec.convertTo(&sink.Spec); err != nil {
+			return err.ViaField("spec")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", sink)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *HasuraTarget) ConvertFrom(_ context.Context, from apis.Convertible) error {
+	switch source := from.(type) {
+	case *targetsv1alpha1.HasuraTarget:
+		t.ObjectMeta = source.ObjectMeta
+		t.Spec.convertFrom(&source.Spec)
+		t.Status = source.Status
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", source)
+	}
+}
+
+// convertTo copies the attributes of a HasuraTargetSpec to its v1alpha1 counterpart.
+func (s *HasuraTargetSpec) convertTo(sink *targetsv1alpha1.HasuraTargetSpec) *apis.FieldError {
+	var errs *apis.FieldError
+
+	sink.Endpoint = s.Endpoint
+	if s.JwtToken != nil {
+		sink.JwtToken = &targetsv1alpha1.SecretValueFromSource{}
+		errs = errs.Also(convertSecretValueTo(s.JwtToken, sink.JwtToken).ViaField("jwt"))
+	}
+	if s.AdminToken != nil {
+		sink.AdminToken = &targetsv1alpha1.SecretValueFromSource{}
+		errs = errs.Also(convertSecretValueTo(s.AdminToken, sink.AdminToken).ViaField("admin"))
+	}
+	sink.DefaultRole = s.DefaultRole
+	sink.Queries = s.Queries
+	sink.AdapterOverrides = s.AdapterOverrides
+
+	return errs
+}
+
+// convertFrom copies the attributes of a v1alpha1 HasuraTargetSpec.
+func (s *HasuraTargetSpec) convertFrom(source *targetsv1alpha1.HasuraTargetSpec) {
+	s.Endpoint = source.Endpoint
+	if source.JwtToken != nil {
+		s.JwtToken = &v1alpha1.ValueFromField{}
+		convertSecretValueFrom(source.JwtToken, s.JwtToken)
+	}
+	if source.AdminToken != nil {
+		s.AdminToken = &v1alpha1.ValueFromField{}
+		convertSecretValueFrom(source.AdminToken, s.AdminToken)
+	}
+	s.DefaultRole = source.DefaultRole
+	s.Queries = source.Queries
+	s.AdapterOverrides = source.AdapterOverrides
+}
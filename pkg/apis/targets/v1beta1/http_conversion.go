@@ -0,0 +1,104 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+
+	"knative.dev/pkg/apis"
+
+	targetsv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
+)
+
+// ConvertTo implements apis.Convertible.
+func (t *HTTPTarget) ConvertTo(_ context.Context, to apis.Convertible) error {
+	switch sink := to.(type) {
+	case *targetsv1alpha1.HTTPTarget:
+		sink.ObjectMeta = t.ObjectMeta
+		sink.Status = t.Status
+		if err := t.Spec.convertTo(&sink.Spec); err != nil {
+			return err.ViaField("spec")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", sink)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *HTTPTarget) ConvertFrom(_ context.Context, from apis.Convertible) error {
+	switch source := from.(type) {
+	case *targetsv1alpha1.HTTPTarget:
+		t.ObjectMeta = source.ObjectMeta
+		t.Spec.convertFrom(&source.Spec)
+		t.Status = source.Status
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", source)
+	}
+}
+
+// convertTo copies the attributes of an HTTPTargetSpec to its v1alpha1 counterpart.
+func (s *HTTPTargetSpec) convertTo(sink *targetsv1alpha1.HTTPTargetSpec) *apis.FieldError {
+	var errs *apis.FieldError
+
+	s.EventOptions.convertTo(&sink.Response)
+	sink.Endpoint = s.Endpoint
+	sink.Method = s.Method
+	sink.Headers = s.Headers
+	sink.SkipVerify = s.SkipVerify
+	sink.CACertificate = s.CACertificate
+	sink.BasicAuthUsername = s.BasicAuthUsername
+	errs = errs.Also(convertSecretValueTo(&s.BasicAuthPassword, &sink.BasicAuthPassword).ViaField("basicAuthPassword"))
+	sink.OAuthClientID = s.OAuthClientID
+	errs = errs.Also(convertSecretValueTo(&s.OAuthClientSecret, &sink.OAuthClientSecret).ViaField("oauthClientSecret"))
+	sink.OAuthTokenURL = s.OAuthTokenURL
+	sink.OAuthScopes = s.OAuthScopes
+	sink.AdapterOverrides = s.AdapterOverrides
+
+	return errs
+}
+
+// convertFrom copies the attributes of a v1alpha1 HTTPTargetSpec.
+func (s *HTTPTargetSpec) convertFrom(source *targetsv1alpha1.HTTPTargetSpec) {
+	s.EventOptions.convertFrom(&source.Response)
+	s.Endpoint = source.Endpoint
+	s.Method = source.Method
+	s.Headers = source.Headers
+	s.SkipVerify = source.SkipVerify
+	s.CACertificate = source.CACertificate
+	s.BasicAuthUsername = source.BasicAuthUsername
+	convertSecretValueFrom(&source.BasicAuthPassword, &s.BasicAuthPassword)
+	s.OAuthClientID = source.OAuthClientID
+	convertSecretValueFrom(&source.OAuthClientSecret, &s.OAuthClientSecret)
+	s.OAuthTokenURL = source.OAuthTokenURL
+	s.OAuthScopes = source.OAuthScopes
+	s.AdapterOverrides = source.AdapterOverrides
+}
+
+// convertTo copies the attributes of an HTTPEventOptions to its v1alpha1 counterpart.
+func (o *HTTPEventOptions) convertTo(sink *targetsv1alpha1.HTTPEventResponse) {
+	sink.EventType = o.EventType
+	sink.EventSource = o.EventSource
+}
+
+// convertFrom copies the attributes of a v1alpha1 HTTPEventResponse.
+func (o *HTTPEventOptions) convertFrom(source *targetsv1alpha1.HTTPEventResponse) {
+	o.EventType = source.EventType
+	o.EventSource = source.EventSource
+}
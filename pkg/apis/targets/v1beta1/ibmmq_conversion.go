@@ -0,0 +1,150 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+
+	"knative.dev/pkg/apis"
+
+	targetsv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
+)
+
+// ConvertTo implements apis.Convertible.
+func (t *IBMMQTarget) ConvertTo(_ context.Context, to apis.Convertible) error {
+	switch sink := to.(type) {
+	case *targetsv1alpha1.IBMMQTarget:
+		sink.ObjectMeta = t.ObjectMeta
+		t.Spec.convertTo(&sink.Spec)
+		sink.Status = t.Status
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", sink)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *IBMMQTarget) ConvertFrom(_ context.Context, from apis.Convertible) error {
+	switch source := from.(type) {
+	case *targetsv1alpha1.IBMMQTarget:
+		t.ObjectMeta = source.ObjectMeta
+		t.Spec.convertFrom(&source.Spec)
+		t.Status = source.Status
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", source)
+	}
+}
+
+// convertTo copies the attributes of an IBMMQTargetSpec to its v1alpha1 counterpart.
+func (s *IBMMQTargetSpec) convertTo(sink *targetsv1alpha1.IBMMQTargetSpec) {
+	sink.ConnectionName = s.ConnectionName
+	sink.QueueManager = s.QueueManager
+	sink.QueueName = s.QueueName
+	sink.ChannelName = s.ChannelName
+	if s.ReplyTo != nil {
+		sink.ReplyTo = &targetsv1alpha1.MQReplyOptions{}
+		s.ReplyTo.convertTo(sink.ReplyTo)
+	}
+	s.Auth.convertTo(&sink.Auth)
+	if s.EventOptions != nil {
+		sink.EventOptions = &targetsv1alpha1.EventOptions{}
+		s.EventOptions.convertTo(sink.EventOptions)
+	}
+	sink.DiscardCEContext = s.DiscardCloudEventContext
+	sink.AdapterOverrides = s.AdapterOverrides
+}
+
+// convertFrom copies the attributes of a v1alpha1 IBMMQTargetSpec.
+func (s *IBMMQTargetSpec) convertFrom(source *targetsv1alpha1.IBMMQTargetSpec) {
+	s.ConnectionName = source.ConnectionName
+	s.QueueManager = source.QueueManager
+	s.QueueName = source.QueueName
+	s.ChannelName = source.ChannelName
+	if source.ReplyTo != nil {
+		s.ReplyTo = &MQReplyOptions{}
+		s.ReplyTo.convertFrom(source.ReplyTo)
+	}
+	s.Auth.convertFrom(&source.Auth)
+	if source.EventOptions != nil {
+		s.EventOptions = &EventOptions{}
+		s.EventOptions.convertFrom(source.EventOptions)
+	}
+	s.DiscardCloudEventContext = source.DiscardCEContext
+	s.AdapterOverrides = source.AdapterOverrides
+}
+
+// convertTo copies the attributes of a MQReplyOptions to its v1alpha1 counterpart.
+func (o *MQReplyOptions) convertTo(sink *targetsv1alpha1.MQReplyOptions) {
+	sink.QueueManager = o.QueueManager
+	sink.QueueName = o.QueueName
+}
+
+// convertFrom copies the attributes of a v1alpha1 MQReplyOptions.
+func (o *MQReplyOptions) convertFrom(source *targetsv1alpha1.MQReplyOptions) {
+	o.QueueManager = source.QueueManager
+	o.QueueName = source.QueueName
+}
+
+// convertTo copies the attributes of a Credentials to its v1alpha1 counterpart.
+func (c *Credentials) convertTo(sink *targetsv1alpha1.Credentials) {
+	sink.User = c.User
+	sink.Password = c.Password
+	if c.TLS != nil {
+		sink.TLS = &targetsv1alpha1.TLSSpec{}
+		c.TLS.convertTo(sink.TLS)
+	}
+}
+
+// convertFrom copies the attributes of a v1alpha1 Credentials.
+func (c *Credentials) convertFrom(source *targetsv1alpha1.Credentials) {
+	c.User = source.User
+	c.Password = source.Password
+	if source.TLS != nil {
+		c.TLS = &TLSSpec{}
+		c.TLS.convertFrom(source.TLS)
+	}
+}
+
+// convertTo copies the attributes of a TLSSpec to its v1alpha1 counterpart.
+func (s *TLSSpec) convertTo(sink *targetsv1alpha1.TLSSpec) {
+	sink.Cipher = s.Cipher
+	sink.ClientAuthRequired = s.ClientAuthRequired
+	sink.CertLabel = s.CertLabel
+	s.KeyRepository.convertTo(&sink.KeyRepository)
+}
+
+// convertFrom copies the attributes of a v1alpha1 TLSSpec.
+func (s *TLSSpec) convertFrom(source *targetsv1alpha1.TLSSpec) {
+	s.Cipher = source.Cipher
+	s.ClientAuthRequired = source.ClientAuthRequired
+	s.CertLabel = source.CertLabel
+	s.KeyRepository.convertFrom(&source.KeyRepository)
+}
+
+// convertTo copies the attributes of a Keystore to its v1alpha1 counterpart.
+func (k *Keystore) convertTo(sink *targetsv1alpha1.Keystore) {
+	sink.KeyDatabase = k.KeyDatabase
+	sink.PasswordStash = k.PasswordStash
+}
+
+// convertFrom copies the attributes of a v1alpha1 Keystore.
+func (k *Keystore) convertFrom(source *targetsv1alpha1.Keystore) {
+	k.KeyDatabase = source.KeyDatabase
+	k.PasswordStash = source.PasswordStash
+}
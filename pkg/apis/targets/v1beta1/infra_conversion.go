@@ -0,0 +1,110 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+
+	"knative.dev/pkg/apis"
+
+	targetsv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
+)
+
+// ConvertTo implements apis.Convertible.
+func (t *InfraTarget) ConvertTo(_ context.Context, to apis.Convertible) error {
+	switch sink := to.(type) {
+	case *targetsv1alpha1.InfraTarget:
+		sink.ObjectMeta = t.ObjectMeta
+		t.Spec.convertTo(&sink.Spec)
+		sink.Status = t.Status
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", sink)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *InfraTarget) ConvertFrom(_ context.Context, from apis.Convertible) error {
+	switch source := from.(type) {
+	case *targetsv1alpha1.InfraTarget:
+		t.ObjectMeta = source.ObjectMeta
+		t.Spec.convertFrom(&source.Spec)
+		t.Status = source.Status
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", source)
+	}
+}
+
+// convertTo copies the attributes of an InfraTargetSpec to its v1alpha1 counterpart.
+func (s *InfraTargetSpec) convertTo(sink *targetsv1alpha1.InfraTargetSpec) {
+	if s.Script != nil {
+		sink.Script = &targetsv1alpha1.InfraTargetScript{}
+		s.Script.convertTo(sink.Script)
+	}
+	if s.State != nil {
+		sink.State = &targetsv1alpha1.InfraTargetState{}
+		s.State.convertTo(sink.State)
+	}
+	sink.TypeLoopProtection = s.TypeLoopProtection
+	sink.SourceSpec = s.SourceSpec
+	sink.AdapterOverrides = s.AdapterOverrides
+}
+
+// convertFrom copies the attributes of a v1alpha1 InfraTargetSpec.
+func (s *InfraTargetSpec) convertFrom(source *targetsv1alpha1.InfraTargetSpec) {
+	if source.Script != nil {
+		s.Script = &InfraTargetScript{}
+		s.Script.convertFrom(source.Script)
+	}
+	if source.State != nil {
+		s.State = &InfraTargetState{}
+		s.State.convertFrom(source.State)
+	}
+	s.TypeLoopProtection = source.TypeLoopProtection
+	s.SourceSpec = source.SourceSpec
+	s.AdapterOverrides = source.AdapterOverrides
+}
+
+// convertTo copies the attributes of an InfraTargetScript to its v1alpha1 counterpart.
+func (i *InfraTargetScript) convertTo(sink *targetsv1alpha1.InfraTargetScript) {
+	sink.Code = i.Code
+	sink.Timeout = i.Timeout
+	sink.Engine = (*targetsv1alpha1.ScriptEngine)(i.Engine)
+}
+
+// convertFrom copies the attributes of a v1alpha1 InfraTargetScript.
+func (i *InfraTargetScript) convertFrom(source *targetsv1alpha1.InfraTargetScript) {
+	i.Code = source.Code
+	i.Timeout = source.Timeout
+	i.Engine = (*ScriptEngine)(source.Engine)
+}
+
+// convertTo copies the attributes of an InfraTargetState to its v1alpha1 counterpart.
+func (i *InfraTargetState) convertTo(sink *targetsv1alpha1.InfraTargetState) {
+	sink.HeadersPolicy = (*targetsv1alpha1.HeaderPolicy)(i.HeadersPolicy)
+	sink.Bridge = i.Bridge
+	sink.TTL = i.TTL
+}
+
+// convertFrom copies the attributes of a v1alpha1 InfraTargetState.
+func (i *InfraTargetState) convertFrom(source *targetsv1alpha1.InfraTargetState) {
+	i.HeadersPolicy = (*HeaderPolicy)(source.HeadersPolicy)
+	i.Bridge = source.Bridge
+	i.TTL = source.TTL
+}
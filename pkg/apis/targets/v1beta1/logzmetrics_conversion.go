@@ -0,0 +1,122 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+
+	"knative.dev/pkg/apis"
+
+	targetsv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
+)
+
+// ConvertTo implements apis.Convertible.
+func (t *LogzMetricsTarget) ConvertTo(_ context.Context, to apis.Convertible) error {
+	switch sink := to.(type) {
+	case *targetsv1alpha1.LogzMetricsTarget:
+		sink.ObjectMeta = t.ObjectMeta
+		sink.Status = t.Status
+		if err := t.Spec.convertTo(&sink.Spec); err != nil {
+			return err.ViaField("spec")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", sink)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *LogzMetricsTarget) ConvertFrom(_ context.Context, from apis.Convertible) error {
+	switch source := from.(type) {
+	case *targetsv1alpha1.LogzMetricsTarget:
+		t.ObjectMeta = source.ObjectMeta
+		t.Spec.convertFrom(&source.Spec)
+		t.Status = source.Status
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", source)
+	}
+}
+
+// convertTo copies the attributes of a LogzMetricsTargetSpec to its v1alpha1 counterpart.
+func (s *LogzMetricsTargetSpec) convertTo(sink *targetsv1alpha1.LogzMetricsTargetSpec) *apis.FieldError {
+	var errs *apis.FieldError
+
+	errs = errs.Also(s.Connection.convertTo(&sink.Connection).ViaField("connection"))
+	if s.Instruments != nil {
+		sink.Instruments = make([]targetsv1alpha1.Instrument, len(s.Instruments))
+		for i := range s.Instruments {
+			s.Instruments[i].convertTo(&sink.Instruments[i])
+		}
+	}
+	if s.EventOptions != nil {
+		sink.EventOptions = &targetsv1alpha1.EventOptions{}
+		s.EventOptions.convertTo(sink.EventOptions)
+	}
+	sink.AdapterOverrides = s.AdapterOverrides
+
+	return errs
+}
+
+// convertFrom copies the attributes of a v1alpha1 LogzMetricsTargetSpec.
+func (s *LogzMetricsTargetSpec) convertFrom(source *targetsv1alpha1.LogzMetricsTargetSpec) {
+	s.Connection.convertFrom(&source.Connection)
+	if source.Instruments != nil {
+		s.Instruments = make([]Instrument, len(source.Instruments))
+		for i := range source.Instruments {
+			s.Instruments[i].convertFrom(&source.Instruments[i])
+		}
+	}
+	if source.EventOptions != nil {
+		s.EventOptions = &EventOptions{}
+		s.EventOptions.convertFrom(source.EventOptions)
+	}
+	s.AdapterOverrides = source.AdapterOverrides
+}
+
+// convertTo copies the attributes of a LogzMetricsConnection to its v1alpha1 counterpart.
+func (l *LogzMetricsConnection) convertTo(sink *targetsv1alpha1.LogzMetricsConnection) *apis.FieldError {
+	var errs *apis.FieldError
+
+	errs = errs.Also(convertSecretValueTo(&l.Token, &sink.Token).ViaField("token"))
+	sink.ListenerURL = l.ListenerURL
+
+	return errs
+}
+
+// convertFrom copies the attributes of a v1alpha1 LogzMetricsConnection.
+func (l *LogzMetricsConnection) convertFrom(source *targetsv1alpha1.LogzMetricsConnection) {
+	convertSecretValueFrom(&source.Token, &l.Token)
+	l.ListenerURL = source.ListenerURL
+}
+
+// convertTo copies the attributes of an Instrument to its v1alpha1 counterpart.
+func (i *Instrument) convertTo(sink *targetsv1alpha1.Instrument) {
+	sink.Name = i.Name
+	sink.Description = i.Description
+	sink.Instrument = targetsv1alpha1.InstrumentKind(i.Instrument)
+	sink.Number = targetsv1alpha1.NumberKind(i.Number)
+}
+
+// convertFrom copies the attributes of a v1alpha1 Instrument.
+func (i *Instrument) convertFrom(source *targetsv1alpha1.Instrument) {
+	i.Name = source.Name
+	i.Description = source.Description
+	i.Instrument = InstrumentKind(source.Instrument)
+	i.Number = NumberKind(source.Number)
+}
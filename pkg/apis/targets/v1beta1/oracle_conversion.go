@@ -0,0 +1,98 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+
+	"knative.dev/pkg/apis"
+
+	targetsv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
+)
+
+// ConvertTo implements apis.Convertible.
+func (t *OracleTarget) ConvertTo(_ context.Context, to apis.Convertible) error {
+	switch sink := to.(type) {
+	case *targetsv1alpha1.OracleTarget:
+		sink.ObjectMeta = t.ObjectMeta
+		sink.Status = t.Status
+		if err := t.Spec.convertTo(&sink.Spec); err != nil {
+			return err.ViaField("spec")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", sink)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *OracleTarget) ConvertFrom(_ context.Context, from apis.Convertible) error {
+	switch source := from.(type) {
+	case *targetsv1alpha1.OracleTarget:
+		t.ObjectMeta = source.ObjectMeta
+		t.Spec.convertFrom(&source.Spec)
+		t.Status = source.Status
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", source)
+	}
+}
+
+// convertTo copies the attributes of an OracleTargetSpec to its v1alpha1 counterpart.
+func (s *OracleTargetSpec) convertTo(sink *targetsv1alpha1.OracleTargetSpec) *apis.FieldError {
+	var errs *apis.FieldError
+
+	errs = errs.Also(convertSecretValueTo(&s.OracleAPIPrivateKey, &sink.OracleAPIPrivateKey).ViaField("oracleApiPrivateKey"))
+	errs = errs.Also(convertSecretValueTo(&s.OracleAPIPrivateKeyPassphrase, &sink.OracleAPIPrivateKeyPassphrase).ViaField("oracleApiPrivateKeyPassphrase"))
+	errs = errs.Also(convertSecretValueTo(&s.OracleAPIPrivateKeyFingerprint, &sink.OracleAPIPrivateKeyFingerprint).ViaField("oracleApiPrivateKeyFingerprint"))
+	sink.Tenancy = s.Tenancy
+	sink.User = s.User
+	sink.Region = s.Region
+	if s.OracleFunctionSpec != nil {
+		sink.OracleFunctionSpec = &targetsv1alpha1.OracleFunctionSpecSpec{}
+		s.OracleFunctionSpec.convertTo(sink.OracleFunctionSpec)
+	}
+	sink.AdapterOverrides = s.AdapterOverrides
+
+	return errs
+}
+
+// convertFrom copies the attributes of a v1alpha1 OracleTargetSpec.
+func (s *OracleTargetSpec) convertFrom(source *targetsv1alpha1.OracleTargetSpec) {
+	convertSecretValueFrom(&source.OracleAPIPrivateKey, &s.OracleAPIPrivateKey)
+	convertSecretValueFrom(&source.OracleAPIPrivateKeyPassphrase, &s.OracleAPIPrivateKeyPassphrase)
+	convertSecretValueFrom(&source.OracleAPIPrivateKeyFingerprint, &s.OracleAPIPrivateKeyFingerprint)
+	s.Tenancy = source.Tenancy
+	s.User = source.User
+	s.Region = source.Region
+	if source.OracleFunctionSpec != nil {
+		s.OracleFunctionSpec = &OracleFunctionSpecSpec{}
+		s.OracleFunctionSpec.convertFrom(source.OracleFunctionSpec)
+	}
+	s.AdapterOverrides = source.AdapterOverrides
+}
+
+// convertTo copies the attributes of an OracleFunctionSpecSpec to its v1alpha1 counterpart.
+func (s *OracleFunctionSpecSpec) convertTo(sink *targetsv1alpha1.OracleFunctionSpecSpec) {
+	sink.Function = s.Function
+}
+
+// convertFrom copies the attributes of a v1alpha1 OracleFunctionSpecSpec.
+func (s *OracleFunctionSpecSpec) convertFrom(source *targetsv1alpha1.OracleFunctionSpecSpec) {
+	s.Function = source.Function
+}
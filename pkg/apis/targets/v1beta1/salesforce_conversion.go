@@ -0,0 +1,100 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+
+	"knative.dev/pkg/apis"
+
+	targetsv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
+)
+
+// ConvertTo implements apis.Convertible.
+func (t *SalesforceTarget) ConvertTo(_ context.Context, to apis.Convertible) error {
+	switch sink := to.(type) {
+	case *targetsv1alpha1.SalesforceTarget:
+		sink.ObjectMeta = t.ObjectMeta
+		sink.Status = t.Status
+		if err := t.Spec.convertTo(&sink.Spec); err != nil {
+			return err.ViaField("spec")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", sink)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *SalesforceTarget) ConvertFrom(_ context.Context, from apis.Convertible) error {
+	switch source := from.(type) {
+	case *targetsv1alpha1.SalesforceTarget:
+		t.ObjectMeta = source.ObjectMeta
+		t.Spec.convertFrom(&source.Spec)
+		t.Status = source.Status
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", source)
+	}
+}
+
+// convertTo copies the attributes of a SalesforceTargetSpec to its v1alpha1 counterpart.
+func (s *SalesforceTargetSpec) convertTo(sink *targetsv1alpha1.SalesforceTargetSpec) *apis.FieldError {
+	var errs *apis.FieldError
+
+	errs = errs.Also(s.Auth.convertTo(&sink.Auth).ViaField("auth"))
+	sink.APIVersion = s.APIVersion
+	if s.EventOptions != nil {
+		sink.EventOptions = &targetsv1alpha1.EventOptions{}
+		s.EventOptions.convertTo(sink.EventOptions)
+	}
+	sink.AdapterOverrides = s.AdapterOverrides
+
+	return errs
+}
+
+// convertFrom copies the attributes of a v1alpha1 SalesforceTargetSpec.
+func (s *SalesforceTargetSpec) convertFrom(source *targetsv1alpha1.SalesforceTargetSpec) {
+	s.Auth.convertFrom(&source.Auth)
+	s.APIVersion = source.APIVersion
+	if source.EventOptions != nil {
+		s.EventOptions = &EventOptions{}
+		s.EventOptions.convertFrom(source.EventOptions)
+	}
+	s.AdapterOverrides = source.AdapterOverrides
+}
+
+// convertTo copies the attributes of a SalesforceAuth to its v1alpha1 counterpart.
+func (s *SalesforceAuth) convertTo(sink *targetsv1alpha1.SalesforceAuth) *apis.FieldError {
+	var errs *apis.FieldError
+
+	sink.ClientID = s.ClientID
+	sink.Server = s.Server
+	sink.User = s.User
+	errs = errs.Also(convertSecretValueTo(&s.CertKey, &sink.CertKey).ViaField("certKey"))
+
+	return errs
+}
+
+// convertFrom copies the attributes of a v1alpha1 SalesforceAuth.
+func (s *SalesforceAuth) convertFrom(source *targetsv1alpha1.SalesforceAuth) {
+	s.ClientID = source.ClientID
+	s.Server = source.Server
+	s.User = source.User
+	convertSecretValueFrom(&source.CertKey, &s.CertKey)
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+
+	"knative.dev/pkg/apis"
+
+	targetsv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
+)
+
+// ConvertTo implements apis.Convertible.
+func (t *TektonTarget) ConvertTo(_ context.Context, to apis.Convertible) error {
+	switch sink := to.(type) {
+	case *targetsv1alpha1.TektonTarget:
+		sink.ObjectMeta = t.ObjectMeta
+		t.Spec.convertTo(&sink.Spec)
+		sink.Status = t.Status
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", sink)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *TektonTarget) ConvertFrom(_ context.Context, from apis.Convertible) error {
+	switch source := from.(type) {
+	case *targetsv1alpha1.TektonTarget:
+		t.ObjectMeta = source.ObjectMeta
+		t.Spec.convertFrom(&source.Spec)
+		t.Status = source.Status
+		return nil
+	default:
+		return fmt.Errorf("unknown version, got: %T", source)
+	}
+}
+
+// convertTo copies the attributes of a TektonTargetSpec to its v1alpha1 counterpart.
+func (s *TektonTargetSpec) convertTo(sink *targetsv1alpha1.TektonTargetSpec) {
+	if s.ReapPolicy != nil {
+		sink.ReapPolicy = &targetsv1alpha1.TektonTargetReapPolicy{}
+		s.ReapPolicy.convertTo(sink.ReapPolicy)
+	}
+	sink.AdapterOverrides = s.AdapterOverrides
+}
+
+// convertFrom copies the attributes of a v1alpha1 TektonTargetSpec.
+func (s *TektonTargetSpec) convertFrom(source *targetsv1alpha1.TektonTargetSpec) {
+	if source.ReapPolicy != nil {
+		s.ReapPolicy = &TektonTargetReapPolicy{}
+		s.ReapPolicy.convertFrom(source.ReapPolicy)
+	}
+	s.AdapterOverrides = source.AdapterOverrides
+}
+
+// convertTo copies the attributes of a TektonTargetReapPolicy to its v1alpha1 counterpart.
+func (t *TektonTargetReapPolicy) convertTo(sink *targetsv1alpha1.TektonTargetReapPolicy) {
+	sink.ReapSuccessAge = t.ReapSuccessAge
+	sink.ReapFailAge = t.ReapFailAge
+}
+
+// convertFrom copies the attributes of a v1alpha1 TektonTargetReapPolicy.
+func (t *TektonTargetReapPolicy) convertFrom(source *targetsv1alpha1.TektonTargetReapPolicy) {
+	t.ReapSuccessAge = source.ReapSuccessAge
+	t.ReapFailAge = source.ReapFailAge
+}
@@ -28,6 +28,10 @@ type FakeFlowV1alpha1 struct {
 	*testing.Fake
 }
 
+func (c *FakeFlowV1alpha1) Aggregators(namespace string) v1alpha1.AggregatorInterface {
+	return &FakeAggregators{c, namespace}
+}
+
 func (c *FakeFlowV1alpha1) DataWeaveTransformations(namespace string) v1alpha1.DataWeaveTransformationInterface {
 	return &FakeDataWeaveTransformations{c, namespace}
 }
@@ -28,6 +28,7 @@ import (
 
 type FlowV1alpha1Interface interface {
 	RESTClient() rest.Interface
+	AggregatorsGetter
 	DataWeaveTransformationsGetter
 	JQTransformationsGetter
 	SynchronizersGetter
@@ -41,6 +42,10 @@ type FlowV1alpha1Client struct {
 	restClient rest.Interface
 }
 
+func (c *FlowV1alpha1Client) Aggregators(namespace string) AggregatorInterface {
+	return newAggregators(c, namespace)
+}
+
 func (c *FlowV1alpha1Client) DataWeaveTransformations(namespace string) DataWeaveTransformationInterface {
 	return newDataWeaveTransformations(c, namespace)
 }
@@ -18,6 +18,8 @@ limitations under the License.
 
 package v1alpha1
 
+type AggregatorExpansion interface{}
+
 type DataWeaveTransformationExpansion interface{}
 
 type JQTransformationExpansion interface{}
@@ -24,6 +24,8 @@ import (
 
 // Interface provides access to all the informers in this group version.
 type Interface interface {
+	// Aggregators returns a AggregatorInformer.
+	Aggregators() AggregatorInformer
 	// DataWeaveTransformations returns a DataWeaveTransformationInformer.
 	DataWeaveTransformations() DataWeaveTransformationInformer
 	// JQTransformations returns a JQTransformationInformer.
@@ -49,6 +51,11 @@ func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakList
 	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
 }
 
+// Aggregators returns a AggregatorInformer.
+func (v *version) Aggregators() AggregatorInformer {
+	return &aggregatorInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // DataWeaveTransformations returns a DataWeaveTransformationInformer.
 func (v *version) DataWeaveTransformations() DataWeaveTransformationInformer {
 	return &dataWeaveTransformationInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -61,6 +61,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Extensions().V1alpha1().Functions().Informer()}, nil
 
 		// Group=flow.triggermesh.io, Version=v1alpha1
+	case flowv1alpha1.SchemeGroupVersion.WithResource("aggregators"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Flow().V1alpha1().Aggregators().Informer()}, nil
 	case flowv1alpha1.SchemeGroupVersion.WithResource("dataweavetransformations"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Flow().V1alpha1().DataWeaveTransformations().Informer()}, nil
 	case flowv1alpha1.SchemeGroupVersion.WithResource("jqtransformations"):
@@ -139,6 +141,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Sources().V1alpha1().HTTPPollerSources().Informer()}, nil
 	case sourcesv1alpha1.SchemeGroupVersion.WithResource("ibmmqsources"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Sources().V1alpha1().IBMMQSources().Informer()}, nil
+	case sourcesv1alpha1.SchemeGroupVersion.WithResource("kafkasources"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Sources().V1alpha1().KafkaSources().Informer()}, nil
 	case sourcesv1alpha1.SchemeGroupVersion.WithResource("ocimetricssources"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Sources().V1alpha1().OCIMetricsSources().Informer()}, nil
 	case sourcesv1alpha1.SchemeGroupVersion.WithResource("salesforcesources"):
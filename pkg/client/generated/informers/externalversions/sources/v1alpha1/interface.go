@@ -82,6 +82,8 @@ type Interface interface {
 	HTTPPollerSources() HTTPPollerSourceInformer
 	// IBMMQSources returns a IBMMQSourceInformer.
 	IBMMQSources() IBMMQSourceInformer
+	// KafkaSources returns a KafkaSourceInformer.
+	KafkaSources() KafkaSourceInformer
 	// OCIMetricsSources returns a OCIMetricsSourceInformer.
 	OCIMetricsSources() OCIMetricsSourceInformer
 	// SalesforceSources returns a SalesforceSourceInformer.
@@ -252,6 +254,11 @@ func (v *version) IBMMQSources() IBMMQSourceInformer {
 	return &iBMMQSourceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// KafkaSources returns a KafkaSourceInformer.
+func (v *version) KafkaSources() KafkaSourceInformer {
+	return &kafkaSourceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // OCIMetricsSources returns a OCIMetricsSourceInformer.
 func (v *version) OCIMetricsSources() OCIMetricsSourceInformer {
 	return &oCIMetricsSourceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -18,6 +18,14 @@ limitations under the License.
 
 package v1alpha1
 
+// AggregatorListerExpansion allows custom methods to be added to
+// AggregatorLister.
+type AggregatorListerExpansion interface{}
+
+// AggregatorNamespaceListerExpansion allows custom methods to be added to
+// AggregatorNamespaceLister.
+type AggregatorNamespaceListerExpansion interface{}
+
 // DataWeaveTransformationListerExpansion allows custom methods to be added to
 // DataWeaveTransformationLister.
 type DataWeaveTransformationListerExpansion interface{}
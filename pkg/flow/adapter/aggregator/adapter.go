@@ -0,0 +1,262 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aggregator
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/types"
+	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
+	"knative.dev/pkg/logging"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/flow"
+	"github.com/triggermesh/triggermesh/pkg/apis/flow/v1alpha1"
+	routingv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/routing/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/routing/eventfilter/cel"
+	targetce "github.com/triggermesh/triggermesh/pkg/targets/adapter/cloudevents"
+)
+
+var _ pkgadapter.Adapter = (*adapter)(nil)
+
+type adapter struct {
+	ceClient cloudevents.Client
+	logger   *zap.SugaredLogger
+
+	mt *pkgadapter.MetricTag
+
+	correlationKey string
+	count          int
+	expression     *cel.ConditionalFilter
+	timeout        time.Duration
+	mode           v1alpha1.AggregatorMode
+
+	groups   *storage
+	ceSource string
+	sinkURL  string
+	bridgeID string
+}
+
+// NewAdapter returns adapter implementation.
+func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClient cloudevents.Client) pkgadapter.Adapter {
+	logger := logging.FromContext(ctx)
+
+	mt := &pkgadapter.MetricTag{
+		ResourceGroup: flow.AggregatorResource.String(),
+		Namespace:     envAcc.GetNamespace(),
+		Name:          envAcc.GetName(),
+	}
+
+	env := envAcc.(*envAccessor)
+
+	var expression *cel.ConditionalFilter
+	if env.CompletionExpression != "" {
+		cond, err := cel.CompileExpression(env.CompletionExpression)
+		if err != nil {
+			logger.Panicw("Cannot compile completion expression", zap.Error(err))
+		}
+		expression = &cond
+	}
+
+	return &adapter{
+		ceClient: ceClient,
+		logger:   logger,
+
+		mt: mt,
+
+		correlationKey: env.CorrelationKey,
+		count:          env.CompletionCount,
+		expression:     expression,
+		timeout:        env.CompletionTimeout,
+		mode:           v1alpha1.AggregatorMode(env.AggregationMode),
+
+		ceSource: env.CESource,
+		sinkURL:  env.Sink,
+		bridgeID: env.BridgeIdentifier,
+	}
+}
+
+// Returns if stopCh is closed or Send() returns an error.
+func (a *adapter) Start(ctx context.Context) error {
+	a.logger.Info("Starting Aggregator Adapter")
+	ctx = pkgadapter.ContextWithMetricTag(ctx, a.mt)
+
+	a.groups = newStorage(a.timeout, func(id string, events []cloudevents.Event) {
+		a.logger.Debugf("Group %q timed out with %d event(s)", id, len(events))
+		a.deliver(ctx, id, events, 0, true)
+	})
+
+	return a.ceClient.StartReceiver(ctx, a.dispatch)
+}
+
+func (a *adapter) dispatch(ctx context.Context, event cloudevents.Event) cloudevents.Result {
+	a.logger.Debugf("Received the event: %s", event.String())
+
+	id, ok := correlationID(event, a.correlationKey)
+	if !ok {
+		return cloudevents.NewHTTPResult(http.StatusBadRequest, "event has no value for correlation key %q", a.correlationKey)
+	}
+
+	events, complete := a.groups.add(id, event, a.expectedCount(event), a.isLast(id, event))
+	if !complete {
+		return cloudevents.ResultACK
+	}
+
+	a.logger.Debugf("Group %q completed with %d event(s)", id, len(events))
+
+	return a.deliver(ctx, id, events, a.expectedCount(event), false)
+}
+
+// isLast returns whether the given event completes its group according to
+// the completion expression. An event for which the expression can not be
+// evaluated, e.g. because it lacks a referenced field, does not complete its
+// group.
+func (a *adapter) isLast(id string, event cloudevents.Event) bool {
+	if a.expression == nil {
+		return false
+	}
+
+	last, err := a.expression.Eval(event)
+	if err != nil {
+		a.logger.Warnw("Unable to evaluate completion expression", zap.String("group", id), zap.Error(err))
+		return false
+	}
+
+	return last
+}
+
+// deliver sends a single event aggregating the given group members to the
+// sink, and returns the result to respond with.
+// A group which the sink fails to accept is put back into the storage so that
+// its delivery is retried, up to maxDeliveryAttempts times. A group which can
+// not be aggregated is dropped, since its delivery can never succeed.
+func (a *adapter) deliver(ctx context.Context, id string, events []cloudevents.Event, expected int, partial bool) cloudevents.Result {
+	out, err := a.newGroupEvent(id, events, partial)
+	if err != nil {
+		a.groups.done(id)
+		a.logger.Errorw("Dropping group which can not be aggregated", zap.String("group", id),
+			zap.Int("events", len(events)), zap.Error(err))
+		return cloudevents.NewHTTPResult(http.StatusBadRequest, "unable to aggregate group %q: %v", id, err)
+	}
+
+	res := a.ceClient.Send(cloudevents.ContextWithTarget(ctx, a.sinkURL), *out)
+	if cloudevents.IsACK(res) {
+		a.groups.done(id)
+		return cloudevents.ResultACK
+	}
+
+	if a.groups.restore(id, events, expected) {
+		a.logger.Errorw("Unable to deliver group", zap.String("group", id), zap.Error(res))
+	} else {
+		a.logger.Errorw("Dropping group after too many failed delivery attempts", zap.String("group", id),
+			zap.Int("events", len(events)), zap.Error(res))
+	}
+
+	return cloudevents.NewHTTPResult(http.StatusBadGateway, "unable to deliver group %q: %v", id, res)
+}
+
+// newGroupEvent returns a single event aggregating the given group members.
+func (a *adapter) newGroupEvent(id string, events []cloudevents.Event, partial bool) (*cloudevents.Event, error) {
+	data, err := aggregate(events, a.mode)
+	if err != nil {
+		return nil, err
+	}
+
+	typ := v1alpha1.AggregatorGroupEventType
+	if partial {
+		typ = v1alpha1.AggregatorPartialGroupEventType
+	}
+
+	out := cloudevents.NewEvent()
+	out.SetID(uuid.New().String())
+	out.SetType(typ)
+	out.SetSource(a.ceSource)
+	setCorrelationID(&out, a.correlationKey, id)
+
+	if err := out.SetData(cloudevents.ApplicationJSON, data); err != nil {
+		return nil, fmt.Errorf("setting event data: %w", err)
+	}
+
+	if a.bridgeID != "" {
+		out.SetExtension(targetce.StatefulWorkflowHeader, a.bridgeID)
+	}
+
+	return &out, nil
+}
+
+// expectedCount returns the number of events expected in the group of the
+// given event, or 0 if it is unknown.
+func (a *adapter) expectedCount(event cloudevents.Event) int {
+	if a.count > 0 {
+		return a.count
+	}
+
+	ext, exists := event.Extensions()[routingv1alpha1.SplitterCountExtension]
+	if !exists {
+		return 0
+	}
+	count, err := types.ToInteger(ext)
+	if err != nil {
+		return 0
+	}
+	return int(count)
+}
+
+// correlationID returns the value of the correlation key in the context of
+// the given event.
+func correlationID(event cloudevents.Event, key string) (string, bool) {
+	var id string
+
+	switch key {
+	case "subject":
+		id = event.Subject()
+	case "source":
+		id = event.Source()
+	case "type":
+		id = event.Type()
+	default:
+		ext, exists := event.Extensions()[key]
+		if !exists {
+			return "", false
+		}
+		s, err := types.Format(ext)
+		if err != nil {
+			return "", false
+		}
+		id = s
+	}
+
+	return id, id != ""
+}
+
+// setCorrelationID sets the correlation key on the given aggregated event.
+func setCorrelationID(event *cloudevents.Event, key, id string) {
+	switch key {
+	case "subject":
+		event.SetSubject(id)
+	case "source", "type":
+		// reserved for the aggregator's own values
+	default:
+		event.SetExtension(key, id)
+	}
+}
@@ -0,0 +1,189 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aggregator
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
+	logtesting "knative.dev/pkg/logging/testing"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/flow/v1alpha1"
+)
+
+func TestDispatchSinkFailure(t *testing.T) {
+	var mu sync.Mutex
+	var delivered []string
+	failures := 1
+
+	sink := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if failures > 0 {
+			failures--
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		body, err := ioutil.ReadAll(r.Body)
+		assert.NoError(t, err)
+		delivered = append(delivered, string(body))
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	t.Cleanup(sink.Close)
+
+	ceClient, err := cloudevents.NewClientHTTP()
+	require.NoError(t, err)
+
+	a := &adapter{
+		ceClient:       ceClient,
+		logger:         logtesting.TestLogger(t),
+		correlationKey: "subject",
+		count:          2,
+		mode:           v1alpha1.AggregatorModeArray,
+		ceSource:       "test.aggregator",
+		sinkURL:        sink.URL,
+		groups: newStorage(time.Minute, func(string, []cloudevents.Event) {
+			t.Error("Group timed out")
+		}),
+	}
+
+	ctx := context.Background()
+
+	res := a.dispatch(ctx, withSubject(newEvent(t, "1", `"a"`), "g"))
+	assert.True(t, cloudevents.IsACK(res), "First member should be accepted")
+
+	// the sink responds with a 5xx status code, the group is kept and the
+	// event is NACKed so that it gets redelivered
+	res = a.dispatch(ctx, withSubject(newEvent(t, "2", `"b"`), "g"))
+	assert.False(t, cloudevents.IsACK(res), "Group delivery should fail")
+	assert.Equal(t, 1, a.groups.len())
+
+	res = a.dispatch(ctx, withSubject(newEvent(t, "2", `"b"`), "g"))
+	assert.True(t, cloudevents.IsACK(res), "Group delivery should succeed after redelivery")
+	assert.Equal(t, 0, a.groups.len())
+
+	mu.Lock()
+	defer mu.Unlock()
+	if assert.Len(t, delivered, 1) {
+		assert.JSONEq(t, `["a","b"]`, delivered[0])
+	}
+}
+
+func TestDispatchAggregationFailure(t *testing.T) {
+	sink := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Group should not be sent to the sink")
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	t.Cleanup(sink.Close)
+
+	ceClient, err := cloudevents.NewClientHTTP()
+	require.NoError(t, err)
+
+	a := &adapter{
+		ceClient:       ceClient,
+		logger:         logtesting.TestLogger(t),
+		correlationKey: "subject",
+		count:          2,
+		mode:           v1alpha1.AggregatorModeMerge,
+		ceSource:       "test.aggregator",
+		sinkURL:        sink.URL,
+		groups: newStorage(time.Minute, func(string, []cloudevents.Event) {
+			t.Error("Group timed out")
+		}),
+	}
+
+	ctx := context.Background()
+
+	res := a.dispatch(ctx, withSubject(newEvent(t, "1", `{"a":1}`), "g"))
+	assert.True(t, cloudevents.IsACK(res), "First member should be accepted")
+
+	// the data of the second member is not a JSON object, the group can
+	// never be aggregated in merge mode and is dropped
+	res = a.dispatch(ctx, withSubject(newEvent(t, "2", `["b"]`), "g"))
+	var httpRes *cehttp.Result
+	if assert.ErrorAs(t, res, &httpRes) {
+		assert.Equal(t, http.StatusBadRequest, httpRes.StatusCode)
+	}
+	assert.Equal(t, 0, a.groups.len())
+}
+
+func TestTimeoutSinkFailure(t *testing.T) {
+	var mu sync.Mutex
+	var attempts int
+
+	sink := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		attempts++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(sink.Close)
+
+	ceClient, err := cloudevents.NewClientHTTP()
+	require.NoError(t, err)
+
+	a := &adapter{
+		ceClient:       ceClient,
+		logger:         logtesting.TestLogger(t),
+		correlationKey: "subject",
+		count:          2,
+		mode:           v1alpha1.AggregatorModeArray,
+		ceSource:       "test.aggregator",
+		sinkURL:        sink.URL,
+	}
+
+	ctx := context.Background()
+
+	a.groups = newStorage(10*time.Millisecond, func(id string, events []cloudevents.Event) {
+		a.deliver(ctx, id, events, 0, true)
+	})
+
+	res := a.dispatch(ctx, withSubject(newEvent(t, "1", `"a"`), "g"))
+	assert.True(t, cloudevents.IsACK(res), "First member should be accepted")
+
+	// the partial group is retried after each timeout, until it is
+	// dropped after maxDeliveryAttempts failed attempts
+	require.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return attempts == maxDeliveryAttempts && a.groups.len() == 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	time.Sleep(50 * time.Millisecond)
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, maxDeliveryAttempts, attempts, "Group should not be retried after it was dropped")
+}
+
+func withSubject(e cloudevents.Event, subject string) cloudevents.Event {
+	e.SetSubject(subject)
+	return e
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aggregator
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/types"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/flow/v1alpha1"
+	routingv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/routing/v1alpha1"
+)
+
+// aggregate returns the data of an event which aggregates the given group
+// members according to the given mode.
+func aggregate(events []cloudevents.Event, mode v1alpha1.AggregatorMode) (interface{}, error) {
+	events = sortByIndex(events)
+
+	switch mode {
+	case v1alpha1.AggregatorModeMerge:
+		return mergeData(events)
+	default:
+		return arrayData(events), nil
+	}
+}
+
+// arrayData returns the data of all given events as a list. Data which isn't
+// valid JSON is represented as a string.
+func arrayData(events []cloudevents.Event) []interface{} {
+	items := make([]interface{}, 0, len(events))
+
+	for _, e := range events {
+		data := e.Data()
+		switch {
+		case len(data) == 0:
+			items = append(items, nil)
+		case json.Valid(data):
+			items = append(items, json.RawMessage(data))
+		default:
+			items = append(items, string(data))
+		}
+	}
+
+	return items
+}
+
+// mergeData merges the data of all given events into a single object.
+// Properties of later events override the ones of earlier events.
+func mergeData(events []cloudevents.Event) (map[string]interface{}, error) {
+	merged := make(map[string]interface{})
+
+	for _, e := range events {
+		if len(e.Data()) == 0 {
+			continue
+		}
+
+		obj := make(map[string]interface{})
+		if err := json.Unmarshal(e.Data(), &obj); err != nil {
+			return nil, fmt.Errorf("data of event %q is not a JSON object: %w", e.ID(), err)
+		}
+		for k, v := range obj {
+			merged[k] = v
+		}
+	}
+
+	return merged, nil
+}
+
+// sortByIndex orders the given events by the index set by the Splitter, if
+// all events carry one. Otherwise, the events are returned in their order of
+// arrival.
+func sortByIndex(events []cloudevents.Event) []cloudevents.Event {
+	type indexedEvent struct {
+		index int32
+		event cloudevents.Event
+	}
+
+	indexed := make([]indexedEvent, len(events))
+
+	for i, e := range events {
+		ext, exists := e.Extensions()[routingv1alpha1.SplitterIndexExtension]
+		if !exists {
+			return events
+		}
+		idx, err := types.ToInteger(ext)
+		if err != nil {
+			return events
+		}
+		indexed[i] = indexedEvent{index: idx, event: e}
+	}
+
+	sort.SliceStable(indexed, func(i, j int) bool {
+		return indexed[i].index < indexed[j].index
+	})
+
+	sorted := make([]cloudevents.Event, len(indexed))
+	for i := range indexed {
+		sorted[i] = indexed[i].event
+	}
+
+	return sorted
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aggregator
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/flow/v1alpha1"
+	routingv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/routing/v1alpha1"
+)
+
+func TestAggregate(t *testing.T) {
+	testCases := map[string]struct {
+		events    []cloudevents.Event
+		mode      v1alpha1.AggregatorMode
+		expect    string
+		expectErr bool
+	}{
+		"Array in order of arrival": {
+			events: []cloudevents.Event{
+				newEvent(t, "1", `{"a":1}`),
+				newEvent(t, "2", `"b"`),
+			},
+			mode:   v1alpha1.AggregatorModeArray,
+			expect: `[{"a":1},"b"]`,
+		},
+		"Array ordered by split index": {
+			events: []cloudevents.Event{
+				withIndex(newEvent(t, "1", `{"a":1}`), 2),
+				withIndex(newEvent(t, "2", `{"b":2}`), 0),
+				withIndex(newEvent(t, "3", `{"c":3}`), 1),
+			},
+			mode:   v1alpha1.AggregatorModeArray,
+			expect: `[{"b":2},{"c":3},{"a":1}]`,
+		},
+		"Merged objects": {
+			events: []cloudevents.Event{
+				newEvent(t, "1", `{"a":1,"b":1}`),
+				newEvent(t, "2", `{"b":2}`),
+			},
+			mode:   v1alpha1.AggregatorModeMerge,
+			expect: `{"a":1,"b":2}`,
+		},
+		"Merged non-object": {
+			events: []cloudevents.Event{
+				newEvent(t, "1", `[1,2]`),
+			},
+			mode:      v1alpha1.AggregatorModeMerge,
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			data, err := aggregate(tc.events, tc.mode)
+			if tc.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			out, err := json.Marshal(data)
+			require.NoError(t, err)
+			assert.JSONEq(t, tc.expect, string(out))
+		})
+	}
+}
+
+func newEvent(t *testing.T, id, data string) cloudevents.Event {
+	t.Helper()
+
+	e := cloudevents.NewEvent()
+	e.SetID(id)
+	e.SetType("test.type")
+	e.SetSource("test.source")
+	require.NoError(t, e.SetData(cloudevents.ApplicationJSON, []byte(data)))
+	return e
+}
+
+func withIndex(e cloudevents.Event, idx int) cloudevents.Event {
+	e.SetExtension(routingv1alpha1.SplitterIndexExtension, strconv.Itoa(idx))
+	return e
+}
@@ -0,0 +1,44 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aggregator
+
+import (
+	"time"
+
+	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
+)
+
+// EnvAccessorCtor for configuration parameters
+func EnvAccessorCtor() pkgadapter.EnvConfigAccessor {
+	return &envAccessor{}
+}
+
+type envAccessor struct {
+	pkgadapter.EnvConfig
+
+	CorrelationKey       string        `envconfig:"CORRELATION_KEY" required:"true"`
+	CompletionCount      int           `envconfig:"COMPLETION_COUNT"`
+	CompletionExpression string        `envconfig:"COMPLETION_EXPRESSION"`
+	CompletionTimeout    time.Duration `envconfig:"COMPLETION_TIMEOUT" required:"true"`
+	AggregationMode      string        `envconfig:"AGGREGATION_MODE" default:"array"`
+
+	// CloudEvents attributes
+	CESource string `envconfig:"CE_SOURCE" required:"true"`
+
+	// BridgeIdentifier is the name of the bridge workflow this target is part of
+	BridgeIdentifier string `envconfig:"EVENTS_BRIDGE_IDENTIFIER"`
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aggregator
+
+import (
+	"sync"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+)
+
+// maxDeliveryAttempts is the number of times the delivery of a group is
+// attempted before that group is dropped.
+const maxDeliveryAttempts = 5
+
+// group holds the events received so far for a given correlation ID.
+type group struct {
+	events   []cloudevents.Event
+	expected int
+	timer    *time.Timer
+}
+
+// storage holds the map of open groups.
+type storage struct {
+	sync.Mutex
+	groups map[string]*group
+	// number of failed delivery attempts per group
+	failures map[string]int
+
+	timeout   time.Duration
+	onTimeout func(id string, events []cloudevents.Event)
+}
+
+// newStorage returns an instance of the groups storage. The onTimeout
+// callback is invoked with the members of every group which isn't
+// completed within the given timeout.
+func newStorage(timeout time.Duration, onTimeout func(id string, events []cloudevents.Event)) *storage {
+	return &storage{
+		groups:    make(map[string]*group),
+		failures:  make(map[string]int),
+		timeout:   timeout,
+		onTimeout: onTimeout,
+	}
+}
+
+// add appends the event to the group with the given ID, and creates that
+// group if it doesn't exist yet. An event which is already a member of the
+// group, such as an event redelivered after a failed attempt to emit the
+// group, replaces the existing member instead of being appended again. The expected number of members is recorded
+// on the group the first time it is known, and last indicates that the
+// event completes the group regardless of its size.
+// When the group is complete, it is removed from the storage and its
+// members are returned.
+func (s *storage) add(id string, event cloudevents.Event, expected int, last bool) ([]cloudevents.Event, bool) {
+	s.Lock()
+	defer s.Unlock()
+
+	g := s.open(id)
+
+	if g.expected == 0 {
+		g.expected = expected
+	}
+	g.put(event)
+
+	if !last && (g.expected == 0 || len(g.events) < g.expected) {
+		return nil, false
+	}
+
+	g.timer.Stop()
+	delete(s.groups, id)
+	return g.events, true
+}
+
+// restore puts the members of a group which could not be delivered back into
+// the storage, ahead of any event received for the same ID in the meantime.
+// The group remains open until it is completed again or times out, so that
+// its delivery can be retried.
+// Once the delivery of the group has failed maxDeliveryAttempts times, the
+// group is discarded instead and restore returns false.
+func (s *storage) restore(id string, events []cloudevents.Event, expected int) bool {
+	s.Lock()
+	defer s.Unlock()
+
+	s.failures[id]++
+	if s.failures[id] >= maxDeliveryAttempts {
+		delete(s.failures, id)
+		return false
+	}
+
+	g := s.open(id)
+
+	if g.expected == 0 {
+		g.expected = expected
+	}
+	received := g.events
+	g.events = append([]cloudevents.Event(nil), events...)
+	for _, e := range received {
+		g.put(e)
+	}
+
+	return true
+}
+
+// done forgets the failed delivery attempts of the group with the given ID,
+// once that group was either delivered or discarded.
+func (s *storage) done(id string) {
+	s.Lock()
+	defer s.Unlock()
+
+	delete(s.failures, id)
+}
+
+// put adds the given event to the members of the group, or replaces the
+// member with the same identity (source and ID) if there is one.
+func (g *group) put(event cloudevents.Event) {
+	for i := range g.events {
+		if g.events[i].ID() == event.ID() && g.events[i].Source() == event.Source() {
+			g.events[i] = event
+			return
+		}
+	}
+	g.events = append(g.events, event)
+}
+
+// open returns the group with the given ID, and creates that group if it
+// doesn't exist yet. The caller must hold the lock.
+func (s *storage) open(id string) *group {
+	g, exists := s.groups[id]
+	if !exists {
+		g = &group{}
+		g.timer = time.AfterFunc(s.timeout, func() {
+			s.expire(id, g)
+		})
+		s.groups[id] = g
+	}
+
+	return g
+}
+
+// expire removes the given group from the storage and hands its members
+// over to the timeout callback, unless the group was completed in the
+// meantime.
+func (s *storage) expire(id string, g *group) {
+	s.Lock()
+	if s.groups[id] != g {
+		s.Unlock()
+		return
+	}
+	delete(s.groups, id)
+	s.Unlock()
+
+	s.onTimeout(id, g.events)
+}
+
+// len returns the number of open groups.
+func (s *storage) len() int {
+	s.Lock()
+	defer s.Unlock()
+
+	return len(s.groups)
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aggregator
+
+import (
+	"testing"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStorage(t *testing.T) {
+	timedOut := make(chan []cloudevents.Event, 1)
+	s := newStorage(50*time.Millisecond, func(id string, events []cloudevents.Event) {
+		timedOut <- events
+	})
+
+	t.Run("Complete on count", func(t *testing.T) {
+		_, complete := s.add("a", newEvent(t, "1", `{}`), 2, false)
+		assert.False(t, complete)
+
+		events, complete := s.add("a", newEvent(t, "2", `{}`), 0, false)
+		assert.True(t, complete)
+		assert.Len(t, events, 2)
+		assert.Equal(t, 0, s.len())
+	})
+
+	t.Run("Complete on last event", func(t *testing.T) {
+		events, complete := s.add("b", newEvent(t, "1", `{}`), 0, true)
+		assert.True(t, complete)
+		assert.Len(t, events, 1)
+		assert.Equal(t, 0, s.len())
+	})
+
+	t.Run("Timeout", func(t *testing.T) {
+		_, complete := s.add("c", newEvent(t, "1", `{}`), 3, false)
+		assert.False(t, complete)
+
+		select {
+		case events := <-timedOut:
+			assert.Len(t, events, 1)
+		case <-time.After(time.Second):
+			t.Fatal("Group did not time out")
+		}
+		assert.Equal(t, 0, s.len())
+	})
+
+	t.Run("Restore undelivered group", func(t *testing.T) {
+		events, complete := s.add("d", newEvent(t, "1", `{}`), 2, true)
+		assert.True(t, complete)
+		assert.Equal(t, 0, s.len())
+
+		assert.True(t, s.restore("d", events, 2))
+		assert.Equal(t, 1, s.len())
+
+		events, complete = s.add("d", newEvent(t, "2", `{}`), 0, false)
+		assert.True(t, complete)
+		if assert.Len(t, events, 2) {
+			assert.Equal(t, "1", events[0].ID())
+			assert.Equal(t, "2", events[1].ID())
+		}
+		assert.Equal(t, 0, s.len())
+		s.done("d")
+	})
+
+	t.Run("Redelivered member", func(t *testing.T) {
+		_, complete := s.add("e", newEvent(t, "1", `{}`), 3, false)
+		assert.False(t, complete)
+
+		_, complete = s.add("e", newEvent(t, "1", `{}`), 0, false)
+		assert.False(t, complete)
+
+		events, complete := s.add("e", newEvent(t, "2", `{}`), 0, true)
+		assert.True(t, complete)
+		assert.Len(t, events, 2)
+
+		assert.True(t, s.restore("e", events, 3))
+		events, complete = s.add("e", newEvent(t, "2", `{}`), 0, true)
+		assert.True(t, complete)
+		assert.Len(t, events, 2)
+		assert.Equal(t, 0, s.len())
+		s.done("e")
+	})
+
+	t.Run("Discard group after too many delivery failures", func(t *testing.T) {
+		events, complete := s.add("f", newEvent(t, "1", `{}`), 0, true)
+		assert.True(t, complete)
+
+		for i := 1; i < maxDeliveryAttempts; i++ {
+			assert.True(t, s.restore("f", events, 0), "Attempt %d should be retried", i)
+
+			events, complete = s.add("f", newEvent(t, "1", `{}`), 0, true)
+			assert.True(t, complete)
+		}
+
+		assert.False(t, s.restore("f", events, 0), "Group should be discarded")
+		assert.Equal(t, 0, s.len())
+		assert.Empty(t, s.failures)
+	})
+}
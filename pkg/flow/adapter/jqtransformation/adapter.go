@@ -18,7 +18,7 @@ package jqtransformation
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 
 	"github.com/itchyny/gojq"
 
@@ -28,6 +28,7 @@ import (
 	"knative.dev/pkg/logging"
 
 	"github.com/triggermesh/triggermesh/pkg/apis/flow"
+	"github.com/triggermesh/triggermesh/pkg/apis/flow/v1alpha1"
 	"github.com/triggermesh/triggermesh/pkg/metrics"
 	targetce "github.com/triggermesh/triggermesh/pkg/targets/adapter/cloudevents"
 )
@@ -54,13 +55,22 @@ func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClie
 		logger.Panicf("Error creating CloudEvents replier: %v", err)
 	}
 
-	query, err := gojq.Parse(env.Query)
+	query, err := compileQuery(env.Query)
 	if err != nil {
 		logger.Panicf("Error creating query: %v", err)
 	}
 
+	outputMode := v1alpha1.JQOutputMode(env.OutputMode)
+	switch outputMode {
+	case v1alpha1.JQOutputModeLast, v1alpha1.JQOutputModeEach, v1alpha1.JQOutputModeArray:
+	default:
+		logger.Panicf("Unsupported output mode %q", outputMode)
+	}
+
 	return &jqadapter{
-		query: query,
+		query:          query,
+		outputMode:     outputMode,
+		outputEnvelope: env.OutputEnvelope,
 
 		sink:     env.Sink,
 		replier:  replier,
@@ -75,7 +85,9 @@ func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClie
 var _ pkgadapter.Adapter = (*jqadapter)(nil)
 
 type jqadapter struct {
-	query *gojq.Query
+	query          *gojq.Code
+	outputMode     v1alpha1.JQOutputMode
+	outputEnvelope bool
 
 	sink     string
 	replier  *targetce.Replier
@@ -96,12 +108,13 @@ func (a *jqadapter) Start(ctx context.Context) error {
 
 func (a *jqadapter) dispatch(ctx context.Context, event cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
 	var data interface{}
-	var qd interface{}
 	if err := event.DataAs(&data); err != nil {
 		return a.replier.Error(&event, targetce.ErrorCodeRequestParsing, err, nil)
 	}
 
-	iter := a.query.Run(data)
+	var outputs []interface{}
+
+	iter := a.query.Run(data, ceVariable(&event))
 	for {
 		v, ok := iter.Next()
 		if !ok {
@@ -110,25 +123,30 @@ func (a *jqadapter) dispatch(ctx context.Context, event cloudevents.Event) (*clo
 		if err, ok := v.(error); ok {
 			return a.replier.Error(&event, targetce.ErrorCodeRequestParsing, err, nil)
 		}
-		qd = v
+		outputs = append(outputs, v)
 	}
 
-	// Reserialize the query results for the response
-	bs, err := json.Marshal(&qd)
+	events, err := a.outputEvents(&event, outputs)
 	if err != nil {
-		return a.replier.Error(&event, targetce.ErrorCodeRequestParsing, err, nil)
-	}
-
-	if err := event.SetData(cloudevents.ApplicationJSON, bs); err != nil {
 		return a.replier.Error(&event, targetce.ErrorCodeAdapterProcess, err, nil)
 	}
 
 	if a.sink != "" {
-		if result := a.ceClient.Send(ctx, event); !cloudevents.IsACK(result) {
-			return a.replier.Error(&event, targetce.ErrorCodeAdapterProcess, result, "sending the cloudevent to the sink")
+		for _, e := range events {
+			if result := a.ceClient.Send(ctx, *e); !cloudevents.IsACK(result) {
+				return a.replier.Error(&event, targetce.ErrorCodeAdapterProcess, result, "sending the cloudevent to the sink")
+			}
 		}
 		return nil, cloudevents.ResultACK
 	}
 
-	return &event, cloudevents.ResultACK
+	switch len(events) {
+	case 0:
+		return nil, cloudevents.ResultACK
+	case 1:
+		return events[0], cloudevents.ResultACK
+	default:
+		return a.replier.Error(&event, targetce.ErrorCodeAdapterProcess,
+			errors.New("the query returned multiple outputs, which can only be sent to a sink"), nil)
+	}
 }
@@ -35,8 +35,7 @@ import (
 	"knative.dev/eventing/pkg/adapter/v2"
 	logtesting "knative.dev/pkg/logging/testing"
 
-	"github.com/itchyny/gojq"
-
+	"github.com/triggermesh/triggermesh/pkg/apis/flow/v1alpha1"
 	"github.com/triggermesh/triggermesh/pkg/metrics"
 	metricstesting "github.com/triggermesh/triggermesh/pkg/metrics/testing"
 	targetce "github.com/triggermesh/triggermesh/pkg/targets/adapter/cloudevents"
@@ -84,7 +83,8 @@ func TestSink(t *testing.T) {
 					Component: tCloudEventSource,
 					Sink:      svr.URL,
 				},
-				Query: tJSONQuery,
+				Query:      tJSONQuery,
+				OutputMode: string(v1alpha1.JQOutputModeLast),
 			}
 
 			ctx, cancel := context.WithCancel(context.Background())
@@ -140,7 +140,7 @@ func TestReplier(t *testing.T) {
 			replier, err := targetce.New(tCloudEventSource, logger)
 			require.NoError(t, err)
 
-			query, err := gojq.Parse(tc.query)
+			query, err := compileQuery(tc.query)
 			require.NoError(t, err)
 
 			mt := &adapter.MetricTag{}
@@ -27,6 +27,11 @@ type envAccessor struct {
 	pkgadapter.EnvConfig
 	// Query represents the jq query to be applied to the incoming event
 	Query string `envconfig:"JQ_QUERY" required:"true"`
+	// OutputMode is the mode in which the outputs of the query are emitted
+	OutputMode string `envconfig:"JQ_OUTPUT_MODE" default:"last"`
+	// OutputEnvelope indicates whether outputs of the query carry CloudEvent
+	// attributes in addition to the event's data
+	OutputEnvelope bool `envconfig:"JQ_OUTPUT_ENVELOPE"`
 	// BridgeIdentifier is the name of the bridge workflow this target is part of
 	BridgeIdentifier string `envconfig:"EVENTS_BRIDGE_IDENTIFIER"`
 	// CloudEvents responses parametrization
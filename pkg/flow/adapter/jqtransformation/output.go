@@ -0,0 +1,250 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jqtransformation
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/itchyny/gojq"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/types"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/flow/v1alpha1"
+)
+
+// Name of the jq variable which exposes the attributes of the incoming event.
+const ceVariableName = "$ce"
+
+// Keys of the $ce variable, and of the "ce" object of output envelopes.
+const (
+	ceKeyID         = "id"
+	ceKeyType       = "type"
+	ceKeySource     = "source"
+	ceKeySubject    = "subject"
+	ceKeyExtensions = "extensions"
+)
+
+// Keys of output envelopes.
+const (
+	envelopeKeyCE   = "ce"
+	envelopeKeyData = "data"
+)
+
+// compileQuery compiles the given jq query with the predefined variables
+// exposed by the adapter.
+func compileQuery(q string) (*gojq.Code, error) {
+	query, err := gojq.Parse(q)
+	if err != nil {
+		return nil, err
+	}
+
+	return gojq.Compile(query, gojq.WithVariables([]string{ceVariableName}))
+}
+
+// ceVariable returns the value of the $ce variable for the given event.
+func ceVariable(e *cloudevents.Event) map[string]interface{} {
+	exts := make(map[string]interface{}, len(e.Extensions()))
+	for name, val := range e.Extensions() {
+		s, err := types.Format(val)
+		if err != nil {
+			s = fmt.Sprint(val)
+		}
+		exts[name] = s
+	}
+
+	return map[string]interface{}{
+		ceKeyID:         e.ID(),
+		ceKeyType:       e.Type(),
+		ceKeySource:     e.Source(),
+		ceKeySubject:    e.Subject(),
+		ceKeyExtensions: exts,
+	}
+}
+
+// outputEvents returns the events to emit for the given outputs of the query,
+// according to the adapter's output mode.
+func (a *jqadapter) outputEvents(in *cloudevents.Event, outputs []interface{}) ([]*cloudevents.Event, error) {
+	switch a.outputMode {
+	case v1alpha1.JQOutputModeEach:
+		events := make([]*cloudevents.Event, 0, len(outputs))
+
+		for i, out := range outputs {
+			e := in.Clone()
+			e.SetID(in.ID() + "-" + strconv.Itoa(i))
+
+			if err := a.setOutput(&e, out); err != nil {
+				return nil, fmt.Errorf("output %d: %w", i, err)
+			}
+			events = append(events, &e)
+		}
+
+		return events, nil
+
+	case v1alpha1.JQOutputModeArray:
+		e := in.Clone()
+
+		data := make([]interface{}, 0, len(outputs))
+
+		for i, out := range outputs {
+			if a.outputEnvelope {
+				var err error
+				if out, err = applyEnvelope(&e, out); err != nil {
+					return nil, fmt.Errorf("output %d: %w", i, err)
+				}
+			}
+			data = append(data, out)
+		}
+
+		if err := setJSONData(&e, data); err != nil {
+			return nil, err
+		}
+
+		return []*cloudevents.Event{&e}, nil
+
+	default:
+		e := in.Clone()
+
+		var out interface{}
+		if n := len(outputs); n > 0 {
+			out = outputs[n-1]
+		}
+
+		if err := a.setOutput(&e, out); err != nil {
+			return nil, err
+		}
+
+		return []*cloudevents.Event{&e}, nil
+	}
+}
+
+// setOutput sets the given output of the query on the given event.
+func (a *jqadapter) setOutput(e *cloudevents.Event, out interface{}) error {
+	if a.outputEnvelope {
+		var err error
+		if out, err = applyEnvelope(e, out); err != nil {
+			return err
+		}
+	}
+
+	return setJSONData(e, out)
+}
+
+// setJSONData sets the JSON representation of the given value as the data of
+// the given event.
+func setJSONData(e *cloudevents.Event, v interface{}) error {
+	bs, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("serializing output: %w", err)
+	}
+
+	return e.SetData(cloudevents.ApplicationJSON, bs)
+}
+
+// applyEnvelope sets the CloudEvent attributes contained in the given output
+// envelope on the given event, and returns the data contained in the envelope.
+func applyEnvelope(e *cloudevents.Event, out interface{}) (interface{}, error) {
+	envelope, ok := out.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("expected an envelope object but got: %T", out)
+	}
+
+	if ce := envelope[envelopeKeyCE]; ce != nil {
+		attrs, ok := ce.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("expected %q to be an object but got: %T", envelopeKeyCE, ce)
+		}
+
+		if err := setAttributes(e, attrs); err != nil {
+			return nil, err
+		}
+	}
+
+	return envelope[envelopeKeyData], nil
+}
+
+// setAttributes sets the given CloudEvent attributes on the given event.
+func setAttributes(e *cloudevents.Event, attrs map[string]interface{}) error {
+	for key, val := range attrs {
+		if key == ceKeyExtensions {
+			if err := setExtensions(e, val); err != nil {
+				return err
+			}
+			continue
+		}
+
+		s, ok := val.(string)
+		if !ok && !(val == nil && key == ceKeySubject) {
+			return fmt.Errorf("expected attribute %q to be a string but got: %T", key, val)
+		}
+
+		switch key {
+		case ceKeyID:
+			e.SetID(s)
+		case ceKeyType:
+			e.SetType(s)
+		case ceKeySource:
+			e.SetSource(s)
+		case ceKeySubject:
+			e.SetSubject(s)
+		default:
+			return fmt.Errorf("unsupported attribute %q", key)
+		}
+	}
+
+	if err := e.Validate(); err != nil {
+		return fmt.Errorf("invalid attributes: %w", err)
+	}
+
+	return nil
+}
+
+// setExtensions sets the given CloudEvent extensions on the given event.
+// Extensions with a null value are removed.
+func setExtensions(e *cloudevents.Event, val interface{}) error {
+	exts, ok := val.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("expected %q to be an object but got: %T", ceKeyExtensions, val)
+	}
+
+	for name, v := range exts {
+		var extVal interface{}
+
+		switch tv := v.(type) {
+		case nil:
+			// removes the extension
+		case string:
+			extVal = tv
+		case bool:
+			extVal = strconv.FormatBool(tv)
+		case float64:
+			extVal = strconv.FormatFloat(tv, 'f', -1, 64)
+		case int:
+			extVal = strconv.Itoa(tv)
+		default:
+			return fmt.Errorf("expected extension %q to be a scalar value but got: %T", name, v)
+		}
+
+		if err := e.Context.SetExtension(name, extVal); err != nil {
+			return fmt.Errorf("setting extension %q: %w", name, err)
+		}
+	}
+
+	return nil
+}
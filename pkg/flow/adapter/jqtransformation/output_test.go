@@ -0,0 +1,198 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jqtransformation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/flow/v1alpha1"
+)
+
+func TestOutputEvents(t *testing.T) {
+	const tJSONItems = `{"items":[{"id":1},{"id":2}]}`
+
+	type expectEvent struct {
+		id    string
+		typ   string
+		data  string
+		attrs map[string]interface{}
+	}
+
+	testCases := map[string]struct {
+		query    string
+		mode     v1alpha1.JQOutputMode
+		envelope bool
+		inData   string
+		inExts   map[string]interface{}
+		expect   []expectEvent
+		expErr   bool
+	}{
+		"last output": {
+			query:  ".items[]",
+			mode:   v1alpha1.JQOutputModeLast,
+			inData: tJSONItems,
+			expect: []expectEvent{
+				{id: tCloudEventID, typ: tCloudEventType, data: `{"id":2}`},
+			},
+		},
+		"no output in last mode": {
+			query:  "empty",
+			mode:   v1alpha1.JQOutputModeLast,
+			inData: tJSONItems,
+			expect: []expectEvent{
+				{id: tCloudEventID, typ: tCloudEventType, data: `null`},
+			},
+		},
+		"each output": {
+			query:  ".items[]",
+			mode:   v1alpha1.JQOutputModeEach,
+			inData: tJSONItems,
+			expect: []expectEvent{
+				{id: tCloudEventID + "-0", typ: tCloudEventType, data: `{"id":1}`},
+				{id: tCloudEventID + "-1", typ: tCloudEventType, data: `{"id":2}`},
+			},
+		},
+		"no output in each mode": {
+			query:  "empty",
+			mode:   v1alpha1.JQOutputModeEach,
+			inData: tJSONItems,
+			expect: []expectEvent{},
+		},
+		"array of outputs": {
+			query:  ".items[]",
+			mode:   v1alpha1.JQOutputModeArray,
+			inData: tJSONItems,
+			expect: []expectEvent{
+				{id: tCloudEventID, typ: tCloudEventType, data: `[{"id":1},{"id":2}]`},
+			},
+		},
+		"ce variable": {
+			query:  "{type: $ce.type, source: $ce.source, ext: $ce.extensions.myext}",
+			mode:   v1alpha1.JQOutputModeLast,
+			inData: tJSONItems,
+			inExts: map[string]interface{}{"myext": "myval"},
+			expect: []expectEvent{
+				{id: tCloudEventID, typ: tCloudEventType,
+					data: `{"ext":"myval","source":"` + tCloudEventSource + `","type":"` + tCloudEventType + `"}`},
+			},
+		},
+		"envelope overrides attributes": {
+			query: `.items[] | {ce: {type: "item.\(.id)", subject: "s\(.id)",` +
+				` extensions: {itemid: .id, myext: null}}, data: .}`,
+			mode:     v1alpha1.JQOutputModeEach,
+			envelope: true,
+			inData:   tJSONItems,
+			inExts:   map[string]interface{}{"myext": "myval"},
+			expect: []expectEvent{
+				{id: tCloudEventID + "-0", typ: "item.1", data: `{"id":1}`,
+					attrs: map[string]interface{}{"subject": "s1", "itemid": "1"}},
+				{id: tCloudEventID + "-1", typ: "item.2", data: `{"id":2}`,
+					attrs: map[string]interface{}{"subject": "s2", "itemid": "2"}},
+			},
+		},
+		"envelopes merged in array mode": {
+			query:    `.items[] | {ce: {type: "item.\(.id)"}, data: .id}`,
+			mode:     v1alpha1.JQOutputModeArray,
+			envelope: true,
+			inData:   tJSONItems,
+			expect: []expectEvent{
+				{id: tCloudEventID, typ: "item.2", data: `[1,2]`},
+			},
+		},
+		"output is not an envelope": {
+			query:    ".items[0]",
+			mode:     v1alpha1.JQOutputModeLast,
+			envelope: true,
+			inData:   `{"items":["a"]}`,
+			expErr:   true,
+		},
+		"unsupported envelope attribute": {
+			query:    `{ce: {time: "now"}, data: .}`,
+			mode:     v1alpha1.JQOutputModeLast,
+			envelope: true,
+			inData:   tJSONItems,
+			expErr:   true,
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			query, err := compileQuery(tc.query)
+			require.NoError(t, err)
+
+			a := &jqadapter{
+				query:          query,
+				outputMode:     tc.mode,
+				outputEnvelope: tc.envelope,
+			}
+
+			in := newCloudEvent(t, tc.inData, cloudevents.ApplicationJSON)
+			for k, v := range tc.inExts {
+				in.SetExtension(k, v)
+			}
+
+			var data interface{}
+			require.NoError(t, in.DataAs(&data))
+
+			var outputs []interface{}
+			iter := a.query.Run(data, ceVariable(&in))
+			for v, ok := iter.Next(); ok; v, ok = iter.Next() {
+				if err, isErr := v.(error); isErr {
+					require.NoError(t, err)
+				}
+				outputs = append(outputs, v)
+			}
+
+			events, err := a.outputEvents(&in, outputs)
+			if tc.expErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Len(t, events, len(tc.expect))
+			for i, e := range events {
+				expect := tc.expect[i]
+
+				assert.Equal(t, expect.id, e.ID())
+				assert.Equal(t, expect.typ, e.Type())
+				assert.Equal(t, tCloudEventSource, e.Source())
+				assert.JSONEq(t, expect.data, string(e.Data()))
+
+				for k, v := range expect.attrs {
+					if k == "subject" {
+						assert.Equal(t, v, e.Subject())
+						continue
+					}
+					assert.Equal(t, v, e.Extensions()[k])
+				}
+
+				if tc.envelope && tc.inExts != nil {
+					assert.NotContains(t, e.Extensions(), "myext", "Extension should have been removed")
+				}
+			}
+
+			assert.Equal(t, tCloudEventID, in.ID(), "Incoming event should not be altered")
+		})
+	}
+}
@@ -18,6 +18,8 @@ package synchronizer
 
 import (
 	"context"
+	"crypto/tls"
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
@@ -25,7 +27,10 @@ import (
 	"go.uber.org/zap"
 
 	cloudevents "github.com/cloudevents/sdk-go/v2"
+	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
+	"github.com/redis/go-redis/v9"
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
+	"knative.dev/eventing/pkg/metrics/source"
 	"knative.dev/pkg/logging"
 
 	"github.com/triggermesh/triggermesh/pkg/apis/flow"
@@ -33,19 +38,24 @@ import (
 	targetce "github.com/triggermesh/triggermesh/pkg/targets/adapter/cloudevents"
 )
 
+// maxSessionAttempts is the number of correlation IDs generated for a request
+// before giving up on collisions with open sessions.
+const maxSessionAttempts = 5
+
 var _ pkgadapter.Adapter = (*adapter)(nil)
 
 type adapter struct {
 	ceClient cloudevents.Client
 	logger   *zap.SugaredLogger
 
-	mt *pkgadapter.MetricTag
-	sr *metrics.EventProcessingStatsReporter
+	mt    *pkgadapter.MetricTag
+	sr    *metrics.EventProcessingStatsReporter
+	sessr *metrics.SessionStatsReporter
 
 	correlationKey  *correlationKey
 	responseTimeout time.Duration
 
-	sessions *storage
+	sessions sessionStorage
 	sinkURL  string
 	bridgeID string
 }
@@ -61,25 +71,64 @@ func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClie
 	}
 
 	metrics.MustRegisterEventProcessingStatsView()
+	metrics.MustRegisterSessionStatsView()
 
 	env := envAcc.(*envAccessor)
 
-	key, err := newCorrelationKey(env.CorrelationKey, env.CorrelationKeyLength)
+	if env.ResponseWaitTimeout <= 0 {
+		logger.Panicf("Invalid response wait timeout %s, the timeout must be positive", env.ResponseWaitTimeout)
+	}
+
+	key, err := newCorrelationKey(env.CorrelationKey, env.CorrelationKeyLength, env.CorrelationKeyGenerator)
 	if err != nil {
-		logger.Panic("Cannot create an instance of Correlation Key: %v", err)
+		logger.Panicf("Cannot create an instance of Correlation Key: %v", err)
+	}
+	if key, err = key.withCallerID(env.CorrelationKeyFromExtension, env.CorrelationKeyFromHeader); err != nil {
+		logger.Panicf("Cannot create an instance of Correlation Key: %v", err)
+	}
+
+	// HTTP headers of requests are only exposed to the receiver when the
+	// corresponding middleware is enabled
+	if env.CorrelationKeyFromHeader != "" {
+		if ceClient, err = newClientWithRequestData(env); err != nil {
+			logger.Panicw("Error creating CloudEvents client", zap.Error(err))
+		}
+	}
+
+	var sessions sessionStorage
+	switch env.SessionBackend {
+	case sessionBackendMemory:
+		sessions = newStorage()
+	case sessionBackendRedis:
+		opts := &redis.Options{
+			Addr:     env.RedisAddress,
+			Password: env.RedisPassword,
+			DB:       env.RedisDatabase,
+		}
+		if env.RedisTLS {
+			opts.TLSConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+		}
+		client := redis.NewClient(opts)
+		prefix := "synchronizer:" + envAcc.GetNamespace() + "." + envAcc.GetName()
+		// session records outlive the sessions themselves, they only
+		// expire if the replica terminates before deleting them
+		sessions = newRedisStorage(client, prefix, 2*env.ResponseWaitTimeout, logger)
+	default:
+		logger.Panicf("Unsupported session backend %q", env.SessionBackend)
 	}
 
 	return &adapter{
 		ceClient: ceClient,
 		logger:   logger,
 
-		mt: mt,
-		sr: metrics.MustNewEventProcessingStatsReporter(mt),
+		mt:    mt,
+		sr:    metrics.MustNewEventProcessingStatsReporter(mt),
+		sessr: metrics.MustNewSessionStatsReporter(mt),
 
 		correlationKey:  key,
 		responseTimeout: env.ResponseWaitTimeout,
 
-		sessions: newStorage(),
+		sessions: sessions,
 		sinkURL:  env.Sink,
 		bridgeID: env.BridgeIdentifier,
 	}
@@ -89,9 +138,36 @@ func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClie
 func (a *adapter) Start(ctx context.Context) error {
 	a.logger.Info("Starting Synchronizer Adapter")
 	ctx = pkgadapter.ContextWithMetricTag(ctx, a.mt)
+
+	if s, ok := a.sessions.(runnableSessionStorage); ok {
+		go s.run(ctx)
+	}
+
 	return a.ceClient.StartReceiver(ctx, a.dispatch)
 }
 
+// newClientWithRequestData returns a CloudEvents client configured like the
+// one supplied by pkgadapter, which additionally exposes the data of incoming
+// HTTP requests to the receiver.
+func newClientWithRequestData(env pkgadapter.EnvConfigAccessor) (cloudevents.Client, error) {
+	ceOverrides, err := env.GetCloudEventOverrides()
+	if err != nil {
+		return nil, fmt.Errorf("reading CloudEvent overrides: %w", err)
+	}
+
+	reporter, err := source.NewStatsReporter()
+	if err != nil {
+		return nil, fmt.Errorf("creating stats reporter: %w", err)
+	}
+
+	opts := []cehttp.Option{cehttp.WithRequestDataAtContextMiddleware()}
+	if sink := env.GetSink(); sink != "" {
+		opts = append(opts, cehttp.WithTarget(sink))
+	}
+
+	return pkgadapter.NewCloudEventsClientWithOptions(ceOverrides, reporter, opts...)
+}
+
 func (a *adapter) dispatch(ctx context.Context, event cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
 	a.logger.Debugf("Received the event: %s", event.String())
 
@@ -99,19 +175,26 @@ func (a *adapter) dispatch(ctx context.Context, event cloudevents.Event) (*cloud
 		return a.serveResponse(ctx, correlationID, event)
 	}
 
-	correlationID := a.correlationKey.set(&event)
-	return a.serveRequest(ctx, correlationID, event)
+	return a.serveRequest(ctx, event)
 }
 
 // serveRequest creates the session for the incoming events and blocks the client.
-func (a *adapter) serveRequest(ctx context.Context, correlationID string, event cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
-	a.logger.Debugf("Handling request %q", correlationID)
-
-	respChan, err := a.sessions.add(correlationID)
-	if err != nil {
-		return nil, cloudevents.NewHTTPResult(http.StatusInternalServerError, "cannot add session %q: %w", correlationID, err)
+func (a *adapter) serveRequest(ctx context.Context, event cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
+	correlationID, respChan, err := a.openSession(ctx, event)
+	switch {
+	case errors.Is(err, errSessionExists):
+		return nil, cloudevents.NewHTTPResult(http.StatusConflict, "a request with correlation ID %q is already in progress", correlationID)
+	case err != nil:
+		return nil, cloudevents.NewHTTPResult(http.StatusInternalServerError, "cannot add session %q: %v", correlationID, err)
 	}
-	defer a.sessions.delete(correlationID)
+
+	a.logger.Debugf("Handling request %q", correlationID)
+	a.correlationKey.set(&event, correlationID)
+	a.sessr.ReportOpenSessions(a.sessions.len())
+	defer func() {
+		a.sessions.delete(ctx, correlationID)
+		a.sessr.ReportOpenSessions(a.sessions.len())
+	}()
 
 	sendErr := make(chan error)
 	defer close(sendErr)
@@ -137,29 +220,60 @@ func (a *adapter) serveRequest(ctx context.Context, correlationID string, event
 		res := a.withBridgeIdentifier(result)
 		return &res, cloudevents.ResultACK
 	case <-time.After(a.responseTimeout):
+		a.sessr.ReportSessionTimeout()
 		a.logger.Errorw("Request time out", zap.Error(fmt.Errorf("request %q did not receive backend response in time", correlationID)))
 		return nil, cloudevents.NewHTTPResult(http.StatusGatewayTimeout, "backend did not respond in time")
 	}
 }
 
+// openSession adds a session for the request, identified by either the
+// correlation ID supplied by the caller or a generated one. Generated IDs are
+// regenerated when they collide with an open session.
+func (a *adapter) openSession(ctx context.Context, event cloudevents.Event) (string, <-chan *cloudevents.Event, error) {
+	var headers http.Header
+	if rd := cehttp.RequestDataFromContext(ctx); rd != nil {
+		headers = rd.Header
+	}
+
+	if correlationID, exists := a.correlationKey.callerID(event, headers); exists {
+		respChan, err := a.sessions.add(ctx, correlationID)
+		return correlationID, respChan, err
+	}
+
+	for i := 0; i < maxSessionAttempts; i++ {
+		correlationID, err := a.correlationKey.generate()
+		if err != nil {
+			return "", nil, fmt.Errorf("generating correlation ID: %w", err)
+		}
+
+		respChan, err := a.sessions.add(ctx, correlationID)
+		if errors.Is(err, errSessionExists) {
+			a.logger.Warnw("Generated correlation ID collides with an open session", zap.String("session", correlationID))
+			continue
+		}
+		return correlationID, respChan, err
+	}
+
+	return "", nil, fmt.Errorf("unable to generate a unique correlation ID after %d attempts", maxSessionAttempts)
+}
+
 // serveResponse matches event's correlation key and writes response back to the session's communication channel.
 func (a *adapter) serveResponse(ctx context.Context, correlationID string, event cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
 	a.logger.Debugf("Handling response %q", correlationID)
 
-	responseChan, exists := a.sessions.get(correlationID)
-	if !exists {
-		a.logger.Errorw("Session not found", zap.Error(fmt.Errorf("client session with ID %q does not exist", correlationID)))
-		return nil, cloudevents.NewHTTPResult(http.StatusBadGateway, "client session does not exist")
-	}
-
 	a.logger.Debugf("Forwarding response %q", correlationID)
-	select {
-	case responseChan <- &event:
+
+	switch err := a.sessions.respond(ctx, correlationID, &event); {
+	case err == nil:
 		a.logger.Debugf("Response %q completed", correlationID)
 		return nil, cloudevents.ResultACK
+	case errors.Is(err, errSessionNotFound), errors.Is(err, errSessionAnswered):
+		a.sessr.ReportOrphanResponse()
+		a.logger.Errorw("Unable to forward the response", zap.String("session", correlationID), zap.Error(err))
+		return nil, cloudevents.NewHTTPResult(http.StatusBadGateway, "%v", err)
 	default:
-		a.logger.Errorw("Unable to forward the response", zap.Error(fmt.Errorf("client connection with ID %q is closed", correlationID)))
-		return nil, cloudevents.NewHTTPResult(http.StatusBadGateway, "client connection is closed")
+		a.logger.Errorw("Unable to forward the response", zap.String("session", correlationID), zap.Error(err))
+		return nil, cloudevents.NewHTTPResult(http.StatusInternalServerError, "unable to forward the response: %v", err)
 	}
 }
 
@@ -17,9 +17,12 @@ limitations under the License.
 package synchronizer
 
 import (
+	"crypto/rand"
 	"fmt"
-	"math/rand"
-	"time"
+	"math/big"
+	"net/http"
+
+	"github.com/google/uuid"
 
 	cloudevents "github.com/cloudevents/sdk-go/v2/event"
 )
@@ -27,44 +30,74 @@ import (
 // Correlation Key charset.
 const correlationKeycharset = "abcdefghijklmnopqrstuvwxyz0123456789"
 
-var (
-	// CloudEvent attributes cannot be used as a correltaion key.
-	restrictedKeys = []string{
-		"id",
-		"type",
-		"time",
-		"subject",
-		"schemaurl",
-		"dataschema",
-		"specversion",
-		"datamediatype",
-		"datacontenttype",
-		"datacontentencoding",
-	}
-
-	seededRand *rand.Rand = rand.New(rand.NewSource(time.Now().UnixNano()))
+// Correlation Key generators.
+const (
+	correlationGeneratorRandom = "random"
+	correlationGeneratorUUID   = "uuid"
 )
 
+// CloudEvent attributes cannot be used as a correltaion key.
+var restrictedKeys = []string{
+	"id",
+	"type",
+	"time",
+	"subject",
+	"schemaurl",
+	"dataschema",
+	"specversion",
+	"datamediatype",
+	"datacontenttype",
+	"datacontentencoding",
+}
+
 // correlationKey is the correlation attribute for the CloudEvents.
 type correlationKey struct {
 	attribute string
 	length    int
+	generator string
+
+	// caller-supplied correlation IDs
+	fromExtension string
+	fromHeader    string
 }
 
 // NewCorrelationKey returns an instance of the CloudEvent Correlation key.
-func newCorrelationKey(attribute string, length int) (*correlationKey, error) {
+func newCorrelationKey(attribute string, length int, generator string) (*correlationKey, error) {
 	for _, rk := range restrictedKeys {
 		if attribute == rk {
 			return nil, fmt.Errorf("%q cannot be used as a correlation key", attribute)
 		}
 	}
 
+	switch generator {
+	case correlationGeneratorRandom:
+		if length < 1 {
+			return nil, fmt.Errorf("invalid correlation key length %d", length)
+		}
+	case correlationGeneratorUUID:
+	default:
+		return nil, fmt.Errorf("unsupported correlation key generator %q", generator)
+	}
+
 	return &correlationKey{
 		attribute: attribute,
 		length:    length,
+		generator: generator,
 	}, nil
 }
 
+// withCallerID allows callers to supply the correlation ID of their request,
+// either in the given CloudEvent extension or in the given HTTP header.
+func (k *correlationKey) withCallerID(extension, header string) (*correlationKey, error) {
+	if extension != "" && extension == k.attribute {
+		return nil, fmt.Errorf("the correlation key %q cannot be supplied by the caller", extension)
+	}
+
+	k.fromExtension = extension
+	k.fromHeader = header
+	return k, nil
+}
+
 // Get returns the value of Correlation Key.
 func (k *correlationKey) get(event cloudevents.Event) (string, bool) {
 	if val, exists := event.Extensions()[k.attribute]; exists {
@@ -73,19 +106,53 @@ func (k *correlationKey) get(event cloudevents.Event) (string, bool) {
 	return "", false
 }
 
-// Set updates the CloudEvent's context with the random Correlation Key value.
-func (k *correlationKey) set(event *cloudevents.Event) string {
-	correlationID := randString(k.length)
+// callerID returns the correlation ID supplied by the caller, if any.
+func (k *correlationKey) callerID(event cloudevents.Event, headers http.Header) (string, bool) {
+	if k.fromExtension != "" {
+		if val, err := event.Context.GetExtension(k.fromExtension); err == nil {
+			if id := fmt.Sprint(val); id != "" {
+				return id, true
+			}
+		}
+	}
+
+	if k.fromHeader != "" {
+		if val := headers.Get(k.fromHeader); val != "" {
+			return val, true
+		}
+	}
+
+	return "", false
+}
+
+// Set updates the CloudEvent's context with the given Correlation Key value.
+func (k *correlationKey) set(event *cloudevents.Event, correlationID string) {
 	event.SetExtension(k.attribute, correlationID)
-	return correlationID
 }
 
-// randString generates the random string with fixed length.
-func randString(length int) string {
+// generate returns a new random Correlation Key value.
+func (k *correlationKey) generate() (string, error) {
+	if k.generator == correlationGeneratorUUID {
+		id, err := uuid.NewRandom()
+		if err != nil {
+			return "", err
+		}
+		return id.String(), nil
+	}
+	return randString(k.length)
+}
+
+// randString generates the random string with fixed length, using a
+// cryptographically secure source of randomness.
+func randString(length int) (string, error) {
 	k := make([]byte, length)
-	l := len(correlationKeycharset) - 1
+	l := big.NewInt(int64(len(correlationKeycharset)))
 	for i := range k {
-		k[i] = correlationKeycharset[seededRand.Intn(l)]
+		n, err := rand.Int(rand.Reader, l)
+		if err != nil {
+			return "", fmt.Errorf("reading random source: %w", err)
+		}
+		k[i] = correlationKeycharset[n.Int64()]
 	}
-	return string(k)
+	return string(k), nil
 }
@@ -0,0 +1,100 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package synchronizer
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2/event"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRandString(t *testing.T) {
+	seen := make(map[byte]struct{})
+
+	// the probability of missing a character of the charset in 10k draws
+	// is negligible
+	for i := 0; i < 100; i++ {
+		s, err := randString(100)
+		require.NoError(t, err)
+		require.Len(t, s, 100)
+
+		for j := range s {
+			seen[s[j]] = struct{}{}
+		}
+	}
+
+	for i := range correlationKeycharset {
+		assert.Contains(t, seen, correlationKeycharset[i], "character %q was never generated", correlationKeycharset[i])
+	}
+}
+
+func TestCorrelationKeyGenerate(t *testing.T) {
+	random, err := newCorrelationKey("correlationid", 32, correlationGeneratorRandom)
+	require.NoError(t, err)
+
+	id, err := random.generate()
+	require.NoError(t, err)
+	assert.Len(t, id, 32)
+	assert.Equal(t, "", strings.Trim(id, correlationKeycharset))
+
+	uuidKey, err := newCorrelationKey("correlationid", 0, correlationGeneratorUUID)
+	require.NoError(t, err)
+
+	id, err = uuidKey.generate()
+	require.NoError(t, err)
+	assert.Regexp(t, "^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$", id)
+
+	_, err = newCorrelationKey("correlationid", 0, correlationGeneratorRandom)
+	assert.Error(t, err)
+	_, err = newCorrelationKey("correlationid", 24, "sequential")
+	assert.Error(t, err)
+	_, err = newCorrelationKey("id", 24, correlationGeneratorRandom)
+	assert.Error(t, err)
+}
+
+func TestCorrelationKeyCallerID(t *testing.T) {
+	key, err := newCorrelationKey("correlationid", 24, correlationGeneratorRandom)
+	require.NoError(t, err)
+
+	_, err = key.withCallerID("correlationid", "")
+	assert.Error(t, err, "the correlation attribute can not be supplied by callers")
+
+	key, err = key.withCallerID("requestid", "X-Request-Id")
+	require.NoError(t, err)
+
+	event := cloudevents.New()
+
+	_, exists := key.callerID(event, nil)
+	assert.False(t, exists)
+
+	headers := http.Header{}
+	headers.Set("x-request-id", "from-header")
+
+	id, exists := key.callerID(event, headers)
+	assert.True(t, exists)
+	assert.Equal(t, "from-header", id)
+
+	event.SetExtension("requestid", "from-extension")
+
+	id, exists = key.callerID(event, headers)
+	assert.True(t, exists)
+	assert.Equal(t, "from-extension", id, "the extension takes precedence over the header")
+}
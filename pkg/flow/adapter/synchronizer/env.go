@@ -30,9 +30,21 @@ func EnvAccessorCtor() pkgadapter.EnvConfigAccessor {
 type envAccessor struct {
 	pkgadapter.EnvConfig
 
-	CorrelationKey       string        `envconfig:"CORRELATION_KEY"`
-	CorrelationKeyLength int           `envconfig:"CORRELATION_KEY_LENGTH"`
-	ResponseWaitTimeout  time.Duration `envconfig:"RESPONSE_WAIT_TIMEOUT"`
+	CorrelationKey          string        `envconfig:"CORRELATION_KEY"`
+	CorrelationKeyLength    int           `envconfig:"CORRELATION_KEY_LENGTH" default:"24"`
+	CorrelationKeyGenerator string        `envconfig:"CORRELATION_KEY_GENERATOR" default:"random"`
+	ResponseWaitTimeout     time.Duration `envconfig:"RESPONSE_WAIT_TIMEOUT" default:"30s"`
+
+	// Location of correlation IDs supplied by callers
+	CorrelationKeyFromExtension string `envconfig:"CORRELATION_KEY_FROM_EXTENSION"`
+	CorrelationKeyFromHeader    string `envconfig:"CORRELATION_KEY_FROM_HEADER"`
+
+	// Backend used to keep track of client sessions
+	SessionBackend string `envconfig:"SESSION_BACKEND" default:"memory"`
+	RedisAddress   string `envconfig:"SESSION_REDIS_ADDRESS"`
+	RedisPassword  string `envconfig:"SESSION_REDIS_PASSWORD"`
+	RedisDatabase  int    `envconfig:"SESSION_REDIS_DATABASE"`
+	RedisTLS       bool   `envconfig:"SESSION_REDIS_TLS_ENABLED"`
 
 	// BridgeIdentifier is the name of the bridge workflow this target is part of
 	BridgeIdentifier string `envconfig:"EVENTS_BRIDGE_IDENTIFIER"`
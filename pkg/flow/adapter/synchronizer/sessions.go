@@ -0,0 +1,64 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package synchronizer
+
+import (
+	"context"
+	"errors"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+)
+
+// Supported session backends.
+const (
+	sessionBackendMemory = "memory"
+	sessionBackendRedis  = "redis"
+)
+
+var (
+	// errSessionExists is returned when a session is opened with an ID
+	// that is already in use.
+	errSessionExists = errors.New("session already exists")
+	// errSessionNotFound is returned when a response does not match any
+	// open session.
+	errSessionNotFound = errors.New("client session does not exist")
+	// errSessionAnswered is returned when a response matches a session
+	// which already received a response.
+	errSessionAnswered = errors.New("client session already received a response")
+)
+
+// sessionStorage keeps track of the client sessions awaiting a response.
+type sessionStorage interface {
+	// add opens a session and returns the channel on which the response
+	// to this session is delivered.
+	add(ctx context.Context, id string) (<-chan *cloudevents.Event, error)
+	// delete closes a session.
+	delete(ctx context.Context, id string)
+	// respond delivers the response to a session, regardless of the
+	// replica holding the client connection.
+	respond(ctx context.Context, id string, event *cloudevents.Event) error
+	// len returns the number of sessions held by this replica.
+	len() int
+}
+
+// runnableSessionStorage is a sessionStorage which requires background
+// processing, such as receiving responses routed from other replicas.
+type runnableSessionStorage interface {
+	sessionStorage
+	// run blocks until the context is cancelled.
+	run(ctx context.Context)
+}
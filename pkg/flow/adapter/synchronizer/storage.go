@@ -17,7 +17,7 @@ limitations under the License.
 package synchronizer
 
 import (
-	"fmt"
+	"context"
 	"sync"
 
 	cloudevents "github.com/cloudevents/sdk-go/v2"
@@ -29,6 +29,8 @@ type storage struct {
 	sessions map[string]chan *cloudevents.Event
 }
 
+var _ sessionStorage = (*storage)(nil)
+
 // newStorage returns an instance of the sessions storage.
 func newStorage() *storage {
 	return &storage{
@@ -37,33 +39,65 @@ func newStorage() *storage {
 }
 
 // add creates the new communication channel and adds it to the session storage.
-func (s *storage) add(id string) (<-chan *cloudevents.Event, error) {
+func (s *storage) add(_ context.Context, id string) (<-chan *cloudevents.Event, error) {
 	s.Lock()
 	defer s.Unlock()
 
 	if _, exists := s.sessions[id]; exists {
-		return nil, fmt.Errorf("session already exists")
+		return nil, errSessionExists
 	}
 
-	c := make(chan *cloudevents.Event)
+	// buffered, so that a response is not lost when it is delivered before
+	// the client starts waiting for it
+	c := make(chan *cloudevents.Event, 1)
 	s.sessions[id] = c
 	return c, nil
 }
 
 // delete closes the communication channel and removes it from the storage.
-func (s *storage) delete(id string) {
+func (s *storage) delete(_ context.Context, id string) {
 	s.Lock()
 	defer s.Unlock()
 
-	close(s.sessions[id])
-	delete(s.sessions, id)
+	if c, exists := s.sessions[id]; exists {
+		close(c)
+		delete(s.sessions, id)
+	}
 }
 
-// open returns the communication channel for the session id.
-func (s *storage) get(id string) (chan<- *cloudevents.Event, bool) {
+// respond writes the response to the communication channel of the session.
+// The channel is written while holding the lock so that it can not be closed
+// concurrently.
+func (s *storage) respond(_ context.Context, id string, event *cloudevents.Event) error {
 	s.Lock()
 	defer s.Unlock()
 
 	session, exists := s.sessions[id]
-	return session, exists
+	if !exists {
+		return errSessionNotFound
+	}
+
+	select {
+	case session <- event:
+		return nil
+	default:
+		return errSessionAnswered
+	}
+}
+
+// has returns whether the session is held by this storage.
+func (s *storage) has(id string) bool {
+	s.Lock()
+	defer s.Unlock()
+
+	_, exists := s.sessions[id]
+	return exists
+}
+
+// len returns the number of open sessions.
+func (s *storage) len() int {
+	s.Lock()
+	defer s.Unlock()
+
+	return len(s.sessions)
 }
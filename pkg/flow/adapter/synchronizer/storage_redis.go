@@ -0,0 +1,195 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package synchronizer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+// Maximum duration of the removal of a session record. Removals are not bound
+// to the context of the client request, which is usually cancelled by the
+// time the session is closed.
+const redisDeleteTimeout = 5 * time.Second
+
+// redisStorage is a sessionStorage which shares the location of sessions
+// between replicas through a Redis server. Client connections remain held by
+// the local storage of each replica, and responses received by a replica
+// which does not hold the session are published to the channel of the
+// replica which does.
+type redisStorage struct {
+	local  *storage
+	client *redis.Client
+	logger *zap.SugaredLogger
+
+	// unique identifier of this replica
+	replica string
+	// prefix of all Redis keys and channels
+	prefix string
+	// expiration of session keys, in case a replica terminates without
+	// deleting its sessions
+	ttl time.Duration
+}
+
+var _ runnableSessionStorage = (*redisStorage)(nil)
+
+// routedResponse is the message published to the channel of a replica.
+type routedResponse struct {
+	Session string             `json:"session"`
+	Event   *cloudevents.Event `json:"event"`
+}
+
+// newRedisStorage returns a sessionStorage which shares sessions through the
+// Redis server of the given client.
+func newRedisStorage(client *redis.Client, prefix string, ttl time.Duration, logger *zap.SugaredLogger) *redisStorage {
+	return &redisStorage{
+		local:   newStorage(),
+		client:  client,
+		logger:  logger,
+		replica: uuid.New().String(),
+		prefix:  prefix,
+		ttl:     ttl,
+	}
+}
+
+// add opens the session locally and records this replica as its owner.
+func (s *redisStorage) add(ctx context.Context, id string) (<-chan *cloudevents.Event, error) {
+	c, err := s.local.add(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	registered, err := s.client.SetNX(ctx, s.sessionKey(id), s.replica, s.ttl).Result()
+	if err != nil {
+		s.local.delete(ctx, id)
+		return nil, fmt.Errorf("registering session: %w", err)
+	}
+	if !registered {
+		// the key already exists, the session is held by another replica
+		s.local.delete(ctx, id)
+		return nil, errSessionExists
+	}
+
+	return c, nil
+}
+
+// delete closes the local session and removes its owner record.
+func (s *redisStorage) delete(ctx context.Context, id string) {
+	s.local.delete(ctx, id)
+
+	delCtx, cancel := context.WithTimeout(context.Background(), redisDeleteTimeout)
+	defer cancel()
+
+	// the key expires anyway, so failures are only logged
+	if err := s.client.Del(delCtx, s.sessionKey(id)).Err(); err != nil {
+		s.logger.Warnw("Unable to delete session record", zap.String("session", id), zap.Error(err))
+	}
+}
+
+// respond delivers the response locally if the session is held by this
+// replica, or publishes it to the replica which holds it otherwise.
+func (s *redisStorage) respond(ctx context.Context, id string, event *cloudevents.Event) error {
+	if s.local.has(id) {
+		return s.local.respond(ctx, id, event)
+	}
+
+	owner, err := s.client.Get(ctx, s.sessionKey(id)).Result()
+	switch {
+	case errors.Is(err, redis.Nil):
+		return errSessionNotFound
+	case err != nil:
+		return fmt.Errorf("looking up session: %w", err)
+	}
+
+	msg, err := json.Marshal(routedResponse{
+		Session: id,
+		Event:   event,
+	})
+	if err != nil {
+		return fmt.Errorf("serializing response: %w", err)
+	}
+
+	receivers, err := s.client.Publish(ctx, s.replicaChannel(owner), msg).Result()
+	if err != nil {
+		return fmt.Errorf("routing response: %w", err)
+	}
+	if receivers == 0 {
+		// the owner is gone, its record hasn't expired yet
+		return errSessionNotFound
+	}
+
+	return nil
+}
+
+// len returns the number of sessions held by this replica.
+func (s *redisStorage) len() int {
+	return s.local.len()
+}
+
+// run receives the responses routed to this replica by other replicas until
+// the context is cancelled. The subscription is re-established by the client
+// whenever the connection to the Redis server is lost.
+func (s *redisStorage) run(ctx context.Context) {
+	sub := s.client.Subscribe(ctx, s.replicaChannel(s.replica))
+	defer func() {
+		if err := sub.Close(); err != nil {
+			s.logger.Warnw("Unable to close subscription to routed responses", zap.Error(err))
+		}
+	}()
+
+	msgs := sub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg, ok := <-msgs:
+			if !ok {
+				return
+			}
+			s.deliver(ctx, []byte(msg.Payload))
+		}
+	}
+}
+
+// deliver writes a routed response to its local session.
+func (s *redisStorage) deliver(ctx context.Context, msg []byte) {
+	var r routedResponse
+	if err := json.Unmarshal(msg, &r); err != nil {
+		s.logger.Errorw("Unable to decode routed response", zap.Error(err))
+		return
+	}
+
+	if err := s.local.respond(ctx, r.Session, r.Event); err != nil {
+		s.logger.Errorw("Unable to deliver routed response", zap.String("session", r.Session), zap.Error(err))
+	}
+}
+
+func (s *redisStorage) sessionKey(id string) string {
+	return s.prefix + ":session:" + id
+}
+
+func (s *redisStorage) replicaChannel(replica string) string {
+	return s.prefix + ":replica:" + replica
+}
@@ -0,0 +1,172 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package synchronizer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	loggingtesting "knative.dev/pkg/logging/testing"
+)
+
+const (
+	tRedisPrefix = "test"
+	tRedisTTL    = time.Minute
+)
+
+func TestRedisStorage(t *testing.T) {
+	mr := miniredis.RunT(t)
+
+	s1 := newTestRedisStorage(t, mr)
+	s2 := newTestRedisStorage(t, mr)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go s1.run(ctx)
+	waitForSubscription(t, mr, s1)
+
+	c, err := s1.add(ctx, "1")
+	require.NoError(t, err)
+	assert.Equal(t, 1, s1.len())
+
+	owner, err := mr.Get(s1.sessionKey("1"))
+	require.NoError(t, err)
+	assert.Equal(t, s1.replica, owner)
+	assert.Equal(t, tRedisTTL, mr.TTL(s1.sessionKey("1")))
+
+	_, err = s2.add(ctx, "1")
+	assert.ErrorIs(t, err, errSessionExists, "Session should be held by the first replica")
+	assert.Equal(t, 0, s2.len())
+
+	event := newTestResponse()
+
+	// the response is received by the replica which doesn't hold the
+	// session, and routed to the one which does
+	require.NoError(t, s2.respond(ctx, "1", event))
+
+	select {
+	case got := <-c:
+		assert.Equal(t, "response", got.ID())
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for routed response")
+	}
+
+	assert.ErrorIs(t, s2.respond(ctx, "2", event), errSessionNotFound)
+
+	s1.delete(ctx, "1")
+	assert.Equal(t, 0, s1.len())
+	assert.False(t, mr.Exists(s1.sessionKey("1")), "Session record should be deleted")
+	assert.ErrorIs(t, s2.respond(ctx, "1", event), errSessionNotFound)
+}
+
+func TestRedisStorageLocalResponse(t *testing.T) {
+	mr := miniredis.RunT(t)
+	s := newTestRedisStorage(t, mr)
+
+	ctx := context.Background()
+
+	c, err := s.add(ctx, "1")
+	require.NoError(t, err)
+
+	event := newTestResponse()
+
+	require.NoError(t, s.respond(ctx, "1", event))
+	assert.ErrorIs(t, s.respond(ctx, "1", event), errSessionAnswered)
+
+	got := <-c
+	assert.Equal(t, "response", got.ID())
+}
+
+func TestRedisStorageOwnerGone(t *testing.T) {
+	mr := miniredis.RunT(t)
+	s := newTestRedisStorage(t, mr)
+
+	// record left behind by a replica which terminated without deleting
+	// its sessions
+	require.NoError(t, mr.Set(s.sessionKey("1"), "terminated-replica"))
+
+	event := newTestResponse()
+
+	assert.ErrorIs(t, s.respond(context.Background(), "1", event), errSessionNotFound)
+}
+
+func TestRedisStorageDeleteCancelledRequest(t *testing.T) {
+	mr := miniredis.RunT(t)
+	s := newTestRedisStorage(t, mr)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	_, err := s.add(ctx, "1")
+	require.NoError(t, err)
+
+	// the client request is cancelled before the session is closed
+	cancel()
+	s.delete(ctx, "1")
+
+	assert.False(t, mr.Exists(s.sessionKey("1")), "Session record should be deleted")
+}
+
+func TestRedisStorageUnavailable(t *testing.T) {
+	mr := miniredis.RunT(t)
+	s := newTestRedisStorage(t, mr)
+
+	mr.SetError("server unavailable")
+
+	_, err := s.add(context.Background(), "1")
+	assert.Error(t, err)
+	assert.Equal(t, 0, s.len(), "Local session should be closed when it can't be registered")
+}
+
+// newTestResponse returns a valid response event, which can be routed between
+// replicas.
+func newTestResponse() *cloudevents.Event {
+	event := cloudevents.NewEvent()
+	event.SetID("response")
+	event.SetType("io.triggermesh.test.response")
+	event.SetSource("test")
+	return &event
+}
+
+// newTestRedisStorage returns a redisStorage backed by the given Redis server.
+func newTestRedisStorage(t *testing.T, mr *miniredis.Miniredis) *redisStorage {
+	t.Helper()
+
+	cli := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { _ = cli.Close() })
+
+	return newRedisStorage(cli, tRedisPrefix, tRedisTTL, loggingtesting.TestLogger(t))
+}
+
+// waitForSubscription waits until the given storage is subscribed to the
+// channel of its replica.
+func waitForSubscription(t *testing.T, mr *miniredis.Miniredis, s *redisStorage) {
+	t.Helper()
+
+	ch := s.replicaChannel(s.replica)
+
+	require.Eventually(t, func() bool {
+		return mr.PubSubNumSub(ch)[ch] > 0
+	}, 5*time.Second, 10*time.Millisecond, "Storage did not subscribe to routed responses")
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package synchronizer
+
+import (
+	"context"
+	"testing"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorage(t *testing.T) {
+	ctx := context.Background()
+	s := newStorage()
+
+	c, err := s.add(ctx, "1")
+	require.NoError(t, err)
+
+	_, err = s.add(ctx, "1")
+	assert.ErrorIs(t, err, errSessionExists)
+	assert.Equal(t, 1, s.len())
+
+	event := cloudevents.NewEvent()
+	event.SetID("response")
+
+	// the response is delivered before the client starts waiting for it
+	require.NoError(t, s.respond(ctx, "1", &event))
+	assert.ErrorIs(t, s.respond(ctx, "1", &event), errSessionAnswered)
+	assert.ErrorIs(t, s.respond(ctx, "2", &event), errSessionNotFound)
+
+	got := <-c
+	assert.Equal(t, "response", got.ID())
+
+	s.delete(ctx, "1")
+	assert.False(t, s.has("1"))
+	assert.Equal(t, 0, s.len())
+	assert.ErrorIs(t, s.respond(ctx, "1", &event), errSessionNotFound)
+
+	_, open := <-c
+	assert.False(t, open, "channel should be closed")
+}
@@ -24,6 +24,7 @@ import (
 	"time"
 
 	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/google/uuid"
 	"go.uber.org/zap"
 
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
@@ -51,6 +52,9 @@ type adapter struct {
 	ContextPipeline *Pipeline
 	DataPipeline    *Pipeline
 
+	// Pipeline variables, scoped per event
+	variables *storage.Storage
+
 	mt *pkgadapter.MetricTag
 	sr *metrics.EventProcessingStatsReporter
 
@@ -112,6 +116,8 @@ func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClie
 		ContextPipeline: contextPl,
 		DataPipeline:    dataPl,
 
+		variables: sharedStorage,
+
 		mt: mt,
 		sr: metrics.MustNewEventProcessingStatsReporter(mt),
 
@@ -202,22 +208,30 @@ func (t *adapter) applyTransformations(event cloudevents.Event) (*cloudevents.Ev
 		return nil, fmt.Errorf("cannot encode CE context: %w", err)
 	}
 
+	// Pipeline variables are scoped to this transformation, event IDs
+	// can't be used as they are not guaranteed to be unique
+	scope := uuid.New().String()
+	defer t.variables.Delete(scope)
+
+	// conditions are evaluated against the incoming event
+	original := event.Clone()
+
 	// init indicates if we need to run initial step transformation
 	var init = true
 	var errs []error
 
 	// Run init step such as load Pipeline variables first
-	eventContext, err := t.ContextPipeline.apply(localContextBytes, init)
+	eventContext, err := t.ContextPipeline.apply(scope, original, localContextBytes, init)
 	if err != nil {
 		errs = append(errs, err)
 	}
-	eventPayload, err := t.DataPipeline.apply(event.Data(), init)
+	eventPayload, err := t.DataPipeline.apply(scope, original, event.Data(), init)
 	if err != nil {
 		errs = append(errs, err)
 	}
 
 	// CE Context transformation
-	if eventContext, err = t.ContextPipeline.apply(eventContext, !init); err != nil {
+	if eventContext, err = t.ContextPipeline.apply(scope, original, eventContext, !init); err != nil {
 		errs = append(errs, err)
 	}
 	if err := json.Unmarshal(eventContext, &localContext); err != nil {
@@ -233,7 +247,7 @@ func (t *adapter) applyTransformations(event cloudevents.Event) (*cloudevents.Ev
 	}
 
 	// CE Data transformation
-	if eventPayload, err = t.DataPipeline.apply(eventPayload, !init); err != nil {
+	if eventPayload, err = t.DataPipeline.apply(scope, original, eventPayload, !init); err != nil {
 		errs = append(errs, err)
 	}
 	if err = event.SetData(cloudevents.ApplicationJSON, eventPayload); err != nil {
@@ -19,6 +19,9 @@ package transformation
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"strconv"
+	"sync"
 	"testing"
 	"time"
 
@@ -42,7 +45,8 @@ func TestStart(t *testing.T) {
 	pipeline, err := newPipeline(availableTransformations)
 	assert.NoError(t, err)
 
-	pipeline.setStorage(storage.New())
+	variables := storage.New()
+	pipeline.setStorage(variables)
 
 	ceClient, err := cloudevents.NewClientHTTP()
 	assert.NoError(t, err)
@@ -50,6 +54,7 @@ func TestStart(t *testing.T) {
 	a := &adapter{
 		ContextPipeline: pipeline,
 		DataPipeline:    pipeline,
+		variables:       variables,
 
 		client: ceClient,
 		logger: logtesting.TestLogger(t),
@@ -241,6 +246,121 @@ func TestReceiveAndTransform(t *testing.T) {
 					},
 				},
 			},
+		}, {
+			name: "Cast operation",
+			originalEvent: setData(t, newEvent(),
+				json.RawMessage(`{"num":"42.5","flag":"true","str":7,"ts":"2022-06-01T10:00:00+02:00","epoch":0}`)),
+			expectedEventData: `{"epoch":"1970-01-01T00:00:00Z","flag":true,"num":42.5,"str":"7","ts":"2022-06-01T08:00:00Z"}`,
+			data: []v1alpha1.Transform{
+				{
+					Operation: "cast",
+					Paths: []v1alpha1.Path{
+						{
+							Key:   "num",
+							Value: "number",
+						}, {
+							Key:   "flag",
+							Value: "bool",
+						}, {
+							Key:   "str",
+							Value: "string",
+						}, {
+							Key:   "ts",
+							Value: "timestamp",
+						}, {
+							Key:   "epoch",
+							Value: "timestamp",
+						},
+					},
+				},
+			},
+		}, {
+			name: "String operations",
+			originalEvent: setData(t, newEvent(),
+				json.RawMessage(`{"name":"Foo","code":"abc","tags":"a,b,c","phone":"555-123-456"}`)),
+			expectedEventData: `{"code":"ABC","name":"foo-bar","phone":"555123456","tags":["a","b","c"]}`,
+			data: []v1alpha1.Transform{
+				{
+					Operation: "lower",
+					Paths: []v1alpha1.Path{
+						{
+							Key: "name",
+						},
+					},
+				}, {
+					Operation: "concat",
+					Paths: []v1alpha1.Path{
+						{
+							Key:   "name",
+							Value: "-bar",
+						},
+					},
+				}, {
+					Operation: "upper",
+					Paths: []v1alpha1.Path{
+						{
+							Key: "code",
+						},
+					},
+				}, {
+					Operation: "split",
+					Paths: []v1alpha1.Path{
+						{
+							Key: "tags",
+						},
+					},
+				}, {
+					Operation: "replace",
+					Paths: []v1alpha1.Path{
+						{
+							Key:   "phone",
+							Value: "/[^0-9]//",
+						},
+					},
+				},
+			},
+		}, {
+			name: "Map operation",
+			originalEvent: setData(t, newEvent(),
+				json.RawMessage(`{"items":[{"id":1,"name":"a"},{"id":2,"name":"b"},{"name":"c"}]}`)),
+			expectedEventData: `{"items":[1,2,null]}`,
+			data: []v1alpha1.Transform{
+				{
+					Operation: "map",
+					Paths: []v1alpha1.Path{
+						{
+							Key:   "items",
+							Value: "id",
+						},
+					},
+				},
+			},
+		}, {
+			name: "Conditional operations",
+			originalEvent: setData(t, newEvent(),
+				json.RawMessage(`{"foo":"bar"}`)),
+			expectedEventData: `{"foo":"bar","matched":"yes"}`,
+			data: []v1alpha1.Transform{
+				{
+					Operation: "add",
+					Paths: []v1alpha1.Path{
+						{
+							Key:   "matched",
+							Value: "yes",
+						},
+					},
+					Condition: `ce.type == "test" && data.foo == "bar"`,
+				}, {
+					Operation: "add",
+					Paths: []v1alpha1.Path{
+						{
+							Key:   "unmatched",
+							Value: "yes",
+						},
+					},
+					Condition: `ce.type == "other"`,
+				},
+			},
 		},
 		{
 			name: "Ignore errors", //ensure that errored transformation won't affect the event
@@ -293,11 +413,13 @@ func TestReceiveAndTransform(t *testing.T) {
 			pipeline, err := newPipeline(tc.data)
 			assert.NoError(t, err)
 
-			pipeline.setStorage(storage.New())
+			variables := storage.New()
+			pipeline.setStorage(variables)
 
 			a := &adapter{
 				DataPipeline:    pipeline,
 				ContextPipeline: pipeline,
+				variables:       variables,
 				logger:          logtesting.TestLogger(t),
 			}
 
@@ -308,3 +430,67 @@ func TestReceiveAndTransform(t *testing.T) {
 		})
 	}
 }
+
+func TestConcurrentTransformations(t *testing.T) {
+	const numEvents = 200
+
+	// the variable stored from each event's data is added back to the
+	// same event, which allows detecting leaks between events
+	dataPipeline, err := newPipeline([]v1alpha1.Transform{
+		{
+			Operation: "store",
+			Paths: []v1alpha1.Path{{
+				Key:   "$id",
+				Value: "id",
+			}},
+		}, {
+			Operation: "add",
+			Paths: []v1alpha1.Path{{
+				Key:   "copy",
+				Value: "$id",
+			}},
+		}, {
+			Operation: "delete",
+			Paths: []v1alpha1.Path{{
+				Key: "id",
+			}},
+		},
+	})
+	assert.NoError(t, err)
+
+	contextPipeline, err := newPipeline(nil)
+	assert.NoError(t, err)
+
+	variables := storage.New()
+	dataPipeline.setStorage(variables)
+	contextPipeline.setStorage(variables)
+
+	a := &adapter{
+		ContextPipeline: contextPipeline,
+		DataPipeline:    dataPipeline,
+		variables:       variables,
+		logger:          logtesting.TestLogger(t),
+	}
+
+	var wg sync.WaitGroup
+	results := make([]*cloudevents.Event, numEvents)
+	errs := make([]error, numEvents)
+
+	for i := 0; i < numEvents; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			event := setData(t, newEvent(), map[string]string{"id": strconv.Itoa(i)})
+			results[i], errs[i] = a.applyTransformations(event)
+		}(i)
+	}
+	wg.Wait()
+
+	for i := 0; i < numEvents; i++ {
+		if !assert.NoError(t, errs[i]) {
+			continue
+		}
+		expect := fmt.Sprintf(`{"copy":"%d"}`, i)
+		assert.Equal(t, expect, string(results[i].Data()), "Unexpected data for event %d", i)
+	}
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package convert
+
+import (
+	"strconv"
+	"strings"
+)
+
+// ReadValue returns the value located at the given path of the decoded JSON
+// source. Path elements are object keys, optionally followed by an array
+// index, e.g. "foo[1]" or "[0]". The boolean return value indicates whether
+// the path exists.
+func ReadValue(source interface{}, path []string) (interface{}, bool) {
+	for _, elem := range path {
+		key, index, isIndex := parsePathElement(elem)
+
+		if key != "" || !isIndex {
+			m, ok := source.(map[string]interface{})
+			if !ok {
+				return nil, false
+			}
+			if source, ok = m[key]; !ok {
+				return nil, false
+			}
+		}
+
+		if isIndex {
+			arr, ok := source.([]interface{})
+			if !ok || index >= len(arr) {
+				return nil, false
+			}
+			source = arr[index]
+		}
+	}
+
+	return source, true
+}
+
+// SetValue writes the value at the given path of the decoded JSON source,
+// replacing any existing value and creating intermediate objects and array
+// elements when necessary. It returns the updated source.
+func SetValue(source interface{}, path []string, value interface{}) interface{} {
+	if len(path) == 0 {
+		return value
+	}
+
+	key, index, isIndex := parsePathElement(path[0])
+
+	setElem := func(current interface{}) interface{} {
+		if !isIndex {
+			return SetValue(current, path[1:], value)
+		}
+		arr, _ := current.([]interface{})
+		for len(arr) <= index {
+			arr = append(arr, nil)
+		}
+		arr[index] = SetValue(arr[index], path[1:], value)
+		return arr
+	}
+
+	if key == "" && isIndex {
+		return setElem(source)
+	}
+
+	m, ok := source.(map[string]interface{})
+	if !ok {
+		m = make(map[string]interface{})
+	}
+	m[key] = setElem(m[key])
+
+	return m
+}
+
+// parsePathElement splits a path element into an object key and an optional
+// array index.
+func parsePathElement(elem string) (string, int, bool) {
+	i := strings.Index(elem, "[")
+	if i == -1 || !strings.HasSuffix(elem, "]") {
+		return elem, 0, false
+	}
+
+	index, err := strconv.Atoi(elem[i+1 : len(elem)-1])
+	if err != nil || index < 0 {
+		return elem, 0, false
+	}
+
+	return elem[:i], index, true
+}
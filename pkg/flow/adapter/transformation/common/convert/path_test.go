@@ -0,0 +1,92 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package convert
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testJSON = `{"foo":{"bar":"baz","list":[{"id":1},{"id":2}]},"root":true}`
+
+func TestReadValue(t *testing.T) {
+	testCases := []struct {
+		path   string
+		value  interface{}
+		exists bool
+	}{
+		{path: "root", value: true, exists: true},
+		{path: "foo.bar", value: "baz", exists: true},
+		{path: "foo.list[1].id", value: 2.0, exists: true},
+		{path: "foo.list.[0].id", value: 1.0, exists: true},
+		{path: "foo.list[2].id", exists: false},
+		{path: "foo.missing", exists: false},
+		{path: "root.bar", exists: false},
+	}
+
+	var source interface{}
+	require.NoError(t, json.Unmarshal([]byte(testJSON), &source))
+
+	for _, tc := range testCases {
+		t.Run(tc.path, func(t *testing.T) {
+			value, exists := ReadValue(source, strings.Split(tc.path, "."))
+			assert.Equal(t, tc.exists, exists)
+			assert.Equal(t, tc.value, value)
+		})
+	}
+}
+
+func TestSetValue(t *testing.T) {
+	testCases := []struct {
+		path   string
+		value  interface{}
+		result string
+	}{
+		{
+			path:   "root",
+			value:  "replaced",
+			result: `{"foo":{"bar":"baz","list":[{"id":1},{"id":2}]},"root":"replaced"}`,
+		}, {
+			path:   "foo.list",
+			value:  []interface{}{1, 2},
+			result: `{"foo":{"bar":"baz","list":[1,2]},"root":true}`,
+		}, {
+			path:   "foo.list[3].id",
+			value:  4,
+			result: `{"foo":{"bar":"baz","list":[{"id":1},{"id":2},null,{"id":4}]},"root":true}`,
+		}, {
+			path:   "new.nested",
+			value:  "value",
+			result: `{"foo":{"bar":"baz","list":[{"id":1},{"id":2}]},"new":{"nested":"value"},"root":true}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.path, func(t *testing.T) {
+			var source interface{}
+			require.NoError(t, json.Unmarshal([]byte(testJSON), &source))
+
+			result, err := json.Marshal(SetValue(source, strings.Split(tc.path, "."), tc.value))
+			require.NoError(t, err)
+			assert.JSONEq(t, tc.result, string(result))
+		})
+	}
+}
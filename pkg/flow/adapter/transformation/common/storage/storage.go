@@ -19,41 +19,56 @@ package storage
 import "sync"
 
 // Storage is a simple object that provides thread safe
-// methods to read and write into a map.
+// methods to read and write Pipeline variables. Variables
+// are scoped to the event being transformed so that
+// concurrent transformations do not see each other's values.
 type Storage struct {
-	data map[string]interface{}
+	data map[string]map[string]interface{}
 	mux  sync.RWMutex
 }
 
 // New returns an instance of Storage.
 func New() *Storage {
 	return &Storage{
-		data: make(map[string]interface{}),
+		data: make(map[string]map[string]interface{}),
 		mux:  sync.RWMutex{},
 	}
 }
 
-// Set writes a value interface to a string key.
-func (s *Storage) Set(k string, v interface{}) {
+// Set writes a value interface to a string key in the given scope.
+func (s *Storage) Set(scope, k string, v interface{}) {
 	s.mux.Lock()
-	s.data[k] = v
+	vars, ok := s.data[scope]
+	if !ok {
+		vars = make(map[string]interface{})
+		s.data[scope] = vars
+	}
+	vars[k] = v
 	s.mux.Unlock()
 }
 
-// Get reads value by a key.
-func (s *Storage) Get(k string) interface{} {
+// Get reads value by a key in the given scope.
+func (s *Storage) Get(scope, k string) interface{} {
 	s.mux.RLock()
 	defer s.mux.RUnlock()
-	return s.data[k]
+	return s.data[scope][k]
 }
 
-// ListKeys returns the slice of var keys stored in memory.
-func (s *Storage) ListKeys() []string {
+// ListKeys returns the slice of var keys stored in memory
+// in the given scope.
+func (s *Storage) ListKeys(scope string) []string {
 	s.mux.RLock()
 	defer s.mux.RUnlock()
 	list := []string{}
-	for k := range s.data {
+	for k := range s.data[scope] {
 		list = append(list, k)
 	}
 	return list
 }
+
+// Delete removes all variables stored in the given scope.
+func (s *Storage) Delete(scope string) {
+	s.mux.Lock()
+	delete(s.data, scope)
+	s.mux.Unlock()
+}
@@ -20,20 +20,33 @@ import (
 	"fmt"
 	"strings"
 
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+
 	"github.com/triggermesh/triggermesh/pkg/apis/flow/v1alpha1"
 	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/common/storage"
 	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/transformer"
 	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/transformer/add"
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/transformer/casemap"
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/transformer/cast"
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/transformer/concat"
 	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/transformer/delete"
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/transformer/mapping"
 	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/transformer/parse"
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/transformer/replace"
 	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/transformer/shift"
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/transformer/split"
 	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/transformer/store"
+	"github.com/triggermesh/triggermesh/pkg/routing/eventfilter/cel"
 )
 
 // Pipeline is a set of Transformations that are
 // sequentially applied to JSON data.
 type Pipeline struct {
 	Transformers []transformer.Transformer
+
+	// conditions holds the optional condition of each
+	// Transformer, at the same index.
+	conditions []*cel.ConditionalFilter
 }
 
 // register loads available Transformation into a named map.
@@ -45,6 +58,12 @@ func register() map[string]transformer.Transformer {
 	shift.Register(transformations)
 	store.Register(transformations)
 	parse.Register(transformations)
+	cast.Register(transformations)
+	concat.Register(transformations)
+	casemap.Register(transformations)
+	split.Register(transformations)
+	replace.Register(transformations)
+	mapping.Register(transformations)
 
 	return transformations
 }
@@ -53,19 +72,37 @@ func register() map[string]transformer.Transformer {
 func newPipeline(transformations []v1alpha1.Transform) (*Pipeline, error) {
 	availableTransformers := register()
 	pipeline := []transformer.Transformer{}
+	conditions := []*cel.ConditionalFilter{}
 
 	for _, transformation := range transformations {
 		operation, exist := availableTransformers[transformation.Operation]
 		if !exist {
 			return nil, fmt.Errorf("transformation %q not found", transformation.Operation)
 		}
+
+		var condition *cel.ConditionalFilter
+		if transformation.Condition != "" {
+			cond, err := cel.CompileExpression(transformation.Condition)
+			if err != nil {
+				return nil, fmt.Errorf("cannot compile condition of transformation %q: %w", transformation.Operation, err)
+			}
+			condition = &cond
+		}
+
 		for _, kv := range transformation.Paths {
-			pipeline = append(pipeline, operation.New(kv.Key, kv.Value))
+			t := operation.New(kv.Key, kv.Value)
+			if t == nil {
+				return nil, fmt.Errorf("invalid parameters for transformation %q: key %q, value %q",
+					transformation.Operation, kv.Key, kv.Value)
+			}
+			pipeline = append(pipeline, t)
+			conditions = append(conditions, condition)
 		}
 	}
 
 	return &Pipeline{
 		Transformers: pipeline,
+		conditions:   conditions,
 	}, nil
 }
 
@@ -76,15 +113,28 @@ func (p *Pipeline) setStorage(s *storage.Storage) {
 	}
 }
 
-// Apply applies Pipeline transformations.
-func (p *Pipeline) apply(data []byte, init bool) ([]byte, error) {
+// Apply applies Pipeline transformations. Variables are read and written
+// in the given scope, and transformation conditions are evaluated against
+// the given event.
+func (p *Pipeline) apply(scope string, event cloudevents.Event, data []byte, init bool) ([]byte, error) {
 	var err error
 	var errs []string
-	for _, v := range p.Transformers {
-		if init == v.InitStep() {
-			if data, err = v.Apply(data); err != nil {
-				errs = append(errs, err.Error())
+	for i, v := range p.Transformers {
+		if init != v.InitStep() {
+			continue
+		}
+		if cond := p.conditions[i]; cond != nil {
+			pass, err := cond.Eval(event)
+			if err != nil {
+				errs = append(errs, fmt.Sprintf("cannot evaluate condition: %v", err))
+				continue
 			}
+			if !pass {
+				continue
+			}
+		}
+		if data, err = v.Apply(scope, data); err != nil {
+			errs = append(errs, err.Error())
 		}
 	}
 	if len(errs) != 0 {
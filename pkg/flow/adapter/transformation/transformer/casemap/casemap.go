@@ -0,0 +1,110 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package casemap
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/common/convert"
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/common/storage"
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/transformer"
+)
+
+var _ transformer.Transformer = (*CaseMap)(nil)
+
+// CaseMap object implements Transformer interface.
+type CaseMap struct {
+	Path  string
+	Value string
+
+	// mapping converts the case of string values.
+	mapping func(string) string
+
+	variables *storage.Storage
+}
+
+// InitStep is used to figure out if this operation should
+// run before main Transformations. For example, Store
+// operation needs to run first to load all Pipeline variables.
+var InitStep bool = false
+
+// operations maps the names used to identify this transformation
+// to the case mapping each of them applies.
+var operations = map[string]func(string) string{
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+}
+
+// Register adds this transformation to the map which will
+// be used to create Transformation pipeline.
+func Register(m map[string]transformer.Transformer) {
+	for name, mapping := range operations {
+		m[name] = &CaseMap{mapping: mapping}
+	}
+}
+
+// SetStorage sets a shared Storage with Pipeline variables.
+func (c *CaseMap) SetStorage(storage *storage.Storage) {
+	c.variables = storage
+}
+
+// InitStep returns "true" if this Transformation should run
+// as init step.
+func (c *CaseMap) InitStep() bool {
+	return InitStep
+}
+
+// New returns a new instance of CaseMap object.
+func (c *CaseMap) New(key, value string) transformer.Transformer {
+	return &CaseMap{
+		Path:  key,
+		Value: value,
+
+		mapping:   c.mapping,
+		variables: c.variables,
+	}
+}
+
+// Apply is a main method of Transformation that converts the case
+// of an existing string.
+func (c *CaseMap) Apply(scope string, data []byte) ([]byte, error) {
+	path := strings.Split(c.Path, ".")
+
+	var event interface{}
+	if err := json.Unmarshal(data, &event); err != nil {
+		return data, err
+	}
+
+	value, exists := convert.ReadValue(event, path)
+	if !exists {
+		return data, nil
+	}
+
+	str, ok := value.(string)
+	if !ok {
+		return data, fmt.Errorf("value of %q is not a string", c.Path)
+	}
+
+	output, err := json.Marshal(convert.SetValue(event, path, c.mapping(str)))
+	if err != nil {
+		return data, err
+	}
+
+	return output, nil
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package casemap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/common/storage"
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/transformer"
+)
+
+const tScope = "test"
+
+func TestCaseMap(t *testing.T) {
+	testCases := map[string]struct {
+		operation string
+		key       string
+		data      string
+		expect    string
+		expectErr bool
+	}{
+		"Upper": {
+			operation: "upper",
+			key:       "name",
+			data:      `{"name":"Foo"}`,
+			expect:    `{"name":"FOO"}`,
+		},
+		"Lower": {
+			operation: "lower",
+			key:       "a.b",
+			data:      `{"a":{"b":"Foo"}}`,
+			expect:    `{"a":{"b":"foo"}}`,
+		},
+		"Missing path": {
+			operation: "upper",
+			key:       "name",
+			data:      "{}",
+			expect:    "{}",
+		},
+		"Non-string value": {
+			operation: "lower",
+			key:       "name",
+			data:      `{"name":1}`,
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			m := make(map[string]transformer.Transformer)
+			Register(m)
+
+			tr := m[tc.operation].New(tc.key, "")
+			tr.SetStorage(storage.New())
+
+			out, err := tr.Apply(tScope, []byte(tc.data))
+			if tc.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.JSONEq(t, tc.expect, string(out))
+		})
+	}
+}
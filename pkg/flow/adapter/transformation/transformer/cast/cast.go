@@ -0,0 +1,206 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cast
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/common/convert"
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/common/storage"
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/transformer"
+)
+
+var _ transformer.Transformer = (*Cast)(nil)
+
+// Cast object implements Transformer interface.
+type Cast struct {
+	Path  string
+	Value string
+
+	variables *storage.Storage
+}
+
+// InitStep is used to figure out if this operation should
+// run before main Transformations. For example, Store
+// operation needs to run first to load all Pipeline variables.
+var InitStep bool = false
+
+// operationName is used to identify this transformation.
+var operationName string = "cast"
+
+// Register adds this transformation to the map which will
+// be used to create Transformation pipeline.
+func Register(m map[string]transformer.Transformer) {
+	m[operationName] = &Cast{}
+}
+
+// SetStorage sets a shared Storage with Pipeline variables.
+func (c *Cast) SetStorage(storage *storage.Storage) {
+	c.variables = storage
+}
+
+// InitStep returns "true" if this Transformation should run
+// as init step.
+func (c *Cast) InitStep() bool {
+	return InitStep
+}
+
+// New returns a new instance of Cast object.
+func (c *Cast) New(key, value string) transformer.Transformer {
+	return &Cast{
+		Path:  key,
+		Value: value,
+
+		variables: c.variables,
+	}
+}
+
+// Supported target types.
+const (
+	typeString    = "string"
+	typeNumber    = "number"
+	typeBool      = "bool"
+	typeTimestamp = "timestamp"
+)
+
+// timestampLayouts are the layouts accepted when converting
+// strings to timestamps, in order of precedence.
+var timestampLayouts = []string{
+	time.RFC3339Nano,
+	time.RFC1123Z,
+	time.RFC1123,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+}
+
+// Apply is a main method of Transformation that converts existing
+// values to the type set in the Value field.
+func (c *Cast) Apply(scope string, data []byte) ([]byte, error) {
+	path := strings.Split(c.Path, ".")
+
+	var event interface{}
+	if err := json.Unmarshal(data, &event); err != nil {
+		return data, err
+	}
+
+	value, exists := convert.ReadValue(event, path)
+	if !exists {
+		return data, nil
+	}
+
+	var converted interface{}
+	var err error
+
+	switch c.Value {
+	case typeString:
+		converted, err = toString(value)
+	case typeNumber:
+		converted, err = toNumber(value)
+	case typeBool:
+		converted, err = toBool(value)
+	case typeTimestamp:
+		converted, err = toTimestamp(value)
+	default:
+		return data, fmt.Errorf("cast operation does not support %q type", c.Value)
+	}
+	if err != nil {
+		return data, fmt.Errorf("cannot cast value of %q to %s: %w", c.Path, c.Value, err)
+	}
+
+	output, err := json.Marshal(convert.SetValue(event, path, converted))
+	if err != nil {
+		return data, err
+	}
+
+	return output, nil
+}
+
+func toString(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case nil:
+		return "", nil
+	default:
+		b, err := json.Marshal(v)
+		return string(b), err
+	}
+}
+
+func toNumber(value interface{}) (float64, error) {
+	switch v := value.(type) {
+	case float64:
+		return v, nil
+	case string:
+		return strconv.ParseFloat(strings.TrimSpace(v), 64)
+	case bool:
+		if v {
+			return 1, nil
+		}
+		return 0, nil
+	default:
+		return 0, fmt.Errorf("unsupported type %T", value)
+	}
+}
+
+func toBool(value interface{}) (bool, error) {
+	switch v := value.(type) {
+	case bool:
+		return v, nil
+	case string:
+		return strconv.ParseBool(strings.TrimSpace(v))
+	case float64:
+		return v != 0, nil
+	default:
+		return false, fmt.Errorf("unsupported type %T", value)
+	}
+}
+
+// toTimestamp converts Unix times (in seconds) and date strings
+// to RFC 3339 timestamps in UTC.
+func toTimestamp(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case float64:
+		return unixToTimestamp(v), nil
+	case string:
+		v = strings.TrimSpace(v)
+		if f, err := strconv.ParseFloat(v, 64); err == nil {
+			return unixToTimestamp(f), nil
+		}
+		for _, layout := range timestampLayouts {
+			if t, err := time.Parse(layout, v); err == nil {
+				return t.UTC().Format(time.RFC3339Nano), nil
+			}
+		}
+		return "", fmt.Errorf("unknown time format %q", v)
+	default:
+		return "", fmt.Errorf("unsupported type %T", value)
+	}
+}
+
+func unixToTimestamp(sec float64) string {
+	return time.Unix(0, int64(sec*float64(time.Second))).UTC().Format(time.RFC3339Nano)
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cast
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/common/storage"
+)
+
+const tScope = "test"
+
+func TestCast(t *testing.T) {
+	testCases := map[string]struct {
+		key       string
+		value     string
+		data      string
+		expect    string
+		expectErr bool
+	}{
+		"To number": {
+			key:    "count",
+			value:  "number",
+			data:   `{"count":"42"}`,
+			expect: `{"count":42}`,
+		},
+		"To string": {
+			key:    "a.b",
+			value:  "string",
+			data:   `{"a":{"b":1.5}}`,
+			expect: `{"a":{"b":"1.5"}}`,
+		},
+		"To bool": {
+			key:    "flag",
+			value:  "bool",
+			data:   `{"flag":"true"}`,
+			expect: `{"flag":true}`,
+		},
+		"Unix time to timestamp": {
+			key:    "at",
+			value:  "timestamp",
+			data:   `{"at":1654084800}`,
+			expect: `{"at":"2022-06-01T12:00:00Z"}`,
+		},
+		"Date to timestamp": {
+			key:    "at",
+			value:  "timestamp",
+			data:   `{"at":"2022-06-01"}`,
+			expect: `{"at":"2022-06-01T00:00:00Z"}`,
+		},
+		"Missing path": {
+			key:    "missing",
+			value:  "number",
+			data:   `{"count":"42"}`,
+			expect: `{"count":"42"}`,
+		},
+		"Invalid number": {
+			key:       "count",
+			value:     "number",
+			data:      `{"count":"many"}`,
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			tr := (&Cast{}).New(tc.key, tc.value)
+			tr.SetStorage(storage.New())
+
+			out, err := tr.Apply(tScope, []byte(tc.data))
+			if tc.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.JSONEq(t, tc.expect, string(out))
+		})
+	}
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package concat
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/common/convert"
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/common/storage"
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/transformer"
+)
+
+var _ transformer.Transformer = (*Concat)(nil)
+
+// Concat object implements Transformer interface.
+type Concat struct {
+	Path  string
+	Value string
+
+	variables *storage.Storage
+}
+
+// InitStep is used to figure out if this operation should
+// run before main Transformations. For example, Store
+// operation needs to run first to load all Pipeline variables.
+var InitStep bool = false
+
+// operationName is used to identify this transformation.
+var operationName string = "concat"
+
+// Register adds this transformation to the map which will
+// be used to create Transformation pipeline.
+func Register(m map[string]transformer.Transformer) {
+	m[operationName] = &Concat{}
+}
+
+// SetStorage sets a shared Storage with Pipeline variables.
+func (c *Concat) SetStorage(storage *storage.Storage) {
+	c.variables = storage
+}
+
+// InitStep returns "true" if this Transformation should run
+// as init step.
+func (c *Concat) InitStep() bool {
+	return InitStep
+}
+
+// New returns a new instance of Concat object.
+func (c *Concat) New(key, value string) transformer.Transformer {
+	return &Concat{
+		Path:  key,
+		Value: value,
+
+		variables: c.variables,
+	}
+}
+
+// Apply is a main method of Transformation that appends a value,
+// or the value of a Pipeline variable, to an existing string.
+func (c *Concat) Apply(scope string, data []byte) ([]byte, error) {
+	path := strings.Split(c.Path, ".")
+
+	var event interface{}
+	if err := json.Unmarshal(data, &event); err != nil {
+		return data, err
+	}
+
+	var prefix string
+	if value, exists := convert.ReadValue(event, path); exists && value != nil {
+		switch v := value.(type) {
+		case string, float64, bool:
+			prefix = fmt.Sprint(v)
+		default:
+			return data, fmt.Errorf("value of %q is not a scalar", c.Path)
+		}
+	}
+
+	output, err := json.Marshal(convert.SetValue(event, path, prefix+c.retrieveString(scope, c.Value)))
+	if err != nil {
+		return data, err
+	}
+
+	return output, nil
+}
+
+func (c *Concat) retrieveString(scope, key string) string {
+	if value := c.variables.Get(scope, key); value != nil {
+		return fmt.Sprint(value)
+	}
+	return key
+}
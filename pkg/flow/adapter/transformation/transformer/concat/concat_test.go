@@ -0,0 +1,79 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package concat
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/common/storage"
+)
+
+const tScope = "test"
+
+func TestConcat(t *testing.T) {
+	testCases := map[string]struct {
+		key       string
+		value     string
+		data      string
+		expect    string
+		expectErr bool
+	}{
+		"Append to string": {
+			key:    "name",
+			value:  "-suffix",
+			data:   `{"name":"foo"}`,
+			expect: `{"name":"foo-suffix"}`,
+		},
+		"Append to number": {
+			key:    "id",
+			value:  "-a",
+			data:   `{"id":42}`,
+			expect: `{"id":"42-a"}`,
+		},
+		"Missing path": {
+			key:    "name",
+			value:  "new",
+			data:   "{}",
+			expect: `{"name":"new"}`,
+		},
+		"Non-scalar value": {
+			key:       "obj",
+			value:     "x",
+			data:      `{"obj":{"a":1}}`,
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			tr := (&Concat{}).New(tc.key, tc.value)
+			tr.SetStorage(storage.New())
+
+			out, err := tr.Apply(tScope, []byte(tc.data))
+			if tc.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.JSONEq(t, tc.expect, string(out))
+		})
+	}
+}
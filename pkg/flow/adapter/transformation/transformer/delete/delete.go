@@ -73,10 +73,12 @@ func (d *Delete) New(key, value string) transformer.Transformer {
 
 // Apply is a main method of Transformation that removed any type of
 // variables from existing JSON.
-func (d *Delete) Apply(data []byte) ([]byte, error) {
-	d.Value = d.retrieveString(d.Value)
+func (d *Delete) Apply(scope string, data []byte) ([]byte, error) {
+	// the receiver is shared between events and must not be mutated
+	del := *d
+	del.Value = d.retrieveString(scope, d.Value)
 
-	result, err := d.parse(data, "", "")
+	result, err := del.parse(data, "", "")
 	if err != nil {
 		return data, err
 	}
@@ -89,8 +91,8 @@ func (d *Delete) Apply(data []byte) ([]byte, error) {
 	return output, nil
 }
 
-func (d *Delete) retrieveString(key string) string {
-	if value := d.variables.Get(key); value != nil {
+func (d *Delete) retrieveString(scope, key string) string {
+	if value := d.variables.Get(scope, key); value != nil {
 		if str, ok := value.(string); ok {
 			return str
 		}
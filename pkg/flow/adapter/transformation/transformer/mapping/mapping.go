@@ -0,0 +1,108 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mapping
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/common/convert"
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/common/storage"
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/transformer"
+)
+
+var _ transformer.Transformer = (*Map)(nil)
+
+// Map object implements Transformer interface.
+type Map struct {
+	Path  string
+	Value string
+
+	variables *storage.Storage
+}
+
+// InitStep is used to figure out if this operation should
+// run before main Transformations. For example, Store
+// operation needs to run first to load all Pipeline variables.
+var InitStep bool = false
+
+// operationName is used to identify this transformation.
+var operationName string = "map"
+
+// Register adds this transformation to the map which will
+// be used to create Transformation pipeline.
+func Register(m map[string]transformer.Transformer) {
+	m[operationName] = &Map{}
+}
+
+// SetStorage sets a shared Storage with Pipeline variables.
+func (m *Map) SetStorage(storage *storage.Storage) {
+	m.variables = storage
+}
+
+// InitStep returns "true" if this Transformation should run
+// as init step.
+func (m *Map) InitStep() bool {
+	return InitStep
+}
+
+// New returns a new instance of Map object.
+func (m *Map) New(key, value string) transformer.Transformer {
+	return &Map{
+		Path:  key,
+		Value: value,
+
+		variables: m.variables,
+	}
+}
+
+// Apply is a main method of Transformation that replaces each element
+// of an existing array with the value located at the path set in the
+// Value field of this element. Elements which do not contain this path
+// are replaced with null.
+func (m *Map) Apply(scope string, data []byte) ([]byte, error) {
+	path := strings.Split(m.Path, ".")
+	elemPath := strings.Split(m.Value, ".")
+
+	var event interface{}
+	if err := json.Unmarshal(data, &event); err != nil {
+		return data, err
+	}
+
+	value, exists := convert.ReadValue(event, path)
+	if !exists {
+		return data, nil
+	}
+
+	arr, ok := value.([]interface{})
+	if !ok {
+		return data, fmt.Errorf("value of %q is not an array", m.Path)
+	}
+
+	items := make([]interface{}, len(arr))
+	for i, elem := range arr {
+		items[i], _ = convert.ReadValue(elem, elemPath)
+	}
+
+	output, err := json.Marshal(convert.SetValue(event, path, items))
+	if err != nil {
+		return data, err
+	}
+
+	return output, nil
+}
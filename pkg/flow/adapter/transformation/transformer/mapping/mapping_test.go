@@ -0,0 +1,79 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mapping
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/common/storage"
+)
+
+const tScope = "test"
+
+func TestMap(t *testing.T) {
+	testCases := map[string]struct {
+		key       string
+		value     string
+		data      string
+		expect    string
+		expectErr bool
+	}{
+		"Map elements": {
+			key:    "items",
+			value:  "sku",
+			data:   `{"items":[{"sku":"a"},{"sku":"b","qty":2}]}`,
+			expect: `{"items":["a","b"]}`,
+		},
+		"Nested element path": {
+			key:    "items",
+			value:  "product.id",
+			data:   `{"items":[{"product":{"id":1}},{}]}`,
+			expect: `{"items":[1,null]}`,
+		},
+		"Missing path": {
+			key:    "items",
+			value:  "sku",
+			data:   "{}",
+			expect: "{}",
+		},
+		"Non-array value": {
+			key:       "items",
+			value:     "sku",
+			data:      `{"items":"a"}`,
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			tr := (&Map{}).New(tc.key, tc.value)
+			tr.SetStorage(storage.New())
+
+			out, err := tr.Apply(tScope, []byte(tc.data))
+			if tc.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.JSONEq(t, tc.expect, string(out))
+		})
+	}
+}
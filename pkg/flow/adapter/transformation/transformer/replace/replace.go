@@ -0,0 +1,114 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package replace
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/flow/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/common/convert"
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/common/storage"
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/transformer"
+)
+
+var _ transformer.Transformer = (*Replace)(nil)
+
+// Replace object implements Transformer interface.
+type Replace struct {
+	Path  string
+	Value string
+
+	re          *regexp.Regexp
+	replacement string
+
+	variables *storage.Storage
+}
+
+// InitStep is used to figure out if this operation should
+// run before main Transformations. For example, Store
+// operation needs to run first to load all Pipeline variables.
+var InitStep bool = false
+
+// operationName is used to identify this transformation.
+var operationName string = "replace"
+
+// Register adds this transformation to the map which will
+// be used to create Transformation pipeline.
+func Register(m map[string]transformer.Transformer) {
+	m[operationName] = &Replace{}
+}
+
+// SetStorage sets a shared Storage with Pipeline variables.
+func (r *Replace) SetStorage(storage *storage.Storage) {
+	r.variables = storage
+}
+
+// InitStep returns "true" if this Transformation should run
+// as init step.
+func (r *Replace) InitStep() bool {
+	return InitStep
+}
+
+// New returns a new instance of Replace object. The value is expected
+// in the "/regexp/replacement/" form, where the first character is used
+// as the delimiter. It returns nil if the value can not be parsed.
+func (r *Replace) New(key, value string) transformer.Transformer {
+	re, replacement, err := v1alpha1.ParseReplaceExpression(value)
+	if err != nil {
+		return nil
+	}
+	return &Replace{
+		Path:  key,
+		Value: value,
+
+		re:          re,
+		replacement: replacement,
+
+		variables: r.variables,
+	}
+}
+
+// Apply is a main method of Transformation that replaces the matches
+// of a regular expression in an existing string.
+func (r *Replace) Apply(scope string, data []byte) ([]byte, error) {
+	path := strings.Split(r.Path, ".")
+
+	var event interface{}
+	if err := json.Unmarshal(data, &event); err != nil {
+		return data, err
+	}
+
+	value, exists := convert.ReadValue(event, path)
+	if !exists {
+		return data, nil
+	}
+
+	str, ok := value.(string)
+	if !ok {
+		return data, fmt.Errorf("value of %q is not a string", r.Path)
+	}
+
+	output, err := json.Marshal(convert.SetValue(event, path, r.re.ReplaceAllString(str, r.replacement)))
+	if err != nil {
+		return data, err
+	}
+
+	return output, nil
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package split
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/common/convert"
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/common/storage"
+	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation/transformer"
+)
+
+var _ transformer.Transformer = (*Split)(nil)
+
+// Split object implements Transformer interface.
+type Split struct {
+	Path  string
+	Value string
+
+	variables *storage.Storage
+}
+
+// InitStep is used to figure out if this operation should
+// run before main Transformations. For example, Store
+// operation needs to run first to load all Pipeline variables.
+var InitStep bool = false
+
+// operationName is used to identify this transformation.
+var operationName string = "split"
+
+// Register adds this transformation to the map which will
+// be used to create Transformation pipeline.
+func Register(m map[string]transformer.Transformer) {
+	m[operationName] = &Split{}
+}
+
+// SetStorage sets a shared Storage with Pipeline variables.
+func (s *Split) SetStorage(storage *storage.Storage) {
+	s.variables = storage
+}
+
+// InitStep returns "true" if this Transformation should run
+// as init step.
+func (s *Split) InitStep() bool {
+	return InitStep
+}
+
+// New returns a new instance of Split object.
+func (s *Split) New(key, value string) transformer.Transformer {
+	return &Split{
+		Path:  key,
+		Value: value,
+
+		variables: s.variables,
+	}
+}
+
+// defaultSeparator is used when the Value field is empty.
+const defaultSeparator = ","
+
+// Apply is a main method of Transformation that splits an existing
+// string into an array of strings, using the Value field as separator.
+func (s *Split) Apply(scope string, data []byte) ([]byte, error) {
+	path := strings.Split(s.Path, ".")
+
+	var event interface{}
+	if err := json.Unmarshal(data, &event); err != nil {
+		return data, err
+	}
+
+	value, exists := convert.ReadValue(event, path)
+	if !exists {
+		return data, nil
+	}
+
+	str, ok := value.(string)
+	if !ok {
+		return data, fmt.Errorf("value of %q is not a string", s.Path)
+	}
+
+	sep := s.Value
+	if sep == "" {
+		sep = defaultSeparator
+	}
+
+	parts := strings.Split(str, sep)
+	items := make([]interface{}, len(parts))
+	for i, p := range parts {
+		items[i] = p
+	}
+
+	output, err := json.Marshal(convert.SetValue(event, path, items))
+	if err != nil {
+		return data, err
+	}
+
+	return output, nil
+}
@@ -21,10 +21,12 @@ import (
 )
 
 // Transformer is an interface that contains common methods
-// to work with JSON data.
+// to work with JSON data. Transformers are shared between
+// concurrently processed events, the scope passed to Apply
+// identifies the set of Pipeline variables of a single transformation.
 type Transformer interface {
 	New(string, string) Transformer
-	Apply([]byte) ([]byte, error)
+	Apply(string, []byte) ([]byte, error)
 	SetStorage(*storage.Storage)
 	InitStep() bool
 }
@@ -0,0 +1,94 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aggregator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"knative.dev/eventing/pkg/reconciler/source"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/logging"
+	rt "knative.dev/pkg/reconciler/testing"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	"github.com/triggermesh/triggermesh/pkg/apis"
+	"github.com/triggermesh/triggermesh/pkg/apis/flow/v1alpha1"
+	fakeinjectionclient "github.com/triggermesh/triggermesh/pkg/client/generated/injection/client/fake"
+	reconcilerv1alpha1 "github.com/triggermesh/triggermesh/pkg/client/generated/injection/reconciler/flow/v1alpha1/aggregator"
+	common "github.com/triggermesh/triggermesh/pkg/reconciler"
+	. "github.com/triggermesh/triggermesh/pkg/reconciler/testing"
+)
+
+func TestReconcile(t *testing.T) {
+	adapterCfg := &adapterConfig{
+		Image:     "registry/image:tag",
+		obsConfig: &source.EmptyVarsGenerator{},
+	}
+
+	ctor := reconcilerCtor(adapterCfg)
+	trg := newTarget()
+	ab := adapterBuilder(adapterCfg)
+
+	TestReconcileAdapter(t, ctor, trg, ab)
+}
+
+// reconcilerCtor returns a Ctor for an Aggregator Reconciler.
+func reconcilerCtor(cfg *adapterConfig) Ctor {
+	return func(t *testing.T, ctx context.Context, _ *rt.TableRow, ls *Listers) controller.Reconciler {
+		r := &Reconciler{
+			adapterCfg: cfg,
+		}
+
+		r.base = NewTestServiceReconciler[*v1alpha1.Aggregator](ctx, ls,
+			ls.GetAggregatorLister().Aggregators,
+		)
+
+		return reconcilerv1alpha1.NewReconciler(ctx, logging.FromContext(ctx),
+			fakeinjectionclient.Get(ctx), ls.GetAggregatorLister(),
+			controller.GetEventRecorder(ctx), r)
+	}
+}
+
+// tCount is the number of events which completes a group.
+var tCount = 3
+
+// newTarget returns a populated target object.
+func newTarget() *v1alpha1.Aggregator {
+	trg := &v1alpha1.Aggregator{
+		Spec: v1alpha1.AggregatorSpec{
+			CorrelationKey: "correlationid",
+			Completion: v1alpha1.AggregatorCompletion{
+				Count:   &tCount,
+				Timeout: apis.Duration(20 * time.Second),
+			},
+		},
+	}
+
+	Populate(trg)
+
+	return trg
+}
+
+// adapterBuilder returns a slim Reconciler containing only the fields accessed
+// by r.BuildAdapter().
+func adapterBuilder(cfg *adapterConfig) common.AdapterBuilder[*servingv1.Service] {
+	return &Reconciler{
+		adapterCfg: cfg,
+	}
+}
@@ -17,6 +17,8 @@ limitations under the License.
 package jqtransformation
 
 import (
+	"strconv"
+
 	corev1 "k8s.io/api/core/v1"
 
 	"knative.dev/eventing/pkg/reconciler/source"
@@ -31,6 +33,8 @@ import (
 
 const (
 	envQuery               = "JQ_QUERY"
+	envOutputMode          = "JQ_OUTPUT_MODE"
+	envOutputEnvelope      = "JQ_OUTPUT_ENVELOPE"
 	envEventsPayloadPolicy = "EVENTS_PAYLOAD_POLICY"
 )
 
@@ -69,6 +73,21 @@ func makeAppEnv(o *v1alpha1.JQTransformation) []corev1.EnvVar {
 		},
 	}
 
+	if out := o.Spec.Output; out != nil {
+		if out.Mode != nil {
+			env = append(env, corev1.EnvVar{
+				Name:  envOutputMode,
+				Value: string(*out.Mode),
+			})
+		}
+		if out.Envelope != nil {
+			env = append(env, corev1.EnvVar{
+				Name:  envOutputEnvelope,
+				Value: strconv.FormatBool(*out.Envelope),
+			})
+		}
+	}
+
 	if o.Spec.EventOptions != nil && o.Spec.EventOptions.PayloadPolicy != nil {
 		env = append(env, corev1.EnvVar{
 			Name:  envEventsPayloadPolicy,
@@ -77,5 +77,55 @@ func makeAppEnv(o *v1alpha1.Synchronizer) []corev1.EnvVar {
 		})
 	}
 
+	if g := o.Spec.CorrelationKey.Generator; g != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  "CORRELATION_KEY_GENERATOR",
+			Value: string(*g),
+		})
+	}
+
+	if e := o.Spec.CorrelationKey.FromExtension; e != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  "CORRELATION_KEY_FROM_EXTENSION",
+			Value: *e,
+		})
+	}
+
+	if h := o.Spec.CorrelationKey.FromHeader; h != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  "CORRELATION_KEY_FROM_HEADER",
+			Value: *h,
+		})
+	}
+
+	if s := o.Spec.Sessions; s != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  "SESSION_BACKEND",
+			Value: string(s.Backend),
+		})
+
+		if r := s.Redis; r != nil && s.Backend == v1alpha1.SynchronizerSessionBackendRedis {
+			env = append(env, corev1.EnvVar{
+				Name:  "SESSION_REDIS_ADDRESS",
+				Value: r.Address,
+			})
+			if r.Password != nil {
+				env = common.MaybeAppendValueFromEnvVar(env, "SESSION_REDIS_PASSWORD", *r.Password)
+			}
+			if r.Database != nil {
+				env = append(env, corev1.EnvVar{
+					Name:  "SESSION_REDIS_DATABASE",
+					Value: strconv.Itoa(*r.Database),
+				})
+			}
+			if r.TLSEnabled != nil {
+				env = append(env, corev1.EnvVar{
+					Name:  "SESSION_REDIS_TLS_ENABLED",
+					Value: strconv.FormatBool(*r.TLSEnabled),
+				})
+			}
+		}
+	}
+
 	return env
 }
@@ -0,0 +1,132 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"fmt"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+
+	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
+	"knative.dev/pkg/metrics"
+)
+
+const (
+	metricNameSessionsOpen               = "sessions_open"
+	metricNameSessionTimeoutCount        = "session_timeout_count"
+	metricNameSessionOrphanResponseCount = "session_orphan_response_count"
+)
+
+// sessionsOpenM is a measure of the number of client sessions currently held
+// open by a component.
+var sessionsOpenM = stats.Int64(
+	metricNameSessionsOpen,
+	"Number of client sessions currently awaiting a response",
+	stats.UnitDimensionless,
+)
+
+// sessionTimeoutCountM is a measure of the number of client sessions that
+// expired before receiving a response.
+var sessionTimeoutCountM = stats.Int64(
+	metricNameSessionTimeoutCount,
+	"Number of client sessions that expired before receiving a response",
+	stats.UnitDimensionless,
+)
+
+// sessionOrphanResponseCountM is a measure of the number of responses that
+// could not be matched with any open client session.
+var sessionOrphanResponseCountM = stats.Int64(
+	metricNameSessionOrphanResponseCount,
+	"Number of responses that could not be matched with an open client session",
+	stats.UnitDimensionless,
+)
+
+// MustRegisterSessionStatsView registers an OpenCensus stats view for
+// metrics related to client sessions, and panics in case of error.
+func MustRegisterSessionStatsView() {
+	commonTagKeys := []tag.Key{
+		tagKeyResourceGroup,
+		tagKeyNamespace,
+		tagKeyName,
+	}
+
+	err := view.Register(
+		&view.View{
+			Measure:     sessionsOpenM,
+			Description: sessionsOpenM.Description(),
+			Aggregation: view.LastValue(),
+			TagKeys:     commonTagKeys,
+		},
+		&view.View{
+			Measure:     sessionTimeoutCountM,
+			Description: sessionTimeoutCountM.Description(),
+			Aggregation: view.Count(),
+			TagKeys:     commonTagKeys,
+		},
+		&view.View{
+			Measure:     sessionOrphanResponseCountM,
+			Description: sessionOrphanResponseCountM.Description(),
+			Aggregation: view.Count(),
+			TagKeys:     commonTagKeys,
+		},
+	)
+	if err != nil {
+		panic(fmt.Errorf("error registering OpenCensus stats view: %w", err))
+	}
+}
+
+// SessionStatsReporter collects and reports stats about client sessions.
+type SessionStatsReporter struct {
+	// context that holds pre-populated OpenCensus tags
+	tagsCtx context.Context
+}
+
+// MustNewSessionStatsReporter returns a new SessionStatsReporter initialized
+// with the given tags and panics in case of error.
+func MustNewSessionStatsReporter(tags *pkgadapter.MetricTag) *SessionStatsReporter {
+	ctx, err := tag.New(context.Background(),
+		tag.Insert(tagKeyResourceGroup, tags.ResourceGroup),
+		tag.Insert(tagKeyNamespace, tags.Namespace),
+		tag.Insert(tagKeyName, tags.Name),
+	)
+	if err != nil {
+		panic(fmt.Errorf("error creating OpenCensus tags: %w", err))
+	}
+
+	return &SessionStatsReporter{
+		tagsCtx: ctx,
+	}
+}
+
+// ReportOpenSessions records in sessionsOpenM the current number of open
+// sessions.
+func (r *SessionStatsReporter) ReportOpenSessions(n int) {
+	metrics.Record(r.tagsCtx, sessionsOpenM.M(int64(n)))
+}
+
+// ReportSessionTimeout increments sessionTimeoutCountM.
+func (r *SessionStatsReporter) ReportSessionTimeout() {
+	metrics.Record(r.tagsCtx, sessionTimeoutCountM.M(1))
+}
+
+// ReportOrphanResponse increments sessionOrphanResponseCountM.
+func (r *SessionStatsReporter) ReportOrphanResponse() {
+	metrics.Record(r.tagsCtx, sessionOrphanResponseCountM.M(1))
+}
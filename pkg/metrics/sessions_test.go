@@ -0,0 +1,66 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics_test
+
+import (
+	"testing"
+
+	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
+	"knative.dev/pkg/metrics/metricstest"
+
+	// Essential. Initializes a Prometheus metrics exporter for tests.
+	_ "knative.dev/pkg/metrics/testing"
+
+	. "github.com/triggermesh/triggermesh/pkg/metrics"
+)
+
+func TestSessionStatsReporter(t *testing.T) {
+	const (
+		tRg   = "foos.fake.example.com"
+		tNs   = "test-ns"
+		tName = "test"
+	)
+
+	metricstest.Unregister(
+		"sessions_open",
+		"session_timeout_count",
+		"session_orphan_response_count",
+	)
+	MustRegisterSessionStatsView()
+
+	st := MustNewSessionStatsReporter(&pkgadapter.MetricTag{
+		ResourceGroup: tRg,
+		Namespace:     tNs,
+		Name:          tName,
+	})
+
+	wantTags := map[string]string{
+		"resource_group": tRg,
+		"namespace_name": tNs,
+		"name":           tName,
+	}
+
+	st.ReportOpenSessions(3)
+	st.ReportOpenSessions(2)
+	st.ReportSessionTimeout()
+	st.ReportOrphanResponse()
+	st.ReportOrphanResponse()
+
+	metricstest.CheckLastValueData(t, "sessions_open", wantTags, 2)
+	metricstest.CheckCountData(t, "session_timeout_count", wantTags, 1)
+	metricstest.CheckCountData(t, "session_orphan_response_count", wantTags, 2)
+}
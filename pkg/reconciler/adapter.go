@@ -17,6 +17,7 @@ limitations under the License.
 package reconciler
 
 import (
+	"encoding/json"
 	"strconv"
 	"strings"
 	"time"
@@ -53,6 +54,8 @@ const (
 
 const roleNameConfigWatcher = "triggermesh-config-watcher"
 
+const roleNameCheckpointWriter = "triggermesh-checkpoint-writer"
+
 const defaultSinkTimeout = 30 * time.Second
 
 // ComponentName returns the component name for the given object.
@@ -95,8 +98,13 @@ func NewAdapterDeployment(rcl v1alpha1.Reconcilable, sinkURI *apis.URL, opts ...
 		sinkURIStr = sinkURI.String()
 	}
 
+	commonOpts := commonAdapterDeploymentOptions(rcl)
+	if v1alpha1.WritesCheckpoints(rcl) {
+		commonOpts = append(commonOpts, resource.EnvVar(envCheckpointOwner, checkpointOwner(rcl)))
+	}
+
 	return resource.NewDeployment(rclNs, kmeta.ChildName(ComponentName(rcl)+"-", rclName),
-		append(commonAdapterDeploymentOptions(rcl), append([]resource.ObjectOption{
+		append(commonOpts, append([]resource.ObjectOption{
 			resource.Controller(rcl),
 
 			// Used to label Prometheus metrics with the component
@@ -258,6 +266,22 @@ func commonAdapterKnServiceOptions(rcl v1alpha1.Reconcilable) []resource.ObjectO
 	return objectOptions
 }
 
+// checkpointOwner returns a JSON-serialized reference to the given component
+// instance, which its adapter sets as the owner of the checkpoints and leases
+// it persists, so that those are garbage collected along with the instance.
+func checkpointOwner(rcl v1alpha1.Reconcilable) string {
+	gvk := rcl.GetGroupVersionKind()
+
+	owner, _ := json.Marshal(metav1.OwnerReference{
+		APIVersion: gvk.GroupVersion().String(),
+		Kind:       gvk.Kind,
+		Name:       rcl.GetName(),
+		UID:        rcl.GetUID(),
+	})
+
+	return string(owner)
+}
+
 // newServiceAccount returns a ServiceAccount object with its OwnerReferences
 // metadata attribute populated from the given owners.
 func newServiceAccount(rcl v1alpha1.Reconcilable, owners []kmeta.OwnerRefable) *corev1.ServiceAccount {
@@ -275,6 +299,15 @@ func newConfigWatchRoleBinding(rcl v1alpha1.Reconcilable, owner *corev1.ServiceA
 	return newRoleBinding(rbName, roleNameConfigWatcher, rcl, owner)
 }
 
+// newCheckpointWriterRoleBinding returns a RoleBinding object that binds a
+// ServiceAccount (namespace-scoped) to the checkpoint writer ClusterRole
+// (cluster-scoped).
+func newCheckpointWriterRoleBinding(rcl v1alpha1.Reconcilable, owner *corev1.ServiceAccount) *rbacv1.RoleBinding {
+	rbName := owner.Name + "-checkpoint-writer" // {kind}-adapter-checkpoint-writer or {kind}-i-{name}-checkpoint-writer
+
+	return newRoleBinding(rbName, roleNameCheckpointWriter, rcl, owner)
+}
+
 // newMTAdapterRoleBinding returns a RoleBinding object that binds a ServiceAccount
 // (namespace-scoped) to the (mt-)adapter's ClusterRole (cluster-scoped).
 func newMTAdapterRoleBinding(rcl v1alpha1.Reconcilable, owner *corev1.ServiceAccount) *rbacv1.RoleBinding {
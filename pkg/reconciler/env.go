@@ -25,6 +25,7 @@ const (
 	envComponent             = "K_COMPONENT"
 	envSinkTimeout           = "K_SINK_TIMEOUT"
 	envMetricsPrometheusPort = "METRICS_PROMETHEUS_PORT"
+	envCheckpointOwner       = "CHECKPOINT_OWNER"
 
 	// Overrides for CloudEvents context attributes (only supported by a subset of components)
 	EnvCESource = "CE_SOURCE"
@@ -55,6 +56,11 @@ const (
 	EnvServiceBusKeyValue         = "SERVICEBUS_KEY_VALUE"
 	EnvServiceBusConnStr          = "SERVICEBUS_CONNECTION_STRING"
 	EnvServiceBusEntityResourceID = "SERVICEBUS_ENTITY_RESOURCE_ID"
+	EnvServiceBusSessionsEnabled  = "SERVICEBUS_SESSIONS_ENABLED"
+	EnvServiceBusMaxConcurrency   = "SERVICEBUS_MAX_CONCURRENCY"
+	EnvServiceBusPrefetchCount    = "SERVICEBUS_PREFETCH_COUNT"
+	EnvServiceBusOnFailure        = "SERVICEBUS_SETTLEMENT_ON_FAILURE"
+	EnvServiceBusMaxDelivery      = "SERVICEBUS_MAX_DELIVERY_ATTEMPTS"
 
 	// Common Google Cloud attributes
 	EnvGCloudSAKey = "GCLOUD_SERVICEACCOUNT_KEY"
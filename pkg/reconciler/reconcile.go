@@ -338,6 +338,21 @@ func (r *GenericRBACReconciler[T, L]) reconcileRBAC(ctx context.Context,
 		}
 	}
 
+	// Bind serviceAccount to shared "triggermesh-checkpoint-writer" clusterRole.
+	// Adapters which persist checkpoints require permissions to write
+	// configMaps and leases.
+	if v1alpha1.WritesCheckpoints(rcl) {
+		desiredRB := newCheckpointWriterRoleBinding(rcl, currentSA)
+		currentRB, err := r.getOrCreateAdapterRoleBinding(ctx, desiredRB)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err = r.syncAdapterRoleBinding(ctx, currentRB, desiredRB); err != nil {
+			return nil, fmt.Errorf("synchronizing adapter RoleBinding: %w", err)
+		}
+	}
+
 	return currentSA, nil
 }
 
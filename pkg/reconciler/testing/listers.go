@@ -129,6 +129,11 @@ func (l *Listers) GetRoleBindingLister() rbaclistersv1.RoleBindingLister {
 	return rbaclistersv1.NewRoleBindingLister(l.IndexerFor(&rbacv1.RoleBinding{}))
 }
 
+// GetAggregatorLister returns a Lister for Aggregator objects.
+func (l *Listers) GetAggregatorLister() flowlistersv1alpha1.AggregatorLister {
+	return flowlistersv1alpha1.NewAggregatorLister(l.IndexerFor(&flowv1alpha1.Aggregator{}))
+}
+
 // GetDataWeaveTransformationLister returns a Lister for DataWeaveTransformation objects.
 func (l *Listers) GetDataWeaveTransformationLister() flowlistersv1alpha1.DataWeaveTransformationLister {
 	return flowlistersv1alpha1.NewDataWeaveTransformationLister(l.IndexerFor(&flowv1alpha1.DataWeaveTransformation{}))
@@ -284,6 +289,11 @@ func (l *Listers) GetHTTPPollerSourceLister() sourceslistersv1alpha1.HTTPPollerS
 	return sourceslistersv1alpha1.NewHTTPPollerSourceLister(l.IndexerFor(&sourcesv1alpha1.HTTPPollerSource{}))
 }
 
+// GetKafkaSourceLister returns a Lister for KafkaSource objects.
+func (l *Listers) GetKafkaSourceLister() sourceslistersv1alpha1.KafkaSourceLister {
+	return sourceslistersv1alpha1.NewKafkaSourceLister(l.IndexerFor(&sourcesv1alpha1.KafkaSource{}))
+}
+
 // GetOCIMetricsSourceLister returns a Lister for OCIMetricsSource objects.
 func (l *Listers) GetOCIMetricsSourceLister() sourceslistersv1alpha1.OCIMetricsSourceLister {
 	return sourceslistersv1alpha1.NewOCIMetricsSourceLister(l.IndexerFor(&sourcesv1alpha1.OCIMetricsSource{}))
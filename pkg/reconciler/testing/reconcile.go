@@ -89,6 +89,7 @@ func TestReconcileAdapter[T kmeta.Accessor](t *testing.T,
 	newServiceAccount := NewServiceAccount(rcl)
 	newConfigWatchRoleBinding := NewConfigWatchRoleBinding(newServiceAccount())
 	newMTAdapterRoleBinding := NewMTAdapterRoleBinding(newServiceAccount())
+	newCheckpointWriterRoleBinding := NewCheckpointWriterRoleBinding(newServiceAccount())
 	newAdapter := mustAdapterCtor(t, ab, rcl)
 
 	comp := newComponentInstance()
@@ -119,7 +120,11 @@ func TestReconcileAdapter[T kmeta.Accessor](t *testing.T,
 				}
 				// only multi-tenant components expect a RoleBinding
 				if v1alpha1.IsMultiTenant(comp) {
-					return insertObject(objs, newMTAdapterRoleBinding(), 2)
+					objs = insertObject(objs, newMTAdapterRoleBinding(), 2)
+				}
+				// only components which write checkpoints expect a RoleBinding
+				if v1alpha1.WritesCheckpoints(comp) {
+					objs = insertObject(objs, newCheckpointWriterRoleBinding(), len(objs)-1)
 				}
 				return objs
 			}(),
@@ -134,7 +139,11 @@ func TestReconcileAdapter[T kmeta.Accessor](t *testing.T,
 				}
 				// only multi-tenant components expect a RoleBinding
 				if v1alpha1.IsMultiTenant(comp) {
-					return insertString(events, createMTAdapterRoleBindingEvent(comp), 2)
+					events = insertString(events, createMTAdapterRoleBindingEvent(comp), 2)
+				}
+				// only components which write checkpoints expect a RoleBinding
+				if v1alpha1.WritesCheckpoints(comp) {
+					events = insertString(events, createCheckpointWriterRoleBindingEvent(comp), len(events)-1)
 				}
 				return events
 			}(),
@@ -160,6 +169,7 @@ func TestReconcileAdapter[T kmeta.Accessor](t *testing.T,
 				newServiceAccount(),
 				newConfigWatchRoleBinding(),
 				newMTAdapterRoleBinding(),
+				newCheckpointWriterRoleBinding(),
 				newAdapter(ready),
 			},
 			WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
@@ -176,6 +186,7 @@ func TestReconcileAdapter[T kmeta.Accessor](t *testing.T,
 				newServiceAccount(),
 				newConfigWatchRoleBinding(),
 				newMTAdapterRoleBinding(),
+				newCheckpointWriterRoleBinding(),
 				newAdapter(notReady),
 			},
 			WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
@@ -192,6 +203,7 @@ func TestReconcileAdapter[T kmeta.Accessor](t *testing.T,
 				newServiceAccount(),
 				newConfigWatchRoleBinding(),
 				newMTAdapterRoleBinding(),
+				newCheckpointWriterRoleBinding(),
 				newAdapter(ready, bumpImage),
 			},
 			WantUpdates: []clientgotesting.UpdateActionImpl{{
@@ -211,6 +223,7 @@ func TestReconcileAdapter[T kmeta.Accessor](t *testing.T,
 				newServiceAccount(noOwner),
 				newConfigWatchRoleBinding(),
 				newMTAdapterRoleBinding(),
+				newCheckpointWriterRoleBinding(),
 				newAdapter(ready),
 			},
 			WantUpdates: []clientgotesting.UpdateActionImpl{{
@@ -230,6 +243,7 @@ func TestReconcileAdapter[T kmeta.Accessor](t *testing.T,
 				newServiceAccount(),
 				newConfigWatchRoleBinding(),
 				newMTAdapterRoleBinding(),
+				newCheckpointWriterRoleBinding(),
 				newAdapter(ready),
 			},
 			WantUpdates: nil,
@@ -245,6 +259,7 @@ func TestReconcileAdapter[T kmeta.Accessor](t *testing.T,
 				newServiceAccount(),
 				newConfigWatchRoleBinding(),
 				newMTAdapterRoleBinding(),
+				newCheckpointWriterRoleBinding(),
 				newAdapter(ready, rename),
 			},
 			WantUpdates: nil,
@@ -264,6 +279,7 @@ func TestReconcileAdapter[T kmeta.Accessor](t *testing.T,
 				newServiceAccount(),
 				newConfigWatchRoleBinding(),
 				newMTAdapterRoleBinding(),
+				newCheckpointWriterRoleBinding(),
 				newAdapter(ready),
 			},
 			WantStatusUpdates: func() []clientgotesting.UpdateActionImpl {
@@ -315,6 +331,7 @@ func TestReconcileAdapter[T kmeta.Accessor](t *testing.T,
 				newServiceAccount(),
 				newConfigWatchRoleBinding(),
 				newMTAdapterRoleBinding(),
+				newCheckpointWriterRoleBinding(),
 				newAdapter(ready),
 			},
 			WantStatusUpdates: func() []clientgotesting.UpdateActionImpl {
@@ -357,6 +374,7 @@ func TestReconcileAdapter[T kmeta.Accessor](t *testing.T,
 				newServiceAccount(),
 				newConfigWatchRoleBinding(),
 				newMTAdapterRoleBinding(),
+				newCheckpointWriterRoleBinding(),
 			},
 			WantCreates: []runtime.Object{
 				newAdapter(),
@@ -382,6 +400,7 @@ func TestReconcileAdapter[T kmeta.Accessor](t *testing.T,
 				newServiceAccount(),
 				newConfigWatchRoleBinding(),
 				newMTAdapterRoleBinding(),
+				newCheckpointWriterRoleBinding(),
 				newAdapter(ready, bumpImage),
 			},
 			WantUpdates: []clientgotesting.UpdateActionImpl{{
@@ -905,6 +924,43 @@ func NewMTAdapterRoleBinding(sa *corev1.ServiceAccount) func() *rbacv1.RoleBindi
 	}
 }
 
+// NewCheckpointWriterRoleBinding returns a checkpoint writer RoleBinding
+// constructor for the given ServiceAccount.
+func NewCheckpointWriterRoleBinding(sa *corev1.ServiceAccount) func() *rbacv1.RoleBinding {
+	return func() *rbacv1.RoleBinding {
+		return &rbacv1.RoleBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: tNs,
+				Name:      sa.Name + "-checkpoint-writer",
+				Labels:    sa.Labels,
+				OwnerReferences: []metav1.OwnerReference{
+					{
+						APIVersion:         "v1",
+						Kind:               "ServiceAccount",
+						Name:               sa.Name,
+						UID:                sa.UID,
+						Controller:         ptr.Bool(true),
+						BlockOwnerDeletion: ptr.Bool(true),
+					},
+				},
+			},
+			RoleRef: rbacv1.RoleRef{
+				APIGroup: "rbac.authorization.k8s.io",
+				Kind:     "ClusterRole",
+				Name:     "triggermesh-checkpoint-writer",
+			},
+			Subjects: []rbacv1.Subject{
+				{
+					APIGroup:  "",
+					Kind:      "ServiceAccount",
+					Namespace: tNs,
+					Name:      sa.Name,
+				},
+			},
+		}
+	}
+}
+
 /* Events */
 
 func createServiceAccountEvent(rcl v1alpha1.Reconcilable) string {
@@ -927,6 +983,11 @@ func createMTAdapterRoleBindingEvent(rcl v1alpha1.Reconcilable) string {
 		"Created RoleBinding %q due to the creation of a %s object",
 		common.MTAdapterObjectName(rcl), rcl.GetGroupVersionKind().Kind)
 }
+func createCheckpointWriterRoleBindingEvent(rcl v1alpha1.Reconcilable) string {
+	return eventtesting.Eventf(corev1.EventTypeNormal, common.ReasonRBACCreate,
+		"Created RoleBinding %q due to the creation of a %s object",
+		common.MTAdapterObjectName(rcl)+"-checkpoint-writer", rcl.GetGroupVersionKind().Kind)
+}
 func createAdapterEvent(name, kind string) string {
 	return eventtesting.Eventf(corev1.EventTypeNormal, common.ReasonAdapterCreate, "Created adapter %s %q", kind, name)
 }
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"sync"
 
 	cloudevents "github.com/cloudevents/sdk-go/v2"
 	"github.com/cloudevents/sdk-go/v2/binding"
@@ -37,6 +38,7 @@ import (
 	"knative.dev/pkg/logging"
 
 	commonv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/apis/routing/v1alpha1"
 	informerv1alpha1 "github.com/triggermesh/triggermesh/pkg/client/generated/injection/informers/routing/v1alpha1/filter"
 	routinglisters "github.com/triggermesh/triggermesh/pkg/client/generated/listers/routing/v1alpha1"
 	"github.com/triggermesh/triggermesh/pkg/routing/adapter/common/env"
@@ -135,24 +137,95 @@ func (h *Handler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
 		return
 	}
 
-	cond, exists := h.expressions.get(f.UID, f.Generation)
+	conds, exists := h.expressions.get(f.UID, f.Generation)
 	if !exists {
-		cond, err = cel.CompileExpression(f.Spec.Expression)
+		conds, err = compileExpressions(&f.Spec)
 		if err != nil {
 			h.logger.Errorw("Failed to compile filter expression", zap.Error(err), zap.Any("filter", filter))
 			writer.WriteHeader(http.StatusBadRequest)
 			return
 		}
-		h.expressions.set(f.UID, f.Generation, cond)
+		h.expressions.set(f.UID, f.Generation, conds)
 	}
 
-	filterResult := filterEvent(ctx, cond, *event)
-	if filterResult == eventfilter.FailFilter {
+	targets, err := destinations(ctx, f, conds, *event)
+	if err != nil {
+		h.logger.Errorw("Unable to determine the destinations of the event", zap.Error(err), zap.Any("filter", filter))
+		writer.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+	if len(targets) == 0 {
 		return
 	}
 
-	event = updateAttributes(f.Status, event)
-	h.send(ctx, writer, request.Header, f.Status.SinkURI.String(), event)
+	event = updateAttributes(f.Status.Status, event)
+
+	if len(targets) == 1 {
+		h.send(ctx, writer, request.Header, targets[0], event)
+		return
+	}
+	h.fanOut(ctx, writer, request.Header, targets, event)
+}
+
+// compileExpressions returns the compiled CEL expressions of the given Filter
+// spec, in the order of its routes.
+func compileExpressions(fs *v1alpha1.FilterSpec) ([]cel.ConditionalFilter, error) {
+	if len(fs.Routes) == 0 {
+		cond, err := cel.CompileExpression(fs.Expression)
+		if err != nil {
+			return nil, err
+		}
+		return []cel.ConditionalFilter{cond}, nil
+	}
+
+	conds := make([]cel.ConditionalFilter, 0, len(fs.Routes))
+	for i, r := range fs.Routes {
+		cond, err := cel.CompileExpression(r.Expression)
+		if err != nil {
+			return nil, fmt.Errorf("route %d: %w", i, err)
+		}
+		conds = append(conds, cond)
+	}
+
+	return conds, nil
+}
+
+// destinations returns the URLs of the sinks the given event must be
+// forwarded to, based on the compiled expressions of the Filter.
+func destinations(ctx context.Context, f *v1alpha1.Filter, conds []cel.ConditionalFilter,
+	event cloudevents.Event) ([]string, error) {
+
+	if len(f.Spec.Routes) == 0 {
+		if filterEvent(ctx, conds[0], event) == eventfilter.FailFilter {
+			return nil, nil
+		}
+		return []string{f.Status.SinkURI.String()}, nil
+	}
+
+	if len(f.Status.RouteSinkURIs) != len(conds) {
+		return nil, errors.New("the sinks of the routes have not been resolved yet")
+	}
+
+	mode := f.GetRoutingMode()
+
+	var targets []string
+	for i, cond := range conds {
+		if filterEvent(ctx, cond, event) == eventfilter.FailFilter {
+			continue
+		}
+
+		targets = append(targets, f.Status.RouteSinkURIs[i].String())
+		if mode != v1alpha1.FilterRoutingModeFanOut {
+			break
+		}
+	}
+
+	// events which match none of the routes are sent to the default sink
+	if len(targets) == 0 {
+		targets = append(targets, f.Status.SinkURI.String())
+	}
+
+	return targets, nil
 }
 
 func updateAttributes(fs commonv1alpha1.Status, event *event.Event) *event.Event {
@@ -181,6 +254,49 @@ func (h *Handler) send(ctx context.Context, writer http.ResponseWriter, headers
 	}
 }
 
+// fanOut sends the event to all the given targets concurrently. Responses
+// from the targets are discarded and the request is answered with an error
+// status if any of the deliveries failed.
+func (h *Handler) fanOut(ctx context.Context, writer http.ResponseWriter, headers http.Header, targets []string, event *cloudevents.Event) {
+	errs := make([]error, len(targets))
+
+	var wg sync.WaitGroup
+	for i, target := range targets {
+		wg.Add(1)
+		go func(i int, target string) {
+			defer wg.Done()
+
+			response, err := h.sendEvent(ctx, headers, target, event)
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			response.Body.Close()
+
+			if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+				errs[i] = fmt.Errorf("received status code %d", response.StatusCode)
+			}
+		}(i, target)
+	}
+	wg.Wait()
+
+	failed := false
+	for i, err := range errs {
+		if err != nil {
+			h.logger.Errorw("Failed to send event", zap.Error(err), zap.String("target", targets[i]))
+			failed = true
+		}
+	}
+
+	if failed {
+		writer.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	h.logger.Debug("Successfully dispatched message", zap.Strings("targets", targets))
+	writer.WriteHeader(http.StatusOK)
+}
+
 func (h *Handler) sendEvent(ctx context.Context, headers http.Header, target string, event *cloudevents.Event) (*http.Response, error) {
 	// Send the event to the subscriber
 	req, err := h.sender.NewCloudEventRequestWithTarget(ctx, target)
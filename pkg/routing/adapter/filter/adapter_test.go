@@ -208,6 +208,100 @@ func TestAdapter(t *testing.T) {
 	}
 }
 
+func TestDestinations(t *testing.T) {
+	const (
+		defaultSink = "http://default.sink"
+		fooSink     = "http://foo.sink"
+		barSink     = "http://bar.sink"
+	)
+
+	firstMatch := v1alpha1.FilterRoutingModeFirstMatch
+	fanOut := v1alpha1.FilterRoutingModeFanOut
+
+	routes := []v1alpha1.FilterRoute{{
+		Expression: `$company.(string) == "foo"`,
+	}, {
+		Expression: `$employees.(int64) > 10`,
+	}}
+
+	testCases := map[string]struct {
+		payload     string
+		routes      []v1alpha1.FilterRoute
+		routeSinks  []string
+		mode        *v1alpha1.FilterRoutingMode
+		expect      []string
+		expectError bool
+	}{
+		"Single expression, pass": {
+			payload: `{"company":"foo"}`,
+			expect:  []string{defaultSink},
+		},
+		"Single expression, fail": {
+			payload: `{"company":"bar"}`,
+			expect:  nil,
+		},
+		"First match, default mode": {
+			payload:    `{"company":"foo","employees":20}`,
+			routes:     routes,
+			routeSinks: []string{fooSink, barSink},
+			expect:     []string{fooSink},
+		},
+		"First match, second route": {
+			payload:    `{"company":"bar","employees":20}`,
+			routes:     routes,
+			routeSinks: []string{fooSink, barSink},
+			mode:       &firstMatch,
+			expect:     []string{barSink},
+		},
+		"Fan out, all matches": {
+			payload:    `{"company":"foo","employees":20}`,
+			routes:     routes,
+			routeSinks: []string{fooSink, barSink},
+			mode:       &fanOut,
+			expect:     []string{fooSink, barSink},
+		},
+		"No match, default route": {
+			payload:    `{"company":"bar","employees":5}`,
+			routes:     routes,
+			routeSinks: []string{fooSink, barSink},
+			mode:       &fanOut,
+			expect:     []string{defaultSink},
+		},
+		"Unresolved route sinks": {
+			payload:     `{"company":"foo"}`,
+			routes:      routes,
+			expectError: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			f := newFilter(t, tNS+"/router", `$company.(string) == "foo"`, "default.sink")
+			if tc.routes != nil {
+				f.Spec.Expression = ""
+				f.Spec.Routes = tc.routes
+				f.Spec.RoutingMode = tc.mode
+			}
+			for _, s := range tc.routeSinks {
+				u, err := apis.ParseURL(s)
+				require.NoError(t, err)
+				f.Status.RouteSinkURIs = append(f.Status.RouteSinkURIs, u)
+			}
+
+			conds, err := compileExpressions(&f.Spec)
+			require.NoError(t, err)
+
+			targets, err := destinations(context.Background(), f, conds, newCloudEvent(t, tc.payload))
+			if tc.expectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expect, targets)
+		})
+	}
+}
+
 func newCloudEvent(t *testing.T, data string) cloudevents.Event {
 	event := cloudevents.NewEvent()
 	event.SetID(tCloudEventID)
@@ -259,9 +353,11 @@ func newFilter(t *testing.T, key, expression, addr string) *v1alpha1.Filter {
 		Spec: v1alpha1.FilterSpec{
 			Expression: expression,
 		},
-		Status: common.Status{
-			SourceStatus: duckv1.SourceStatus{
-				SinkURI: sinkURI,
+		Status: v1alpha1.FilterStatus{
+			Status: common.Status{
+				SourceStatus: duckv1.SourceStatus{
+					SinkURI: sinkURI,
+				},
 			},
 		},
 	}
@@ -23,7 +23,10 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 )
 
-type filterGenerations map[int64]cel.ConditionalFilter
+// filterGenerations holds the compiled expressions of a Filter, in the order
+// of its routes. Filters which define a single expression have exactly one
+// element.
+type filterGenerations map[int64][]cel.ConditionalFilter
 type filterUIDs map[types.UID]filterGenerations
 
 type expressionStorage struct {
@@ -38,13 +41,13 @@ func newExpressionStorage() *expressionStorage {
 	}
 }
 
-func (f *expressionStorage) get(uid types.UID, generation int64) (cel.ConditionalFilter, bool) {
+func (f *expressionStorage) get(uid types.UID, generation int64) ([]cel.ConditionalFilter, bool) {
 	f.RLock()
 	defer f.RUnlock()
 
 	filterGens, exist := f.filterUIDs[uid]
 	if !exist {
-		return cel.ConditionalFilter{}, false
+		return nil, false
 	}
 
 	filter, exist := filterGens[generation]
@@ -52,11 +55,11 @@ func (f *expressionStorage) get(uid types.UID, generation int64) (cel.Conditiona
 }
 
 // set method overrides previous generations of compiled expressions
-func (f *expressionStorage) set(uid types.UID, generation int64, condition cel.ConditionalFilter) {
+func (f *expressionStorage) set(uid types.UID, generation int64, conditions []cel.ConditionalFilter) {
 	f.Lock()
 	defer f.Unlock()
 
 	f.filterUIDs[uid] = filterGenerations{
-		generation: condition,
+		generation: conditions,
 	}
 }
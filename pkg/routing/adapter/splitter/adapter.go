@@ -26,6 +26,7 @@ import (
 	"github.com/cloudevents/sdk-go/v2/binding"
 	"github.com/cloudevents/sdk-go/v2/event"
 	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
+	"github.com/google/uuid"
 	"github.com/tidwall/gjson"
 	"go.uber.org/zap"
 
@@ -35,6 +36,7 @@ import (
 	"knative.dev/pkg/injection"
 	"knative.dev/pkg/logging"
 
+	"github.com/triggermesh/triggermesh/pkg/apis/routing/v1alpha1"
 	informerv1alpha1 "github.com/triggermesh/triggermesh/pkg/client/generated/injection/informers/routing/v1alpha1/splitter"
 	routinglisters "github.com/triggermesh/triggermesh/pkg/client/generated/listers/routing/v1alpha1"
 	"github.com/triggermesh/triggermesh/pkg/routing/adapter/common/env"
@@ -125,21 +127,101 @@ func (h *Handler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
 		return
 	}
 
-	for i, e := range h.split(s.Spec.Path, event) {
+	parts := h.split(s.Spec.Path, event)
+	groupID := uuid.New().String()
+	policy := s.GetFailurePolicy()
+	keepReplies := s.Spec.Aggregation != nil
+
+	results := make([]deliveryResult, 0, len(parts))
+	for i, e := range parts {
 		e.SetID(fmt.Sprintf("%s-%d", event.ID(), i))
 		e.SetType(s.Spec.CEContext.Type)
 		e.SetSource(s.Spec.CEContext.Source)
 		for key, value := range s.Spec.CEContext.Extensions {
 			e.SetExtension(key, value)
 		}
-		// we may want to keep responses and send them back to the source
-		_, err := h.sendEvent(ctx, request.Header, s.Status.SinkURI.String(), e)
-		if err != nil {
-			h.logger.Errorw("Failed to send the event", zap.Error(err))
+		e.SetExtension(v1alpha1.SplitterGroupExtension, groupID)
+		e.SetExtension(v1alpha1.SplitterIndexExtension, i)
+		e.SetExtension(v1alpha1.SplitterCountExtension, len(parts))
+
+		res := h.deliver(ctx, request.Header, s.Status.SinkURI.String(), e, keepReplies)
+		res.index = i
+		results = append(results, res)
+
+		if res.err != nil {
+			h.logger.Errorw("Failed to send the event", zap.Error(res.err), zap.Int("index", i))
+			if policy == v1alpha1.SplitterFailurePolicyFailFast {
+				break
+			}
 		}
 	}
 
-	writer.WriteHeader(http.StatusOK)
+	status := responseStatus(policy, results)
+
+	if !keepReplies {
+		writer.WriteHeader(status)
+		return
+	}
+
+	aggregated, err := aggregate(s, event, groupID, len(parts), results)
+	if err != nil {
+		h.logger.Errorw("Failed to aggregate replies", zap.Error(err))
+		writer.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	response := binding.ToMessage(aggregated)
+	// cannot be err, but makes linter complain about missing err check
+	//nolint
+	defer response.Finish(nil)
+
+	if err := cehttp.WriteResponseWriter(ctx, response, status, writer); err != nil {
+		h.logger.Errorw("Failed to write response event", zap.Error(err))
+	}
+}
+
+// deliver sends a split event to the given target and returns the outcome
+// of the delivery, including the event replied by the target if keepReply is
+// true.
+func (h *Handler) deliver(ctx context.Context, headers http.Header, target string,
+	e *cloudevents.Event, keepReply bool) deliveryResult {
+
+	var res deliveryResult
+
+	resp, err := h.sendEvent(ctx, headers, target, e)
+	if err != nil {
+		res.err = err
+		return res
+	}
+	defer resp.Body.Close()
+
+	res.statusCode = resp.StatusCode
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		res.err = fmt.Errorf("received status code %d", resp.StatusCode)
+		return res
+	}
+
+	if !keepReply {
+		return res
+	}
+
+	message := cehttp.NewMessageFromHttpResponse(resp)
+	// cannot be err, but makes linter complain about missing err check
+	//nolint
+	defer message.Finish(nil)
+
+	if message.ReadEncoding() == binding.EncodingUnknown {
+		return res
+	}
+
+	reply, err := binding.ToEvent(ctx, message)
+	if err != nil {
+		res.err = fmt.Errorf("reading reply: %w", err)
+		return res
+	}
+	res.reply = reply
+
+	return res
 }
 
 func (h *Handler) split(path string, e *event.Event) []*event.Event {
@@ -0,0 +1,117 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package splitter
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/routing/v1alpha1"
+)
+
+// deliveryResult is the outcome of the delivery of a split event.
+type deliveryResult struct {
+	index      int
+	statusCode int
+	reply      *cloudevents.Event
+	err        error
+}
+
+// aggregatedItem is the representation of a deliveryResult inside the data
+// of an aggregated event.
+type aggregatedItem struct {
+	Index      int             `json:"index"`
+	StatusCode int             `json:"statusCode,omitempty"`
+	Data       json.RawMessage `json:"data,omitempty"`
+	Error      string          `json:"error,omitempty"`
+}
+
+// responseStatus returns the HTTP status code to respond to the caller with,
+// based on the given failure policy and delivery results.
+func responseStatus(policy v1alpha1.SplitterFailurePolicy, results []deliveryResult) int {
+	failed := 0
+	for _, r := range results {
+		if r.err != nil {
+			failed++
+		}
+	}
+
+	switch {
+	case failed == 0, policy == v1alpha1.SplitterFailurePolicyBestEffort:
+		return http.StatusOK
+	case policy == v1alpha1.SplitterFailurePolicyReportPartial && failed < len(results):
+		return http.StatusMultiStatus
+	default:
+		return http.StatusBadGateway
+	}
+}
+
+// aggregate returns an event which data is the list of outcomes of the
+// deliveries of the split events, including the data of their replies.
+func aggregate(s *v1alpha1.Splitter, in *cloudevents.Event, groupID string, count int,
+	results []deliveryResult) (*cloudevents.Event, error) {
+
+	items := make([]aggregatedItem, 0, len(results))
+	for _, r := range results {
+		item := aggregatedItem{
+			Index:      r.index,
+			StatusCode: r.statusCode,
+		}
+		if r.err != nil {
+			item.Error = r.err.Error()
+		}
+		if r.reply != nil && len(r.reply.Data()) != 0 {
+			item.Data = replyData(r.reply)
+		}
+		items = append(items, item)
+	}
+
+	typ := v1alpha1.SplitterAggregateEventType
+	if t := s.Spec.Aggregation.Type; t != nil && *t != "" {
+		typ = *t
+	}
+
+	out := cloudevents.NewEvent()
+	out.SetID(in.ID())
+	out.SetType(typ)
+	out.SetSource(s.Spec.CEContext.Source)
+	out.SetExtension(v1alpha1.SplitterGroupExtension, groupID)
+	out.SetExtension(v1alpha1.SplitterCountExtension, count)
+
+	if err := out.SetData(cloudevents.ApplicationJSON, items); err != nil {
+		return nil, fmt.Errorf("setting data of aggregated event: %w", err)
+	}
+
+	return &out, nil
+}
+
+// replyData returns the data of a reply as a JSON value. Data which isn't
+// valid JSON is represented as a JSON string.
+func replyData(reply *cloudevents.Event) json.RawMessage {
+	if data := reply.Data(); json.Valid(data) {
+		return data
+	}
+
+	data, err := json.Marshal(string(reply.Data()))
+	if err != nil {
+		return nil
+	}
+	return data
+}
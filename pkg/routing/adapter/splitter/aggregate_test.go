@@ -0,0 +1,115 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package splitter
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/routing/v1alpha1"
+)
+
+func TestResponseStatus(t *testing.T) {
+	ok := deliveryResult{statusCode: http.StatusOK}
+	ko := deliveryResult{err: errors.New("fake error")}
+
+	testCases := map[string]struct {
+		policy  v1alpha1.SplitterFailurePolicy
+		results []deliveryResult
+		expect  int
+	}{
+		"No event": {
+			policy: v1alpha1.SplitterFailurePolicyFailFast,
+			expect: http.StatusOK,
+		},
+		"Best effort, partial failure": {
+			policy:  v1alpha1.SplitterFailurePolicyBestEffort,
+			results: []deliveryResult{ok, ko},
+			expect:  http.StatusOK,
+		},
+		"Fail fast, success": {
+			policy:  v1alpha1.SplitterFailurePolicyFailFast,
+			results: []deliveryResult{ok, ok},
+			expect:  http.StatusOK,
+		},
+		"Fail fast, failure": {
+			policy:  v1alpha1.SplitterFailurePolicyFailFast,
+			results: []deliveryResult{ok, ko},
+			expect:  http.StatusBadGateway,
+		},
+		"Report partial, partial failure": {
+			policy:  v1alpha1.SplitterFailurePolicyReportPartial,
+			results: []deliveryResult{ok, ko},
+			expect:  http.StatusMultiStatus,
+		},
+		"Report partial, total failure": {
+			policy:  v1alpha1.SplitterFailurePolicyReportPartial,
+			results: []deliveryResult{ko, ko},
+			expect:  http.StatusBadGateway,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expect, responseStatus(tc.policy, tc.results))
+		})
+	}
+}
+
+func TestAggregate(t *testing.T) {
+	s := &v1alpha1.Splitter{
+		Spec: v1alpha1.SplitterSpec{
+			CEContext: v1alpha1.CloudEventContext{
+				Source: "test.source",
+			},
+			Aggregation: &v1alpha1.SplitterAggregation{},
+		},
+	}
+
+	jsonReply := newCloudEvent(t, `{"ok":true}`)
+	textReply := cloudevents.NewEvent()
+	err := textReply.SetData(cloudevents.TextPlain, "done")
+	require.NoError(t, err)
+
+	results := []deliveryResult{
+		{index: 0, statusCode: http.StatusOK, reply: &jsonReply},
+		{index: 1, statusCode: http.StatusAccepted, reply: &textReply},
+		{index: 2, err: errors.New("fake error")},
+	}
+
+	in := newCloudEvent(t, `{}`)
+	out, err := aggregate(s, &in, "group-1", 3, results)
+	require.NoError(t, err)
+
+	assert.Equal(t, tCloudEventID, out.ID())
+	assert.Equal(t, v1alpha1.SplitterAggregateEventType, out.Type())
+	assert.Equal(t, "test.source", out.Source())
+	assert.Equal(t, "group-1", out.Extensions()[v1alpha1.SplitterGroupExtension])
+	assert.EqualValues(t, 3, out.Extensions()[v1alpha1.SplitterCountExtension])
+
+	const expectData = `[` +
+		`{"index":0,"statusCode":200,"data":{"ok":true}},` +
+		`{"index":1,"statusCode":202,"data":"done"},` +
+		`{"index":2,"error":"fake error"}` +
+		`]`
+	assert.JSONEq(t, expectData, string(out.Data()))
+}
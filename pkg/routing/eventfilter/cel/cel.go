@@ -36,13 +36,14 @@ func CompileExpression(expression string) (ConditionalFilter, error) {
 	if err != nil {
 		return ConditionalFilter{}, err
 	}
-	prog, err := newCEL(expr, vars)
+	prog, native, err := newCEL(expr, vars)
 	if err != nil {
 		return ConditionalFilter{}, err
 	}
 	return ConditionalFilter{
-		Expression: &prog,
-		Variables:  vars,
+		Expression:  &prog,
+		Variables:   vars,
+		failOnError: native,
 	}, nil
 }
 
@@ -99,9 +100,13 @@ func parseExpressionString(expression string) (string, []Variable, error) {
 }
 
 // newCEL creates CEL env, sets its variables, compiles expression string
-// and validates expression result type
-func newCEL(expr string, vars []Variable) (cel.Program, error) {
-	declVars := []*exprpb.Decl{}
+// and validates expression result type. It also returns whether the
+// expression references the native variables which expose the CloudEvent.
+func newCEL(expr string, vars []Variable) (cel.Program, bool, error) {
+	declVars := []*exprpb.Decl{
+		decls.NewVar(ceVariable, decls.NewMapType(decls.String, decls.Dyn)),
+		decls.NewVar(dataVariable, decls.Dyn),
+	}
 	for _, variable := range vars {
 		primitiveType := exprpb.Type_PrimitiveType(exprpb.Type_PrimitiveType_value[strings.ToUpper(variable.Type)])
 		declVars = append(declVars, decls.NewVar(variable.Name, decls.NewPrimitiveType(primitiveType)))
@@ -111,17 +116,45 @@ func newCEL(expr string, vars []Variable) (cel.Program, error) {
 		cel.Declarations(declVars...),
 	)
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
 
 	ast, iss := env.Compile(expr)
 	if iss.Err() != nil {
-		return nil, iss.Err()
+		return nil, false, iss.Err()
 	}
 
 	if !proto.Equal(ast.ResultType(), decls.Bool) {
-		return nil, fmt.Errorf("expression %q must return bool type, got %s", expr, ast.ResultType().String())
+		return nil, false, fmt.Errorf("expression %q must return bool type, got %s", expr, ast.ResultType().String())
+	}
+
+	native, err := referencesNativeVariables(ast)
+	if err != nil {
+		return nil, false, err
+	}
+
+	prog, err := env.Program(ast)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return prog, native, nil
+}
+
+// referencesNativeVariables returns whether the given checked expression
+// references any of the native variables.
+func referencesNativeVariables(ast *cel.Ast) (bool, error) {
+	checked, err := cel.AstToCheckedExpr(ast)
+	if err != nil {
+		return false, err
+	}
+
+	for _, ref := range checked.ReferenceMap {
+		switch ref.Name {
+		case ceVariable, dataVariable:
+			return true, nil
+		}
 	}
 
-	return env.Program(ast)
+	return false, nil
 }
@@ -86,6 +86,16 @@ func TestCompileExpression(t *testing.T) {
 		"Valid expression 5": {
 			expression: `true`,
 		},
+		"Valid expression 6": {
+			expression: `ce.type == "io.triggermesh.test" && has(ce.extensions.foo)`,
+		},
+		"Valid expression 7": {
+			expression: `data.items.exists(i, i.price > 10) && $id.(string) != ""`,
+		},
+		"Undeclared native variable": {
+			expression: `event.type == "foo"`,
+			wantError:  true,
+		},
 	}
 
 	for name, tc := range cases {
@@ -17,20 +17,36 @@ limitations under the License.
 package cel
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"fmt"
 
 	cloudevents "github.com/cloudevents/sdk-go/v2"
+	cetypes "github.com/cloudevents/sdk-go/v2/types"
 	"github.com/google/cel-go/cel"
 	"github.com/tidwall/gjson"
 
 	"github.com/triggermesh/triggermesh/pkg/routing/eventfilter"
 )
 
+// Names of the native variables exposing the CloudEvent to CEL expressions.
+const (
+	ceVariable   = "ce"
+	dataVariable = "data"
+)
+
 // ConditionalFilter structure holds both CEL Program and variable definitions
 // so that it can be evaluated with the new variable values
 type ConditionalFilter struct {
 	Expression *cel.Program
 	Variables  []Variable
+
+	// Expressions which reference the native variables (ce, data) do not
+	// let Events pass when they can't be evaluated. Expressions which
+	// only use the legacy "$json_path.(type)" variables let them pass
+	// for backward compatibility.
+	failOnError bool
 }
 
 // Variable contains the meta data required to parse event payload and execute
@@ -43,8 +59,38 @@ type Variable struct {
 
 // Filter parses Event payload values defined as the expression variables, asserts their types,
 // and executes CEL Program. If expression result is true, Event passes the filter.
+// An expression which references the native variables and can not be evaluated, e.g. because
+// it references a context attribute, extension or data field that is absent from the Event,
+// does not let the Event pass. Legacy expressions let the Event pass in that case.
 func (c *ConditionalFilter) Filter(ctx context.Context, event cloudevents.Event) eventfilter.FilterResult {
-	vars := make(map[string]interface{})
+	pass, err := c.Eval(event)
+	if err != nil {
+		if c.failOnError {
+			return eventfilter.FailFilter
+		}
+		return eventfilter.PassFilter
+	}
+
+	if !pass {
+		return eventfilter.FailFilter
+	}
+
+	return eventfilter.PassFilter
+}
+
+// Eval executes the CEL Program against the given Event and returns the
+// boolean result of the expression.
+func (c *ConditionalFilter) Eval(event cloudevents.Event) (bool, error) {
+	vars := map[string]interface{}{
+		// native variables are evaluated lazily, only when referenced
+		// in the expression
+		ceVariable: func() interface{} {
+			return contextAttributes(event)
+		},
+		dataVariable: func() interface{} {
+			return decodeData(event.Data())
+		},
+	}
 
 	for _, v := range c.Variables {
 		switch v.Type {
@@ -61,16 +107,94 @@ func (c *ConditionalFilter) Filter(ctx context.Context, event cloudevents.Event)
 		}
 	}
 
-	pass, err := eval(*c.Expression, vars)
-	if err != nil || pass {
-		return eventfilter.PassFilter
-	}
-
-	return eventfilter.FailFilter
+	return eval(*c.Expression, vars)
 }
 
 // eval evaluates precompiled Expression with passed variables
 func eval(program cel.Program, vars map[string]interface{}) (bool, error) {
 	out, _, err := program.Eval(vars)
-	return out.Value().(bool), err
+	if err != nil {
+		return false, err
+	}
+
+	pass, ok := out.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("expression returned a non-bool value of type %T", out.Value())
+	}
+	return pass, nil
+}
+
+// contextAttributes returns the context attributes of the given event as a
+// map which keys are the names of the attributes.
+func contextAttributes(event cloudevents.Event) map[string]interface{} {
+	attrs := map[string]interface{}{
+		"specversion": event.SpecVersion(),
+		"id":          event.ID(),
+		"type":        event.Type(),
+		"source":      event.Source(),
+	}
+
+	if v := event.Subject(); v != "" {
+		attrs["subject"] = v
+	}
+	if v := event.DataContentType(); v != "" {
+		attrs["datacontenttype"] = v
+	}
+	if v := event.DataSchema(); v != "" {
+		attrs["dataschema"] = v
+	}
+	if v := event.Time(); !v.IsZero() {
+		attrs["time"] = v
+	}
+
+	exts := make(map[string]interface{}, len(event.Extensions()))
+	for name, val := range event.Extensions() {
+		s, err := cetypes.Format(val)
+		if err != nil {
+			continue
+		}
+		exts[name] = s
+	}
+	attrs["extensions"] = exts
+
+	return attrs
+}
+
+// decodeData decodes the given JSON payload into a value that can be
+// processed by CEL expressions. Integer numbers are decoded as int64 to allow
+// their comparison with integer literals. A payload which isn't valid JSON
+// is returned as null.
+func decodeData(data []byte) interface{} {
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+
+	var v interface{}
+	if err := d.Decode(&v); err != nil {
+		return nil
+	}
+
+	return normalizeNumbers(v)
+}
+
+// normalizeNumbers replaces in-place all json.Number values contained in v
+// with either an int64 or a float64.
+func normalizeNumbers(v interface{}) interface{} {
+	switch tv := v.(type) {
+	case map[string]interface{}:
+		for k, e := range tv {
+			tv[k] = normalizeNumbers(e)
+		}
+	case []interface{}:
+		for i, e := range tv {
+			tv[i] = normalizeNumbers(e)
+		}
+	case json.Number:
+		if i, err := tv.Int64(); err == nil {
+			return i
+		}
+		f, _ := tv.Float64()
+		return f
+	}
+
+	return v
 }
@@ -0,0 +1,136 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cel
+
+import (
+	"context"
+	"testing"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/triggermesh/triggermesh/pkg/routing/eventfilter"
+)
+
+func TestFilter(t *testing.T) {
+	const payload = `{"user":{"name":"alice","age":30},"items":[{"sku":"a","price":5.5},{"sku":"b","price":12.5}]}`
+
+	cases := map[string]struct {
+		expression string
+		expect     eventfilter.FilterResult
+	}{
+		"Legacy variable": {
+			expression: `$user.name.(string) == "alice"`,
+			expect:     eventfilter.PassFilter,
+		},
+		"Legacy variable mismatch": {
+			expression: `$user.name.(string) == "bob"`,
+			expect:     eventfilter.FailFilter,
+		},
+		"Legacy variable evaluation error": {
+			expression: `$user.age.(int64) / $user.missing.(int64) > 1`,
+			expect:     eventfilter.PassFilter,
+		},
+		"Context attribute": {
+			expression: `ce.type == "test.type" && ce.source == "test.source"`,
+			expect:     eventfilter.PassFilter,
+		},
+		"Context attribute mismatch": {
+			expression: `ce.type == "other.type"`,
+			expect:     eventfilter.FailFilter,
+		},
+		"Optional context attribute": {
+			expression: `has(ce.subject) && ce.subject == "test"`,
+			expect:     eventfilter.PassFilter,
+		},
+		"Extension": {
+			expression: `ce.extensions.tenant == "acme"`,
+			expect:     eventfilter.PassFilter,
+		},
+		"Missing extension": {
+			expression: `has(ce.extensions.missing)`,
+			expect:     eventfilter.FailFilter,
+		},
+		"Data integer": {
+			expression: `data.user.age >= 18`,
+			expect:     eventfilter.PassFilter,
+		},
+		"Data exists macro": {
+			expression: `data.items.exists(i, i.price > 10.0)`,
+			expect:     eventfilter.PassFilter,
+		},
+		"Data all macro": {
+			expression: `data.items.all(i, i.sku == "a")`,
+			expect:     eventfilter.FailFilter,
+		},
+		"Data has macro": {
+			expression: `has(data.user.email)`,
+			expect:     eventfilter.FailFilter,
+		},
+		"Missing context attribute": {
+			expression: `ce.dataschema == "nope"`,
+			expect:     eventfilter.FailFilter,
+		},
+		"Missing context attribute negated": {
+			expression: `ce.dataschema != "nope"`,
+			expect:     eventfilter.FailFilter,
+		},
+		"Missing extension comparison": {
+			expression: `ce.extensions.region == "x"`,
+			expect:     eventfilter.FailFilter,
+		},
+		"Missing data field": {
+			expression: `data.user.email == "alice@example.com"`,
+			expect:     eventfilter.FailFilter,
+		},
+		"Missing nested data field": {
+			expression: `data.b.c == 5`,
+			expect:     eventfilter.FailFilter,
+		},
+		"Guarded missing data field": {
+			expression: `!has(data.user.email) || data.user.email == "alice@example.com"`,
+			expect:     eventfilter.PassFilter,
+		},
+		"Mixed syntaxes": {
+			expression: `ce.type == "test.type" && data.user.name == $user.name.(string)`,
+			expect:     eventfilter.PassFilter,
+		},
+		"Mixed syntaxes evaluation error": {
+			expression: `data.user.age / $user.missing.(int64) > 1`,
+			expect:     eventfilter.FailFilter,
+		},
+	}
+
+	event := cloudevents.NewEvent()
+	event.SetID("test-id")
+	event.SetType("test.type")
+	event.SetSource("test.source")
+	event.SetSubject("test")
+	event.SetExtension("tenant", "acme")
+	err := event.SetData(cloudevents.ApplicationJSON, []byte(payload))
+	require.NoError(t, err)
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cond, err := CompileExpression(tc.expression)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expect, cond.Filter(context.Background(), event))
+		})
+	}
+}
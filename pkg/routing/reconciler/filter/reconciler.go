@@ -19,6 +19,10 @@ package filter
 import (
 	"context"
 
+	corev1 "k8s.io/api/core/v1"
+
+	"knative.dev/pkg/apis"
+	"knative.dev/pkg/controller"
 	"knative.dev/pkg/reconciler"
 
 	commonv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
@@ -43,5 +47,38 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, o *v1alpha1.Filter) reco
 	// inject component instance into context for usage in reconciliation logic
 	ctx = commonv1alpha1.WithReconcilable(ctx, o)
 
+	if err := r.resolveRouteSinks(ctx, o); err != nil {
+		return err
+	}
+
 	return r.base.ReconcileAdapter(ctx, r)
 }
+
+// resolveRouteSinks resolves the URLs of the sinks referenced in the Filter's
+// routes and propagates them to its status.
+func (r *Reconciler) resolveRouteSinks(ctx context.Context, o *v1alpha1.Filter) error {
+	o.Status.RouteSinkURIs = nil
+
+	if len(o.Spec.Routes) == 0 {
+		return nil
+	}
+
+	uris := make([]*apis.URL, 0, len(o.Spec.Routes))
+	for i, route := range o.Spec.Routes {
+		sink := *route.Sink.DeepCopy()
+		if sinkRef := sink.Ref; sinkRef != nil && sinkRef.Namespace == "" {
+			sinkRef.Namespace = o.Namespace
+		}
+
+		uri, err := r.base.SinkResolver.URIFromDestinationV1(ctx, sink, o)
+		if err != nil {
+			o.GetStatusManager().MarkNoSink()
+			return controller.NewPermanentError(reconciler.NewEvent(corev1.EventTypeWarning,
+				common.ReasonBadSinkURI, "Could not resolve sink URI of route %d: %s", i, err))
+		}
+		uris = append(uris, uri)
+	}
+	o.Status.RouteSinkURIs = uris
+
+	return nil
+}
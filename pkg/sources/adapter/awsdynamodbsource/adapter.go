@@ -41,6 +41,7 @@ import (
 	"github.com/triggermesh/triggermesh/pkg/apis/sources"
 	"github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
 	"github.com/triggermesh/triggermesh/pkg/sources/adapter/common"
+	"github.com/triggermesh/triggermesh/pkg/sources/adapter/common/checkpoint"
 	"github.com/triggermesh/triggermesh/pkg/sources/adapter/common/health"
 )
 
@@ -63,6 +64,15 @@ type envConfig struct {
 
 	ARN string `envconfig:"ARN" required:"true"`
 
+	// Position in a shard from which records are read when no checkpoint
+	// was previously recorded for that shard.
+	InitialPosition string `envconfig:"DYNAMODB_INITIAL_POSITION" default:"LATEST"`
+
+	// Backend used to persist checkpoints.
+	CheckpointBackend string `envconfig:"DYNAMODB_CHECKPOINT_BACKEND" default:"kubernetes"`
+	// Component instance which owns the persisted checkpoints.
+	CheckpointOwner checkpoint.Owner `envconfig:"CHECKPOINT_OWNER"`
+
 	// The environment variables below aren't read from the envConfig struct
 	// by the AWS SDK, but rather directly using os.Getenv().
 	// They are nevertheless listed here for documentation purposes.
@@ -81,12 +91,25 @@ type adapter struct {
 
 	arn arn.ARN
 
+	checkpoints     checkpoint.Store
+	initialPosition v1alpha1.AWSDynamoDBInitialPosition
+
 	// tracker for running records processors
 	processors sync.Map
 	wg         sync.WaitGroup
 
+	// tracker for shards which were sealed and fully processed
+	sealedShards sync.Map
+
 	lastStreamARN    *string
 	lastStreamStatus *string
+
+	// whether the stream's shards were listed at least once since the
+	// adapter started
+	scannedOnce bool
+	// shards returned by the last listing of the stream, and whether each
+	// of them was created after the adapter started
+	listedShards map[string]bool
 }
 
 // NewEnvConfig satisfies pkgadapter.EnvConfigConstructor.
@@ -108,6 +131,22 @@ func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClie
 
 	arn := common.MustParseARN(env.ARN)
 
+	initialPosition := v1alpha1.AWSDynamoDBInitialPosition(env.InitialPosition)
+	switch initialPosition {
+	case v1alpha1.AWSDynamoDBInitialPositionTrimHorizon,
+		v1alpha1.AWSDynamoDBInitialPositionLatest:
+	default:
+		logger.Panicf("Unsupported initial position %q", initialPosition)
+	}
+
+	// This adapter doesn't support multiple replicas, so leases are
+	// irrelevant.
+	checkpoints, _, err := checkpoint.New(env.CheckpointBackend, envAcc.GetNamespace(),
+		"awsdynamodbsource-"+envAcc.GetName(), checkpoint.Holder(), 0, env.CheckpointOwner.References()...)
+	if err != nil {
+		logger.Panicw("Unable to initialize checkpoint backend", zap.Error(err))
+	}
+
 	cfg := session.Must(session.NewSession(aws.NewConfig().
 		WithRegion(arn.Region),
 	))
@@ -121,6 +160,9 @@ func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClie
 		ceClient:       ceClient,
 
 		arn: arn,
+
+		checkpoints:     checkpoints,
+		initialPosition: initialPosition,
 	}
 }
 
@@ -204,6 +246,8 @@ func (a *adapter) recheckStream(ctx context.Context, streamARN *string) error {
 
 	var lastEvaluatedShardID *string
 
+	var shards []*dynamodbstreams.Shard
+
 	for {
 		stream, err := a.dyndbStrClient.DescribeStreamWithContext(ctx, &dynamodbstreams.DescribeStreamInput{
 			StreamArn:             streamARN,
@@ -229,9 +273,7 @@ func (a *adapter) recheckStream(ctx context.Context, streamARN *string) error {
 			return nil
 		}
 
-		for _, s := range stream.StreamDescription.Shards {
-			a.ensureRecordsProcessor(ctx, streamARN, s.ShardId)
-		}
+		shards = append(shards, stream.StreamDescription.Shards...)
 
 		lastEvaluatedShardID = stream.StreamDescription.LastEvaluatedShardId
 
@@ -242,11 +284,83 @@ func (a *adapter) recheckStream(ctx context.Context, streamARN *string) error {
 		}
 	}
 
+	listedShards := make(map[string]bool, len(shards))
+
+	for _, s := range shards {
+		// A shard is only considered new when it is listed for the
+		// first time, so that records processors which are restarted
+		// don't read pre-existing shards from their oldest record.
+		isNew, isListed := a.listedShards[*s.ShardId]
+		if !isListed {
+			isNew = a.scannedOnce
+		}
+		listedShards[*s.ShardId] = isNew
+	}
+
+	for _, s := range shards {
+		var parentListed bool
+		if s.ParentShardId != nil {
+			_, parentListed = listedShards[*s.ParentShardId]
+		}
+
+		a.ensureRecordsProcessor(ctx, streamARN, s, listedShards[*s.ShardId], parentListed)
+	}
+
+	a.pruneExpiredShards(listedShards)
+
+	a.listedShards = listedShards
+	a.scannedOnce = true
+
 	return nil
 }
 
-// ensureRecordsProcessor ensures a records processor is running for the given shard.
-func (a *adapter) ensureRecordsProcessor(ctx context.Context, streamARN *string, shardID *string) {
+// pruneExpiredShards stops tracking the shards which are not listed in the
+// stream anymore because they exceeded the retention period of the stream,
+// and deletes their checkpoints.
+//
+// Shards which are still being processed are retained in the given listing,
+// so that their checkpoint is deleted by a later check of the stream.
+func (a *adapter) pruneExpiredShards(listedShards map[string]bool) {
+	expired := make(map[string]bool)
+
+	for shardID, isNew := range a.listedShards {
+		if _, isListed := listedShards[shardID]; !isListed {
+			expired[shardID] = isNew
+		}
+	}
+
+	a.sealedShards.Range(func(shardID, _ interface{}) bool {
+		if _, isListed := listedShards[shardID.(string)]; !isListed {
+			a.sealedShards.Delete(shardID)
+			expired[shardID.(string)] = false
+		}
+		return true
+	})
+
+	for shardID, isNew := range expired {
+		if _, running := a.processors.Load(shardID); running {
+			listedShards[shardID] = isNew
+			continue
+		}
+
+		a.logger.Debug("Deleting checkpoint of expired shard ID ", shardID)
+		a.deleteCheckpoint(shardID)
+	}
+}
+
+// ensureRecordsProcessor ensures a records processor is running for the given
+// shard, unless that shard was sealed and fully processed. isNew indicates
+// whether the shard was created after the adapter started, and parentListed
+// whether the parent of the shard is still listed in the stream.
+func (a *adapter) ensureRecordsProcessor(ctx context.Context, streamARN *string,
+	shard *dynamodbstreams.Shard, isNew, parentListed bool) {
+
+	shardID := shard.ShardId
+
+	if _, sealed := a.sealedShards.Load(*shardID); sealed {
+		return
+	}
+
 	if _, running := a.processors.LoadOrStore(*shardID, struct{}{}); running {
 		a.logger.Debug("Record processor already running for shard ID ", *shardID)
 		return
@@ -260,7 +374,7 @@ func (a *adapter) ensureRecordsProcessor(ctx context.Context, streamARN *string,
 
 		a.logger.Debug("Starting records processor for shard ID ", *shardID)
 
-		if err := a.runRecordsProcessor(ctx, streamARN, shardID); err != nil {
+		if err := a.runRecordsProcessor(ctx, streamARN, shard, isNew, parentListed); err != nil {
 			a.logger.Errorw("Records processor for shard ID "+*shardID+" returned with error", zap.Error(err))
 			return
 		}
@@ -270,12 +384,40 @@ func (a *adapter) ensureRecordsProcessor(ctx context.Context, streamARN *string,
 }
 
 // runRecordsProcessor runs a records processor for the given shard.
-func (a *adapter) runRecordsProcessor(ctx context.Context, streamARN *string, shardID *string) error {
-	si, err := a.dyndbStrClient.GetShardIteratorWithContext(ctx, &dynamodbstreams.GetShardIteratorInput{
-		StreamArn:         streamARN,
-		ShardId:           shardID,
-		ShardIteratorType: aws.String(dynamodbstreams.ShardIteratorTypeLatest),
-	})
+//
+// Records are processed from the last checkpoint recorded for the shard, if
+// any. A record which can't be sent is retried until it is acknowledged by the
+// sink, so that the checkpoint never moves past a record which wasn't sent.
+//
+// The processing of a shard which parent is still being processed is
+// postponed, so that the records of a given item are sent in order. The
+// records processor returns immediately in that case, and is started again by
+// a later check of the stream.
+func (a *adapter) runRecordsProcessor(ctx context.Context, streamARN *string,
+	shard *dynamodbstreams.Shard, isNew, parentListed bool) error {
+
+	shardID := shard.ShardId
+
+	lastSeqNum, err := a.checkpoints.Get(ctx, *shardID)
+	if err != nil {
+		return fmt.Errorf("reading checkpoint of shard ID %s: %w", *shardID, err)
+	}
+
+	if lastSeqNum == checkpointShardEnd {
+		a.sealedShards.Store(*shardID, struct{}{})
+		return nil
+	}
+
+	iterIn, err := a.shardIteratorInput(ctx, streamARN, shard, lastSeqNum, isNew, parentListed)
+	if err != nil {
+		return err
+	}
+	if iterIn == nil {
+		a.logger.Debug("Postponing processing of shard ID ", *shardID, " until its parent shard is processed")
+		return nil
+	}
+
+	si, err := a.dyndbStrClient.GetShardIteratorWithContext(ctx, iterIn)
 	if err != nil {
 		return fmt.Errorf("getting shard iterator for shard ID %s: %w", *shardID, err)
 	}
@@ -315,11 +457,17 @@ loop:
 			for _, r := range r.Records {
 				a.logger.Debug("Processing record ID: " + *r.EventID)
 
-				if err := a.sendDynamoDBEvent(ctx, r); err != nil {
-					return fmt.Errorf("sending CloudEvent: %w", err)
+				if err := a.sendDynamoDBEventWithRetry(ctx, r); err != nil {
+					// interrupted, record the progress made so far
+					a.saveCheckpoint(*shardID, lastSeqNum)
+					return nil
 				}
+
+				lastSeqNum = *r.Dynamodb.SequenceNumber
 			}
 
+			a.saveCheckpoint(*shardID, lastSeqNum)
+
 			currentShardIter = r.NextShardIterator
 
 			// ShardIterator only becomes nil when the shard is
@@ -327,6 +475,8 @@ loop:
 			// average every 4 hours.
 			if currentShardIter == nil {
 				a.logger.Debug("Shard ID ", *shardID, " got sealed")
+				a.saveCheckpoint(*shardID, checkpointShardEnd)
+				a.sealedShards.Store(*shardID, struct{}{})
 				break loop
 			}
 
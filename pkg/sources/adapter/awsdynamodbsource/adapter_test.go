@@ -35,6 +35,9 @@ import (
 
 	adaptertest "knative.dev/eventing/pkg/adapter/v2/test"
 	loggingtesting "knative.dev/pkg/logging/testing"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/sources/adapter/common/checkpoint"
 )
 
 const (
@@ -60,12 +63,16 @@ func TestAdapter(t *testing.T) {
 		shards: makeMockShards(numShards, itersPerShard),
 	}
 
+	checkpoints := checkpoint.NewMemoryStore()
+
 	a := adapter{
-		logger:         loggingtesting.TestLogger(t),
-		dyndbClient:    &standardMockDynamoDBClient{},
-		dyndbStrClient: strClient,
-		arn:            makeARN(tTableArnResource),
-		ceClient:       ceClient,
+		logger:          loggingtesting.TestLogger(t),
+		dyndbClient:     &standardMockDynamoDBClient{},
+		dyndbStrClient:  strClient,
+		arn:             makeARN(tTableArnResource),
+		ceClient:        ceClient,
+		checkpoints:     checkpoints,
+		initialPosition: v1alpha1.AWSDynamoDBInitialPositionLatest,
 	}
 
 	testCtx, testCancel := context.WithTimeout(context.Background(), testTimeout)
@@ -118,6 +125,16 @@ poll:
 	assert.Equal(t, "arn:aws:dynamodb:us-fake-0:123456789012:table/MyTable", ev.Source())
 	assert.Contains(t, []string{"id,name", "name,id"}, ev.Subject())
 	assert.Contains(t, validDynamoDBOperations, ev.Extensions()[ceExtDynamoDBOperation])
+
+	// the checkpoint of each shard is the sequence number of the last
+	// record processed in that shard
+	for i := 0; i < numShards; i++ {
+		shardID := fmt.Sprintf(tShardIDPrefix+"%03d", i+1)
+
+		cp, err := checkpoints.Get(context.Background(), shardID)
+		assert.NoError(t, err)
+		assert.Equal(t, mockSequenceNumber(i+1, itersPerShard, 3), cp)
+	}
 }
 
 // Enumerates valid DynamoDB operations / event names.
@@ -278,19 +295,28 @@ func makeMockRecords(shardIdx, iteratorIdx int) []*dynamodbstreams.Record {
 		EventID:   aws.String(fmt.Sprintf("shard%03d-iterator%03d-001", shardIdx, iteratorIdx)),
 		EventName: aws.String(dynamodbstreams.OperationTypeInsert),
 		Dynamodb: &dynamodbstreams.StreamRecord{
-			Keys: map[string]*dynamodb.AttributeValue{"id": nil, "name": nil},
+			Keys:           map[string]*dynamodb.AttributeValue{"id": nil, "name": nil},
+			SequenceNumber: aws.String(mockSequenceNumber(shardIdx, iteratorIdx, 1)),
 		},
 	}, {
 		EventID:   aws.String(fmt.Sprintf("shard%03d-iterator%03d-002", shardIdx, iteratorIdx)),
 		EventName: aws.String(dynamodbstreams.OperationTypeModify),
 		Dynamodb: &dynamodbstreams.StreamRecord{
-			Keys: map[string]*dynamodb.AttributeValue{"id": nil, "name": nil},
+			Keys:           map[string]*dynamodb.AttributeValue{"id": nil, "name": nil},
+			SequenceNumber: aws.String(mockSequenceNumber(shardIdx, iteratorIdx, 2)),
 		},
 	}, {
 		EventID:   aws.String(fmt.Sprintf("shard%03d-iterator%03d-003", shardIdx, iteratorIdx)),
 		EventName: aws.String(dynamodbstreams.OperationTypeRemove),
 		Dynamodb: &dynamodbstreams.StreamRecord{
-			Keys: map[string]*dynamodb.AttributeValue{"id": nil, "name": nil},
+			Keys:           map[string]*dynamodb.AttributeValue{"id": nil, "name": nil},
+			SequenceNumber: aws.String(mockSequenceNumber(shardIdx, iteratorIdx, 3)),
 		},
 	}}
 }
+
+// mockSequenceNumber returns the sequence number of a mocked StreamRecord
+// given its shard, iterator and record indexes.
+func mockSequenceNumber(shardIdx, iteratorIdx, recordIdx int) string {
+	return fmt.Sprintf("%03d%03d%03d", shardIdx, iteratorIdx, recordIdx)
+}
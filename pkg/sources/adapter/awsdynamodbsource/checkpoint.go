@@ -0,0 +1,149 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsdynamodbsource
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodbstreams"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/sources/adapter/common"
+)
+
+const (
+	// Checkpoint recorded for shards which were sealed and fully processed.
+	checkpointShardEnd = "SHARD_END"
+
+	// Maximum duration of the write of a checkpoint. Checkpoints are
+	// written independently of the lifecycle of the records processor,
+	// so that the progress made until its interruption is preserved.
+	checkpointWriteTimeout = 5 * time.Second
+)
+
+// shardIteratorInput returns the input of a GetShardIterator request for the
+// given shard, which starts right after the given checkpoint if one exists.
+//
+// In the absence of a checkpoint, shards which were created after the adapter
+// started, or which parent was already processed, are read from their oldest
+// record so that no record is skipped. Other shards are read from the
+// configured initial position.
+//
+// Shards which are read from their oldest record must not be read before
+// their parent was fully processed, otherwise the records of a given item
+// could be sent out of order. A nil input is returned while the parent of
+// such shard is still listed in the stream (parentListed) and its processing
+// isn't complete.
+func (a *adapter) shardIteratorInput(ctx context.Context, streamARN *string, shard *dynamodbstreams.Shard,
+	lastSeqNum string, isNew, parentListed bool) (*dynamodbstreams.GetShardIteratorInput, error) {
+
+	in := &dynamodbstreams.GetShardIteratorInput{
+		StreamArn: streamARN,
+		ShardId:   shard.ShardId,
+	}
+
+	if lastSeqNum != "" {
+		in.ShardIteratorType = aws.String(dynamodbstreams.ShardIteratorTypeAfterSequenceNumber)
+		in.SequenceNumber = aws.String(lastSeqNum)
+		return in, nil
+	}
+
+	fromOldest := isNew || a.initialPosition == v1alpha1.AWSDynamoDBInitialPositionTrimHorizon
+
+	if parentID := shard.ParentShardId; parentID != nil {
+		parentSeqNum, err := a.checkpoints.Get(ctx, *parentID)
+		if err != nil {
+			return nil, fmt.Errorf("reading checkpoint of parent shard ID %s: %w", *parentID, err)
+		}
+
+		// The parent was (at least partially) processed, the shard
+		// must be read from its oldest record.
+		if parentSeqNum != "" {
+			fromOldest = true
+		}
+
+		if fromOldest && parentListed && parentSeqNum != checkpointShardEnd {
+			return nil, nil
+		}
+	}
+
+	switch {
+	case fromOldest:
+		in.ShardIteratorType = aws.String(dynamodbstreams.ShardIteratorTypeTrimHorizon)
+	default:
+		in.ShardIteratorType = aws.String(string(a.initialPosition))
+	}
+
+	return in, nil
+}
+
+// saveCheckpoint records the given checkpoint for the given shard. Failures
+// are logged but otherwise ignored, since records are simply processed again
+// from an older checkpoint after a restart of the adapter.
+func (a *adapter) saveCheckpoint(shardID, cp string) {
+	if cp == "" {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), checkpointWriteTimeout)
+	defer cancel()
+
+	if err := a.checkpoints.Set(ctx, shardID, cp); err != nil {
+		a.logger.Errorw("Failed to record checkpoint of shard ID "+shardID, zap.Error(err))
+	}
+}
+
+// deleteCheckpoint deletes the checkpoint of the given shard. Failures are
+// logged but otherwise ignored.
+func (a *adapter) deleteCheckpoint(shardID string) {
+	ctx, cancel := context.WithTimeout(context.Background(), checkpointWriteTimeout)
+	defer cancel()
+
+	if err := a.checkpoints.Delete(ctx, shardID); err != nil {
+		a.logger.Errorw("Failed to delete checkpoint of shard ID "+shardID, zap.Error(err))
+	}
+}
+
+// sendDynamoDBEventWithRetry sends the given Record as a CloudEvent, and
+// retries with an exponential backoff until it is acknowledged. It returns an
+// error only if the context is cancelled before that happens.
+func (a *adapter) sendDynamoDBEventWithRetry(ctx context.Context, r *dynamodbstreams.Record) error {
+	backoff := common.NewBackoff()
+
+	for {
+		err := a.sendDynamoDBEvent(ctx, r)
+		if err == nil {
+			return nil
+		}
+
+		delay := backoff.Duration()
+
+		a.logger.Errorw("Failed to send CloudEvent for record ID "+*r.EventID+", retrying in "+delay.String(),
+			zap.Error(err))
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+}
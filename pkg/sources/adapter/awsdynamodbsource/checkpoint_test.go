@@ -0,0 +1,477 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsdynamodbsource
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/dynamodbstreams"
+	"github.com/aws/aws-sdk-go/service/dynamodbstreams/dynamodbstreamsiface"
+
+	adaptertest "knative.dev/eventing/pkg/adapter/v2/test"
+	loggingtesting "knative.dev/pkg/logging/testing"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/sources/adapter/common/checkpoint"
+)
+
+func TestShardIteratorInput(t *testing.T) {
+	const shardID = "shard-2"
+	const parentShardID = "shard-1"
+
+	testCases := map[string]struct {
+		initialPosition v1alpha1.AWSDynamoDBInitialPosition
+		lastSeqNum      string
+		isNew           bool
+		parentSeqNum    string
+		parentListed    bool
+		expectType      string // empty if the shard must not be read yet
+		expectSeqNum    *string
+	}{
+		"Resume from checkpoint": {
+			initialPosition: v1alpha1.AWSDynamoDBInitialPositionLatest,
+			lastSeqNum:      "0001",
+			isNew:           true,
+			expectType:      dynamodbstreams.ShardIteratorTypeAfterSequenceNumber,
+			expectSeqNum:    aws.String("0001"),
+		},
+		"Initial position": {
+			initialPosition: v1alpha1.AWSDynamoDBInitialPositionLatest,
+			expectType:      dynamodbstreams.ShardIteratorTypeLatest,
+		},
+		"Initial position is oldest record": {
+			initialPosition: v1alpha1.AWSDynamoDBInitialPositionTrimHorizon,
+			expectType:      dynamodbstreams.ShardIteratorTypeTrimHorizon,
+		},
+		"Shard created after start": {
+			initialPosition: v1alpha1.AWSDynamoDBInitialPositionLatest,
+			isNew:           true,
+			expectType:      dynamodbstreams.ShardIteratorTypeTrimHorizon,
+		},
+		"Parent shard was processed": {
+			initialPosition: v1alpha1.AWSDynamoDBInitialPositionLatest,
+			parentSeqNum:    checkpointShardEnd,
+			parentListed:    true,
+			expectType:      dynamodbstreams.ShardIteratorTypeTrimHorizon,
+		},
+		"Parent shard is partially processed": {
+			initialPosition: v1alpha1.AWSDynamoDBInitialPositionLatest,
+			parentSeqNum:    "0001",
+			parentListed:    true,
+		},
+		"Parent shard is not processed yet": {
+			initialPosition: v1alpha1.AWSDynamoDBInitialPositionTrimHorizon,
+			parentListed:    true,
+		},
+		"Shard created after start while parent is processed": {
+			initialPosition: v1alpha1.AWSDynamoDBInitialPositionLatest,
+			isNew:           true,
+			parentListed:    true,
+		},
+		"Parent shard is not processed yet, initial position is latest record": {
+			initialPosition: v1alpha1.AWSDynamoDBInitialPositionLatest,
+			parentListed:    true,
+			expectType:      dynamodbstreams.ShardIteratorTypeLatest,
+		},
+		"Partially processed parent shard expired": {
+			initialPosition: v1alpha1.AWSDynamoDBInitialPositionLatest,
+			parentSeqNum:    "0001",
+			expectType:      dynamodbstreams.ShardIteratorTypeTrimHorizon,
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+
+			checkpoints := checkpoint.NewMemoryStore()
+			if tc.parentSeqNum != "" {
+				require.NoError(t, checkpoints.Set(ctx, parentShardID, tc.parentSeqNum))
+			}
+
+			a := &adapter{
+				checkpoints:     checkpoints,
+				initialPosition: tc.initialPosition,
+			}
+
+			shard := &dynamodbstreams.Shard{
+				ShardId:       aws.String(shardID),
+				ParentShardId: aws.String(parentShardID),
+			}
+
+			in, err := a.shardIteratorInput(ctx, aws.String("arn"), shard, tc.lastSeqNum, tc.isNew, tc.parentListed)
+			require.NoError(t, err)
+
+			if tc.expectType == "" {
+				assert.Nil(t, in, "Shard should not be read before its parent")
+				return
+			}
+
+			require.NotNil(t, in)
+			assert.Equal(t, tc.expectType, *in.ShardIteratorType)
+			assert.Equal(t, tc.expectSeqNum, in.SequenceNumber)
+		})
+	}
+}
+
+func TestRecordsProcessorSealedShard(t *testing.T) {
+	const shardID = "shard-1"
+
+	ceClient := newFlakyCEClient(1)
+	checkpoints := checkpoint.NewMemoryStore()
+
+	a := &adapter{
+		logger:   loggingtesting.TestLogger(t),
+		arn:      makeARN(tTableArnResource),
+		ceClient: ceClient,
+		dyndbStrClient: &sealedShardMockDynamoDBStreamsClient{
+			records: makeMockRecords(1, 1),
+		},
+		checkpoints:     checkpoints,
+		initialPosition: v1alpha1.AWSDynamoDBInitialPositionLatest,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	shard := &dynamodbstreams.Shard{
+		ShardId: aws.String(shardID),
+	}
+
+	err := a.runRecordsProcessor(ctx, aws.String("arn"), shard, false, false)
+	require.NoError(t, err)
+	require.NoError(t, ctx.Err(), "Records processor didn't return after the shard got sealed")
+
+	// the failed send was retried instead of being skipped
+	assert.Len(t, ceClient.Sent(), 3)
+	assert.Equal(t, 4, ceClient.attempts)
+
+	cp, err := checkpoints.Get(ctx, shardID)
+	require.NoError(t, err)
+	assert.Equal(t, checkpointShardEnd, cp)
+
+	_, sealed := a.sealedShards.Load(shardID)
+	assert.True(t, sealed, "Shard should be marked as sealed")
+
+	// a sealed shard is never processed again
+	a.dyndbStrClient = nil // would panic if used
+	err = a.runRecordsProcessor(ctx, aws.String("arn"), shard, false, false)
+	assert.NoError(t, err)
+}
+
+func TestRecheckStreamShardPositions(t *testing.T) {
+	cli := &shardListingMockDynamoDBStreamsClient{
+		shards: []string{"shard-1"},
+	}
+
+	a := &adapter{
+		logger:          loggingtesting.TestLogger(t),
+		arn:             makeARN(tTableArnResource),
+		ceClient:        adaptertest.NewTestClient(),
+		dyndbStrClient:  cli,
+		checkpoints:     checkpoint.NewMemoryStore(),
+		initialPosition: v1alpha1.AWSDynamoDBInitialPositionLatest,
+	}
+
+	ctx := context.Background()
+
+	// records processors return immediately, and are restarted by each
+	// subsequent check of the stream
+	require.NoError(t, a.recheckStream(ctx, aws.String("arn")))
+	a.wg.Wait()
+
+	cli.setShards("shard-1", "shard-2")
+
+	require.NoError(t, a.recheckStream(ctx, aws.String("arn")))
+	a.wg.Wait()
+	require.NoError(t, a.recheckStream(ctx, aws.String("arn")))
+	a.wg.Wait()
+
+	expect := map[string][]string{
+		"shard-1": {
+			dynamodbstreams.ShardIteratorTypeLatest,
+			dynamodbstreams.ShardIteratorTypeLatest,
+			dynamodbstreams.ShardIteratorTypeLatest,
+		},
+		"shard-2": {
+			dynamodbstreams.ShardIteratorTypeTrimHorizon,
+			dynamodbstreams.ShardIteratorTypeTrimHorizon,
+		},
+	}
+	assert.Equal(t, expect, cli.iteratorTypes())
+}
+
+func TestRecheckStreamPruneSealedShards(t *testing.T) {
+	cli := &shardListingMockDynamoDBStreamsClient{
+		shards: []string{"shard-2"},
+	}
+
+	a := &adapter{
+		logger:          loggingtesting.TestLogger(t),
+		arn:             makeARN(tTableArnResource),
+		ceClient:        adaptertest.NewTestClient(),
+		dyndbStrClient:  cli,
+		checkpoints:     checkpoint.NewMemoryStore(),
+		initialPosition: v1alpha1.AWSDynamoDBInitialPositionLatest,
+	}
+
+	// shard-1 exceeded the retention period of the stream
+	a.sealedShards.Store("shard-1", struct{}{})
+	a.sealedShards.Store("shard-2", struct{}{})
+
+	require.NoError(t, a.recheckStream(context.Background(), aws.String("arn")))
+	a.wg.Wait()
+
+	_, sealed := a.sealedShards.Load("shard-1")
+	assert.False(t, sealed, "Unlisted shard should not be tracked anymore")
+	_, sealed = a.sealedShards.Load("shard-2")
+	assert.True(t, sealed, "Listed shard should still be marked as sealed")
+
+	assert.Empty(t, cli.iteratorTypes(), "Sealed shard should not be processed")
+}
+
+func TestRecheckStreamPartiallyProcessedParent(t *testing.T) {
+	cli := &shardListingMockDynamoDBStreamsClient{
+		shards: []string{"shard-1", "shard-2"},
+		parents: map[string]string{
+			"shard-2": "shard-1",
+		},
+	}
+
+	checkpoints := checkpoint.NewMemoryStore()
+
+	a := &adapter{
+		logger:          loggingtesting.TestLogger(t),
+		arn:             makeARN(tTableArnResource),
+		ceClient:        adaptertest.NewTestClient(),
+		dyndbStrClient:  cli,
+		checkpoints:     checkpoints,
+		initialPosition: v1alpha1.AWSDynamoDBInitialPositionLatest,
+	}
+
+	ctx := context.Background()
+
+	// the adapter restarts while the parent shard is partially processed
+	require.NoError(t, checkpoints.Set(ctx, "shard-1", "0001"))
+
+	require.NoError(t, a.recheckStream(ctx, aws.String("arn")))
+	a.wg.Wait()
+
+	expect := map[string][]string{
+		"shard-1": {dynamodbstreams.ShardIteratorTypeAfterSequenceNumber},
+	}
+	assert.Equal(t, expect, cli.iteratorTypes(), "Child shard should not be read before its parent")
+
+	// the parent shard gets fully processed
+	require.NoError(t, checkpoints.Set(ctx, "shard-1", checkpointShardEnd))
+
+	require.NoError(t, a.recheckStream(ctx, aws.String("arn")))
+	a.wg.Wait()
+
+	expect = map[string][]string{
+		"shard-1": {dynamodbstreams.ShardIteratorTypeAfterSequenceNumber},
+		"shard-2": {dynamodbstreams.ShardIteratorTypeTrimHorizon},
+	}
+	assert.Equal(t, expect, cli.iteratorTypes())
+}
+
+func TestRecheckStreamPruneCheckpoints(t *testing.T) {
+	cli := &shardListingMockDynamoDBStreamsClient{
+		shards: []string{"shard-1", "shard-2"},
+	}
+
+	checkpoints := checkpoint.NewMemoryStore()
+
+	a := &adapter{
+		logger:          loggingtesting.TestLogger(t),
+		arn:             makeARN(tTableArnResource),
+		ceClient:        adaptertest.NewTestClient(),
+		dyndbStrClient:  cli,
+		checkpoints:     checkpoints,
+		initialPosition: v1alpha1.AWSDynamoDBInitialPositionLatest,
+	}
+
+	ctx := context.Background()
+
+	require.NoError(t, checkpoints.Set(ctx, "shard-1", checkpointShardEnd))
+	require.NoError(t, checkpoints.Set(ctx, "shard-2", "0001"))
+
+	require.NoError(t, a.recheckStream(ctx, aws.String("arn")))
+	a.wg.Wait()
+
+	// shard-1 exceeded the retention period of the stream
+	cli.setShards("shard-2")
+
+	require.NoError(t, a.recheckStream(ctx, aws.String("arn")))
+	a.wg.Wait()
+
+	cp, err := checkpoints.Get(ctx, "shard-1")
+	require.NoError(t, err)
+	assert.Empty(t, cp, "Checkpoint of unlisted shard should be deleted")
+
+	cp, err = checkpoints.Get(ctx, "shard-2")
+	require.NoError(t, err)
+	assert.Equal(t, "0001", cp, "Checkpoint of listed shard should be retained")
+
+	_, sealed := a.sealedShards.Load("shard-1")
+	assert.False(t, sealed, "Unlisted shard should not be tracked anymore")
+}
+
+// shardListingMockDynamoDBStreamsClient is a mocked DynamoDBStreams client
+// which lists the given shards, and records the types of the shard iterators
+// requested for each of them. Reading records always fails.
+type shardListingMockDynamoDBStreamsClient struct {
+	dynamodbstreamsiface.DynamoDBStreamsAPI
+
+	mu        sync.Mutex
+	shards    []string
+	parents   map[string]string // indexed by shard ID
+	iterTypes map[string][]string
+}
+
+func (c *shardListingMockDynamoDBStreamsClient) setShards(shardIDs ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.shards = shardIDs
+}
+
+func (c *shardListingMockDynamoDBStreamsClient) iteratorTypes() map[string][]string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.iterTypes
+}
+
+func (c *shardListingMockDynamoDBStreamsClient) DescribeStreamWithContext(context.Context,
+	*dynamodbstreams.DescribeStreamInput, ...request.Option) (*dynamodbstreams.DescribeStreamOutput, error) {
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	shards := make([]*dynamodbstreams.Shard, 0, len(c.shards))
+	for _, id := range c.shards {
+		s := &dynamodbstreams.Shard{
+			ShardId: aws.String(id),
+		}
+		if parentID, hasParent := c.parents[id]; hasParent {
+			s.ParentShardId = aws.String(parentID)
+		}
+		shards = append(shards, s)
+	}
+
+	return &dynamodbstreams.DescribeStreamOutput{
+		StreamDescription: &dynamodbstreams.StreamDescription{
+			StreamStatus: aws.String(dynamodbstreams.StreamStatusEnabled),
+			Shards:       shards,
+		},
+	}, nil
+}
+
+func (c *shardListingMockDynamoDBStreamsClient) GetShardIteratorWithContext(_ context.Context,
+	in *dynamodbstreams.GetShardIteratorInput, _ ...request.Option) (*dynamodbstreams.GetShardIteratorOutput, error) {
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.iterTypes == nil {
+		c.iterTypes = make(map[string][]string)
+	}
+	c.iterTypes[*in.ShardId] = append(c.iterTypes[*in.ShardId], *in.ShardIteratorType)
+
+	return &dynamodbstreams.GetShardIteratorOutput{
+		ShardIterator: aws.String("iterator"),
+	}, nil
+}
+
+func (c *shardListingMockDynamoDBStreamsClient) GetRecordsWithContext(context.Context,
+	*dynamodbstreams.GetRecordsInput, ...request.Option) (*dynamodbstreams.GetRecordsOutput, error) {
+
+	return nil, errors.New("fake error")
+}
+
+// sealedShardMockDynamoDBStreamsClient is a mocked DynamoDBStreams client
+// which returns the given records, then signals that the shard got sealed.
+type sealedShardMockDynamoDBStreamsClient struct {
+	dynamodbstreamsiface.DynamoDBStreamsAPI
+
+	records []*dynamodbstreams.Record
+}
+
+func (c *sealedShardMockDynamoDBStreamsClient) GetShardIteratorWithContext(context.Context,
+	*dynamodbstreams.GetShardIteratorInput, ...request.Option) (*dynamodbstreams.GetShardIteratorOutput, error) {
+
+	return &dynamodbstreams.GetShardIteratorOutput{
+		ShardIterator: aws.String("iterator"),
+	}, nil
+}
+
+func (c *sealedShardMockDynamoDBStreamsClient) GetRecordsWithContext(context.Context,
+	*dynamodbstreams.GetRecordsInput, ...request.Option) (*dynamodbstreams.GetRecordsOutput, error) {
+
+	return &dynamodbstreams.GetRecordsOutput{
+		Records:           c.records,
+		NextShardIterator: nil,
+	}, nil
+}
+
+// flakyCEClient is a CloudEvents client which fails to send the given number
+// of events before succeeding.
+type flakyCEClient struct {
+	cloudevents.Client
+
+	mu       sync.Mutex
+	failures int
+	attempts int
+}
+
+func newFlakyCEClient(failures int) *flakyCEClient {
+	return &flakyCEClient{
+		Client:   adaptertest.NewTestClient(),
+		failures: failures,
+	}
+}
+
+func (c *flakyCEClient) Send(ctx context.Context, e cloudevents.Event) cloudevents.Result {
+	c.mu.Lock()
+	c.attempts++
+	fail := c.failures > 0
+	if fail {
+		c.failures--
+	}
+	c.mu.Unlock()
+
+	if fail {
+		return errors.New("fake error")
+	}
+
+	return c.Client.Send(ctx, e)
+}
+
+func (c *flakyCEClient) Sent() []cloudevents.Event {
+	return c.Client.(*adaptertest.TestCloudEventsClient).Sent()
+}
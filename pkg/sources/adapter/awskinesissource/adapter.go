@@ -20,13 +20,13 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sync"
+	"time"
 
 	"go.uber.org/zap"
 
 	cloudevents "github.com/cloudevents/sdk-go/v2"
 
-	utilerrors "k8s.io/apimachinery/pkg/util/errors"
-
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/session"
@@ -39,6 +39,7 @@ import (
 	"github.com/triggermesh/triggermesh/pkg/apis/sources"
 	"github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
 	"github.com/triggermesh/triggermesh/pkg/sources/adapter/common"
+	"github.com/triggermesh/triggermesh/pkg/sources/adapter/common/checkpoint"
 	"github.com/triggermesh/triggermesh/pkg/sources/adapter/common/health"
 )
 
@@ -49,6 +50,20 @@ type envConfig struct {
 
 	ARN string `envconfig:"ARN" required:"true"`
 
+	// Position in a shard from which records are read when no checkpoint
+	// was previously recorded for that shard.
+	InitialPosition string `envconfig:"KINESIS_INITIAL_POSITION" default:"LATEST"`
+	// Time from which records are read when the initial position is
+	// AT_TIMESTAMP.
+	InitialTimestamp time.Time `envconfig:"KINESIS_INITIAL_TIMESTAMP"`
+	// Interval at which the shards of the stream are listed.
+	ShardDiscoveryInterval time.Duration `envconfig:"KINESIS_SHARD_DISCOVERY_INTERVAL" default:"30s"`
+
+	// Backend used to persist checkpoints and leases.
+	CheckpointBackend string `envconfig:"KINESIS_CHECKPOINT_BACKEND" default:"kubernetes"`
+	// Component instance which owns the persisted checkpoints and leases.
+	CheckpointOwner checkpoint.Owner `envconfig:"CHECKPOINT_OWNER"`
+
 	// The environment variables below aren't read from the envConfig struct
 	// by the AWS SDK, but rather directly using os.Getenv().
 	// They are nevertheless listed here for documentation purposes.
@@ -64,8 +79,15 @@ type adapter struct {
 	knsClient kinesisiface.KinesisAPI
 	ceClient  cloudevents.Client
 
+	checkpoints checkpoint.Store
+	leaser      checkpoint.Leaser
+
 	arn    arn.ARN
 	stream string
+
+	initialPosition   v1alpha1.AWSKinesisInitialPosition
+	initialTimestamp  time.Time
+	discoveryInterval time.Duration
 }
 
 // NewEnvConfig satisfies pkgadapter.EnvConfigConstructor.
@@ -87,6 +109,28 @@ func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClie
 
 	arn := common.MustParseARN(env.ARN)
 
+	initialPosition := v1alpha1.AWSKinesisInitialPosition(env.InitialPosition)
+	switch initialPosition {
+	case v1alpha1.AWSKinesisInitialPositionTrimHorizon,
+		v1alpha1.AWSKinesisInitialPositionLatest:
+	case v1alpha1.AWSKinesisInitialPositionAtTimestamp:
+		if env.InitialTimestamp.IsZero() {
+			logger.Panicf("A timestamp is required with the initial position %s", initialPosition)
+		}
+	default:
+		logger.Panicf("Unsupported initial position %q", initialPosition)
+	}
+
+	// Leases are renewed at every shard discovery, so they must outlive a
+	// few discovery intervals to tolerate transient failures.
+	leaseDuration := env.ShardDiscoveryInterval * leaseDurationIntervals
+
+	checkpoints, leaser, err := checkpoint.New(env.CheckpointBackend, envAcc.GetNamespace(),
+		"awskinesissource-"+envAcc.GetName(), checkpoint.Holder(), leaseDuration, env.CheckpointOwner.References()...)
+	if err != nil {
+		logger.Panicw("Unable to initialize checkpoint backend", zap.Error(err))
+	}
+
 	cfg := session.Must(session.NewSession(aws.NewConfig().
 		WithRegion(arn.Region).
 		WithMaxRetries(5),
@@ -99,12 +143,25 @@ func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClie
 		knsClient: kinesis.New(cfg),
 		ceClient:  ceClient,
 
+		checkpoints: checkpoints,
+		leaser:      leaser,
+
 		arn:    arn,
 		stream: common.MustParseKinesisResource(arn.Resource),
+
+		initialPosition:   initialPosition,
+		initialTimestamp:  env.InitialTimestamp,
+		discoveryInterval: env.ShardDiscoveryInterval,
 	}
 }
 
 // Start implements adapter.Adapter.
+//
+// The adapter periodically lists the shards of the stream, and starts a
+// consumer for each shard it is able to acquire a lease for. The shards
+// created by resharding operations are consumed only after their parents
+// were fully consumed, so that records sharing a partition key are sent in
+// order.
 func (a *adapter) Start(ctx context.Context) error {
 	go health.Start(ctx)
 
@@ -117,94 +174,49 @@ func (a *adapter) Start(ctx context.Context) error {
 
 	health.MarkReady()
 
-	streamARN := myStream.StreamDescription.StreamARN
-
-	a.logger.Infof("Connected to Kinesis stream: %s", *streamARN)
+	a.logger.Infof("Connected to Kinesis stream: %s", *myStream.StreamDescription.StreamARN)
 
 	ctx = pkgadapter.ContextWithMetricTag(ctx, a.mt)
 
-	// Obtain records inputs for different shards
-	inputs := a.getRecordsInputs(myStream.StreamDescription.Shards)
+	consumers := make(map[string]*shardConsumer)
+	finished := make(chan *shardConsumer)
 
-	backoff := common.NewBackoff()
+	var wg sync.WaitGroup
 
-	err = backoff.Run(ctx.Done(), func(ctx context.Context) (bool, error) {
-		resetBackoff := false
-		records, err := a.processInputs(inputs)
-		if err != nil {
-			a.logger.Errorw("There were errors during inputs processing", zap.Error(err))
+	defer func() {
+		for _, c := range consumers {
+			c.cancel()
 		}
-
-		for _, record := range records {
-			resetBackoff = true
-			err = a.sendKinesisRecord(ctx, record)
-			if err != nil {
-				a.logger.Errorw("Failed to send cloudevent", zap.Error(err))
+		wg.Wait()
+
+		// Release the leases of interrupted consumers, so that other
+		// replicas can take over their shards without waiting for
+		// those leases to expire.
+		releaseCtx, cancel := context.WithTimeout(context.Background(), leaseReleaseTimeout)
+		defer cancel()
+		for shardID := range consumers {
+			if err := a.leaser.Release(releaseCtx, shardID); err != nil {
+				a.logger.Errorw("Failed to release lease of shard "+shardID, zap.Error(err))
 			}
 		}
-		return resetBackoff, nil
-	})
-
-	return err
-}
-
-func (a *adapter) getRecordsInputs(shards []*kinesis.Shard) []kinesis.GetRecordsInput {
-	inputs := []kinesis.GetRecordsInput{}
-
-	// Kinesis stream might have several shards and each of them had "LATEST" Iterator.
-	for _, shard := range shards {
-		// Obtain starting Shard Iterator. This is needed to not process already processed records
-		myShardIterator, err := a.knsClient.GetShardIterator(&kinesis.GetShardIteratorInput{
-			ShardId:           shard.ShardId,
-			ShardIteratorType: aws.String("LATEST"),
-			StreamName:        &a.stream,
-		})
-
-		if err != nil {
-			a.logger.Errorw("Failed to get shard iterator", zap.Error(err))
-			continue
-		}
-
-		// set records output limit. Should not be more than 10000, othervise panics
-		input := kinesis.GetRecordsInput{
-			ShardIterator: myShardIterator.ShardIterator,
-		}
+	}()
 
-		inputs = append(inputs, input)
-	}
+	t := time.NewTicker(a.discoveryInterval)
+	defer t.Stop()
 
-	return inputs
-}
+	for {
+		a.syncShardConsumers(ctx, consumers, finished, &wg)
 
-func (a *adapter) processInputs(inputs []kinesis.GetRecordsInput) ([]*kinesis.Record, error) {
-	var errs []error
-	records := []*kinesis.Record{}
+		select {
+		case <-ctx.Done():
+			return nil
 
-	for i, input := range inputs {
-		input := input
+		case c := <-finished:
+			a.handleFinishedConsumer(ctx, consumers, c)
 
-		recordsOutput, err := a.knsClient.GetRecords(&input)
-		if err != nil {
-			errs = append(errs, err)
-			continue
+		case <-t.C:
 		}
-
-		records = append(records, recordsOutput.Records...)
-
-		// remove old input
-		inputs = append(inputs[:i], inputs[i+1:]...)
-
-		// generate new input
-		input = kinesis.GetRecordsInput{
-			ShardIterator: recordsOutput.NextShardIterator,
-		}
-
-		// add newly generated input to the slice
-		// so that new iteration would begin with new sharIterator
-		inputs = append(inputs, input)
 	}
-
-	return records, utilerrors.NewAggregate(errs)
 }
 
 func (a *adapter) sendKinesisRecord(ctx context.Context, record *kinesis.Record) error {
@@ -225,6 +237,31 @@ func (a *adapter) sendKinesisRecord(ctx context.Context, record *kinesis.Record)
 	return nil
 }
 
+// sendKinesisRecordWithRetry sends the given Record as a CloudEvent, and
+// retries with an exponential backoff until it is acknowledged. It returns an
+// error only if the context is cancelled before that happens.
+func (a *adapter) sendKinesisRecordWithRetry(ctx context.Context, record *kinesis.Record) error {
+	backoff := common.NewBackoff()
+
+	for {
+		err := a.sendKinesisRecord(ctx, record)
+		if err == nil {
+			return nil
+		}
+
+		delay := backoff.Duration()
+
+		a.logger.Errorw("Failed to send CloudEvent for record "+*record.SequenceNumber+", retrying in "+delay.String(),
+			zap.Error(err))
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+}
+
 // toCloudEventData returns a Kinesis record in a shape that is suitable for
 // JSON serialization inside some CloudEvent data.
 func toCloudEventData(record *kinesis.Record) interface{} {
@@ -32,10 +32,13 @@ import (
 
 	adaptertest "knative.dev/eventing/pkg/adapter/v2/test"
 	loggingtesting "knative.dev/pkg/logging/testing"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/sources/adapter/common/checkpoint"
 )
 
 type mockedGetRecords struct {
-	kinesisiface.KinesisAPI
+	mockedGetShardIterator
 	Resp kinesis.GetRecordsOutput
 	err  error
 }
@@ -65,41 +68,67 @@ func TestProcessInputs(t *testing.T) {
 		},
 	}
 
+	ceClient := adaptertest.NewTestClient()
+	st := checkpoint.NewMemoryStore()
+
 	a := &adapter{
-		logger:   loggingtesting.TestLogger(t),
-		ceClient: adaptertest.NewTestClient(),
-		stream:   "arn:aws:kinesis:us-east-1:123456789012:stream/foo",
+		logger:      loggingtesting.TestLogger(t),
+		ceClient:    ceClient,
+		checkpoints: st,
+		stream:      "arn:aws:kinesis:us-east-1:123456789012:stream/foo",
+	}
+
+	iterator := mockedGetShardIterator{
+		Resp: kinesis.GetShardIteratorOutput{ShardIterator: aws.String("shardIterator")},
 	}
 
 	a.knsClient = mockedGetRecords{
+		mockedGetShardIterator: iterator,
 		Resp: kinesis.GetRecordsOutput{
-			NextShardIterator: aws.String("nextIterator"),
+			// end of closed shard
+			NextShardIterator: nil,
 			Records:           records,
 		},
 		err: nil,
 	}
 
-	inputs := []kinesis.GetRecordsInput{
-		{},
-	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
 
-	_, err := a.processInputs(inputs)
-	assert.NoError(t, err)
+	a.consumeShard(ctx, "1", shardStart{})
+	require.NoError(t, ctx.Err(), "Consumer didn't return after reaching the end of the shard")
+	assert.Len(t, ceClient.Sent(), 1)
+
+	cp, err := st.Get(ctx, "1")
+	require.NoError(t, err)
+	assert.Equal(t, checkpointShardEnd, cp)
 
 	const errMsg = "fake error"
 
+	ceClient.Reset()
+
 	a.knsClient = mockedGetRecords{
-		Resp: kinesis.GetRecordsOutput{},
-		err:  errors.New(errMsg),
+		mockedGetShardIterator: iterator,
+		Resp:                   kinesis.GetRecordsOutput{},
+		err:                    errors.New(errMsg),
 	}
 
-	_, err = a.processInputs(inputs)
-	assert.EqualError(t, err, errMsg)
+	ctx, cancel = context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	// the consumer retries until it is interrupted
+	a.consumeShard(ctx, "2", shardStart{})
+	assert.Empty(t, ceClient.Sent())
+
+	cp, err = st.Get(context.Background(), "2")
+	require.NoError(t, err)
+	assert.Empty(t, cp, "No checkpoint should be recorded")
 }
 
 func TestGetRecordsInputs(t *testing.T) {
 	a := &adapter{
-		logger: loggingtesting.TestLogger(t),
+		logger:          loggingtesting.TestLogger(t),
+		initialPosition: v1alpha1.AWSKinesisInitialPositionLatest,
 	}
 
 	a.knsClient = mockedGetShardIterator{
@@ -107,20 +136,18 @@ func TestGetRecordsInputs(t *testing.T) {
 		err:  nil,
 	}
 
-	shards := []*kinesis.Shard{
-		{ShardId: aws.String("1")},
-	}
-
-	inputs := a.getRecordsInputs(shards)
-	assert.Equal(t, 1, len(inputs))
+	it, err := a.shardIterator("1", shardStart{})
+	assert.NoError(t, err)
+	assert.Equal(t, aws.String("shardIterator"), it)
 
 	a.knsClient = mockedGetShardIterator{
 		Resp: kinesis.GetShardIteratorOutput{},
 		err:  errors.New("fake error"),
 	}
 
-	inputs = a.getRecordsInputs(shards)
-	assert.Equal(t, 0, len(inputs))
+	it, err = a.shardIterator("1", shardStart{})
+	assert.EqualError(t, err, "fake error")
+	assert.Nil(t, it)
 }
 
 func TestSendCloudevent(t *testing.T) {
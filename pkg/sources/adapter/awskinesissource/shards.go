@@ -0,0 +1,370 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awskinesissource
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/sources/adapter/common"
+)
+
+const (
+	// Checkpoint recorded for shards which were closed by a resharding
+	// operation, and fully consumed.
+	checkpointShardEnd = "SHARD_END"
+
+	// Number of shard discovery intervals after which the lease of a shard
+	// expires if it isn't renewed.
+	leaseDurationIntervals = 3
+
+	// Maximum duration of the release of leases upon termination.
+	leaseReleaseTimeout = 5 * time.Second
+
+	// Maximum duration of the recording of a checkpoint after the
+	// consumption of a shard was interrupted.
+	checkpointSaveTimeout = 5 * time.Second
+)
+
+// errShardEnd signals that a shard was fully consumed.
+var errShardEnd = errors.New("reached the end of the shard")
+
+// shardConsumer is a handle to a running consumer of a shard.
+type shardConsumer struct {
+	shardID string
+	cancel  context.CancelFunc
+}
+
+// shardStart describes the position from which a shard should be consumed.
+type shardStart struct {
+	// Sequence number of the last record consumed from the shard, if any.
+	checkpoint string
+	// Whether the shard should be consumed from its oldest record, in the
+	// absence of a checkpoint.
+	fromOldest bool
+}
+
+// syncShardConsumers lists the shards of the stream, renews the leases of the
+// shards which are being consumed, and starts consumers for consumable
+// shards which lease can be acquired.
+func (a *adapter) syncShardConsumers(ctx context.Context, consumers map[string]*shardConsumer,
+	finished chan<- *shardConsumer, wg *sync.WaitGroup) {
+
+	for shardID, c := range consumers {
+		acquired, err := a.leaser.Acquire(ctx, shardID)
+		if err != nil {
+			// keep consuming, the lease may still be renewed
+			// before it expires
+			a.logger.Errorw("Failed to renew lease of shard "+shardID, zap.Error(err))
+			continue
+		}
+		if !acquired {
+			a.logger.Infow("Lost lease of shard " + shardID)
+			c.cancel()
+			delete(consumers, shardID)
+		}
+	}
+
+	shards, err := a.listShards()
+	if err != nil {
+		a.logger.Errorw("Failed to list shards", zap.Error(err))
+		return
+	}
+
+	for shardID, start := range a.consumableShards(ctx, shards) {
+		if _, isConsumed := consumers[shardID]; isConsumed {
+			continue
+		}
+
+		acquired, err := a.leaser.Acquire(ctx, shardID)
+		if err != nil {
+			a.logger.Errorw("Failed to acquire lease of shard "+shardID, zap.Error(err))
+			continue
+		}
+		if !acquired {
+			continue
+		}
+
+		a.logger.Infow("Starting consumer of shard "+shardID, zap.String("checkpoint", start.checkpoint))
+
+		cctx, cancel := context.WithCancel(ctx)
+		c := &shardConsumer{
+			shardID: shardID,
+			cancel:  cancel,
+		}
+		consumers[shardID] = c
+
+		wg.Add(1)
+		go func(shardID string, start shardStart) {
+			defer wg.Done()
+			a.consumeShard(cctx, shardID, start)
+
+			select {
+			case finished <- c:
+			case <-cctx.Done():
+			}
+		}(shardID, start)
+	}
+}
+
+// handleFinishedConsumer releases the lease of the shard consumed by the given
+// consumer, which returned after reaching the end of its shard.
+func (a *adapter) handleFinishedConsumer(ctx context.Context, consumers map[string]*shardConsumer, c *shardConsumer) {
+	c.cancel()
+
+	// the shard may have been reassigned to a new consumer in the meantime
+	if consumers[c.shardID] != c {
+		return
+	}
+	delete(consumers, c.shardID)
+
+	a.logger.Infow("Finished consuming shard " + c.shardID)
+
+	if err := a.leaser.Release(ctx, c.shardID); err != nil {
+		a.logger.Errorw("Failed to release lease of shard "+c.shardID, zap.Error(err))
+	}
+}
+
+// listShards returns all the shards of the stream, including closed shards
+// which are still within the retention period of the stream.
+func (a *adapter) listShards() ([]*kinesis.Shard, error) {
+	var shards []*kinesis.Shard
+
+	in := &kinesis.ListShardsInput{
+		StreamName: &a.stream,
+	}
+
+	for {
+		out, err := a.knsClient.ListShards(in)
+		if err != nil {
+			return nil, err
+		}
+
+		shards = append(shards, out.Shards...)
+
+		if out.NextToken == nil {
+			return shards, nil
+		}
+
+		// StreamName and NextToken are mutually exclusive
+		in = &kinesis.ListShardsInput{
+			NextToken: out.NextToken,
+		}
+	}
+}
+
+// consumableShards returns the shards among the given ones which can be
+// consumed, indexed by shard ID.
+//
+// Shards which were fully consumed are skipped, and so are shards which
+// parents weren't yet fully consumed. Parents which aren't listed anymore have
+// expired, in which case their children are consumable.
+func (a *adapter) consumableShards(ctx context.Context, shards []*kinesis.Shard) map[string]shardStart {
+	checkpoints := make(map[string]string, len(shards))
+
+	for _, s := range shards {
+		cp, err := a.checkpoints.Get(ctx, *s.ShardId)
+		if err != nil {
+			a.logger.Errorw("Failed to read checkpoint of shard "+*s.ShardId, zap.Error(err))
+			continue
+		}
+		checkpoints[*s.ShardId] = cp
+	}
+
+	consumable := make(map[string]shardStart)
+
+	for _, s := range shards {
+		cp, ok := checkpoints[*s.ShardId]
+		if !ok || cp == checkpointShardEnd {
+			continue
+		}
+
+		parentsEnded := true
+		var hasEndedParent bool
+
+		for _, parentID := range []*string{s.ParentShardId, s.AdjacentParentShardId} {
+			if parentID == nil {
+				continue
+			}
+
+			parentCp, isListed := checkpoints[*parentID]
+			if !isListed {
+				if !isShardListed(shards, *parentID) {
+					// expired parent
+					continue
+				}
+				// listed but unknown checkpoint
+				parentsEnded = false
+				break
+			}
+
+			if parentCp != checkpointShardEnd {
+				parentsEnded = false
+				break
+			}
+			hasEndedParent = true
+		}
+
+		if !parentsEnded {
+			continue
+		}
+
+		consumable[*s.ShardId] = shardStart{
+			checkpoint: cp,
+			// Records written to a child shard right after the
+			// end of its parent(s) must not be skipped.
+			fromOldest: hasEndedParent,
+		}
+	}
+
+	return consumable
+}
+
+// isShardListed returns whether the shard with the given ID is part of the
+// given list.
+func isShardListed(shards []*kinesis.Shard, shardID string) bool {
+	for _, s := range shards {
+		if *s.ShardId == shardID {
+			return true
+		}
+	}
+	return false
+}
+
+// consumeShard sends the records of the given shard to the sink, and records
+// a checkpoint after each batch of records. Records are retried until they are
+// acknowledged, so that checkpoints never skip undelivered records. It returns
+// when the context is cancelled, or when the end of the shard is reached.
+func (a *adapter) consumeShard(ctx context.Context, shardID string, start shardStart) {
+	var iterator *string
+	lastSeqNum := start.checkpoint
+
+	backoff := common.NewBackoff()
+
+	err := backoff.Run(ctx.Done(), func(context.Context) (bool, error) {
+		if iterator == nil {
+			it, err := a.shardIterator(shardID, shardStart{checkpoint: lastSeqNum, fromOldest: start.fromOldest})
+			if err != nil {
+				a.logger.Errorw("Failed to get iterator for shard "+shardID, zap.Error(err))
+				return false, nil
+			}
+			iterator = it
+		}
+
+		out, err := a.knsClient.GetRecords(&kinesis.GetRecordsInput{
+			ShardIterator: iterator,
+		})
+		if err != nil {
+			if isExpiredIterator(err) {
+				// resume from the last checkpoint
+				iterator = nil
+				return true, nil
+			}
+			a.logger.Errorw("Failed to get records from shard "+shardID, zap.Error(err))
+			return false, nil
+		}
+
+		for _, record := range out.Records {
+			if err := a.sendKinesisRecordWithRetry(ctx, record); err != nil {
+				// interrupted, record the progress made so far
+				if lastSeqNum != start.checkpoint {
+					saveCtx, cancel := context.WithTimeout(context.Background(), checkpointSaveTimeout)
+					defer cancel()
+					if err := a.checkpoints.Set(saveCtx, shardID, lastSeqNum); err != nil {
+						a.logger.Errorw("Failed to record checkpoint of shard "+shardID, zap.Error(err))
+					}
+				}
+				return false, nil
+			}
+
+			lastSeqNum = *record.SequenceNumber
+		}
+
+		if len(out.Records) > 0 {
+			if err := a.checkpoints.Set(ctx, shardID, lastSeqNum); err != nil {
+				a.logger.Errorw("Failed to record checkpoint of shard "+shardID, zap.Error(err))
+			}
+		}
+
+		// A nil iterator indicates that the shard was closed and that
+		// all its records were read.
+		if out.NextShardIterator == nil {
+			if err := a.checkpoints.Set(ctx, shardID, checkpointShardEnd); err != nil {
+				// the shard will be consumed again from the
+				// last checkpoint after the next discovery
+				a.logger.Errorw("Failed to record end of shard "+shardID, zap.Error(err))
+			}
+			return false, errShardEnd
+		}
+
+		iterator = out.NextShardIterator
+
+		return len(out.Records) > 0, nil
+	})
+
+	if err != nil && !errors.Is(err, errShardEnd) {
+		a.logger.Errorw("Unexpected error while consuming shard "+shardID, zap.Error(err))
+	}
+}
+
+// shardIterator returns an iterator which starts at the given position in the
+// given shard.
+func (a *adapter) shardIterator(shardID string, start shardStart) (*string, error) {
+	in := &kinesis.GetShardIteratorInput{
+		StreamName: &a.stream,
+		ShardId:    &shardID,
+	}
+
+	switch {
+	case start.checkpoint != "":
+		in.ShardIteratorType = aws.String(kinesis.ShardIteratorTypeAfterSequenceNumber)
+		in.StartingSequenceNumber = &start.checkpoint
+
+	case start.fromOldest:
+		in.ShardIteratorType = aws.String(kinesis.ShardIteratorTypeTrimHorizon)
+
+	case a.initialPosition == v1alpha1.AWSKinesisInitialPositionAtTimestamp:
+		in.ShardIteratorType = aws.String(kinesis.ShardIteratorTypeAtTimestamp)
+		in.Timestamp = &a.initialTimestamp
+
+	default:
+		in.ShardIteratorType = aws.String(string(a.initialPosition))
+	}
+
+	out, err := a.knsClient.GetShardIterator(in)
+	if err != nil {
+		return nil, err
+	}
+
+	return out.ShardIterator, nil
+}
+
+// isExpiredIterator returns whether the given error indicates that a shard
+// iterator has expired.
+func isExpiredIterator(err error) bool {
+	var awsErr awserr.Error
+	return errors.As(err, &awsErr) && awsErr.Code() == kinesis.ErrCodeExpiredIteratorException
+}
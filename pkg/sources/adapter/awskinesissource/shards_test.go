@@ -0,0 +1,427 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awskinesissource
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/aws/aws-sdk-go/service/kinesis/kinesisiface"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+
+	adaptertest "knative.dev/eventing/pkg/adapter/v2/test"
+	loggingtesting "knative.dev/pkg/logging/testing"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/sources/adapter/common/checkpoint"
+)
+
+func TestConsumableShards(t *testing.T) {
+	testCases := map[string]struct {
+		shards      []*kinesis.Shard
+		checkpoints map[string]string
+		expect      map[string]shardStart
+	}{
+		"Shards without checkpoints": {
+			shards: []*kinesis.Shard{
+				shard("shard-1"),
+				shard("shard-2"),
+			},
+			expect: map[string]shardStart{
+				"shard-1": {},
+				"shard-2": {},
+			},
+		},
+		"Shards with checkpoints": {
+			shards: []*kinesis.Shard{
+				shard("shard-1"),
+				shard("shard-2"),
+			},
+			checkpoints: map[string]string{
+				"shard-1": "0001",
+			},
+			expect: map[string]shardStart{
+				"shard-1": {checkpoint: "0001"},
+				"shard-2": {},
+			},
+		},
+		"Parent not fully consumed": {
+			shards: []*kinesis.Shard{
+				shard("shard-1"),
+				shard("shard-2", "shard-1"),
+			},
+			checkpoints: map[string]string{
+				"shard-1": "0001",
+			},
+			expect: map[string]shardStart{
+				"shard-1": {checkpoint: "0001"},
+			},
+		},
+		"Parent fully consumed": {
+			shards: []*kinesis.Shard{
+				shard("shard-1"),
+				shard("shard-2", "shard-1"),
+				shard("shard-3", "shard-1"),
+			},
+			checkpoints: map[string]string{
+				"shard-1": checkpointShardEnd,
+				"shard-3": "0003",
+			},
+			expect: map[string]shardStart{
+				"shard-2": {fromOldest: true},
+				"shard-3": {checkpoint: "0003", fromOldest: true},
+			},
+		},
+		"One of merged parents not fully consumed": {
+			shards: []*kinesis.Shard{
+				shard("shard-1"),
+				shard("shard-2"),
+				shard("shard-3", "shard-1", "shard-2"),
+			},
+			checkpoints: map[string]string{
+				"shard-1": checkpointShardEnd,
+				"shard-2": "0002",
+			},
+			expect: map[string]shardStart{
+				"shard-2": {checkpoint: "0002"},
+			},
+		},
+		"Expired parent": {
+			shards: []*kinesis.Shard{
+				shard("shard-2", "shard-1"),
+			},
+			expect: map[string]shardStart{
+				"shard-2": {},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+
+			st := checkpoint.NewMemoryStore()
+			for k, v := range tc.checkpoints {
+				require.NoError(t, st.Set(ctx, k, v))
+			}
+
+			a := &adapter{
+				logger:      loggingtesting.TestLogger(t),
+				checkpoints: st,
+			}
+
+			assert.Equal(t, tc.expect, a.consumableShards(ctx, tc.shards))
+		})
+	}
+}
+
+func TestShardIterator(t *testing.T) {
+	ts := time.Date(2022, time.June, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		initialPosition v1alpha1.AWSKinesisInitialPosition
+		start           shardStart
+		expect          *kinesis.GetShardIteratorInput
+	}{
+		"From checkpoint": {
+			initialPosition: v1alpha1.AWSKinesisInitialPositionLatest,
+			start:           shardStart{checkpoint: "0001", fromOldest: true},
+			expect: &kinesis.GetShardIteratorInput{
+				ShardIteratorType:      aws.String(kinesis.ShardIteratorTypeAfterSequenceNumber),
+				StartingSequenceNumber: aws.String("0001"),
+			},
+		},
+		"From oldest record": {
+			initialPosition: v1alpha1.AWSKinesisInitialPositionLatest,
+			start:           shardStart{fromOldest: true},
+			expect: &kinesis.GetShardIteratorInput{
+				ShardIteratorType: aws.String(kinesis.ShardIteratorTypeTrimHorizon),
+			},
+		},
+		"From initial position": {
+			initialPosition: v1alpha1.AWSKinesisInitialPositionLatest,
+			expect: &kinesis.GetShardIteratorInput{
+				ShardIteratorType: aws.String(kinesis.ShardIteratorTypeLatest),
+			},
+		},
+		"From initial timestamp": {
+			initialPosition: v1alpha1.AWSKinesisInitialPositionAtTimestamp,
+			expect: &kinesis.GetShardIteratorInput{
+				ShardIteratorType: aws.String(kinesis.ShardIteratorTypeAtTimestamp),
+				Timestamp:         &ts,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			cli := &mockKinesisClient{}
+
+			a := &adapter{
+				knsClient:        cli,
+				stream:           "fooStream",
+				initialPosition:  tc.initialPosition,
+				initialTimestamp: ts,
+			}
+
+			it, err := a.shardIterator("shard-1", tc.start)
+			require.NoError(t, err)
+			assert.Equal(t, "iter-shard-1", *it)
+
+			tc.expect.StreamName = aws.String("fooStream")
+			tc.expect.ShardId = aws.String("shard-1")
+
+			require.Len(t, cli.iteratorInputs, 1)
+			assert.Equal(t, tc.expect, cli.iteratorInputs[0])
+		})
+	}
+}
+
+func TestConsumeShard(t *testing.T) {
+	ceClient := adaptertest.NewTestClient()
+	st := checkpoint.NewMemoryStore()
+
+	a := &adapter{
+		logger:      loggingtesting.TestLogger(t),
+		ceClient:    ceClient,
+		checkpoints: st,
+		stream:      "fooStream",
+		knsClient: &mockKinesisClient{
+			records: map[string]*kinesis.GetRecordsOutput{
+				"iter-shard-1": {
+					Records: []*kinesis.Record{
+						record("0001"),
+						record("0002"),
+					},
+					NextShardIterator: aws.String("iter-shard-1-2"),
+				},
+				"iter-shard-1-2": {
+					Records: []*kinesis.Record{
+						record("0003"),
+					},
+					// end of closed shard
+					NextShardIterator: nil,
+				},
+			},
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	a.consumeShard(ctx, "shard-1", shardStart{})
+	require.NoError(t, ctx.Err(), "Consumer didn't return after reaching the end of the shard")
+
+	assert.Len(t, ceClient.Sent(), 3)
+
+	cp, err := st.Get(ctx, "shard-1")
+	require.NoError(t, err)
+	assert.Equal(t, checkpointShardEnd, cp)
+}
+
+func TestConsumeShardUndelivered(t *testing.T) {
+	ceClient := &rejectingCEClient{
+		Client:   adaptertest.NewTestClient(),
+		rejectID: "0002",
+	}
+	st := checkpoint.NewMemoryStore()
+
+	a := &adapter{
+		logger:      loggingtesting.TestLogger(t),
+		ceClient:    ceClient,
+		checkpoints: st,
+		stream:      "fooStream",
+		knsClient: &mockKinesisClient{
+			records: map[string]*kinesis.GetRecordsOutput{
+				"iter-shard-1": {
+					Records: []*kinesis.Record{
+						record("0001"),
+						record("0002"),
+						record("0003"),
+					},
+					NextShardIterator: aws.String("iter-shard-1-2"),
+				},
+			},
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	a.consumeShard(ctx, "shard-1", shardStart{})
+
+	// the undelivered record was retried until the consumer was
+	// interrupted, and none of the following records was sent
+	sent := ceClient.Client.(*adaptertest.TestCloudEventsClient).Sent()
+	require.Len(t, sent, 1)
+	assert.Equal(t, "0001", sent[0].ID())
+	assert.Greater(t, ceClient.rejections(), 1)
+
+	cp, err := st.Get(context.Background(), "shard-1")
+	require.NoError(t, err)
+	assert.Equal(t, "0001", cp, "Checkpoint should be the last delivered record")
+}
+
+func TestSyncShardConsumers(t *testing.T) {
+	leases := checkpoint.NewMemoryLeases()
+	st := checkpoint.NewMemoryStore()
+
+	cli := &mockKinesisClient{
+		shards: []*kinesis.Shard{
+			shard("shard-1"),
+			shard("shard-2"),
+		},
+	}
+
+	newAdapter := func(holder string) *adapter {
+		return &adapter{
+			logger:      loggingtesting.TestLogger(t),
+			ceClient:    adaptertest.NewTestClient(),
+			knsClient:   cli,
+			checkpoints: st,
+			leaser:      leases.Leaser(holder, time.Hour),
+			stream:      "fooStream",
+
+			initialPosition: v1alpha1.AWSKinesisInitialPositionLatest,
+		}
+	}
+
+	a1 := newAdapter("replica-1")
+	a2 := newAdapter("replica-2")
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var wg sync.WaitGroup
+	finished := make(chan *shardConsumer)
+
+	consumers1 := make(map[string]*shardConsumer)
+	consumers2 := make(map[string]*shardConsumer)
+
+	a1.syncShardConsumers(ctx, consumers1, finished, &wg)
+	a2.syncShardConsumers(ctx, consumers2, finished, &wg)
+
+	cancel()
+	wg.Wait()
+
+	assert.Len(t, consumers1, 2, "First replica should own all shards")
+	assert.Empty(t, consumers2, "Second replica should not own any shard")
+}
+
+// mockKinesisClient is a mock implementation of the Kinesis API.
+type mockKinesisClient struct {
+	kinesisiface.KinesisAPI
+
+	shards []*kinesis.Shard
+
+	// outputs returned by GetRecords, indexed by shard iterator
+	records map[string]*kinesis.GetRecordsOutput
+
+	mu             sync.Mutex
+	iteratorInputs []*kinesis.GetShardIteratorInput
+}
+
+func (m *mockKinesisClient) ListShards(*kinesis.ListShardsInput) (*kinesis.ListShardsOutput, error) {
+	return &kinesis.ListShardsOutput{
+		Shards: m.shards,
+	}, nil
+}
+
+func (m *mockKinesisClient) GetShardIterator(in *kinesis.GetShardIteratorInput) (*kinesis.GetShardIteratorOutput, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.iteratorInputs = append(m.iteratorInputs, in)
+
+	return &kinesis.GetShardIteratorOutput{
+		ShardIterator: aws.String("iter-" + *in.ShardId),
+	}, nil
+}
+
+func (m *mockKinesisClient) GetRecords(in *kinesis.GetRecordsInput) (*kinesis.GetRecordsOutput, error) {
+	if out, ok := m.records[*in.ShardIterator]; ok {
+		return out, nil
+	}
+
+	// open shard without new records
+	return &kinesis.GetRecordsOutput{
+		NextShardIterator: in.ShardIterator,
+	}, nil
+}
+
+// rejectingCEClient is a CloudEvents client which fails to send the event
+// with the given ID.
+type rejectingCEClient struct {
+	cloudevents.Client
+
+	rejectID string
+
+	mu       sync.Mutex
+	rejected int
+}
+
+func (c *rejectingCEClient) Send(ctx context.Context, e cloudevents.Event) cloudevents.Result {
+	if e.ID() == c.rejectID {
+		c.mu.Lock()
+		c.rejected++
+		c.mu.Unlock()
+		return errors.New("fake error")
+	}
+
+	return c.Client.Send(ctx, e)
+}
+
+func (c *rejectingCEClient) rejections() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.rejected
+}
+
+// shard returns a Kinesis shard with the given ID and parents.
+func shard(id string, parentIDs ...string) *kinesis.Shard {
+	s := &kinesis.Shard{
+		ShardId: aws.String(id),
+	}
+
+	if len(parentIDs) > 0 {
+		s.ParentShardId = aws.String(parentIDs[0])
+	}
+	if len(parentIDs) > 1 {
+		s.AdjacentParentShardId = aws.String(parentIDs[1])
+	}
+
+	return s
+}
+
+// record returns a Kinesis record with the given sequence number.
+func record(seqNum string) *kinesis.Record {
+	return &kinesis.Record{
+		SequenceNumber: aws.String(seqNum),
+		PartitionKey:   aws.String("key"),
+		Data:           []byte(`{"foo":"bar"}`),
+	}
+}
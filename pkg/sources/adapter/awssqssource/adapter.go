@@ -47,6 +47,11 @@ const (
 	logfieldMsgIDs = "msgIDs"
 )
 
+// This event source spends most of its time waiting for the network, so we
+// can run more than one of each receiver|processor|deleter for each available
+// thread.
+const instancesPerProc = 3
+
 // envConfig is a set parameters sourced from the environment for the source's
 // adapter.
 type envConfig struct {
@@ -65,6 +70,23 @@ type envConfig struct {
 	// https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-visibility-timeout.html
 	VisibilityTimeout *time.Duration `envconfig:"SQS_VISIBILITY_TIMEOUT"`
 
+	// Base delay before a message which events could not be delivered
+	// becomes visible again, multiplied by the message's receive count.
+	RetryBackoff time.Duration `envconfig:"SQS_RETRY_BACKOFF"`
+
+	// Number of receives after which a message which events could not
+	// be delivered is forwarded to the dead-letter sink.
+	MaxReceiveCount int    `envconfig:"SQS_MAX_RECEIVE_COUNT"`
+	DeadLetterSink  string `envconfig:"SQS_DEAD_LETTER_SINK"`
+
+	// Number of workers which process messages concurrently. Defaults to a
+	// multiple of the number of available threads.
+	ProcessingWorkers int `envconfig:"SQS_PROCESSING_WORKERS"`
+
+	// Whether messages sharing a MessageGroupId are processed sequentially,
+	// in the order they were received (FIFO queues).
+	PreserveGroupOrder bool `envconfig:"SQS_PRESERVE_GROUP_ORDER"`
+
 	// Allows overriding common CloudEvents attributes.
 	CEOverrideSource string `envconfig:"CE_SOURCE"`
 	CEOverrideType   string `envconfig:"CE_TYPE"`
@@ -92,9 +114,18 @@ type adapter struct {
 
 	visibilityTimeoutSeconds *int64
 
+	// handling of messages which events could not be delivered
+	retryBackoff    time.Duration
+	maxReceiveCount int
+	deadLetterSink  string
+
 	processQueue chan *sqs.Message
 	deleteQueue  chan *sqs.Message
 
+	processingWorkers  int
+	preserveGroupOrder bool
+	inFlight           int64 // atomic
+
 	deletePeriod time.Duration
 }
 
@@ -148,6 +179,11 @@ func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClie
 		}
 	}
 
+	processingWorkers := env.ProcessingWorkers
+	if processingWorkers <= 0 {
+		processingWorkers = runtime.GOMAXPROCS(-1) * instancesPerProc
+	}
+
 	cfg := session.Must(session.NewSession(aws.NewConfig().
 		WithRegion(arn.Region).
 		WithEndpointResolver(common.EndpointResolver(arn.Partition)),
@@ -178,9 +214,16 @@ func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClie
 
 		visibilityTimeoutSeconds: visibilityTimeoutSeconds,
 
+		retryBackoff:    env.RetryBackoff,
+		maxReceiveCount: env.MaxReceiveCount,
+		deadLetterSink:  env.DeadLetterSink,
+
 		processQueue: make(chan *sqs.Message, queueBufferSizeProcess),
 		deleteQueue:  make(chan *sqs.Message, queueBufferSizeDelete),
 
+		processingWorkers:  processingWorkers,
+		preserveGroupOrder: env.PreserveGroupOrder,
+
 		deletePeriod: maxDeleteMsgPeriod,
 	}
 }
@@ -205,10 +248,15 @@ func (a *adapter) Start(ctx context.Context) error {
 
 	var wg sync.WaitGroup
 
-	// This event source spends most of its time waiting for the network,
-	// so we can run more than one of each receiver|processor|deleter for
-	// each available thread.
-	const instancesPerProc = 3
+	for _, queue := range a.processorQueues(msgCtx, &wg) {
+		queue := queue
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			a.runMessagesProcessor(msgCtx, queueURL, queue)
+		}()
+	}
 
 	for i := 0; i < runtime.GOMAXPROCS(-1)*instancesPerProc; i++ {
 		// TODO(antoineco): spawn and terminate receivers dynamically
@@ -221,12 +269,6 @@ func (a *adapter) Start(ctx context.Context) error {
 			a.runMessagesReceiver(msgCtx, queueURL)
 		}()
 
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
-			a.runMessagesProcessor(msgCtx)
-		}()
-
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
@@ -0,0 +1,148 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awssqssource
+
+import (
+	"context"
+	"hash/fnv"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/sqs"
+)
+
+// processorQueues returns the queues which message processors read from.
+//
+// Unless the order of message groups must be preserved, all processors share
+// the processing queue. Otherwise, each processor reads from its own queue,
+// and a dispatcher distributes messages from the processing queue to those
+// queues.
+func (a *adapter) processorQueues(ctx context.Context, wg *sync.WaitGroup) []<-chan *sqs.Message {
+	n := a.processingWorkers
+	if n <= 0 {
+		n = runtime.GOMAXPROCS(-1) * instancesPerProc
+	}
+
+	queues := make([]<-chan *sqs.Message, n)
+
+	if !a.preserveGroupOrder {
+		for i := range queues {
+			queues[i] = a.processQueue
+		}
+		return queues
+	}
+
+	workerQueues := make([]chan *sqs.Message, n)
+	for i := range workerQueues {
+		workerQueues[i] = make(chan *sqs.Message, maxReceiveMsgBatchSize)
+		queues[i] = workerQueues[i]
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		a.runMessagesDispatcher(ctx, workerQueues)
+	}()
+
+	return queues
+}
+
+// A message dispatcher distributes messages from processQueue to the queues of
+// message processors. Messages which share a MessageGroupId are always
+// dispatched to the same processor, in the order they were received, so that
+// they are processed sequentially. Other messages are distributed in a
+// round-robin fashion.
+func (a *adapter) runMessagesDispatcher(ctx context.Context, queues []chan *sqs.Message) {
+	var next int
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case msg := <-a.processQueue:
+			var i int
+			if groupID := messageGroupID(msg); groupID != "" {
+				i = groupIndex(groupID, len(queues))
+			} else {
+				i = next
+				next = (next + 1) % len(queues)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case queues[i] <- msg:
+			}
+		}
+	}
+}
+
+// messageGroupID returns the MessageGroupId of the given message, which is
+// only set on messages received from FIFO queues.
+func messageGroupID(msg *sqs.Message) string {
+	if v := msg.Attributes[sqs.MessageSystemAttributeNameMessageGroupId]; v != nil {
+		return *v
+	}
+	return ""
+}
+
+// groupIndex returns the index of the processor which handles the messages of
+// the given group.
+func groupIndex(groupID string, n int) int {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(groupID))
+	return int(h.Sum32() % uint32(n))
+}
+
+// blockedGroups keeps track of message groups which processing is suspended
+// after the failure of one of their messages.
+type blockedGroups map[ /*MessageGroupId*/ string]blockedGroup
+
+// blockedGroup references the failed message of a group.
+type blockedGroup struct {
+	msgID string
+	until time.Time
+}
+
+// block suspends the processing of the given message's group until the
+// message is redelivered, or until the given delay expires, whichever
+// happens first.
+func (b blockedGroups) block(groupID string, msg *sqs.Message, delay time.Duration) {
+	b[groupID] = blockedGroup{
+		msgID: *msg.MessageId,
+		until: time.Now().Add(delay),
+	}
+}
+
+// blocks returns whether the processing of the given message must be skipped
+// because a previous message of its group failed. The group is unblocked once
+// the failed message is redelivered.
+func (b blockedGroups) blocks(groupID string, msg *sqs.Message) bool {
+	bg, ok := b[groupID]
+	if !ok {
+		return false
+	}
+
+	if bg.msgID == *msg.MessageId || time.Now().After(bg.until) {
+		delete(b, groupID)
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awssqssource
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sqs"
+)
+
+func TestMessagesDispatcher(t *testing.T) {
+	const numWorkers = 4
+
+	a := &adapter{
+		processQueue: make(chan *sqs.Message, 100),
+	}
+
+	queues := make([]chan *sqs.Message, numWorkers)
+	for i := range queues {
+		queues[i] = make(chan *sqs.Message, 100)
+	}
+
+	groups := []string{"group-a", "group-b", "group-c"}
+
+	msgs := makeMockMessages(30)
+	for i, msg := range msgs {
+		msg.Attributes = map[string]*string{
+			sqs.MessageSystemAttributeNameMessageGroupId: aws.String(groups[i%len(groups)]),
+		}
+		a.processQueue <- msg
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		a.runMessagesDispatcher(ctx, queues)
+	}()
+
+	require.Eventually(t, func() bool { return len(a.processQueue) == 0 }, time.Second, time.Millisecond)
+	cancel()
+	wg.Wait()
+
+	// all messages of a group must have been dispatched to the same
+	// worker, in the order they were received
+	for _, g := range groups {
+		q := queues[groupIndex(g, numWorkers)]
+
+		var prev string
+		for len(q) > 0 {
+			msg := <-q
+			if messageGroupID(msg) != g {
+				continue
+			}
+			assert.Greater(t, *msg.MessageId, prev, "Messages of group %s are out of order", g)
+			prev = *msg.MessageId
+		}
+		assert.NotEmpty(t, prev, "No message of group %s was dispatched to its worker", g)
+	}
+}
+
+func TestBlockedGroups(t *testing.T) {
+	msgs := makeMockMessages(3)
+
+	b := make(blockedGroups)
+
+	assert.False(t, b.blocks("g1", msgs[1]))
+
+	b.block("g1", msgs[0], time.Hour)
+	assert.True(t, b.blocks("g1", msgs[1]), "Subsequent messages of a blocked group should be skipped")
+	assert.False(t, b.blocks("g2", msgs[1]), "Messages of other groups should be processed")
+
+	assert.False(t, b.blocks("g1", msgs[0]), "The redelivered failed message should unblock its group")
+	assert.False(t, b.blocks("g1", msgs[1]))
+
+	b.block("g1", msgs[0], -time.Second)
+	assert.False(t, b.blocks("g1", msgs[2]), "The block should expire after the redelivery delay")
+}
+
+func TestGroupIndex(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		g := fmt.Sprintf("group-%d", i)
+		idx := groupIndex(g, 7)
+		assert.GreaterOrEqual(t, idx, 0)
+		assert.Less(t, idx, 7)
+		assert.Equal(t, idx, groupIndex(g, 7), "Index of a group should be stable")
+	}
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awssqssource
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sqs"
+)
+
+const (
+	// Default visibility timeout of a queue.
+	// https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-visibility-timeout.html
+	defaultVisibilityTimeout = 30 * time.Second
+
+	// Longest possible visibility timeout of a message.
+	// https://docs.aws.amazon.com/AWSSimpleQueueService/latest/APIReference/API_ChangeMessageVisibility.html
+	maxVisibilityTimeout = 12 * time.Hour
+
+	// Calls to ChangeMessageVisibility are cancelled when they exceed this duration.
+	changeVisibilityRequestTimeout = 10 * time.Second
+
+	// CloudEvent extension which carries the reason why a message was
+	// forwarded to the dead-letter sink.
+	ceExtDeadLetterReason = "deadletterreason"
+)
+
+// handleFailedMessage handles a SQS message which events could not be
+// delivered to the sink. Such message is never deleted from the queue, unless
+// it was received at least maxReceiveCount times and could be forwarded to
+// the dead-letter sink. Otherwise, its visibility timeout is extended
+// according to the retry backoff, after which SQS redelivers it.
+//
+// It returns whether the message was retained in the queue, and the delay
+// after which it is expected to become visible again.
+func (a *adapter) handleFailedMessage(ctx context.Context, queueURL string, msg *sqs.Message, reason error) (bool, time.Duration) {
+	rcvCount := receiveCount(msg)
+
+	a.logger.Errorw("Failed to deliver SQS message", zap.Error(reason),
+		zap.String(logfieldMsgID, *msg.MessageId),
+		zap.Int("receiveCount", rcvCount))
+
+	if a.deadLetterSink != "" && a.maxReceiveCount > 0 && rcvCount >= a.maxReceiveCount {
+		err := a.sendToDeadLetterSink(ctx, msg, reason)
+		if err == nil {
+			a.deleteQueue <- msg
+			a.sr.reportMessageEnqueuedDeleteCount()
+			return false, 0
+		}
+
+		a.logger.Errorw("Failed to send SQS message to the dead-letter sink", zap.Error(err),
+			zap.String(logfieldMsgID, *msg.MessageId))
+	}
+
+	if a.retryBackoff <= 0 {
+		return true, a.visibilityTimeout()
+	}
+
+	backoff := a.retryBackoff * time.Duration(rcvCount)
+	if backoff > maxVisibilityTimeout {
+		backoff = maxVisibilityTimeout
+	}
+
+	if err := a.changeMessageVisibility(ctx, queueURL, msg, backoff); err != nil {
+		// NOTE: the message becomes visible again once its current
+		// visibility timeout expires.
+		a.logger.Errorw("Failed to change the visibility timeout of SQS message", zap.Error(err),
+			zap.String(logfieldMsgID, *msg.MessageId))
+		return true, a.visibilityTimeout()
+	}
+
+	return true, backoff
+}
+
+// visibilityTimeout returns the visibility timeout of received messages.
+func (a *adapter) visibilityTimeout() time.Duration {
+	if vts := a.visibilityTimeoutSeconds; vts != nil {
+		return time.Duration(*vts) * time.Second
+	}
+	return defaultVisibilityTimeout
+}
+
+// sendToDeadLetterSink sends the given SQS message as a CloudEvent to the
+// dead-letter sink, along with the reason of its delivery failure.
+func (a *adapter) sendToDeadLetterSink(ctx context.Context, msg *sqs.Message, reason error) error {
+	event, err := makeSQSEvent(msg, a.arn.String())
+	if err != nil {
+		return fmt.Errorf("creating CloudEvent from SQS message: %w", err)
+	}
+	event.SetExtension(ceExtDeadLetterReason, reason.Error())
+
+	return sendSQSEvent(cloudevents.ContextWithTarget(ctx, a.deadLetterSink), a.ceClient, event)
+}
+
+// changeMessageVisibility makes the given SQS message invisible to consumers
+// for the given duration.
+func (a *adapter) changeMessageVisibility(ctx context.Context, queueURL string, msg *sqs.Message, d time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, changeVisibilityRequestTimeout)
+	defer cancel()
+
+	_, err := a.sqsClient.ChangeMessageVisibilityWithContext(ctx, &sqs.ChangeMessageVisibilityInput{
+		QueueUrl:          &queueURL,
+		ReceiptHandle:     msg.ReceiptHandle,
+		VisibilityTimeout: aws.Int64(durationInSeconds(d)),
+	})
+	return err
+}
+
+// receiveCount returns the number of times the given SQS message was
+// received from the queue.
+func receiveCount(msg *sqs.Message) int {
+	if v, ok := msg.Attributes[sqs.MessageSystemAttributeNameApproximateReceiveCount]; ok && v != nil {
+		if n, err := strconv.Atoi(*v); err == nil {
+			return n
+		}
+	}
+	return 1
+}
@@ -0,0 +1,207 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awssqssource
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/protocol"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/aws/aws-sdk-go/service/sqs/sqsiface"
+
+	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
+	loggingtesting "knative.dev/pkg/logging/testing"
+)
+
+const tDeadLetterSink = "http://dls.example.com/"
+
+func TestProcessFailedMessage(t *testing.T) {
+	testCases := map[string]struct {
+		receiveCount   string
+		sinkDown       bool
+		dlsDown        bool
+		noDeadLetter   bool
+		expectDeleted  bool
+		expectDLEvent  bool
+		expectBackoffS int64
+	}{
+		"delivered": {
+			receiveCount:  "1",
+			expectDeleted: true,
+		},
+		"sink down, first receive": {
+			receiveCount:   "1",
+			sinkDown:       true,
+			expectBackoffS: 10,
+		},
+		"sink down, backoff grows with receive count": {
+			receiveCount:   "2",
+			sinkDown:       true,
+			expectBackoffS: 20,
+		},
+		"sink down, max receive count reached": {
+			receiveCount:  "3",
+			sinkDown:      true,
+			expectDeleted: true,
+			expectDLEvent: true,
+		},
+		"sink down, max receive count reached, dead-letter sink down": {
+			receiveCount:   "3",
+			sinkDown:       true,
+			dlsDown:        true,
+			expectBackoffS: 30,
+		},
+		"sink down, max receive count reached, no dead-letter sink": {
+			receiveCount:   "3",
+			sinkDown:       true,
+			noDeadLetter:   true,
+			expectBackoffS: 30,
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			ceCli := &unreliableCEClient{
+				sinkDown: tc.sinkDown,
+				dlsDown:  tc.dlsDown,
+			}
+			sqsCli := &visibilityMockSQSClient{}
+
+			mt := &pkgadapter.MetricTag{}
+			arn := makeARN(tQueueArnResource)
+
+			a := &adapter{
+				logger: loggingtesting.TestLogger(t),
+				sr:     mustNewStatsReporter(mt),
+
+				sqsClient: sqsCli,
+				ceClient:  ceCli,
+
+				arn: arn,
+
+				msgPrcsr: &defaultMessageProcessor{ceSource: arn.String()},
+
+				retryBackoff:    10 * time.Second,
+				maxReceiveCount: 3,
+				deadLetterSink:  tDeadLetterSink,
+
+				deleteQueue: make(chan *sqs.Message, 1),
+			}
+			if tc.noDeadLetter {
+				a.deadLetterSink = ""
+			}
+
+			msg := makeMockMessages(1)[0]
+			msg.Attributes = map[string]*string{
+				sqs.MessageSystemAttributeNameApproximateReceiveCount: aws.String(tc.receiveCount),
+			}
+
+			a.processMessage(context.Background(), tQueueURL, msg)
+
+			if tc.expectDeleted {
+				require.Len(t, a.deleteQueue, 1, "Expected the message to be marked for deletion")
+				assert.Equal(t, msg, <-a.deleteQueue)
+			} else {
+				assert.Len(t, a.deleteQueue, 0, "Expected the message to be left in the queue")
+			}
+
+			if tc.expectDLEvent {
+				require.Len(t, ceCli.deadLettered, 1, "Expected an event to be sent to the dead-letter sink")
+				assert.Equal(t, *msg.MessageId, ceCli.deadLettered[0].ID())
+				assert.Contains(t, ceCli.deadLettered[0].Extensions()[ceExtDeadLetterReason], "sink is down")
+			} else {
+				assert.Empty(t, ceCli.deadLettered, "Expected no event to be sent to the dead-letter sink")
+			}
+
+			if tc.expectBackoffS != 0 {
+				require.Len(t, sqsCli.requests, 1, "Expected the visibility timeout to be changed")
+				assert.EqualValues(t, tc.expectBackoffS, *sqsCli.requests[0].VisibilityTimeout)
+				assert.Equal(t, msg.ReceiptHandle, sqsCli.requests[0].ReceiptHandle)
+			} else {
+				assert.Empty(t, sqsCli.requests, "Expected the visibility timeout to be left untouched")
+			}
+		})
+	}
+}
+
+func TestReceiveCount(t *testing.T) {
+	assert.Equal(t, 1, receiveCount(&sqs.Message{}))
+	assert.Equal(t, 4, receiveCount(&sqs.Message{Attributes: map[string]*string{
+		sqs.MessageSystemAttributeNameApproximateReceiveCount: aws.String("4"),
+	}}))
+}
+
+// unreliableCEClient is a CloudEvents client which sink and dead-letter sink
+// can be made unavailable.
+type unreliableCEClient struct {
+	cloudevents.Client
+
+	sinkDown bool
+	dlsDown  bool
+
+	sync.Mutex
+	deadLettered []cloudevents.Event
+}
+
+func (c *unreliableCEClient) Send(ctx context.Context, e cloudevents.Event) protocol.Result {
+	if target := cloudevents.TargetFromContext(ctx); target != nil && target.String() == tDeadLetterSink {
+		if c.dlsDown {
+			return errors.New("dead-letter sink is down")
+		}
+
+		c.Lock()
+		defer c.Unlock()
+		c.deadLettered = append(c.deadLettered, e)
+		return protocol.ResultACK
+	}
+
+	if c.sinkDown {
+		return errors.New("sink is down")
+	}
+	return protocol.ResultACK
+}
+
+// visibilityMockSQSClient is a mocked SQS client which records calls to
+// ChangeMessageVisibility.
+type visibilityMockSQSClient struct {
+	sqsiface.SQSAPI
+
+	sync.Mutex
+	requests []*sqs.ChangeMessageVisibilityInput
+}
+
+func (c *visibilityMockSQSClient) ChangeMessageVisibilityWithContext(_ context.Context,
+	in *sqs.ChangeMessageVisibilityInput, _ ...request.Option) (*sqs.ChangeMessageVisibilityOutput, error) {
+
+	c.Lock()
+	defer c.Unlock()
+
+	c.requests = append(c.requests, in)
+	return &sqs.ChangeMessageVisibilityOutput{}, nil
+}
@@ -21,6 +21,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"go.uber.org/zap"
@@ -35,38 +36,73 @@ import (
 )
 
 // A message processor processes SQS messages (sends as CloudEvent)
-// sequentially, as soon as they are written to processQueue.
-func (a *adapter) runMessagesProcessor(ctx context.Context) {
+// sequentially, as soon as they are written to the given queue.
+//
+// When the order of message groups must be preserved, messages which belong
+// to a group are skipped after the processing of a previous message of the
+// same group failed, until that message is redelivered.
+func (a *adapter) runMessagesProcessor(ctx context.Context, queueURL string, queue <-chan *sqs.Message) {
+	blocked := make(blockedGroups)
+
 	for {
 		select {
 		case <-ctx.Done():
 			return
 
-		case msg := <-a.processQueue:
+		case msg := <-queue:
 			a.sr.reportMessageDequeuedProcessCount()
 
-			a.logger.Debugw("Processing message", zap.String(logfieldMsgID, *msg.MessageId))
-
-			events, err := a.msgPrcsr.Process(msg)
-			if err != nil {
-				a.logger.Errorw("Failed to process SQS message", zap.Error(err),
-					zap.String(logfieldMsgID, *msg.MessageId))
-				continue
-			}
-
-			for _, event := range events {
-				if err := sendSQSEvent(ctx, a.ceClient, event); err != nil {
-					a.logger.Errorw("Failed to send event to the sink", zap.Error(err))
+			groupID := messageGroupID(msg)
+			if a.preserveGroupOrder && groupID != "" {
+				if blocked.blocks(groupID, msg) {
+					a.logger.Debugw("Skipping message of a group which previous message failed",
+						zap.String(logfieldMsgID, *msg.MessageId))
 					continue
 				}
 			}
 
-			a.deleteQueue <- msg
-			a.sr.reportMessageEnqueuedDeleteCount()
+			retained, redeliveryDelay := a.processMessage(ctx, queueURL, msg)
+
+			if a.preserveGroupOrder && groupID != "" && retained {
+				blocked.block(groupID, msg, redeliveryDelay)
+			}
 		}
 	}
 }
 
+// processMessage sends the events derived from the given SQS message to the
+// sink. The message is marked for deletion only if all of its events were
+// acknowledged by the sink, otherwise it is handled as a failed message.
+// It returns whether the message was retained in the queue for redelivery,
+// and the delay after which it is expected to become visible again.
+func (a *adapter) processMessage(ctx context.Context, queueURL string, msg *sqs.Message) (bool, time.Duration) {
+	a.sr.reportMessagesInFlight(atomic.AddInt64(&a.inFlight, 1))
+	defer func() {
+		a.sr.reportMessagesInFlight(atomic.AddInt64(&a.inFlight, -1))
+	}()
+
+	a.logger.Debugw("Processing message", zap.String(logfieldMsgID, *msg.MessageId))
+
+	events, err := a.msgPrcsr.Process(msg)
+	if err != nil {
+		return a.handleFailedMessage(ctx, queueURL, msg, fmt.Errorf("processing SQS message: %w", err))
+	}
+
+	for _, event := range events {
+		if err := sendSQSEvent(ctx, a.ceClient, event); err != nil {
+			// NOTE: the message is redelivered as a whole, so events
+			// which were already acknowledged are sent again
+			// (at-least-once delivery).
+			return a.handleFailedMessage(ctx, queueURL, msg, fmt.Errorf("sending event to the sink: %w", err))
+		}
+	}
+
+	a.deleteQueue <- msg
+	a.sr.reportMessageEnqueuedDeleteCount()
+
+	return false, 0
+}
+
 // sendSQSEvent sends a single SQS message as a CloudEvent to the event sink.
 func sendSQSEvent(ctx context.Context, cli cloudevents.Client, event *cloudevents.Event) error {
 	if result := cli.Send(ctx, *event); !cloudevents.IsACK(result) {
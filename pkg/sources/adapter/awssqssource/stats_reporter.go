@@ -36,6 +36,7 @@ const (
 	metricNameMsgDequeuedProcessCount = "message_dequeued_process_count"
 	metricNameMsgEnqueuedDeleteCount  = "message_enqueued_delete_count"
 	metricNameMsgDequeuedDeleteCount  = "message_dequeued_delete_count"
+	metricNameMsgInFlight             = "message_in_flight"
 )
 
 var (
@@ -90,6 +91,14 @@ var msgDequeuedDeleteCountM = stats.Int64(
 	stats.UnitDimensionless,
 )
 
+// msgInFlightM records the number of SQS messages that are currently being
+// processed.
+var msgInFlightM = stats.Int64(
+	metricNameMsgInFlight,
+	"Number of SQS messages that are currently being processed",
+	stats.UnitDimensionless,
+)
+
 // mustRegisterStatsView registers an OpenCensus stats view for the source's
 // metrics and panics in case of error.
 func mustRegisterStatsView() {
@@ -136,6 +145,12 @@ func mustRegisterStatsView() {
 			Aggregation: view.Count(),
 			TagKeys:     tagKeys,
 		},
+		&view.View{
+			Measure:     msgInFlightM,
+			Description: msgInFlightM.Description(),
+			Aggregation: view.LastValue(),
+			TagKeys:     tagKeys,
+		},
 	)
 	if err != nil {
 		panic(fmt.Errorf("error registering OpenCensus stats view: %w", err))
@@ -194,3 +209,8 @@ func (r *statsReporter) reportMessageEnqueuedDeleteCount() {
 func (r *statsReporter) reportMessageDequeuedDeleteCount() {
 	metrics.Record(r.tagsCtx, msgDequeuedDeleteCountM.M(1))
 }
+
+// reportMessagesInFlight sets the value of msgInFlightM.
+func (r *statsReporter) reportMessagesInFlight(n int64) {
+	metrics.Record(r.tagsCtx, msgInFlightM.M(n))
+}
@@ -30,6 +30,8 @@ import (
 	"github.com/cloudevents/sdk-go/v2/protocol"
 
 	eventhub "github.com/Azure/azure-event-hubs-go/v3"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
 
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
 	"knative.dev/pkg/logging"
@@ -37,6 +39,8 @@ import (
 	"github.com/triggermesh/triggermesh/pkg/apis/sources"
 	"github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
 	"github.com/triggermesh/triggermesh/pkg/sources/adapter/azureeventhubsource/trace"
+	"github.com/triggermesh/triggermesh/pkg/sources/adapter/common"
+	"github.com/triggermesh/triggermesh/pkg/sources/adapter/common/checkpoint"
 	"github.com/triggermesh/triggermesh/pkg/sources/adapter/common/health"
 )
 
@@ -64,6 +68,26 @@ type envConfig struct {
 	CEOverrideSource string `envconfig:"CE_SOURCE"`
 	CEOverrideType   string `envconfig:"CE_TYPE"`
 
+	// Consumer group from which events are read.
+	ConsumerGroup string `envconfig:"EVENTHUB_CONSUMER_GROUP" default:"$Default"`
+	// Position in a partition from which events are read when no
+	// checkpoint was previously recorded for that partition.
+	StartPosition string `envconfig:"EVENTHUB_START_POSITION" default:"latest"`
+	// Time from which events are read when the start position is
+	// enqueuedTime.
+	StartTime time.Time `envconfig:"EVENTHUB_START_TIME"`
+
+	// Backend used to persist checkpoints and partition leases. Defaults
+	// to in-memory checkpoints, because this adapter is also used by
+	// component kinds which don't persist checkpoints.
+	CheckpointBackend string `envconfig:"EVENTHUB_CHECKPOINT_BACKEND" default:"memory"`
+	// Storage account and container of the Azure Blob Storage backend.
+	CheckpointBlobAccount    string `envconfig:"EVENTHUB_CHECKPOINT_BLOB_ACCOUNT"`
+	CheckpointBlobContainer  string `envconfig:"EVENTHUB_CHECKPOINT_BLOB_CONTAINER"`
+	CheckpointBlobAccountKey string `envconfig:"EVENTHUB_CHECKPOINT_BLOB_ACCOUNT_KEY"`
+	// Component instance which owns the persisted checkpoints and leases.
+	CheckpointOwner checkpoint.Owner `envconfig:"CHECKPOINT_OWNER"`
+
 	// The environment variables below aren't read from the envConfig struct
 	// by the Event Hubs SDK, but rather directly using os.Getenv().
 	// They are nevertheless listed here for documentation purposes.
@@ -88,6 +112,15 @@ type adapter struct {
 	ceClient cloudevents.Client
 
 	msgPrcsr MessageProcessor
+
+	receive     receiveFunc
+	checkpoints checkpoint.Store
+	leaser      checkpoint.Leaser
+	holder      string
+
+	consumerGroup string
+	startPosition v1alpha1.AzureEventHubStartPosition
+	startTime     time.Time
 }
 
 // NewEnvConfig satisfies pkgadapter.EnvConfigConstructor.
@@ -139,6 +172,25 @@ func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClie
 		panic("Unsupported message processor " + strconv.Quote(env.MessageProcessor))
 	}
 
+	startPosition := v1alpha1.AzureEventHubStartPosition(env.StartPosition)
+	switch startPosition {
+	case v1alpha1.AzureEventHubStartPositionEarliest,
+		v1alpha1.AzureEventHubStartPositionLatest:
+	case v1alpha1.AzureEventHubStartPositionEnqueuedTime:
+		if env.StartTime.IsZero() {
+			logger.Panicf("A start time is required with the start position %s", startPosition)
+		}
+	default:
+		logger.Panicf("Unsupported start position %q", startPosition)
+	}
+
+	holder := checkpoint.Holder()
+
+	checkpoints, leaser, err := newCheckpointBackend(env, envAcc.GetNamespace(), envAcc.GetName(), holder)
+	if err != nil {
+		logger.Panicw("Unable to initialize checkpoint backend", zap.Error(err))
+	}
+
 	// The Event Hubs client uses the default "NoOpTracer" tab.Tracer
 	// implementation, which does not produce any log message. We register
 	// a custom implementation so that event handling errors are logged via
@@ -153,10 +205,75 @@ func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClie
 		ceClient: ceClient,
 
 		msgPrcsr: msgPrcsr,
+
+		receive: func(ctx context.Context, partitionID string, h eventhub.Handler,
+			opts ...eventhub.ReceiveOption) (listener, error) {
+
+			l, err := hub.Receive(ctx, partitionID, h, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return l, nil
+		},
+		checkpoints: checkpoints,
+		leaser:      leaser,
+		holder:      holder,
+
+		consumerGroup: env.ConsumerGroup,
+		startPosition: startPosition,
+		startTime:     env.StartTime,
 	}
 }
 
+// newCheckpointBackend returns a Store and a Leaser for the checkpoint backend
+// selected in the given envConfig.
+func newCheckpointBackend(env *envConfig, namespace, name, holder string) (checkpoint.Store, checkpoint.Leaser, error) {
+	// Checkpoints are specific to a consumer group.
+	prefix := checkpoint.ObjectName("azureeventhubsource-"+name, env.ConsumerGroup)
+
+	// Leases are renewed at every balancing of partitions, so they must
+	// outlive a few balancing intervals to tolerate transient failures.
+	leaseDuration := balanceInterval * leaseDurationIntervals
+
+	if env.CheckpointBackend != checkpoint.BackendAzureBlob {
+		return checkpoint.New(env.CheckpointBackend, namespace, prefix, holder, leaseDuration,
+			env.CheckpointOwner.References()...)
+	}
+
+	containerURL := "https://" + env.CheckpointBlobAccount + ".blob.core.windows.net/" + env.CheckpointBlobContainer
+
+	var cc *azblob.ContainerClient
+
+	if env.CheckpointBlobAccountKey != "" {
+		cred, err := azblob.NewSharedKeyCredential(env.CheckpointBlobAccount, env.CheckpointBlobAccountKey)
+		if err != nil {
+			return nil, nil, fmt.Errorf("creating Storage shared key credential: %w", err)
+		}
+		if cc, err = azblob.NewContainerClientWithSharedKey(containerURL, cred, nil); err != nil {
+			return nil, nil, fmt.Errorf("creating Storage container client: %w", err)
+		}
+	} else {
+		// AAD authentication (service principal)
+		cred, err := azidentity.NewDefaultAzureCredential(nil)
+		if err != nil {
+			return nil, nil, fmt.Errorf("creating Azure credentials: %w", err)
+		}
+		if cc, err = azblob.NewContainerClient(containerURL, cred, nil); err != nil {
+			return nil, nil, fmt.Errorf("creating Storage container client: %w", err)
+		}
+	}
+
+	st, ls := checkpoint.NewAzureBlob(cc, prefix, holder, leaseDuration)
+	return st, ls, nil
+}
+
 // Start implements adapter.Adapter.
+//
+// The adapter periodically balances the partitions of the Event Hub between
+// the replicas of the adapter, and receives events from each partition it
+// is able to acquire a lease for. The position of the last event handled in
+// each partition is recorded as a checkpoint, from which the consumption of
+// the partition is resumed after a restart or a change of ownership.
 func (a *adapter) Start(ctx context.Context) error {
 	go health.Start(ctx)
 
@@ -168,7 +285,10 @@ func (a *adapter) Start(ctx context.Context) error {
 	}
 	a.runtimeInfo = runtimeInfo
 
-	a.logger.Info("Starting Event Hub message receivers for partitions ", runtimeInfo.PartitionIDs)
+	a.logger.Infow("Balancing Event Hub partitions between replicas",
+		zap.Strings("partitions", runtimeInfo.PartitionIDs),
+		zap.String("consumerGroup", a.consumerGroup),
+		zap.String("holder", a.holder))
 
 	// TODO(antoineco): Find a way to inject Prometheus metric tags into
 	// the context.Context that is passed to handleMessage().
@@ -176,38 +296,28 @@ func (a *adapter) Start(ctx context.Context) error {
 	// https://github.com/Azure/azure-event-hubs-go/blob/v3.3.17/receiver.go#L219
 	ctx = pkgadapter.ContextWithMetricTag(ctx, a.mt)
 
-	// listen to each partition of the Event Hub
-	for _, partitionID := range runtimeInfo.PartitionIDs {
-		connCtx, cancel := context.WithTimeout(ctx, connTimeout)
-		_, err := a.ehClient.Receive(connCtx, partitionID, a.handleMessage, eventhub.ReceiveWithLatestOffset())
-		cancel()
-		if err != nil {
-			a.logger.Errorw("An error occurred while starting message receivers. "+
-				"Terminating all active receivers", zap.Error(err))
-
-			closeCtx, cancel := context.WithTimeout(ctx, drainTimeout)
-			defer cancel()
-			if err := a.ehClient.Close(closeCtx); err != nil {
-				a.logger.Errorw("An additional error occurred while terminating active "+
-					"Event Hub message receivers", zap.Error(err))
-			}
+	consumers := make(map[string]*partitionConsumer)
 
-			return fmt.Errorf("starting message receiver for partition %s: %w", partitionID, err)
-		}
-	}
+	defer func() {
+		a.logger.Debug("Terminating all active Event Hub message receivers")
+		a.stopAllConsumers(consumers)
+	}()
+
+	a.balancePartitions(ctx, consumers)
 
 	health.MarkReady()
 
-	<-ctx.Done()
-	a.logger.Debug("Terminating all active Event Hub message receivers")
+	t := time.NewTicker(balanceInterval)
+	defer t.Stop()
 
-	closeCtx, cancel := context.WithTimeout(context.Background(), drainTimeout)
-	defer cancel()
-	if err := a.ehClient.Close(closeCtx); err != nil {
-		return fmt.Errorf("terminating active Event Hub message receivers: %w", err)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-t.C:
+			a.balancePartitions(ctx, consumers)
+		}
 	}
-
-	return nil
 }
 
 // handleMessage satisfies eventhub.Handler.
@@ -221,28 +331,49 @@ func (a *adapter) handleMessage(ctx context.Context, msg *eventhub.Event) error
 		return fmt.Errorf("processing Event Hubs message with ID %s: %w", msg.ID, err)
 	}
 
-	var sendErrs errList
-
 	for _, ev := range events {
 		if err := ev.Validate(); err != nil {
 			ev = sanitizeEvent(err.(event.ValidationError), ev)
 		}
 
-		if err := sendCloudEvent(ctx, a.ceClient, ev); err != nil {
-			sendErrs.errs = append(sendErrs.errs,
-				fmt.Errorf("failed to send event with ID %s: %w", ev.ID(), err),
-			)
-			continue
+		if err := a.sendCloudEventWithRetry(ctx, ev); err != nil {
+			return fmt.Errorf("sending event with ID %s to the sink: %w", ev.ID(), err)
 		}
 	}
 
-	if len(sendErrs.errs) != 0 {
-		return fmt.Errorf("sending events to the sink: %w", sendErrs)
-	}
-
 	return nil
 }
 
+// sendCloudEventWithRetry sends a single CloudEvent to the event sink, and
+// retries with an exponential backoff until it is acknowledged. It returns an
+// error only if the context is cancelled before that happens.
+//
+// Event Hubs does not redeliver events which fail to be handled, and the
+// offset of the next event handled successfully would be recorded past the
+// failed one. Blocking the receiver of the partition until the event is
+// delivered ensures that no event is skipped.
+func (a *adapter) sendCloudEventWithRetry(ctx context.Context, ev *cloudevents.Event) error {
+	backoff := common.NewBackoff()
+
+	for {
+		err := sendCloudEvent(ctx, a.ceClient, ev)
+		if err == nil {
+			return nil
+		}
+
+		delay := backoff.Duration()
+
+		a.logger.Errorw("Failed to send event with ID "+ev.ID()+", retrying in "+delay.String(),
+			zap.Error(err))
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+}
+
 // sendCloudEvent sends a single CloudEvent to the event sink.
 func sendCloudEvent(ctx context.Context, cli cloudevents.Client, event *cloudevents.Event) protocol.Result {
 	if result := cli.Send(ctx, *event); !cloudevents.IsACK(result) {
@@ -251,21 +382,6 @@ func sendCloudEvent(ctx context.Context, cli cloudevents.Client, event *cloudeve
 	return nil
 }
 
-// errList is an aggregate of errors.
-type errList struct {
-	errs []error
-}
-
-var _ error = (*errList)(nil)
-
-// Error implements the error interface.
-func (e errList) Error() string {
-	if len(e.errs) == 0 {
-		return ""
-	}
-	return fmt.Sprintf("%q", e.errs)
-}
-
 // sanitizeEvent tries to fix the validation issues listed in the given
 // cloudevents.ValidationError, and returns a sanitized version of the event.
 //
@@ -0,0 +1,360 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azureeventhubsource
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	eventhub "github.com/Azure/azure-event-hubs-go/v3"
+	"github.com/Azure/azure-event-hubs-go/v3/persist"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+)
+
+const (
+	// Interval at which partitions are balanced between replicas, and
+	// checkpoints are recorded.
+	balanceInterval = 10 * time.Second
+
+	// Number of balancing intervals after which a lease expires if it
+	// isn't renewed.
+	leaseDurationIntervals = 3
+
+	// Maximum duration of the release of leases upon termination.
+	leaseReleaseTimeout = 5 * time.Second
+
+	// Prefixes of the names of the leases which signal the membership of a
+	// replica, and the ownership of a partition.
+	replicaLeasePrefix   = "replica-"
+	partitionLeasePrefix = "partition-"
+)
+
+// listener is a handle to a running receiver of a partition.
+//
+// Implemented by *eventhub.ListenerHandle.
+type listener interface {
+	Close(context.Context) error
+	Done() <-chan struct{}
+}
+
+var _ listener = (*eventhub.ListenerHandle)(nil)
+
+// receiveFunc starts receiving events from the given partition.
+type receiveFunc func(ctx context.Context, partitionID string, h eventhub.Handler,
+	opts ...eventhub.ReceiveOption) (listener, error)
+
+// partitionConsumer is a handle to a running consumer of a partition.
+type partitionConsumer struct {
+	partitionID string
+	listener    listener
+
+	mu sync.Mutex
+	// Offset of the last event handled successfully.
+	offset string
+	// Offset which was last recorded as a checkpoint.
+	savedOffset string
+}
+
+// setOffset records the offset of the last event handled successfully.
+func (c *partitionConsumer) setOffset(offset string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.offset = offset
+}
+
+// unsavedOffset returns the offset of the last event handled successfully if
+// it wasn't yet recorded as a checkpoint.
+func (c *partitionConsumer) unsavedOffset() (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.offset, c.offset != c.savedOffset
+}
+
+// markSaved marks the given offset as recorded.
+func (c *partitionConsumer) markSaved(offset string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.savedOffset = offset
+}
+
+// balancePartitions renews the leases of the partitions which are being
+// consumed and records their checkpoints, then adjusts the number of
+// consumed partitions to the fair share of this replica, based on the number
+// of replicas which are currently alive.
+//
+// Excess partitions are released so that other replicas can acquire them.
+// No partition is ever taken over from another replica before its lease was
+// released or has expired.
+func (a *adapter) balancePartitions(ctx context.Context, consumers map[string]*partitionConsumer) {
+	if _, err := a.leaser.Acquire(ctx, replicaLeasePrefix+a.holder); err != nil {
+		a.logger.Errorw("Failed to renew lease of replica "+a.holder, zap.Error(err))
+	}
+
+	for partitionID, c := range consumers {
+		select {
+		case <-c.listener.Done():
+			// the lease is kept, the partition is consumed again
+			// from its checkpoint below
+			a.logger.Warnw("Receiver of partition " + partitionID + " stopped unexpectedly")
+			a.stopConsumer(c, false)
+			delete(consumers, partitionID)
+			continue
+		default:
+		}
+
+		acquired, err := a.leaser.Acquire(ctx, partitionLeasePrefix+partitionID)
+		switch {
+		case err != nil:
+			// keep consuming, the lease may still be renewed
+			// before it expires
+			a.logger.Errorw("Failed to renew lease of partition "+partitionID, zap.Error(err))
+		case !acquired:
+			a.logger.Infow("Lost lease of partition " + partitionID)
+			a.stopConsumer(c, false)
+			delete(consumers, partitionID)
+			continue
+		}
+
+		a.saveCheckpoint(ctx, c)
+	}
+
+	holders, err := a.leaser.Holders(ctx)
+	if err != nil {
+		a.logger.Errorw("Failed to list leases", zap.Error(err))
+		return
+	}
+
+	maxOwned := ownershipLimit(holders, a.holder, len(a.runtimeInfo.PartitionIDs))
+
+	if excess := len(consumers) - maxOwned; excess > 0 {
+		partitionIDs := make([]string, 0, len(consumers))
+		for partitionID := range consumers {
+			partitionIDs = append(partitionIDs, partitionID)
+		}
+		sort.Strings(partitionIDs)
+
+		for _, partitionID := range partitionIDs[:excess] {
+			a.logger.Infow("Releasing partition " + partitionID + " to another replica")
+			a.stopConsumer(consumers[partitionID], true)
+			delete(consumers, partitionID)
+		}
+		return
+	}
+
+	for _, partitionID := range a.runtimeInfo.PartitionIDs {
+		if len(consumers) >= maxOwned {
+			return
+		}
+
+		if _, isConsumed := consumers[partitionID]; isConsumed {
+			continue
+		}
+		if h, isHeld := holders[partitionLeasePrefix+partitionID]; isHeld && h != a.holder {
+			continue
+		}
+
+		acquired, err := a.leaser.Acquire(ctx, partitionLeasePrefix+partitionID)
+		if err != nil {
+			a.logger.Errorw("Failed to acquire lease of partition "+partitionID, zap.Error(err))
+			continue
+		}
+		if !acquired {
+			continue
+		}
+
+		c, err := a.startConsumer(ctx, partitionID)
+		if err != nil {
+			a.logger.Errorw("Failed to start receiver for partition "+partitionID, zap.Error(err))
+			a.releaseLease(partitionLeasePrefix + partitionID)
+			continue
+		}
+		consumers[partitionID] = c
+	}
+}
+
+// ownershipLimit returns the maximum number of partitions the given replica
+// should own, given the current holders of all leases.
+//
+// Each replica is entitled to its fair share of partitions, rounded up. The
+// share is rounded down instead while some replica owns fewer partitions than
+// the rounded down share, so that replicas which just joined are able to
+// acquire partitions.
+func ownershipLimit(holders map[string]string, self string, numPartitions int) int {
+	replicas := map[string]struct{}{
+		self: {},
+	}
+	owned := make(map[string]int)
+
+	for leaseName, holder := range holders {
+		switch {
+		case strings.HasPrefix(leaseName, replicaLeasePrefix):
+			replicas[holder] = struct{}{}
+		case strings.HasPrefix(leaseName, partitionLeasePrefix):
+			owned[holder]++
+		}
+	}
+
+	minShare := numPartitions / len(replicas)
+	maxShare := minShare
+	if numPartitions%len(replicas) != 0 {
+		maxShare++
+	}
+
+	for replica := range replicas {
+		if owned[replica] < minShare {
+			return minShare
+		}
+	}
+
+	return maxShare
+}
+
+// startConsumer starts receiving events from the given partition, from its
+// last checkpoint if one was recorded, or from the configured start position
+// otherwise.
+func (a *adapter) startConsumer(ctx context.Context, partitionID string) (*partitionConsumer, error) {
+	cp, err := a.checkpoints.Get(ctx, partitionID)
+	if err != nil {
+		return nil, err
+	}
+
+	a.logger.Infow("Starting receiver for partition "+partitionID, zap.String("checkpoint", cp))
+
+	c := &partitionConsumer{
+		partitionID: partitionID,
+		offset:      cp,
+		savedOffset: cp,
+	}
+
+	connCtx, cancel := context.WithTimeout(ctx, connTimeout)
+	defer cancel()
+
+	l, err := a.receive(connCtx, partitionID, a.partitionHandler(c), a.receiveOptions(cp)...)
+	if err != nil {
+		return nil, err
+	}
+	c.listener = l
+
+	return c, nil
+}
+
+// receiveOptions returns the options of a receiver which starts after the
+// given checkpoint.
+func (a *adapter) receiveOptions(cp string) []eventhub.ReceiveOption {
+	opts := []eventhub.ReceiveOption{
+		eventhub.ReceiveWithConsumerGroup(a.consumerGroup),
+	}
+
+	switch {
+	case cp != "":
+		opts = append(opts, eventhub.ReceiveWithStartingOffset(cp))
+	case a.startPosition == v1alpha1.AzureEventHubStartPositionEarliest:
+		opts = append(opts, eventhub.ReceiveWithStartingOffset(persist.StartOfStream))
+	case a.startPosition == v1alpha1.AzureEventHubStartPositionEnqueuedTime:
+		opts = append(opts, eventhub.ReceiveFromTimestamp(a.startTime))
+	default:
+		opts = append(opts, eventhub.ReceiveWithLatestOffset())
+	}
+
+	return opts
+}
+
+// partitionHandler returns an eventhub.Handler which handles the events of
+// the partition consumed by the given consumer, and records the offset of
+// each event handled successfully.
+// The handler blocks until the events are delivered or the receiver is
+// closed, in which case the offset is not recorded and the partition is
+// consumed again from its last checkpoint.
+func (a *adapter) partitionHandler(c *partitionConsumer) eventhub.Handler {
+	return func(ctx context.Context, msg *eventhub.Event) error {
+		if err := a.handleMessage(ctx, msg); err != nil {
+			return err
+		}
+
+		if msg != nil && msg.SystemProperties != nil && msg.SystemProperties.Offset != nil {
+			c.setOffset(strconv.FormatInt(*msg.SystemProperties.Offset, 10))
+		}
+		return nil
+	}
+}
+
+// saveCheckpoint records the offset of the last event handled by the given
+// consumer, if it changed since the last checkpoint.
+func (a *adapter) saveCheckpoint(ctx context.Context, c *partitionConsumer) {
+	offset, unsaved := c.unsavedOffset()
+	if !unsaved {
+		return
+	}
+
+	if err := a.checkpoints.Set(ctx, c.partitionID, offset); err != nil {
+		a.logger.Errorw("Failed to record checkpoint of partition "+c.partitionID, zap.Error(err))
+		return
+	}
+	c.markSaved(offset)
+}
+
+// stopConsumer stops the receiver of the given consumer and records its last
+// checkpoint. The lease of the partition is released if requested, so that
+// another replica can take over the partition without waiting for the lease
+// to expire.
+func (a *adapter) stopConsumer(c *partitionConsumer, release bool) {
+	ctx, cancel := context.WithTimeout(context.Background(), drainTimeout)
+	defer cancel()
+
+	if err := c.listener.Close(ctx); err != nil {
+		a.logger.Debugw("Error closing receiver of partition "+c.partitionID, zap.Error(err))
+	}
+
+	a.saveCheckpoint(ctx, c)
+
+	if release {
+		a.releaseLease(partitionLeasePrefix + c.partitionID)
+	}
+}
+
+// stopAllConsumers stops all the given consumers and releases their leases,
+// as well as the lease of the replica.
+func (a *adapter) stopAllConsumers(consumers map[string]*partitionConsumer) {
+	var wg sync.WaitGroup
+	for _, c := range consumers {
+		wg.Add(1)
+		go func(c *partitionConsumer) {
+			defer wg.Done()
+			a.stopConsumer(c, true)
+		}(c)
+	}
+	wg.Wait()
+
+	a.releaseLease(replicaLeasePrefix + a.holder)
+}
+
+// releaseLease releases the lease with the given name.
+func (a *adapter) releaseLease(name string) {
+	ctx, cancel := context.WithTimeout(context.Background(), leaseReleaseTimeout)
+	defer cancel()
+
+	if err := a.leaser.Release(ctx, name); err != nil {
+		a.logger.Errorw("Failed to release lease "+name, zap.Error(err))
+	}
+}
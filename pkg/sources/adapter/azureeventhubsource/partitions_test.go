@@ -0,0 +1,306 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azureeventhubsource
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/protocol"
+	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
+
+	eventhub "github.com/Azure/azure-event-hubs-go/v3"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+
+	adaptertest "knative.dev/eventing/pkg/adapter/v2/test"
+	loggingtesting "knative.dev/pkg/logging/testing"
+
+	"github.com/triggermesh/triggermesh/pkg/sources/adapter/common/checkpoint"
+)
+
+var tPartitionIDs = []string{"0", "1", "2", "3"}
+
+func TestBalancePartitions(t *testing.T) {
+	ctx := context.Background()
+
+	leases := checkpoint.NewMemoryLeases()
+	store := checkpoint.NewMemoryStore()
+
+	a, aConsumers := newBalancingAdapter(t, "replica-a", leases, store)
+	b, bConsumers := newBalancingAdapter(t, "replica-b", leases, store)
+
+	// a single replica consumes all partitions
+	a.balancePartitions(ctx, aConsumers)
+	assert.Equal(t, tPartitionIDs, consumedPartitions(aConsumers))
+
+	// events are handled by the consumer of partition "0"
+	handle := a.partitionHandler(aConsumers["0"])
+	require.NoError(t, handle(ctx, newEvent(41)))
+	require.NoError(t, handle(ctx, newEvent(42)))
+
+	// a new replica joins, but all partitions are still owned
+	b.balancePartitions(ctx, bConsumers)
+	assert.Empty(t, bConsumers)
+
+	// the first replica releases its excess partitions
+	a.balancePartitions(ctx, aConsumers)
+	assert.Equal(t, []string{"2", "3"}, consumedPartitions(aConsumers))
+
+	cp, err := store.Get(ctx, "0")
+	require.NoError(t, err)
+	assert.Equal(t, "42", cp, "The checkpoint of a released partition should be recorded")
+
+	// the new replica acquires the released partitions
+	b.balancePartitions(ctx, bConsumers)
+	assert.Equal(t, []string{"0", "1"}, consumedPartitions(bConsumers))
+	assert.Equal(t, "42", bConsumers["0"].offset, "Consumption should resume from the checkpoint")
+
+	// the ownership remains stable
+	a.balancePartitions(ctx, aConsumers)
+	b.balancePartitions(ctx, bConsumers)
+	assert.Equal(t, []string{"2", "3"}, consumedPartitions(aConsumers))
+	assert.Equal(t, []string{"0", "1"}, consumedPartitions(bConsumers))
+
+	// a replica terminates and releases its leases
+	b.stopAllConsumers(bConsumers)
+	a.balancePartitions(ctx, aConsumers)
+	assert.Equal(t, tPartitionIDs, consumedPartitions(aConsumers))
+}
+
+func TestBalancePartitionsStoppedReceiver(t *testing.T) {
+	ctx := context.Background()
+
+	a, consumers := newBalancingAdapter(t, "replica-a",
+		checkpoint.NewMemoryLeases(), checkpoint.NewMemoryStore())
+
+	a.balancePartitions(ctx, consumers)
+	require.Contains(t, consumers, "0")
+
+	stopped := consumers["0"]
+	stopped.listener.(*fakeListener).stop()
+
+	a.balancePartitions(ctx, consumers)
+	assert.Equal(t, tPartitionIDs, consumedPartitions(consumers))
+	assert.NotSame(t, stopped, consumers["0"], "The stopped receiver should be restarted")
+	assert.True(t, stopped.listener.(*fakeListener).closed)
+}
+
+func TestPartitionHandlerSendFailure(t *testing.T) {
+	a, _ := newBalancingAdapter(t, "replica-a",
+		checkpoint.NewMemoryLeases(), checkpoint.NewMemoryStore())
+
+	ceClient := &flakyCEClient{
+		TestCloudEventsClient: adaptertest.NewTestClient(),
+		failures:              1,
+	}
+	a.ceClient = ceClient
+
+	c := &partitionConsumer{partitionID: "0", offset: "41"}
+	handle := a.partitionHandler(c)
+
+	// the event is sent again until the sink acknowledges it
+	require.NoError(t, handle(context.Background(), newEvent(42)))
+	assert.Len(t, ceClient.Sent(), 1)
+	assert.Equal(t, "42", c.offset)
+
+	// the receiver is closed while the event can't be delivered, the offset
+	// must not move past the undelivered event
+	ceClient.failures = -1
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	assert.ErrorIs(t, handle(ctx, newEvent(43)), context.DeadlineExceeded)
+	assert.Equal(t, "42", c.offset)
+}
+
+func TestOwnershipLimit(t *testing.T) {
+	testCases := map[string]struct {
+		holders       map[string]string
+		numPartitions int
+		expectLimit   int
+	}{
+		"Single replica": {
+			holders:       map[string]string{},
+			numPartitions: 4,
+			expectLimit:   4,
+		},
+		"Even share": {
+			holders: map[string]string{
+				"replica-a":   "a",
+				"replica-b":   "b",
+				"partition-0": "a",
+				"partition-1": "b",
+			},
+			numPartitions: 4,
+			expectLimit:   2,
+		},
+		"Uneven share": {
+			holders: map[string]string{
+				"replica-a":   "a",
+				"replica-b":   "b",
+				"replica-c":   "c",
+				"partition-0": "a",
+				"partition-1": "b",
+				"partition-2": "c",
+			},
+			numPartitions: 4,
+			expectLimit:   2,
+		},
+		"Starved replica": {
+			holders: map[string]string{
+				"replica-a":   "a",
+				"replica-b":   "b",
+				"replica-c":   "c",
+				"partition-0": "a",
+				"partition-1": "a",
+				"partition-2": "b",
+				"partition-3": "b",
+			},
+			numPartitions: 4,
+			expectLimit:   1,
+		},
+		"More replicas than partitions": {
+			holders: map[string]string{
+				"replica-a":   "a",
+				"replica-b":   "b",
+				"replica-c":   "c",
+				"partition-0": "b",
+				"partition-1": "c",
+			},
+			numPartitions: 2,
+			expectLimit:   1,
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			limit := ownershipLimit(tc.holders, "a", tc.numPartitions)
+			assert.Equal(t, tc.expectLimit, limit)
+		})
+	}
+}
+
+// newBalancingAdapter returns an adapter which acquires leases on behalf of
+// the given holder, and receives events using fake listeners.
+func newBalancingAdapter(t *testing.T, holder string, leases *checkpoint.MemoryLeases,
+	store checkpoint.Store) (*adapter, map[string]*partitionConsumer) {
+
+	a := &adapter{
+		logger: loggingtesting.TestLogger(t),
+		runtimeInfo: &eventhub.HubRuntimeInformation{
+			Path:         "testHub",
+			PartitionIDs: tPartitionIDs,
+		},
+		ceClient: adaptertest.NewTestClient(),
+		msgPrcsr: &defaultMessageProcessor{
+			ceSource: "fake.source",
+			ceType:   "fake.type",
+		},
+		receive: func(context.Context, string, eventhub.Handler, ...eventhub.ReceiveOption) (listener, error) {
+			return newFakeListener(), nil
+		},
+		checkpoints: store,
+		leaser:      leases.Leaser(holder, time.Minute),
+		holder:      holder,
+	}
+
+	return a, make(map[string]*partitionConsumer)
+}
+
+// consumedPartitions returns the sorted IDs of the given consumed partitions.
+func consumedPartitions(consumers map[string]*partitionConsumer) []string {
+	ids := make([]string, 0, len(consumers))
+	for id := range consumers {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// newEvent returns a test event with the given offset.
+func newEvent(offset int64) *eventhub.Event {
+	return &eventhub.Event{
+		ID:   "someMessageID",
+		Data: []byte(`{"test": null}`),
+		SystemProperties: &eventhub.SystemProperties{
+			SequenceNumber: to.Ptr(offset),
+			Offset:         to.Ptr(offset),
+		},
+	}
+}
+
+// fakeListener is a listener which runs until it is closed or stopped.
+type fakeListener struct {
+	done   chan struct{}
+	closed bool
+}
+
+var _ listener = (*fakeListener)(nil)
+
+func newFakeListener() *fakeListener {
+	return &fakeListener{
+		done: make(chan struct{}),
+	}
+}
+
+// Close implements listener.
+func (l *fakeListener) Close(context.Context) error {
+	if !l.closed {
+		l.closed = true
+		l.stop()
+	}
+	return nil
+}
+
+// Done implements listener.
+func (l *fakeListener) Done() <-chan struct{} {
+	return l.done
+}
+
+// stop simulates the unexpected termination of the receiver.
+func (l *fakeListener) stop() {
+	select {
+	case <-l.done:
+	default:
+		close(l.done)
+	}
+}
+
+// flakyCEClient is a CloudEvents client which fails to send a given number of
+// events before succeeding, or all events if that number is negative.
+type flakyCEClient struct {
+	*adaptertest.TestCloudEventsClient
+	failures int
+}
+
+// Send implements cloudevents.Client.
+func (c *flakyCEClient) Send(ctx context.Context, e cloudevents.Event) protocol.Result {
+	if c.failures != 0 {
+		c.failures--
+		return cehttp.NewResult(http.StatusServiceUnavailable, "%w", protocol.ResultNACK)
+	}
+	return c.TestCloudEventsClient.Send(ctx, e)
+}
@@ -71,6 +71,24 @@ type envConfig struct {
 	// Supported values: [ default ]
 	MessageProcessor string `envconfig:"SERVICEBUS_MESSAGE_PROCESSOR" default:"default"`
 
+	// Whether the Service Bus entity requires sessions.
+	SessionsEnabled bool `envconfig:"SERVICEBUS_SESSIONS_ENABLED"`
+	// Maximum number of messages (or sessions, when sessions are enabled)
+	// processed concurrently.
+	MaxConcurrency int `envconfig:"SERVICEBUS_MAX_CONCURRENCY" default:"1"`
+	// Maximum number of messages received from the Service Bus entity in
+	// a single batch.
+	PrefetchCount int `envconfig:"SERVICEBUS_PREFETCH_COUNT" default:"100"`
+
+	// Settlement action applied to messages which could not be delivered
+	// to the sink.
+	//
+	// Supported values: [ abandon deadLetter defer ]
+	SettlementOnFailure string `envconfig:"SERVICEBUS_SETTLEMENT_ON_FAILURE" default:"abandon"`
+	// Number of delivery attempts after which a message is dead-lettered.
+	// 0 leaves dead-lettering to the Service Bus entity.
+	MaxDeliveryAttempts uint32 `envconfig:"SERVICEBUS_MAX_DELIVERY_ATTEMPTS" default:"10"`
+
 	// The environment variables below aren't read from the envConfig struct
 	// by the Service Bus SDK, but rather directly using os.Getenv().
 	// They are nevertheless listed here for documentation purposes.
@@ -86,10 +104,20 @@ type envConfig struct {
 type adapter struct {
 	mt *pkgadapter.MetricTag
 
-	msgRcvr  *azservicebus.Receiver
+	// only one of msgRcvr or acceptSession is set, depending on whether
+	// the Service Bus entity requires sessions
+	msgRcvr       messageReceiver
+	acceptSession sessionAcceptor
+
 	ceClient cloudevents.Client
 
 	msgPrcsr MessageProcessor
+
+	maxConcurrency int
+	prefetchCount  int
+
+	settlement settlementPolicy
+	deferred   *deferredMessages
 }
 
 // NewEnvConfig satisfies pkgadapter.EnvConfigConstructor.
@@ -118,19 +146,69 @@ func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClie
 		logger.Panicw("Unable to obtain interface for Service Bus Namespace", zap.Error(err))
 	}
 
-	var rcvr *azservicebus.Receiver
+	var rcvr messageReceiver
+	var acceptSession sessionAcceptor
+
 	switch entityID.ResourceType {
 	case resourceTypeQueues:
-		rcvr, err = client.NewReceiverForQueue(entityID.ResourceName, nil)
 		mt.ResourceGroup = sources.AzureServiceBusQueueSourceResource.String()
+		if env.SessionsEnabled {
+			acceptSession = func(ctx context.Context) (messageReceiver, error) {
+				r, err := client.AcceptNextSessionForQueue(ctx, entityID.ResourceName, nil)
+				if err != nil {
+					return nil, err
+				}
+				return r, nil
+			}
+			break
+		}
+		rcvr, err = client.NewReceiverForQueue(entityID.ResourceName, nil)
+
 	case resourceTypeSubscriptions, resourceTypeTopics:
-		rcvr, err = client.NewReceiverForSubscription(entityID.ResourceName, entityID.SubResourceName, nil)
 		mt.ResourceGroup = sources.AzureServiceBusTopicSourceResource.String()
+		if env.SessionsEnabled {
+			acceptSession = func(ctx context.Context) (messageReceiver, error) {
+				r, err := client.AcceptNextSessionForSubscription(ctx, entityID.ResourceName, entityID.SubResourceName, nil)
+				if err != nil {
+					return nil, err
+				}
+				return r, nil
+			}
+			break
+		}
+		rcvr, err = client.NewReceiverForSubscription(entityID.ResourceName, entityID.SubResourceName, nil)
 	}
 	if err != nil {
 		logger.Panicw("Unable to obtain message receiver for Service Bus entity "+strconv.Quote(strconv.Quote(entityPath(entityID))), zap.Error(err))
 	}
 
+	if env.MaxConcurrency < 1 {
+		logger.Panic("The maximum concurrency must be greater than 0")
+	}
+	if env.PrefetchCount < 1 {
+		logger.Panic("The prefetch count must be greater than 0")
+	}
+
+	settlement := settlementPolicy{
+		onFailure:           v1alpha1.AzureServiceBusSettlementAction(env.SettlementOnFailure),
+		maxDeliveryAttempts: env.MaxDeliveryAttempts,
+	}
+
+	var deferred *deferredMessages
+	switch settlement.onFailure {
+	case v1alpha1.AzureServiceBusSettlementAbandon, v1alpha1.AzureServiceBusSettlementDeadLetter:
+	case v1alpha1.AzureServiceBusSettlementDefer:
+		// Deferred messages of a session can only be received by the
+		// receiver which holds the lock on that session.
+		if env.SessionsEnabled {
+			logger.Panic("The settlement action " + strconv.Quote(env.SettlementOnFailure) +
+				" is not supported by session-enabled entities")
+		}
+		deferred = newDeferredMessages()
+	default:
+		logger.Panic("Unsupported settlement action " + strconv.Quote(env.SettlementOnFailure))
+	}
+
 	ceSource := env.EntityResourceID
 
 	var msgPrcsr MessageProcessor
@@ -152,8 +230,15 @@ func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClie
 
 		ceClient: ceClient,
 
-		msgRcvr:  rcvr,
-		msgPrcsr: msgPrcsr,
+		msgRcvr:       rcvr,
+		acceptSession: acceptSession,
+		msgPrcsr:      msgPrcsr,
+
+		maxConcurrency: env.MaxConcurrency,
+		prefetchCount:  env.PrefetchCount,
+
+		settlement: settlement,
+		deferred:   deferred,
 	}
 }
 
@@ -253,39 +338,14 @@ func connectionStringFromEnvironment(namespace, entityPath string) string {
 //  Both (DataAction):
 //  - Microsoft.ServiceBus/namespaces/messages/receive/action
 func (a *adapter) Start(ctx context.Context) error {
-	const maxMessages = 100
 	logging.FromContext(ctx).Info("Listening for messages")
 
 	ctx = pkgadapter.ContextWithMetricTag(ctx, a.mt)
 
-loop:
-	for {
-		select {
-		case <-ctx.Done():
-			break loop
-		default:
-			messages, err := a.msgRcvr.ReceiveMessages(ctx, maxMessages, nil)
-			if err != nil {
-				return fmt.Errorf("error receiving messages: %w", err)
-			}
-			for _, m := range messages {
-				msg, err := toMessage(m)
-				if err != nil {
-					return fmt.Errorf("error transforming message: %w", err)
-				}
-
-				if err := a.handleMessage(ctx, msg); err != nil {
-					return fmt.Errorf("error processing message: %w", err)
-				}
-
-				if err := a.msgRcvr.CompleteMessage(ctx, m, nil); err != nil {
-					return fmt.Errorf("error completing message: %w", err)
-				}
-			}
-		}
+	if a.acceptSession != nil {
+		return a.receiveSessions(ctx)
 	}
-
-	return nil
+	return a.receive(ctx)
 }
 
 // handleMessage handles a single Service Bus message.
@@ -296,7 +356,9 @@ func (a *adapter) handleMessage(ctx context.Context, msg *Message) error {
 
 	events, err := a.msgPrcsr.Process(msg)
 	if err != nil {
-		return fmt.Errorf("processing Service Bus message with ID %s: %w", msg.ReceivedMessage.MessageID, err)
+		return processingError{
+			fmt.Errorf("processing Service Bus message with ID %s: %w", msg.ReceivedMessage.MessageID, err),
+		}
 	}
 
 	var sendErrs errList
@@ -0,0 +1,211 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azureservicebussource
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"knative.dev/pkg/logging"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/messaging/azservicebus"
+)
+
+const (
+	// sessionIdleTimeout is the duration after which a session which has
+	// no message available is released, so that another session can be
+	// accepted.
+	sessionIdleTimeout = 10 * time.Second
+	// sessionRetryInterval is the interval at which the acceptance of a
+	// session is retried after a failure.
+	sessionRetryInterval = 5 * time.Second
+	// closeTimeout is the maximum duration of the closing of a receiver.
+	closeTimeout = 10 * time.Second
+)
+
+// messageReceiver receives and settles Service Bus messages.
+//
+// Implemented by azservicebus.Receiver and azservicebus.SessionReceiver.
+type messageReceiver interface {
+	ReceiveMessages(ctx context.Context, maxMessages int,
+		options *azservicebus.ReceiveMessagesOptions) ([]*azservicebus.ReceivedMessage, error)
+	ReceiveDeferredMessages(ctx context.Context, sequenceNumbers []int64,
+		options *azservicebus.ReceiveDeferredMessagesOptions) ([]*azservicebus.ReceivedMessage, error)
+
+	CompleteMessage(context.Context, *azservicebus.ReceivedMessage, *azservicebus.CompleteMessageOptions) error
+	AbandonMessage(context.Context, *azservicebus.ReceivedMessage, *azservicebus.AbandonMessageOptions) error
+	DeferMessage(context.Context, *azservicebus.ReceivedMessage, *azservicebus.DeferMessageOptions) error
+	DeadLetterMessage(context.Context, *azservicebus.ReceivedMessage, *azservicebus.DeadLetterOptions) error
+
+	Close(context.Context) error
+}
+
+var (
+	_ messageReceiver = (*azservicebus.Receiver)(nil)
+	_ messageReceiver = (*azservicebus.SessionReceiver)(nil)
+)
+
+// sessionAcceptor accepts the next available session of a session-enabled
+// Service Bus entity.
+type sessionAcceptor func(context.Context) (messageReceiver, error)
+
+// receive receives messages from a Service Bus entity, and processes them
+// concurrently until the context is cancelled.
+func (a *adapter) receive(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	msgs := make(chan *azservicebus.ReceivedMessage, a.prefetchCount)
+
+	var workers sync.WaitGroup
+	for i := 0; i < a.maxConcurrency; i++ {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			for m := range msgs {
+				// messages which remain buffered at the time the
+				// adapter stops are redelivered once their lock expires
+				if ctx.Err() != nil {
+					continue
+				}
+				a.processMessage(ctx, a.msgRcvr, m)
+			}
+		}()
+	}
+
+	var retrier sync.WaitGroup
+	if a.deferred != nil {
+		retrier.Add(1)
+		go func() {
+			defer retrier.Done()
+			a.deferred.run(ctx, a.msgRcvr, msgs)
+		}()
+	}
+
+	err := a.receiveMessages(ctx, msgs)
+
+	cancel()
+	retrier.Wait()
+	close(msgs)
+	workers.Wait()
+
+	return err
+}
+
+// receiveMessages receives batches of messages from the Service Bus entity
+// and pushes them to the given channel until the context is cancelled.
+func (a *adapter) receiveMessages(ctx context.Context, msgs chan<- *azservicebus.ReceivedMessage) error {
+	for {
+		messages, err := a.msgRcvr.ReceiveMessages(ctx, a.prefetchCount, nil)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("error receiving messages: %w", err)
+		}
+
+		for _, m := range messages {
+			select {
+			case msgs <- m:
+			case <-ctx.Done():
+				return nil
+			}
+		}
+	}
+}
+
+// receiveSessions accepts sessions of a session-enabled Service Bus entity,
+// and processes their messages until the context is cancelled.
+// Sessions are processed concurrently, but the messages of a given session
+// are processed sequentially.
+func (a *adapter) receiveSessions(ctx context.Context) error {
+	var workers sync.WaitGroup
+	for i := 0; i < a.maxConcurrency; i++ {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			a.runSessionWorker(ctx)
+		}()
+	}
+	workers.Wait()
+
+	return nil
+}
+
+// runSessionWorker accepts and processes sessions one at a time until the
+// context is cancelled.
+func (a *adapter) runSessionWorker(ctx context.Context) {
+	logger := logging.FromContext(ctx)
+
+	for ctx.Err() == nil {
+		rcvr, err := a.acceptSession(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			// Failures are expected when no session is available
+			// within the server's timeout.
+			logger.Debugw("Unable to accept a session", zap.Error(err))
+
+			select {
+			case <-ctx.Done():
+			case <-time.After(sessionRetryInterval):
+			}
+			continue
+		}
+
+		a.receiveSession(ctx, rcvr)
+
+		closeCtx, cancel := context.WithTimeout(context.Background(), closeTimeout)
+		if err := rcvr.Close(closeCtx); err != nil {
+			logger.Warnw("Error closing session receiver", zap.Error(err))
+		}
+		cancel()
+	}
+}
+
+// receiveSession processes the messages of a single session until the
+// session becomes idle or the context is cancelled.
+func (a *adapter) receiveSession(ctx context.Context, rcvr messageReceiver) {
+	for {
+		rcvCtx, cancel := context.WithTimeout(ctx, sessionIdleTimeout)
+		messages, err := rcvr.ReceiveMessages(rcvCtx, a.prefetchCount, nil)
+		cancel()
+
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			logging.FromContext(ctx).Warnw("Error receiving messages from session", zap.Error(err))
+			return
+		}
+		if len(messages) == 0 {
+			return
+		}
+
+		for _, m := range messages {
+			if ctx.Err() != nil {
+				return
+			}
+			a.processMessage(ctx, rcvr, m)
+		}
+	}
+}
@@ -0,0 +1,364 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azureservicebussource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"knative.dev/pkg/logging"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/messaging/azservicebus"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+)
+
+// Reasons recorded on dead-lettered messages.
+const (
+	deadLetterReasonProcessing     = "ProcessingFailed"
+	deadLetterReasonDelivery       = "DeliveryFailed"
+	deadLetterReasonMaxDeliveries  = "MaxDeliveryAttemptsExceeded"
+	maxDeadLetterDescriptionLength = 1024
+)
+
+const (
+	// Bounds of the delay applied before abandoning a message. The upper
+	// bound must remain below the lock duration of Service Bus entities
+	// (30s by default), since the message lock is held meanwhile.
+	abandonBackoffMin = 1 * time.Second
+	abandonBackoffMax = 16 * time.Second
+
+	// Bounds of the delay applied before retrying the delivery of a
+	// deferred message. No lock is held on deferred messages, which allows
+	// longer delays.
+	deferBackoffMin = 5 * time.Second
+	deferBackoffMax = 5 * time.Minute
+
+	// deferredPollInterval is the interval at which deferred messages are
+	// checked for due retries.
+	deferredPollInterval = time.Second
+)
+
+// settlementPolicy determines how messages which could not be delivered to
+// the sink are settled.
+type settlementPolicy struct {
+	onFailure v1alpha1.AzureServiceBusSettlementAction
+	// 0 disables dead-lettering based on the number of delivery attempts.
+	maxDeliveryAttempts uint32
+}
+
+// processingError indicates that a message could not be converted to
+// CloudEvents. Such messages are never retried.
+type processingError struct {
+	error
+}
+
+// Unwrap allows processingError to be used with errors.Is and errors.As.
+func (e processingError) Unwrap() error {
+	return e.error
+}
+
+// processMessage handles a single Service Bus message and settles it
+// according to the outcome of its delivery:
+//   - messages acknowledged by the sink are completed
+//   - messages which can not be processed are dead-lettered
+//   - messages which exceeded the maximum number of delivery attempts are
+//     dead-lettered
+//   - other messages are settled according to the configured failure action
+//
+// Settlement errors are not fatal. The lock of a message which could not be
+// settled expires eventually, and the message gets redelivered.
+func (a *adapter) processMessage(ctx context.Context, rcvr messageReceiver, m *azservicebus.ReceivedMessage) {
+	logger := logging.FromContext(ctx).With(
+		zap.String("messageID", m.MessageID),
+		zap.Int64p("sequenceNumber", m.SequenceNumber),
+	)
+
+	attempt := m.DeliveryCount
+	if a.deferred != nil {
+		if n, ok := a.deferred.attempts(m); ok {
+			attempt = n + 1
+		}
+	}
+
+	msg, err := toMessage(m)
+	if err != nil {
+		err = processingError{fmt.Errorf("transforming message: %w", err)}
+	} else {
+		err = a.handleMessage(ctx, msg)
+	}
+
+	switch {
+	case err == nil:
+		if err := rcvr.CompleteMessage(ctx, m, nil); err != nil {
+			logger.Errorw("Failed to complete message", zap.Error(err))
+		}
+		a.deferred.forget(m)
+
+	case errors.As(err, &processingError{}):
+		logger.Errorw("Failed to process message, dead-lettering", zap.Error(err))
+		a.deadLetter(ctx, logger, rcvr, m, deadLetterReasonProcessing, err)
+
+	case a.settlement.maxDeliveryAttempts != 0 && attempt >= a.settlement.maxDeliveryAttempts:
+		logger.Errorw("Maximum number of delivery attempts exceeded, dead-lettering",
+			zap.Uint32("attempt", attempt), zap.Error(err))
+		a.deadLetter(ctx, logger, rcvr, m, deadLetterReasonMaxDeliveries, err)
+
+	default:
+		a.settleFailure(ctx, logger, rcvr, m, attempt, err)
+	}
+}
+
+// settleFailure settles a message which could not be delivered to the sink,
+// according to the configured failure action.
+func (a *adapter) settleFailure(ctx context.Context, logger *zap.SugaredLogger, rcvr messageReceiver,
+	m *azservicebus.ReceivedMessage, attempt uint32, cause error) {
+
+	switch a.settlement.onFailure {
+	case v1alpha1.AzureServiceBusSettlementDeadLetter:
+		logger.Errorw("Failed to deliver message, dead-lettering", zap.Error(cause))
+		a.deadLetter(ctx, logger, rcvr, m, deadLetterReasonDelivery, cause)
+
+	case v1alpha1.AzureServiceBusSettlementDefer:
+		delay := backoffDelay(attempt, deferBackoffMin, deferBackoffMax)
+		logger.Warnw("Failed to deliver message, deferring",
+			zap.Uint32("attempt", attempt), zap.Duration("retryIn", delay), zap.Error(cause))
+
+		if err := rcvr.DeferMessage(ctx, m, nil); err != nil {
+			logger.Errorw("Failed to defer message", zap.Error(err))
+			return
+		}
+		a.deferred.add(m, attempt, time.Now().Add(delay))
+
+	default:
+		delay := backoffDelay(attempt, abandonBackoffMin, abandonBackoffMax)
+		logger.Warnw("Failed to deliver message, abandoning",
+			zap.Uint32("attempt", attempt), zap.Duration("backoff", delay), zap.Error(cause))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+
+		if err := rcvr.AbandonMessage(ctx, m, nil); err != nil {
+			logger.Errorw("Failed to abandon message", zap.Error(err))
+		}
+	}
+}
+
+// deadLetter moves the given message to the dead-letter sub-queue of the
+// Service Bus entity.
+func (a *adapter) deadLetter(ctx context.Context, logger *zap.SugaredLogger, rcvr messageReceiver,
+	m *azservicebus.ReceivedMessage, reason string, cause error) {
+
+	desc := cause.Error()
+	if len(desc) > maxDeadLetterDescriptionLength {
+		desc = desc[:maxDeadLetterDescriptionLength]
+	}
+
+	opts := &azservicebus.DeadLetterOptions{
+		Reason:           to.Ptr(reason),
+		ErrorDescription: to.Ptr(desc),
+	}
+
+	if err := rcvr.DeadLetterMessage(ctx, m, opts); err != nil {
+		logger.Errorw("Failed to dead-letter message", zap.Error(err))
+	}
+	a.deferred.forget(m)
+}
+
+// backoffDelay returns an exponential delay for the given delivery attempt,
+// within the given bounds.
+func backoffDelay(attempt uint32, min, max time.Duration) time.Duration {
+	delay := min
+	for i := uint32(1); i < attempt && delay < max; i++ {
+		delay *= 2
+	}
+	if delay > max {
+		delay = max
+	}
+	return delay
+}
+
+// deferredMessages keeps track of messages deferred by the adapter, so that
+// their delivery can be retried.
+//
+// Deferred messages can only be received by sequence number. The state is
+// held in memory, therefore messages deferred by an adapter which stops
+// before retrying them remain deferred in the Service Bus entity until their
+// time-to-live expires.
+type deferredMessages struct {
+	mu   sync.Mutex
+	msgs map[int64]*deferredMessage
+}
+
+// deferredMessage is the retry state of a deferred message.
+type deferredMessage struct {
+	attempts uint32
+	due      time.Time
+	// inFlight indicates that the message was received and is being
+	// processed.
+	inFlight bool
+}
+
+// newDeferredMessages returns an empty deferredMessages.
+func newDeferredMessages() *deferredMessages {
+	return &deferredMessages{
+		msgs: make(map[int64]*deferredMessage),
+	}
+}
+
+// add records the deferral of a message.
+func (d *deferredMessages) add(m *azservicebus.ReceivedMessage, attempts uint32, due time.Time) {
+	if d == nil || m.SequenceNumber == nil {
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.msgs[*m.SequenceNumber] = &deferredMessage{
+		attempts: attempts,
+		due:      due,
+	}
+}
+
+// attempts returns the number of delivery attempts of a deferred message.
+func (d *deferredMessages) attempts(m *azservicebus.ReceivedMessage) (uint32, bool) {
+	if d == nil || m.SequenceNumber == nil {
+		return 0, false
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	dm, ok := d.msgs[*m.SequenceNumber]
+	if !ok {
+		return 0, false
+	}
+	return dm.attempts, true
+}
+
+// forget stops tracking a message which was settled.
+func (d *deferredMessages) forget(m *azservicebus.ReceivedMessage) {
+	if d == nil || m.SequenceNumber == nil {
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	delete(d.msgs, *m.SequenceNumber)
+}
+
+// due returns the sequence numbers of the deferred messages which are due
+// for a retry, and marks them as in flight.
+func (d *deferredMessages) due(now time.Time) []int64 {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	var seqs []int64
+	for seq, dm := range d.msgs {
+		if dm.inFlight || dm.due.After(now) {
+			continue
+		}
+		dm.inFlight = true
+		seqs = append(seqs, seq)
+	}
+	return seqs
+}
+
+// run periodically receives the deferred messages which are due for a retry
+// and pushes them to the given channel, until the context is cancelled.
+func (d *deferredMessages) run(ctx context.Context, rcvr messageReceiver, msgs chan<- *azservicebus.ReceivedMessage) {
+	logger := logging.FromContext(ctx)
+
+	t := time.NewTicker(deferredPollInterval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-t.C:
+			seqs := d.due(now)
+			if len(seqs) == 0 {
+				continue
+			}
+
+			messages, err := rcvr.ReceiveDeferredMessages(ctx, seqs, nil)
+			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				logger.Errorw("Failed to receive deferred messages", zap.Error(err))
+				d.release(seqs)
+				continue
+			}
+
+			d.prune(seqs, messages)
+
+			for _, m := range messages {
+				select {
+				case msgs <- m:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}
+}
+
+// release clears the in-flight flag of the given deferred messages, so that
+// their reception is retried.
+func (d *deferredMessages) release(seqs []int64) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for _, seq := range seqs {
+		if dm, ok := d.msgs[seq]; ok {
+			dm.inFlight = false
+		}
+	}
+}
+
+// prune stops tracking deferred messages which were requested but not
+// received, e.g. because their time-to-live expired.
+func (d *deferredMessages) prune(requested []int64, received []*azservicebus.ReceivedMessage) {
+	recv := make(map[int64]struct{}, len(received))
+	for _, m := range received {
+		if m.SequenceNumber != nil {
+			recv[*m.SequenceNumber] = struct{}{}
+		}
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for _, seq := range requested {
+		if _, ok := recv[seq]; !ok {
+			delete(d.msgs, seq)
+		}
+	}
+}
@@ -0,0 +1,299 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azureservicebussource
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+
+	adaptertest "knative.dev/eventing/pkg/adapter/v2/test"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/messaging/azservicebus"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+)
+
+const tSeqNum int64 = 42
+
+func TestSettleMessage(t *testing.T) {
+	testCases := map[string]struct {
+		onFailure     v1alpha1.AzureServiceBusSettlementAction
+		deliveryCount uint32
+		// number of delivery attempts of a message deferred by the adapter
+		deferredAttempts uint32
+		processErr       error
+		sendFails        bool
+		expectSettlement string
+	}{
+		"Event is acknowledged": {
+			deliveryCount:    1,
+			expectSettlement: "complete",
+		},
+		"Message can not be processed": {
+			deliveryCount:    1,
+			processErr:       assert.AnError,
+			expectSettlement: "deadLetter:" + deadLetterReasonProcessing,
+		},
+		"Delivery fails with abandon action": {
+			onFailure:        v1alpha1.AzureServiceBusSettlementAbandon,
+			deliveryCount:    1,
+			sendFails:        true,
+			expectSettlement: "abandon",
+		},
+		"Delivery fails with deadLetter action": {
+			onFailure:        v1alpha1.AzureServiceBusSettlementDeadLetter,
+			deliveryCount:    1,
+			sendFails:        true,
+			expectSettlement: "deadLetter:" + deadLetterReasonDelivery,
+		},
+		"Delivery fails with defer action": {
+			onFailure:        v1alpha1.AzureServiceBusSettlementDefer,
+			deliveryCount:    1,
+			sendFails:        true,
+			expectSettlement: "defer",
+		},
+		"Delivery fails after max attempts": {
+			onFailure:        v1alpha1.AzureServiceBusSettlementAbandon,
+			deliveryCount:    tMaxDeliveryAttempts,
+			sendFails:        true,
+			expectSettlement: "deadLetter:" + deadLetterReasonMaxDeliveries,
+		},
+		"Delivery of deferred message fails after max attempts": {
+			onFailure:        v1alpha1.AzureServiceBusSettlementDefer,
+			deliveryCount:    1,
+			deferredAttempts: tMaxDeliveryAttempts - 1,
+			sendFails:        true,
+			expectSettlement: "deadLetter:" + deadLetterReasonMaxDeliveries,
+		},
+		"Deferred message is acknowledged": {
+			onFailure:        v1alpha1.AzureServiceBusSettlementDefer,
+			deliveryCount:    1,
+			deferredAttempts: 2,
+			expectSettlement: "complete",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			msgPrcsr := &fakeMessageProcessor{err: tc.processErr}
+			if tc.sendFails {
+				// type of event rejected by the test client
+				msgPrcsr.eventType = "unit.sendFail"
+			}
+
+			a := &adapter{
+				ceClient: adaptertest.NewTestClient(),
+				msgPrcsr: msgPrcsr,
+				settlement: settlementPolicy{
+					onFailure:           tc.onFailure,
+					maxDeliveryAttempts: tMaxDeliveryAttempts,
+				},
+			}
+
+			msg := newReceivedMessage(tc.deliveryCount)
+
+			if tc.onFailure == v1alpha1.AzureServiceBusSettlementDefer {
+				a.deferred = newDeferredMessages()
+				if tc.deferredAttempts > 0 {
+					a.deferred.add(msg, tc.deferredAttempts, time.Time{})
+				}
+			}
+
+			rcvr := &fakeReceiver{}
+
+			a.processMessage(context.Background(), rcvr, msg)
+
+			assert.Equal(t, []string{tc.expectSettlement}, rcvr.settlements)
+
+			if a.deferred != nil {
+				_, tracked := a.deferred.attempts(msg)
+				expectTracked := tc.expectSettlement == "defer"
+				assert.Equal(t, expectTracked, tracked, "Unexpected tracking of deferred message")
+			}
+		})
+	}
+}
+
+func TestReceiveSession(t *testing.T) {
+	rcvr := &fakeReceiver{
+		batches: [][]*azservicebus.ReceivedMessage{
+			{newReceivedMessage(1), newReceivedMessage(1)},
+			{newReceivedMessage(1)},
+		},
+	}
+
+	a := &adapter{
+		ceClient:      adaptertest.NewTestClient(),
+		msgPrcsr:      &fakeMessageProcessor{},
+		prefetchCount: 2,
+	}
+
+	// returns once the session is idle
+	a.receiveSession(context.Background(), rcvr)
+
+	assert.Equal(t, []string{"complete", "complete", "complete"}, rcvr.settlements)
+}
+
+func TestDeferredMessages(t *testing.T) {
+	now := time.Now()
+
+	d := newDeferredMessages()
+
+	m1, m2, m3 := newReceivedMessage(1), newReceivedMessage(1), newReceivedMessage(1)
+	m1.SequenceNumber, m2.SequenceNumber, m3.SequenceNumber = to.Ptr[int64](1), to.Ptr[int64](2), to.Ptr[int64](3)
+
+	d.add(m1, 1, now.Add(-time.Second))
+	d.add(m2, 1, now.Add(-time.Second))
+	d.add(m3, 1, now.Add(time.Hour))
+
+	due := d.due(now)
+	assert.ElementsMatch(t, []int64{1, 2}, due)
+	assert.Empty(t, d.due(now), "In-flight messages should not be due")
+
+	// m2 was not received, e.g. because it expired
+	d.prune(due, []*azservicebus.ReceivedMessage{m1})
+	_, ok := d.attempts(m2)
+	assert.False(t, ok, "Messages which were not received should not be tracked")
+
+	d.release([]int64{1})
+	assert.Equal(t, []int64{1}, d.due(now))
+}
+
+func TestBackoffDelay(t *testing.T) {
+	const min, max = time.Second, 10 * time.Second
+
+	assert.Equal(t, 1*time.Second, backoffDelay(0, min, max))
+	assert.Equal(t, 1*time.Second, backoffDelay(1, min, max))
+	assert.Equal(t, 2*time.Second, backoffDelay(2, min, max))
+	assert.Equal(t, 8*time.Second, backoffDelay(4, min, max))
+	assert.Equal(t, 10*time.Second, backoffDelay(5, min, max))
+	assert.Equal(t, 10*time.Second, backoffDelay(1000, min, max))
+}
+
+const tMaxDeliveryAttempts = 5
+
+// newReceivedMessage returns a test message with the given delivery count.
+func newReceivedMessage(deliveryCount uint32) *azservicebus.ReceivedMessage {
+	return &azservicebus.ReceivedMessage{
+		MessageID:      "msg-id",
+		SequenceNumber: to.Ptr(tSeqNum),
+		DeliveryCount:  deliveryCount,
+		Body:           []byte(`{"test": null}`),
+	}
+}
+
+// fakeMessageProcessor is a MessageProcessor which returns a static error,
+// or a single event of the given type.
+type fakeMessageProcessor struct {
+	eventType string
+	err       error
+}
+
+var _ MessageProcessor = (*fakeMessageProcessor)(nil)
+
+// Process implements MessageProcessor.
+func (p *fakeMessageProcessor) Process(msg *Message) ([]*cloudevents.Event, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+
+	e := cloudevents.NewEvent()
+	e.SetID(msg.MessageID)
+	e.SetSource("test.source")
+	e.SetType("test.type")
+	if p.eventType != "" {
+		e.SetType(p.eventType)
+	}
+	return []*cloudevents.Event{&e}, nil
+}
+
+// fakeReceiver is a messageReceiver which returns pre-defined batches of
+// messages and records settlements.
+type fakeReceiver struct {
+	mu          sync.Mutex
+	batches     [][]*azservicebus.ReceivedMessage
+	settlements []string
+}
+
+var _ messageReceiver = (*fakeReceiver)(nil)
+
+func (r *fakeReceiver) ReceiveMessages(context.Context, int,
+	*azservicebus.ReceiveMessagesOptions) ([]*azservicebus.ReceivedMessage, error) {
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if len(r.batches) == 0 {
+		return nil, nil
+	}
+	b := r.batches[0]
+	r.batches = r.batches[1:]
+	return b, nil
+}
+
+func (r *fakeReceiver) ReceiveDeferredMessages(context.Context, []int64,
+	*azservicebus.ReceiveDeferredMessagesOptions) ([]*azservicebus.ReceivedMessage, error) {
+
+	return nil, errors.New("not implemented")
+}
+
+func (r *fakeReceiver) CompleteMessage(context.Context, *azservicebus.ReceivedMessage,
+	*azservicebus.CompleteMessageOptions) error {
+
+	r.settle("complete")
+	return nil
+}
+
+func (r *fakeReceiver) AbandonMessage(context.Context, *azservicebus.ReceivedMessage,
+	*azservicebus.AbandonMessageOptions) error {
+
+	r.settle("abandon")
+	return nil
+}
+
+func (r *fakeReceiver) DeferMessage(context.Context, *azservicebus.ReceivedMessage,
+	*azservicebus.DeferMessageOptions) error {
+
+	r.settle("defer")
+	return nil
+}
+
+func (r *fakeReceiver) DeadLetterMessage(_ context.Context, _ *azservicebus.ReceivedMessage,
+	opts *azservicebus.DeadLetterOptions) error {
+
+	r.settle("deadLetter:" + *opts.Reason)
+	return nil
+}
+
+func (r *fakeReceiver) Close(context.Context) error {
+	return nil
+}
+
+func (r *fakeReceiver) settle(s string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.settlements = append(r.settlements, s)
+}
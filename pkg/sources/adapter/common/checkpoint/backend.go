@@ -0,0 +1,140 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package checkpoint
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+// Supported backends for checkpoints and leases.
+const (
+	// Kubernetes ConfigMap (checkpoints) and Lease (leases) objects.
+	BackendKubernetes = "kubernetes"
+	// In-memory checkpoints and leases, which are neither persisted nor
+	// shared between replicas. Suitable for tests only.
+	BackendMemory = "memory"
+	// Azure Storage blobs (checkpoints and leases). Instantiated using
+	// NewAzureBlob, since it requires a client for the Storage container.
+	BackendAzureBlob = "azureBlob"
+)
+
+// New returns a Store and a Leaser for the given backend.
+//
+// With the Kubernetes backend, checkpoints are persisted in a ConfigMap and
+// leases in Lease objects, all in the given namespace, with names derived
+// from the given prefix. Those objects are created with the given owners, so
+// that they are garbage collected together with the component instance which
+// wrote them.
+func New(backend, namespace, prefix, holder string, leaseDuration time.Duration,
+	owners ...metav1.OwnerReference) (Store, Leaser, error) {
+
+	switch backend {
+	case BackendMemory:
+		return NewMemoryStore(), NewMemoryLeases().Leaser(holder, leaseDuration), nil
+
+	case BackendKubernetes:
+		cs, err := inClusterClient()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		st := NewConfigMapStore(cs.CoreV1().ConfigMaps(namespace), ObjectName(prefix, "checkpoints"), owners...)
+		ls := NewKubeLeaser(cs.CoordinationV1().Leases(namespace), prefix, holder, leaseDuration, owners...)
+
+		return st, ls, nil
+
+	default:
+		return nil, nil, fmt.Errorf("unsupported checkpoint backend %q", backend)
+	}
+}
+
+// NewStore returns a Store for the given backend, for adapters which persist
+// checkpoints without sharing resources between replicas. The Store is
+// identical to the one returned by New for the same arguments.
+func NewStore(backend, namespace, prefix string, owners ...metav1.OwnerReference) (Store, error) {
+	switch backend {
+	case BackendMemory:
+		return NewMemoryStore(), nil
+
+	case BackendKubernetes:
+		cs, err := inClusterClient()
+		if err != nil {
+			return nil, err
+		}
+
+		return NewConfigMapStore(cs.CoreV1().ConfigMaps(namespace), ObjectName(prefix, "checkpoints"), owners...), nil
+
+	default:
+		return nil, fmt.Errorf("unsupported checkpoint backend %q", backend)
+	}
+}
+
+// inClusterClient returns a Kubernetes client which uses the in-cluster
+// configuration.
+func inClusterClient() (kubernetes.Interface, error) {
+	cfg, err := rest.InClusterConfig()
+	if err != nil {
+		return nil, fmt.Errorf("reading in-cluster Kubernetes client configuration: %w", err)
+	}
+
+	cs, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("creating Kubernetes client: %w", err)
+	}
+
+	return cs, nil
+}
+
+// Owner is a reference to the component instance which owns the checkpoints
+// and leases written by its adapter. It is decoded by envconfig from a
+// JSON-serialized OwnerReference.
+type Owner metav1.OwnerReference
+
+// Decode implements envconfig.Decoder.
+func (o *Owner) Decode(value string) error {
+	if value == "" {
+		return nil
+	}
+	return json.Unmarshal([]byte(value), (*metav1.OwnerReference)(o))
+}
+
+// References returns the owner as a list of OwnerReferences, which is empty
+// if the owner is unknown.
+func (o Owner) References() []metav1.OwnerReference {
+	if o.UID == "" {
+		return nil
+	}
+	return []metav1.OwnerReference{metav1.OwnerReference(o)}
+}
+
+// Holder returns an identity suitable for acquiring leases on behalf of the
+// current process. Inside a Kubernetes Pod, this is the name of the Pod.
+func Holder() string {
+	if h, err := os.Hostname(); err == nil && h != "" {
+		return h
+	}
+	return uuid.New().String()
+}
@@ -0,0 +1,38 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package checkpoint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOwnerDecode(t *testing.T) {
+	var o Owner
+	require.NoError(t, o.Decode(`{"apiVersion":"sources.triggermesh.io/v1alpha1","kind":"AWSKinesisSource",`+
+		`"name":"my-source","uid":"00000000-0000-0000-0000-000000000000"}`))
+	assert.Equal(t, tOwner, o)
+	assert.Len(t, o.References(), 1)
+
+	var unknown Owner
+	require.NoError(t, unknown.Decode(""))
+	assert.Empty(t, unknown.References(), "Expected no reference for unknown owner")
+
+	assert.Error(t, unknown.Decode("not-json"))
+}
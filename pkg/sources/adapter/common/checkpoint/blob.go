@@ -0,0 +1,336 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package checkpoint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/streaming"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
+)
+
+// Keys of the metadata of the blobs written by the Azure Blob Storage
+// backend.
+const (
+	blobMetaCheckpoint = "checkpoint"
+	blobMetaHolder     = "holder"
+	blobMetaRenewTime  = "renewtime"
+	blobMetaDuration   = "durationseconds"
+)
+
+// Blob name prefixes of the checkpoints and leases written by the Azure Blob
+// Storage backend, relative to the backend's prefix.
+const (
+	blobCheckpointsDir = "checkpoints/"
+	blobLeasesDir      = "leases/"
+)
+
+// NewAzureBlob returns a Store and a Leaser backed by blobs in the given Azure
+// Storage container. Blob names are derived from the given prefix. Leases are
+// acquired on behalf of the given holder, for the given duration.
+//
+// Checkpoints and leases are stored as the metadata of empty blobs, and
+// written using conditional requests, so that concurrent writers never
+// overwrite each other's leases.
+func NewAzureBlob(cli *azblob.ContainerClient, prefix, holder string, leaseDuration time.Duration) (Store, Leaser) {
+	prefix = strings.TrimSuffix(prefix, "/") + "/"
+
+	st := &blobStore{
+		cli:    cli,
+		prefix: prefix + blobCheckpointsDir,
+	}
+
+	ls := &blobLeaser{
+		cli:      cli,
+		prefix:   prefix + blobLeasesDir,
+		holder:   holder,
+		duration: leaseDuration,
+	}
+
+	return st, ls
+}
+
+// blobStore is a Store which persists checkpoints in the metadata of Azure
+// Storage blobs.
+type blobStore struct {
+	cli    *azblob.ContainerClient
+	prefix string
+}
+
+// Verify that blobStore implements Store.
+var _ Store = (*blobStore)(nil)
+
+// Get implements Store.
+func (s *blobStore) Get(ctx context.Context, key string) (string, error) {
+	md, _, err := getBlobMetadata(ctx, s.cli, s.prefix+key)
+	if err != nil {
+		return "", fmt.Errorf("reading checkpoint %q: %w", key, err)
+	}
+
+	return metadataValue(md, blobMetaCheckpoint), nil
+}
+
+// Set implements Store.
+func (s *blobStore) Set(ctx context.Context, key, checkpoint string) error {
+	md := map[string]string{
+		blobMetaCheckpoint: checkpoint,
+	}
+
+	if err := putBlobMetadata(ctx, s.cli, s.prefix+key, md, nil); err != nil {
+		return fmt.Errorf("writing checkpoint %q: %w", key, err)
+	}
+	return nil
+}
+
+// Delete implements Store.
+func (s *blobStore) Delete(ctx context.Context, key string) error {
+	b, err := s.cli.NewBlobClient(s.prefix + key)
+	if err != nil {
+		return fmt.Errorf("creating client for blob %q: %w", s.prefix+key, err)
+	}
+
+	if _, err := b.Delete(ctx, nil); err != nil && !isBlobNotFound(err) {
+		return fmt.Errorf("deleting checkpoint %q: %w", key, err)
+	}
+	return nil
+}
+
+// blobLeaser is a Leaser which persists leases in the metadata of Azure
+// Storage blobs.
+type blobLeaser struct {
+	cli      *azblob.ContainerClient
+	prefix   string
+	holder   string
+	duration time.Duration
+}
+
+// Verify that blobLeaser implements Leaser.
+var _ Leaser = (*blobLeaser)(nil)
+
+// Acquire implements Leaser.
+func (l *blobLeaser) Acquire(ctx context.Context, name string) (bool, error) {
+	blobName := l.prefix + name
+
+	md, etag, err := getBlobMetadata(ctx, l.cli, blobName)
+	if err != nil {
+		return false, fmt.Errorf("reading lease %q: %w", name, err)
+	}
+
+	now := time.Now()
+
+	if holder := metadataValue(md, blobMetaHolder); holder != l.holder && !blobLeaseExpired(md, now) {
+		return false, nil
+	}
+
+	// An empty ETag indicates that the blob doesn't exist. The condition
+	// guarantees that the lease wasn't acquired by another holder in the
+	// meantime.
+	cond := &azblob.ModifiedAccessConditions{IfMatch: to.Ptr(etag)}
+	if etag == "" {
+		cond = &azblob.ModifiedAccessConditions{IfNoneMatch: to.Ptr("*")}
+	}
+
+	md = map[string]string{
+		blobMetaHolder:    l.holder,
+		blobMetaRenewTime: now.UTC().Format(time.RFC3339Nano),
+		blobMetaDuration:  strconv.Itoa(int(l.duration.Seconds())),
+	}
+
+	err = putBlobMetadata(ctx, l.cli, blobName, md, cond)
+	switch {
+	case isBlobConflict(err):
+		// another holder was faster
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("writing lease %q: %w", name, err)
+	}
+
+	return true, nil
+}
+
+// Release implements Leaser.
+func (l *blobLeaser) Release(ctx context.Context, name string) error {
+	blobName := l.prefix + name
+
+	md, etag, err := getBlobMetadata(ctx, l.cli, blobName)
+	if err != nil {
+		return fmt.Errorf("reading lease %q: %w", name, err)
+	}
+
+	if etag == "" || metadataValue(md, blobMetaHolder) != l.holder {
+		return nil
+	}
+
+	cond := &azblob.ModifiedAccessConditions{IfMatch: to.Ptr(etag)}
+
+	err = putBlobMetadata(ctx, l.cli, blobName, map[string]string{}, cond)
+	switch {
+	case isBlobConflict(err):
+		// the lease was acquired in the meantime
+		return nil
+	case err != nil:
+		return fmt.Errorf("writing lease %q: %w", name, err)
+	}
+
+	return nil
+}
+
+// Holders implements Leaser.
+func (l *blobLeaser) Holders(ctx context.Context) (map[string]string, error) {
+	pager := l.cli.ListBlobsFlat(&azblob.ContainerListBlobsFlatOptions{
+		Prefix:  to.Ptr(l.prefix),
+		Include: []azblob.ListBlobsIncludeItem{azblob.ListBlobsIncludeItemMetadata},
+	})
+
+	now := time.Now()
+
+	holders := make(map[string]string)
+
+	for pager.NextPage(ctx) {
+		resp := pager.PageResponse()
+		if resp.Segment == nil {
+			continue
+		}
+
+		for _, item := range resp.Segment.BlobItems {
+			if item.Name == nil {
+				continue
+			}
+
+			md := make(map[string]string, len(item.Metadata))
+			for k, v := range item.Metadata {
+				if v != nil {
+					md[k] = *v
+				}
+			}
+
+			holder := metadataValue(md, blobMetaHolder)
+			if holder == "" || blobLeaseExpired(md, now) {
+				continue
+			}
+			holders[strings.TrimPrefix(*item.Name, l.prefix)] = holder
+		}
+	}
+	if err := pager.Err(); err != nil {
+		return nil, fmt.Errorf("listing leases: %w", err)
+	}
+
+	return holders, nil
+}
+
+// blobLeaseExpired returns whether the lease described by the given blob
+// metadata has expired at the given time.
+func blobLeaseExpired(md map[string]string, now time.Time) bool {
+	if metadataValue(md, blobMetaHolder) == "" {
+		return true
+	}
+
+	renewTime, err := time.Parse(time.RFC3339Nano, metadataValue(md, blobMetaRenewTime))
+	if err != nil {
+		return true
+	}
+	durationSec, err := strconv.Atoi(metadataValue(md, blobMetaDuration))
+	if err != nil {
+		return true
+	}
+
+	expires := renewTime.Add(time.Duration(durationSec) * time.Second)
+	return !now.Before(expires)
+}
+
+// getBlobMetadata returns the metadata and ETag of the blob with the given
+// name. The ETag is empty if the blob doesn't exist.
+func getBlobMetadata(ctx context.Context, cli *azblob.ContainerClient, blobName string) (map[string]string, string, error) {
+	b, err := cli.NewBlobClient(blobName)
+	if err != nil {
+		return nil, "", fmt.Errorf("creating client for blob %q: %w", blobName, err)
+	}
+
+	props, err := b.GetProperties(ctx, nil)
+	switch {
+	case isBlobNotFound(err):
+		return nil, "", nil
+	case err != nil:
+		return nil, "", fmt.Errorf("getting properties of blob %q: %w", blobName, err)
+	}
+
+	var etag string
+	if props.ETag != nil {
+		etag = *props.ETag
+	}
+
+	return props.Metadata, etag, nil
+}
+
+// putBlobMetadata writes an empty blob with the given name and metadata, if
+// the given conditions are met.
+func putBlobMetadata(ctx context.Context, cli *azblob.ContainerClient, blobName string,
+	md map[string]string, cond *azblob.ModifiedAccessConditions) error {
+
+	b, err := cli.NewBlockBlobClient(blobName)
+	if err != nil {
+		return fmt.Errorf("creating client for blob %q: %w", blobName, err)
+	}
+
+	_, err = b.Upload(ctx, streaming.NopCloser(strings.NewReader("")), &azblob.BlockBlobUploadOptions{
+		Metadata: md,
+		BlobAccessConditions: &azblob.BlobAccessConditions{
+			ModifiedAccessConditions: cond,
+		},
+	})
+	return err
+}
+
+// metadataValue returns the value of the given key in the given blob
+// metadata. Keys are case-insensitive.
+func metadataValue(md map[string]string, key string) string {
+	if v, ok := md[key]; ok {
+		return v
+	}
+	for k, v := range md {
+		if strings.EqualFold(k, key) {
+			return v
+		}
+	}
+	return ""
+}
+
+// isBlobNotFound returns whether the given error indicates that a blob
+// doesn't exist.
+func isBlobNotFound(err error) bool {
+	return hasStorageErrorCode(err, azblob.StorageErrorCodeBlobNotFound)
+}
+
+// isBlobConflict returns whether the given error indicates that a conditional
+// write to a blob failed because the blob was modified concurrently.
+func isBlobConflict(err error) bool {
+	return hasStorageErrorCode(err, azblob.StorageErrorCodeConditionNotMet) ||
+		hasStorageErrorCode(err, azblob.StorageErrorCodeBlobAlreadyExists)
+}
+
+// hasStorageErrorCode returns whether the given error is a Storage error with
+// the given code.
+func hasStorageErrorCode(err error, code azblob.StorageErrorCode) bool {
+	var stgErr *azblob.StorageError
+	return errors.As(err, &stgErr) && stgErr.ErrorCode == code
+}
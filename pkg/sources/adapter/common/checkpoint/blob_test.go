@@ -0,0 +1,419 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package checkpoint
+
+import (
+	"context"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
+)
+
+const (
+	tBlobContainer = "test-container"
+	tBlobPrefix    = "test-prefix"
+)
+
+func TestAzureBlobStore(t *testing.T) {
+	cli, _ := newFakeContainerClient(t)
+	st, _ := NewAzureBlob(cli, tBlobPrefix, "holder-a", time.Hour)
+
+	testStore(t, st)
+}
+
+func TestAzureBlobLeaser(t *testing.T) {
+	cli, _ := newFakeContainerClient(t)
+	_, la := NewAzureBlob(cli, tBlobPrefix, "holder-a", time.Hour)
+	_, lb := NewAzureBlob(cli, tBlobPrefix, "holder-b", time.Hour)
+
+	testLeasers(t, la, lb)
+}
+
+func TestAzureBlobLeaserRenewal(t *testing.T) {
+	cli, svc := newFakeContainerClient(t)
+	_, l := NewAzureBlob(cli, tBlobPrefix, "holder-a", time.Hour)
+
+	ctx := context.Background()
+
+	acquired, err := l.Acquire(ctx, "partition-0")
+	require.NoError(t, err)
+	require.True(t, acquired)
+
+	lease := svc.blob(tBlobPrefix + "/leases/partition-0")
+	require.NotNil(t, lease, "Expected lease blob to be written")
+	assert.Equal(t, "holder-a", lease.metadata[blobMetaHolder])
+	assert.Equal(t, "3600", lease.metadata[blobMetaDuration])
+
+	// backdate the lease to observe its renewal
+	svc.setBlob(tBlobPrefix+"/leases/partition-0", leaseMetadata("holder-a", time.Now().Add(-time.Minute), time.Hour))
+	etag := svc.blob(tBlobPrefix + "/leases/partition-0").etag
+
+	acquired, err = l.Acquire(ctx, "partition-0")
+	require.NoError(t, err)
+	assert.True(t, acquired, "Expected held lease to be renewed")
+
+	lease = svc.blob(tBlobPrefix + "/leases/partition-0")
+	assert.NotEqual(t, etag, lease.etag)
+
+	renewTime, err := time.Parse(time.RFC3339Nano, lease.metadata[blobMetaRenewTime])
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now(), renewTime, 10*time.Second)
+}
+
+func TestAzureBlobLeaserConflict(t *testing.T) {
+	testCases := map[string]struct {
+		existingLease map[string]string
+	}{
+		"Concurrent creation": {
+			existingLease: nil,
+		},
+		"Concurrent takeover": {
+			existingLease: leaseMetadata("holder-c", time.Now().Add(-time.Hour), time.Minute),
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			const blobName = tBlobPrefix + "/leases/partition-0"
+
+			cli, svc := newFakeContainerClient(t)
+			_, l := NewAzureBlob(cli, tBlobPrefix, "holder-a", time.Hour)
+
+			if tc.existingLease != nil {
+				svc.setBlob(blobName, tc.existingLease)
+			}
+
+			// another holder acquires the lease between the read and
+			// the conditional write of the lease blob
+			svc.beforeWrite = func(name string) {
+				svc.beforeWrite = nil
+				svc.blobs[name] = &fakeBlob{
+					etag:     svc.nextETag(),
+					metadata: leaseMetadata("holder-b", time.Now(), time.Hour),
+				}
+			}
+
+			acquired, err := l.Acquire(context.Background(), "partition-0")
+			require.NoError(t, err)
+			assert.False(t, acquired, "Expected lease acquired concurrently not to be acquired")
+
+			assert.Equal(t, "holder-b", svc.blob(blobName).metadata[blobMetaHolder])
+		})
+	}
+}
+
+func TestAzureBlobLeaserReleaseConflict(t *testing.T) {
+	const blobName = tBlobPrefix + "/leases/partition-0"
+
+	cli, svc := newFakeContainerClient(t)
+	_, l := NewAzureBlob(cli, tBlobPrefix, "holder-a", time.Hour)
+
+	ctx := context.Background()
+
+	acquired, err := l.Acquire(ctx, "partition-0")
+	require.NoError(t, err)
+	require.True(t, acquired)
+
+	// the lease expires and is taken over by another holder between the
+	// read and the conditional write of the lease blob
+	svc.beforeWrite = func(name string) {
+		svc.beforeWrite = nil
+		svc.blobs[name] = &fakeBlob{
+			etag:     svc.nextETag(),
+			metadata: leaseMetadata("holder-b", time.Now(), time.Hour),
+		}
+	}
+
+	require.NoError(t, l.Release(ctx, "partition-0"))
+	assert.Equal(t, "holder-b", svc.blob(blobName).metadata[blobMetaHolder],
+		"Expected lease acquired concurrently not to be released")
+}
+
+func TestAzureBlobLeaserExpiredLease(t *testing.T) {
+	const blobName = tBlobPrefix + "/leases/partition-0"
+
+	cli, svc := newFakeContainerClient(t)
+	_, la := NewAzureBlob(cli, tBlobPrefix, "holder-a", time.Hour)
+	_, lb := NewAzureBlob(cli, tBlobPrefix, "holder-b", time.Hour)
+
+	ctx := context.Background()
+
+	svc.setBlob(blobName, leaseMetadata("holder-a", time.Now().Add(-time.Minute), 30*time.Second))
+
+	holders, err := lb.Holders(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, holders, "Expected expired lease not to have a holder")
+
+	acquired, err := lb.Acquire(ctx, "partition-0")
+	require.NoError(t, err)
+	assert.True(t, acquired, "Expected expired lease to be acquired")
+
+	assert.Equal(t, "holder-b", svc.blob(blobName).metadata[blobMetaHolder])
+
+	acquired, err = la.Acquire(ctx, "partition-0")
+	require.NoError(t, err)
+	assert.False(t, acquired, "Expected lease taken over by another holder not to be renewed")
+
+	holders, err = la.Holders(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"partition-0": "holder-b"}, holders)
+}
+
+// leaseMetadata returns the metadata of a lease blob.
+func leaseMetadata(holder string, renewTime time.Time, duration time.Duration) map[string]string {
+	return map[string]string{
+		blobMetaHolder:    holder,
+		blobMetaRenewTime: renewTime.UTC().Format(time.RFC3339Nano),
+		blobMetaDuration:  strconv.Itoa(int(duration.Seconds())),
+	}
+}
+
+// newFakeContainerClient returns an Azure Blob Storage container client which
+// sends its requests to a fake Blob service.
+func newFakeContainerClient(t *testing.T) (*azblob.ContainerClient, *fakeBlobService) {
+	t.Helper()
+
+	svc := &fakeBlobService{
+		blobs: make(map[string]*fakeBlob),
+	}
+
+	cli, err := azblob.NewContainerClientWithNoCredential(
+		"https://fake.blob.core.windows.net/"+tBlobContainer,
+		&azblob.ClientOptions{Transport: svc},
+	)
+	require.NoError(t, err)
+
+	return cli, svc
+}
+
+// fakeBlobService is a fake implementation of the Azure Blob Storage REST
+// API, limited to the operations performed by the Azure Blob backend.
+type fakeBlobService struct {
+	mu sync.Mutex
+
+	blobs   map[string]*fakeBlob // indexed by blob name
+	etagSeq int
+
+	// Called before a blob is written, while holding the service's lock.
+	// Allows simulating writes by concurrent clients.
+	beforeWrite func(blobName string)
+}
+
+// fakeBlob is a blob stored in a fakeBlobService.
+type fakeBlob struct {
+	etag     string
+	metadata map[string]string
+}
+
+// Do implements policy.Transporter.
+func (s *fakeBlobService) Do(req *http.Request) (*http.Response, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	containerPath := "/" + tBlobContainer
+	q := req.URL.Query()
+
+	switch {
+	case req.Method == http.MethodGet && req.URL.Path == containerPath && q.Get("comp") == "list":
+		return s.listBlobs(req, q.Get("prefix"))
+
+	case req.Method == http.MethodHead && strings.HasPrefix(req.URL.Path, containerPath+"/"):
+		return s.getProperties(req, strings.TrimPrefix(req.URL.Path, containerPath+"/"))
+
+	case req.Method == http.MethodPut && strings.HasPrefix(req.URL.Path, containerPath+"/"):
+		return s.upload(req, strings.TrimPrefix(req.URL.Path, containerPath+"/"))
+
+	case req.Method == http.MethodDelete && strings.HasPrefix(req.URL.Path, containerPath+"/"):
+		return s.delete(req, strings.TrimPrefix(req.URL.Path, containerPath+"/"))
+	}
+
+	return fakeBlobResponse(req, http.StatusNotImplemented, nil, ""), nil
+}
+
+func (s *fakeBlobService) getProperties(req *http.Request, blobName string) (*http.Response, error) {
+	b, ok := s.blobs[blobName]
+	if !ok {
+		return fakeBlobErrorResponse(req, http.StatusNotFound, azblob.StorageErrorCodeBlobNotFound), nil
+	}
+
+	hdr := http.Header{}
+	hdr.Set("ETag", b.etag)
+	for k, v := range b.metadata {
+		hdr.Set("x-ms-meta-"+k, v)
+	}
+
+	return fakeBlobResponse(req, http.StatusOK, hdr, ""), nil
+}
+
+func (s *fakeBlobService) upload(req *http.Request, blobName string) (*http.Response, error) {
+	if s.beforeWrite != nil {
+		s.beforeWrite(blobName)
+	}
+
+	b, exists := s.blobs[blobName]
+
+	if ifMatch := req.Header.Get("If-Match"); ifMatch != "" && (!exists || b.etag != ifMatch) {
+		return fakeBlobErrorResponse(req, http.StatusPreconditionFailed, azblob.StorageErrorCodeConditionNotMet), nil
+	}
+	if req.Header.Get("If-None-Match") == "*" && exists {
+		return fakeBlobErrorResponse(req, http.StatusConflict, azblob.StorageErrorCodeBlobAlreadyExists), nil
+	}
+
+	md := make(map[string]string)
+	for k := range req.Header {
+		if name := strings.ToLower(k); strings.HasPrefix(name, "x-ms-meta-") {
+			md[strings.TrimPrefix(name, "x-ms-meta-")] = req.Header.Get(k)
+		}
+	}
+
+	b = &fakeBlob{
+		etag:     s.nextETag(),
+		metadata: md,
+	}
+	s.blobs[blobName] = b
+
+	hdr := http.Header{}
+	hdr.Set("ETag", b.etag)
+
+	return fakeBlobResponse(req, http.StatusCreated, hdr, ""), nil
+}
+
+func (s *fakeBlobService) delete(req *http.Request, blobName string) (*http.Response, error) {
+	if _, exists := s.blobs[blobName]; !exists {
+		return fakeBlobErrorResponse(req, http.StatusNotFound, azblob.StorageErrorCodeBlobNotFound), nil
+	}
+	delete(s.blobs, blobName)
+
+	return fakeBlobResponse(req, http.StatusAccepted, nil, ""), nil
+}
+
+func (s *fakeBlobService) listBlobs(req *http.Request, prefix string) (*http.Response, error) {
+	type metadataEntry struct {
+		XMLName xml.Name
+		Value   string `xml:",chardata"`
+	}
+	type blobItem struct {
+		Name     string          `xml:"Name"`
+		Metadata []metadataEntry `xml:"Metadata>entry"`
+	}
+	type listResult struct {
+		XMLName       xml.Name   `xml:"EnumerationResults"`
+		ContainerName string     `xml:"ContainerName,attr"`
+		Blobs         []blobItem `xml:"Blobs>Blob"`
+	}
+
+	res := listResult{
+		ContainerName: tBlobContainer,
+	}
+
+	for name, b := range s.blobs {
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+
+		item := blobItem{Name: name}
+		for k, v := range b.metadata {
+			item.Metadata = append(item.Metadata, metadataEntry{XMLName: xml.Name{Local: k}, Value: v})
+		}
+		res.Blobs = append(res.Blobs, item)
+	}
+
+	body, err := xml.Marshal(res)
+	if err != nil {
+		return nil, err
+	}
+
+	hdr := http.Header{}
+	hdr.Set("Content-Type", "application/xml")
+
+	return fakeBlobResponse(req, http.StatusOK, hdr, xml.Header+string(body)), nil
+}
+
+// blob returns a copy of the blob with the given name, or nil if it doesn't
+// exist.
+func (s *fakeBlobService) blob(blobName string) *fakeBlob {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b, ok := s.blobs[blobName]
+	if !ok {
+		return nil
+	}
+
+	md := make(map[string]string, len(b.metadata))
+	for k, v := range b.metadata {
+		md[k] = v
+	}
+
+	return &fakeBlob{
+		etag:     b.etag,
+		metadata: md,
+	}
+}
+
+// setBlob writes a blob with the given name and metadata.
+func (s *fakeBlobService) setBlob(blobName string, md map[string]string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.blobs[blobName] = &fakeBlob{
+		etag:     s.nextETag(),
+		metadata: md,
+	}
+}
+
+// nextETag returns a new unique ETag.
+// The caller must hold the service's lock.
+func (s *fakeBlobService) nextETag() string {
+	s.etagSeq++
+	return `"0x` + strconv.Itoa(s.etagSeq) + `"`
+}
+
+// fakeBlobResponse returns a HTTP response to the given request.
+func fakeBlobResponse(req *http.Request, status int, hdr http.Header, body string) *http.Response {
+	if hdr == nil {
+		hdr = http.Header{}
+	}
+
+	return &http.Response{
+		StatusCode:    status,
+		Header:        hdr,
+		Body:          io.NopCloser(strings.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+// fakeBlobErrorResponse returns a HTTP response to the given request which
+// conveys the given Storage error.
+func fakeBlobErrorResponse(req *http.Request, status int, code azblob.StorageErrorCode) *http.Response {
+	hdr := http.Header{}
+	hdr.Set("x-ms-error-code", string(code))
+
+	return fakeBlobResponse(req, status, hdr, "")
+}
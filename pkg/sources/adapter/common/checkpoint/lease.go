@@ -0,0 +1,332 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package checkpoint
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	coordinationv1client "k8s.io/client-go/kubernetes/typed/coordination/v1"
+)
+
+// Leaser grants the exclusive ownership of named resources, such as the
+// partitions of a stream, to a single holder for a limited duration.
+type Leaser interface {
+	// Acquire acquires or renews the lease with the given name on behalf
+	// of the leaser's holder. It returns false if the lease is currently
+	// held by another holder.
+	Acquire(ctx context.Context, name string) (bool, error)
+	// Release releases the lease with the given name if it is held by the
+	// leaser's holder, so that other holders can acquire it without
+	// waiting for its expiration.
+	Release(ctx context.Context, name string) error
+	// Holders returns the holders of all the leases which are currently
+	// held, indexed by lease name. Expired and released leases are
+	// omitted.
+	Holders(ctx context.Context) (map[string]string, error)
+}
+
+// Metadata of the Kubernetes Lease objects managed by a kubeLeaser.
+const (
+	// Label which value identifies the set of leases a Lease object
+	// belongs to.
+	leaseSetLabel = "checkpoint.triggermesh.io/lease-set"
+	// Annotation which value is the name of the lease stored in a Lease
+	// object, before it was sanitized.
+	leaseNameAnnotation = "checkpoint.triggermesh.io/lease-name"
+)
+
+// MemoryLeases is an in-memory table of leases that can be shared between
+// multiple Leasers.
+type MemoryLeases struct {
+	mu     sync.Mutex
+	leases map[string]memoryLease
+}
+
+// memoryLease is the state of a lease held in memory.
+type memoryLease struct {
+	holder  string
+	expires time.Time
+}
+
+// NewMemoryLeases returns an empty table of in-memory leases.
+func NewMemoryLeases() *MemoryLeases {
+	return &MemoryLeases{
+		leases: make(map[string]memoryLease),
+	}
+}
+
+// Leaser returns a Leaser which acquires leases from this table on behalf of
+// the given holder, for the given duration.
+func (m *MemoryLeases) Leaser(holder string, duration time.Duration) Leaser {
+	return &memoryLeaser{
+		leases:   m,
+		holder:   holder,
+		duration: duration,
+	}
+}
+
+// memoryLeaser is a Leaser backed by a MemoryLeases table.
+type memoryLeaser struct {
+	leases   *MemoryLeases
+	holder   string
+	duration time.Duration
+}
+
+// Verify that memoryLeaser implements Leaser.
+var _ Leaser = (*memoryLeaser)(nil)
+
+// Acquire implements Leaser.
+func (l *memoryLeaser) Acquire(_ context.Context, name string) (bool, error) {
+	l.leases.mu.Lock()
+	defer l.leases.mu.Unlock()
+
+	now := time.Now()
+
+	if ls, exists := l.leases.leases[name]; exists && ls.holder != l.holder && now.Before(ls.expires) {
+		return false, nil
+	}
+
+	l.leases.leases[name] = memoryLease{
+		holder:  l.holder,
+		expires: now.Add(l.duration),
+	}
+
+	return true, nil
+}
+
+// Release implements Leaser.
+func (l *memoryLeaser) Release(_ context.Context, name string) error {
+	l.leases.mu.Lock()
+	defer l.leases.mu.Unlock()
+
+	if ls, exists := l.leases.leases[name]; exists && ls.holder == l.holder {
+		delete(l.leases.leases, name)
+	}
+
+	return nil
+}
+
+// Holders implements Leaser.
+func (l *memoryLeaser) Holders(context.Context) (map[string]string, error) {
+	l.leases.mu.Lock()
+	defer l.leases.mu.Unlock()
+
+	now := time.Now()
+
+	holders := make(map[string]string, len(l.leases.leases))
+	for name, ls := range l.leases.leases {
+		if now.Before(ls.expires) {
+			holders[name] = ls.holder
+		}
+	}
+
+	return holders, nil
+}
+
+// kubeLeaser is a Leaser backed by Kubernetes Lease objects.
+type kubeLeaser struct {
+	cli      coordinationv1client.LeaseInterface
+	prefix   string
+	holder   string
+	duration time.Duration
+	owners   []metav1.OwnerReference
+}
+
+// Verify that kubeLeaser implements Leaser.
+var _ Leaser = (*kubeLeaser)(nil)
+
+// NewKubeLeaser returns a Leaser which acquires Kubernetes Lease objects on
+// behalf of the given holder, for the given duration. The names of Lease
+// objects are derived from the given prefix and the names of leases, and Lease
+// objects are created with the given owners.
+func NewKubeLeaser(cli coordinationv1client.LeaseInterface, prefix, holder string, duration time.Duration,
+	owners ...metav1.OwnerReference) Leaser {
+
+	return &kubeLeaser{
+		cli:      cli,
+		prefix:   prefix,
+		holder:   holder,
+		duration: duration,
+		owners:   owners,
+	}
+}
+
+// Acquire implements Leaser.
+func (l *kubeLeaser) Acquire(ctx context.Context, name string) (bool, error) {
+	objName := ObjectName(l.prefix, name)
+
+	now := metav1.NewMicroTime(time.Now())
+	durationSec := int32(l.duration.Seconds())
+
+	lease, err := l.cli.Get(ctx, objName, metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+		lease = &coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            objName,
+				OwnerReferences: l.owners,
+				Labels: map[string]string{
+					leaseSetLabel: l.leaseSet(),
+				},
+				Annotations: map[string]string{
+					leaseNameAnnotation: name,
+				},
+			},
+			Spec: coordinationv1.LeaseSpec{
+				HolderIdentity:       &l.holder,
+				LeaseDurationSeconds: &durationSec,
+				AcquireTime:          &now,
+				RenewTime:            &now,
+			},
+		}
+
+		_, err = l.cli.Create(ctx, lease, metav1.CreateOptions{})
+		switch {
+		case apierrors.IsAlreadyExists(err):
+			// another holder was faster
+			return false, nil
+		case err != nil:
+			return false, fmt.Errorf("creating Lease %q: %w", objName, err)
+		}
+
+		return true, nil
+
+	case err != nil:
+		return false, fmt.Errorf("getting Lease %q: %w", objName, err)
+	}
+
+	spec := &lease.Spec
+
+	isHeld := spec.HolderIdentity != nil && *spec.HolderIdentity == l.holder
+
+	if !isHeld && !leaseExpired(spec, now.Time) {
+		return false, nil
+	}
+
+	if !isHeld {
+		spec.HolderIdentity = &l.holder
+		spec.AcquireTime = &now
+
+		var transitions int32
+		if spec.LeaseTransitions != nil {
+			transitions = *spec.LeaseTransitions
+		}
+		transitions++
+		spec.LeaseTransitions = &transitions
+	}
+	spec.LeaseDurationSeconds = &durationSec
+	spec.RenewTime = &now
+
+	// Lease objects written by earlier versions of the leaser can not be
+	// listed until they are labeled.
+	if lease.Labels[leaseSetLabel] == "" || lease.Annotations[leaseNameAnnotation] == "" {
+		metav1.SetMetaDataLabel(&lease.ObjectMeta, leaseSetLabel, l.leaseSet())
+		metav1.SetMetaDataAnnotation(&lease.ObjectMeta, leaseNameAnnotation, name)
+	}
+
+	_, err = l.cli.Update(ctx, lease, metav1.UpdateOptions{})
+	switch {
+	case apierrors.IsConflict(err):
+		// another holder was faster
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("updating Lease %q: %w", objName, err)
+	}
+
+	return true, nil
+}
+
+// Release implements Leaser.
+func (l *kubeLeaser) Release(ctx context.Context, name string) error {
+	objName := ObjectName(l.prefix, name)
+
+	lease, err := l.cli.Get(ctx, objName, metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+		return nil
+	case err != nil:
+		return fmt.Errorf("getting Lease %q: %w", objName, err)
+	}
+
+	if h := lease.Spec.HolderIdentity; h == nil || *h != l.holder {
+		return nil
+	}
+
+	lease.Spec.HolderIdentity = nil
+	lease.Spec.AcquireTime = nil
+	lease.Spec.RenewTime = nil
+
+	_, err = l.cli.Update(ctx, lease, metav1.UpdateOptions{})
+	switch {
+	case apierrors.IsConflict(err):
+		// the lease was acquired in the meantime
+		return nil
+	case err != nil:
+		return fmt.Errorf("updating Lease %q: %w", objName, err)
+	}
+
+	return nil
+}
+
+// Holders implements Leaser.
+func (l *kubeLeaser) Holders(ctx context.Context) (map[string]string, error) {
+	leases, err := l.cli.List(ctx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labels.Set{leaseSetLabel: l.leaseSet()}).String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing Leases: %w", err)
+	}
+
+	now := time.Now()
+
+	holders := make(map[string]string, len(leases.Items))
+	for _, lease := range leases.Items {
+		name := lease.Annotations[leaseNameAnnotation]
+		if name == "" || leaseExpired(&lease.Spec, now) {
+			continue
+		}
+		holders[name] = *lease.Spec.HolderIdentity
+	}
+
+	return holders, nil
+}
+
+// leaseSet returns a value which identifies the set of Lease objects managed
+// by the leaser. It is suitable for a label value.
+func (l *kubeLeaser) leaseSet() string {
+	return ObjectName(l.prefix, "leases")
+}
+
+// leaseExpired returns whether the lease described by the given spec has
+// expired at the given time.
+func leaseExpired(spec *coordinationv1.LeaseSpec, now time.Time) bool {
+	if spec.HolderIdentity == nil || *spec.HolderIdentity == "" ||
+		spec.RenewTime == nil || spec.LeaseDurationSeconds == nil {
+
+		return true
+	}
+
+	expires := spec.RenewTime.Add(time.Duration(*spec.LeaseDurationSeconds) * time.Second)
+	return !now.Before(expires)
+}
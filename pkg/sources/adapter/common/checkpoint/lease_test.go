@@ -0,0 +1,122 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package checkpoint
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+const tLeasePrefix = "test-leases"
+
+func TestMemoryLeaser(t *testing.T) {
+	leases := NewMemoryLeases()
+
+	testLeasers(t,
+		leases.Leaser("holder-a", time.Hour),
+		leases.Leaser("holder-b", time.Hour),
+	)
+}
+
+func TestKubeLeaser(t *testing.T) {
+	cli := fake.NewSimpleClientset().CoordinationV1().Leases(tNs)
+
+	testLeasers(t,
+		NewKubeLeaser(cli, tLeasePrefix, "holder-a", time.Hour, tOwner.References()...),
+		NewKubeLeaser(cli, tLeasePrefix, "holder-b", time.Hour, tOwner.References()...),
+	)
+
+	lease, err := cli.Get(context.Background(), ObjectName(tLeasePrefix, "shard-1"), metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, tOwner.References(), lease.OwnerReferences)
+}
+
+func TestKubeLeaserExpiredLease(t *testing.T) {
+	const leaseName = "shard-1"
+
+	renewTime := metav1.NewMicroTime(time.Now().Add(-time.Minute))
+	holder := "holder-a"
+	durationSec := int32(30)
+
+	lease := &coordinationv1.Lease{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: tNs,
+			Name:      ObjectName(tLeasePrefix, leaseName),
+		},
+		Spec: coordinationv1.LeaseSpec{
+			HolderIdentity:       &holder,
+			LeaseDurationSeconds: &durationSec,
+			RenewTime:            &renewTime,
+		},
+	}
+
+	cli := fake.NewSimpleClientset(lease).CoordinationV1().Leases(tNs)
+
+	l := NewKubeLeaser(cli, tLeasePrefix, "holder-b", time.Hour)
+
+	acquired, err := l.Acquire(context.Background(), leaseName)
+	require.NoError(t, err)
+	assert.True(t, acquired, "Expected expired lease to be acquired")
+
+	lease, err = cli.Get(context.Background(), ObjectName(tLeasePrefix, leaseName), metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "holder-b", *lease.Spec.HolderIdentity)
+	assert.EqualValues(t, 1, *lease.Spec.LeaseTransitions)
+}
+
+// testLeasers runs a series of generic assertions against the given Leasers,
+// which are expected to share the same leases on behalf of distinct holders.
+func testLeasers(t *testing.T, a, b Leaser) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	acquired, err := a.Acquire(ctx, "shard-1")
+	require.NoError(t, err)
+	assert.True(t, acquired, "Expected free lease to be acquired")
+
+	acquired, err = a.Acquire(ctx, "shard-1")
+	require.NoError(t, err)
+	assert.True(t, acquired, "Expected held lease to be renewed")
+
+	acquired, err = b.Acquire(ctx, "shard-1")
+	require.NoError(t, err)
+	assert.False(t, acquired, "Expected lease held by another holder not to be acquired")
+
+	acquired, err = b.Acquire(ctx, "shard-2")
+	require.NoError(t, err)
+	assert.True(t, acquired, "Expected free lease to be acquired")
+
+	require.NoError(t, b.Release(ctx, "shard-1"), "Releasing a lease held by another holder is a no-op")
+	require.NoError(t, a.Release(ctx, "shard-1"))
+
+	acquired, err = b.Acquire(ctx, "shard-1")
+	require.NoError(t, err)
+	assert.True(t, acquired, "Expected released lease to be acquired")
+
+	holders, err := a.Holders(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"shard-1": "holder-b", "shard-2": "holder-b"}, holders)
+}
@@ -0,0 +1,223 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package checkpoint allows receive adapters to persist their progress in
+// partitioned streams, and to share the ownership of partitions between
+// replicas.
+package checkpoint
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/util/retry"
+
+	"knative.dev/pkg/kmeta"
+)
+
+// Store persists checkpoints, such as the position of a consumer in a
+// partition of a stream, under arbitrary keys.
+type Store interface {
+	// Get returns the checkpoint stored under the given key, or an empty
+	// string if no checkpoint was stored under that key.
+	Get(ctx context.Context, key string) (string, error)
+	// Set stores the given checkpoint under the given key.
+	Set(ctx context.Context, key, checkpoint string) error
+	// Delete removes the checkpoint stored under the given key, if any.
+	Delete(ctx context.Context, key string) error
+}
+
+// memoryStore is a Store which keeps checkpoints in memory.
+type memoryStore struct {
+	mu          sync.RWMutex
+	checkpoints map[string]string
+}
+
+// Verify that memoryStore implements Store.
+var _ Store = (*memoryStore)(nil)
+
+// NewMemoryStore returns a Store which keeps checkpoints in memory. Those
+// checkpoints do not survive restarts of the adapter, and are not shared
+// between replicas.
+func NewMemoryStore() Store {
+	return &memoryStore{
+		checkpoints: make(map[string]string),
+	}
+}
+
+// Get implements Store.
+func (s *memoryStore) Get(_ context.Context, key string) (string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.checkpoints[key], nil
+}
+
+// Set implements Store.
+func (s *memoryStore) Set(_ context.Context, key, checkpoint string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.checkpoints[key] = checkpoint
+	return nil
+}
+
+// Delete implements Store.
+func (s *memoryStore) Delete(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.checkpoints, key)
+	return nil
+}
+
+// configMapStore is a Store which persists checkpoints in the data of a
+// Kubernetes ConfigMap.
+type configMapStore struct {
+	cli    corev1client.ConfigMapInterface
+	name   string
+	owners []metav1.OwnerReference
+}
+
+// Verify that configMapStore implements Store.
+var _ Store = (*configMapStore)(nil)
+
+// NewConfigMapStore returns a Store which persists checkpoints in the
+// Kubernetes ConfigMap with the given name. The ConfigMap is created with the
+// given owners if it doesn't exist.
+//
+// Keys must be valid ConfigMap keys, which consist of alphanumeric characters,
+// '-', '_' or '.'.
+func NewConfigMapStore(cli corev1client.ConfigMapInterface, name string, owners ...metav1.OwnerReference) Store {
+	return &configMapStore{
+		cli:    cli,
+		name:   name,
+		owners: owners,
+	}
+}
+
+// Get implements Store.
+func (s *configMapStore) Get(ctx context.Context, key string) (string, error) {
+	cm, err := s.cli.Get(ctx, s.name, metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+		return "", nil
+	case err != nil:
+		return "", fmt.Errorf("getting ConfigMap %q: %w", s.name, err)
+	}
+
+	return cm.Data[key], nil
+}
+
+// Set implements Store.
+func (s *configMapStore) Set(ctx context.Context, key, checkpoint string) error {
+	// Replicas of the same adapter write the checkpoints of distinct keys
+	// to the same ConfigMap, so we expect occasional write conflicts.
+	isRetriable := func(err error) bool {
+		return apierrors.IsConflict(err) || apierrors.IsAlreadyExists(err)
+	}
+
+	err := retry.OnError(retry.DefaultRetry, isRetriable, func() error {
+		cm, err := s.cli.Get(ctx, s.name, metav1.GetOptions{})
+		switch {
+		case apierrors.IsNotFound(err):
+			cm = &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            s.name,
+					OwnerReferences: s.owners,
+				},
+				Data: map[string]string{
+					key: checkpoint,
+				},
+			}
+			_, err = s.cli.Create(ctx, cm, metav1.CreateOptions{})
+			return err
+
+		case err != nil:
+			return err
+		}
+
+		if cm.Data[key] == checkpoint {
+			return nil
+		}
+
+		if cm.Data == nil {
+			cm.Data = make(map[string]string, 1)
+		}
+		cm.Data[key] = checkpoint
+
+		_, err = s.cli.Update(ctx, cm, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("writing checkpoint %q to ConfigMap %q: %w", key, s.name, err)
+	}
+
+	return nil
+}
+
+// Delete implements Store.
+func (s *configMapStore) Delete(ctx context.Context, key string) error {
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cm, err := s.cli.Get(ctx, s.name, metav1.GetOptions{})
+		switch {
+		case apierrors.IsNotFound(err):
+			return nil
+		case err != nil:
+			return err
+		}
+
+		if _, exists := cm.Data[key]; !exists {
+			return nil
+		}
+		delete(cm.Data, key)
+
+		_, err = s.cli.Update(ctx, cm, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("deleting checkpoint %q from ConfigMap %q: %w", key, s.name, err)
+	}
+
+	return nil
+}
+
+// ObjectName returns a name, suitable for a Kubernetes object, which is
+// derived from the given prefix and key.
+func ObjectName(prefix, key string) string {
+	return kmeta.ChildName(prefix+"-", sanitizeName(key))
+}
+
+// sanitizeName converts the given string to a valid DNS-1123 subdomain by
+// lowercasing it and replacing invalid characters with '-'.
+func sanitizeName(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '-', r == '.':
+			return r
+		case r >= 'A' && r <= 'Z':
+			return r + ('a' - 'A')
+		default:
+			return '-'
+		}
+	}, s)
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package checkpoint
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+const (
+	tNs     = "test-ns"
+	tCMName = "test-checkpoints"
+)
+
+var tOwner = Owner{
+	APIVersion: "sources.triggermesh.io/v1alpha1",
+	Kind:       "AWSKinesisSource",
+	Name:       "my-source",
+	UID:        "00000000-0000-0000-0000-000000000000",
+}
+
+func TestMemoryStore(t *testing.T) {
+	testStore(t, NewMemoryStore())
+}
+
+func TestConfigMapStore(t *testing.T) {
+	cli := fake.NewSimpleClientset().CoreV1().ConfigMaps(tNs)
+
+	testStore(t, NewConfigMapStore(cli, tCMName, tOwner.References()...))
+
+	cm, err := cli.Get(context.Background(), tCMName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"shard-1": "2", "shard-2": "1"}, cm.Data)
+	assert.Equal(t, tOwner.References(), cm.OwnerReferences)
+}
+
+func TestConfigMapStoreWithoutData(t *testing.T) {
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: tNs,
+			Name:      tCMName,
+		},
+	}
+
+	cli := fake.NewSimpleClientset(cm).CoreV1().ConfigMaps(tNs)
+
+	testStore(t, NewConfigMapStore(cli, tCMName))
+}
+
+// testStore runs a series of generic assertions against the given Store.
+func testStore(t *testing.T, s Store) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	cp, err := s.Get(ctx, "shard-1")
+	require.NoError(t, err)
+	assert.Empty(t, cp, "Expected no checkpoint for unknown key")
+
+	require.NoError(t, s.Set(ctx, "shard-1", "1"))
+	require.NoError(t, s.Set(ctx, "shard-2", "1"))
+	require.NoError(t, s.Set(ctx, "shard-1", "2"))
+
+	cp, err = s.Get(ctx, "shard-1")
+	require.NoError(t, err)
+	assert.Equal(t, "2", cp)
+
+	cp, err = s.Get(ctx, "shard-2")
+	require.NoError(t, err)
+	assert.Equal(t, "1", cp)
+
+	require.NoError(t, s.Set(ctx, "shard-3", "1"))
+	require.NoError(t, s.Delete(ctx, "shard-3"))
+	require.NoError(t, s.Delete(ctx, "shard-4"), "Deleting an unknown key should not fail")
+
+	cp, err = s.Get(ctx, "shard-3")
+	require.NoError(t, err)
+	assert.Empty(t, cp, "Expected no checkpoint for deleted key")
+}
+
+func TestObjectName(t *testing.T) {
+	testCases := map[string]struct {
+		prefix, key string
+		expect      string
+	}{
+		"Valid key": {
+			prefix: "awskinesissource-my-source",
+			key:    "shard-1",
+			expect: "awskinesissource-my-source-shard-1",
+		},
+		"Key with invalid characters": {
+			prefix: "awskinesissource-my-source",
+			key:    "shardId-000000000001",
+			expect: "awskinesissource-my-source-shardid-000000000001",
+		},
+		"Key with slashes": {
+			prefix: "azureeventhubsource-my-source",
+			key:    "consumer/group/0",
+			expect: "azureeventhubsource-my-source-consumer-group-0",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expect, ObjectName(tc.prefix, tc.key))
+		})
+	}
+}
@@ -0,0 +1,280 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package kafkasource implements a CloudEvents adapter that consumes messages
+// from Kafka topics as a member of a consumer group.
+package kafkasource
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+
+	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
+	"knative.dev/pkg/logging"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/sources"
+	"github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+)
+
+// pollTimeoutMs is the maximum duration, in milliseconds, each call to the
+// Kafka consumer blocks while waiting for messages.
+const pollTimeoutMs = 500
+
+// envConfig is a set parameters sourced from the environment for the source's
+// adapter.
+type envConfig struct {
+	pkgadapter.EnvConfig
+
+	BootstrapServers []string `envconfig:"KAFKA_BOOTSTRAP_SERVERS" required:"true"`
+	Topics           []string `envconfig:"KAFKA_TOPICS" required:"true"`
+	GroupID          string   `envconfig:"KAFKA_GROUP_ID" required:"true"`
+
+	// Offset from which messages are consumed in partitions which have no
+	// committed offset. Supported values: [ earliest latest ]
+	InitialOffset string `envconfig:"KAFKA_INITIAL_OFFSET" default:"latest"`
+
+	SASLUsername     string `envconfig:"KAFKA_SASL_USERNAME"`
+	SASLPassword     string `envconfig:"KAFKA_SASL_PASSWORD"`
+	SecurityProtocol string `envconfig:"KAFKA_SECURITY_PROTOCOL"`
+	SASLMechanisms   string `envconfig:"KAFKA_SASL_MECHANISMS"`
+
+	// This set of variables are experimental and not graduated to the CRD.
+	PartitionBufferSize int `envconfig:"KAFKA_PARTITION_BUFFER_SIZE" default:"100"`
+}
+
+// adapter implements the source's adapter.
+type adapter struct {
+	logger *zap.SugaredLogger
+	mt     *pkgadapter.MetricTag
+
+	consumer *kafka.Consumer
+	ceClient cloudevents.Client
+
+	topics     []string
+	ceSource   string
+	bufferSize int
+
+	// Workers which send the messages of each assigned partition to the
+	// sink. Only accessed from the goroutine which polls the consumer.
+	workers map[partition]*partitionWorker
+}
+
+// NewEnvConfig satisfies pkgadapter.EnvConfigConstructor.
+func NewEnvConfig() pkgadapter.EnvConfigAccessor {
+	return &envConfig{}
+}
+
+// NewAdapter satisfies pkgadapter.AdapterConstructor.
+func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClient cloudevents.Client) pkgadapter.Adapter {
+	logger := logging.FromContext(ctx)
+
+	mt := &pkgadapter.MetricTag{
+		ResourceGroup: sources.KafkaSourceResource.String(),
+		Namespace:     envAcc.GetNamespace(),
+		Name:          envAcc.GetName(),
+	}
+
+	env := envAcc.(*envConfig)
+
+	consumer, err := kafka.NewConsumer(consumerConfig(env))
+	if err != nil {
+		logger.Panicw("Unable to create Kafka consumer", zap.Error(err))
+	}
+
+	return &adapter{
+		logger: logger,
+		mt:     mt,
+
+		consumer: consumer,
+		ceClient: ceClient,
+
+		topics:     env.Topics,
+		ceSource:   v1alpha1.KafkaSourceName(env.BootstrapServers),
+		bufferSize: env.PartitionBufferSize,
+
+		workers: make(map[partition]*partitionWorker),
+	}
+}
+
+// consumerConfig returns the configuration of the Kafka consumer.
+func consumerConfig(env *envConfig) *kafka.ConfigMap {
+	cfg := &kafka.ConfigMap{
+		"bootstrap.servers": strings.Join(env.BootstrapServers, ","),
+		"group.id":          env.GroupID,
+		"auto.offset.reset": env.InitialOffset,
+
+		// Offsets are stored explicitly once messages have been
+		// acknowledged by the sink, and committed periodically.
+		"enable.auto.offset.store": false,
+		"enable.auto.commit":       true,
+	}
+
+	if env.SecurityProtocol != "" {
+		_ = cfg.SetKey("security.protocol", env.SecurityProtocol)
+	}
+	if env.SASLMechanisms != "" {
+		_ = cfg.SetKey("sasl.mechanisms", env.SASLMechanisms)
+	}
+	if env.SASLUsername != "" {
+		_ = cfg.SetKey("sasl.username", env.SASLUsername)
+		_ = cfg.SetKey("sasl.password", env.SASLPassword)
+	}
+
+	return cfg
+}
+
+// Start implements adapter.Adapter.
+func (a *adapter) Start(ctx context.Context) error {
+	ctx = pkgadapter.ContextWithMetricTag(ctx, a.mt)
+
+	if err := a.consumer.SubscribeTopics(a.topics, a.handleRebalance); err != nil {
+		return fmt.Errorf("subscribing to topics %q: %w", a.topics, err)
+	}
+
+	a.logger.Infow("Consuming messages from Kafka", zap.Strings("topics", a.topics))
+
+	for {
+		select {
+		case <-ctx.Done():
+			a.stopAllWorkers()
+			if err := a.consumer.Close(); err != nil {
+				return fmt.Errorf("closing Kafka consumer: %w", err)
+			}
+			return nil
+		default:
+		}
+
+		a.resumeDrainedPartitions()
+
+		switch ev := a.consumer.Poll(pollTimeoutMs).(type) {
+		case *kafka.Message:
+			a.dispatch(ctx, ev)
+
+		case kafka.Error:
+			if ev.IsFatal() {
+				a.stopAllWorkers()
+				_ = a.consumer.Close()
+				return fmt.Errorf("consuming from Kafka: %w", ev)
+			}
+			a.logger.Warnw("Kafka consumer reported an error", zap.Error(ev))
+		}
+	}
+}
+
+// handleRebalance is invoked whenever partitions get assigned to or revoked
+// from the consumer. The workers of revoked partitions are stopped before the
+// revocation completes, so that the offsets of acknowledged messages get
+// committed while the consumer still owns these partitions.
+func (a *adapter) handleRebalance(_ *kafka.Consumer, ev kafka.Event) error {
+	switch ev := ev.(type) {
+	case kafka.AssignedPartitions:
+		a.logger.Infow("Partitions assigned", zap.Stringer("partitions", topicPartitions(ev.Partitions)))
+
+	case kafka.RevokedPartitions:
+		a.logger.Infow("Partitions revoked", zap.Stringer("partitions", topicPartitions(ev.Partitions)))
+		for _, tp := range ev.Partitions {
+			a.stopWorker(partitionOf(tp))
+		}
+	}
+
+	return nil
+}
+
+// dispatch hands the given message over to the worker of its partition.
+//
+// When the worker's buffer is full, the message is set aside and the
+// partition is paused, which prevents the consumer from fetching more
+// messages than the sink is able to process without blocking the poll loop.
+// Blocking the poll loop would cause the consumer to exceed the maximum poll
+// interval and get evicted from its group.
+func (a *adapter) dispatch(ctx context.Context, msg *kafka.Message) {
+	p := partitionOf(msg.TopicPartition)
+
+	w, ok := a.workers[p]
+	if !ok {
+		w = a.startWorker(ctx, p)
+	}
+
+	if len(w.pending) == 0 {
+		select {
+		case w.msgs <- msg:
+			return
+		default:
+		}
+	}
+
+	w.pending = append(w.pending, msg)
+
+	if !w.paused {
+		a.pausePartition(p, w)
+	}
+}
+
+// resumeDrainedPartitions hands the messages set aside for paused partitions
+// over to their worker, as long as the worker's buffer has capacity, and
+// resumes the partitions which no longer have pending messages.
+func (a *adapter) resumeDrainedPartitions() {
+	for p, w := range a.workers {
+		if !w.paused {
+			continue
+		}
+
+		if w.drainPending(); len(w.pending) == 0 {
+			a.resumePartition(p, w)
+		}
+	}
+}
+
+// pausePartition pauses the consumption of the given partition.
+func (a *adapter) pausePartition(p partition, w *partitionWorker) {
+	if err := a.consumer.Pause([]kafka.TopicPartition{p.topicPartition()}); err != nil {
+		a.logger.Errorw("Failed to pause partition", zap.Error(err), zap.Stringer("partition", p))
+		return
+	}
+
+	a.logger.Debugw("Paused partition with a full buffer", zap.Stringer("partition", p))
+	w.paused = true
+}
+
+// resumePartition resumes the consumption of the given partition.
+func (a *adapter) resumePartition(p partition, w *partitionWorker) {
+	if err := a.consumer.Resume([]kafka.TopicPartition{p.topicPartition()}); err != nil {
+		a.logger.Errorw("Failed to resume partition", zap.Error(err), zap.Stringer("partition", p))
+		return
+	}
+
+	a.logger.Debugw("Resumed partition", zap.Stringer("partition", p))
+	w.paused = false
+}
+
+// topicPartitions is a list of Kafka partitions which implements fmt.Stringer.
+type topicPartitions []kafka.TopicPartition
+
+// String implements fmt.Stringer.
+func (tps topicPartitions) String() string {
+	s := make([]string, len(tps))
+	for i, tp := range tps {
+		s[i] = partitionOf(tp).String()
+	}
+	return strings.Join(s, ",")
+}
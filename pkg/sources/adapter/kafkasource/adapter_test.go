@@ -0,0 +1,253 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafkasource
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/protocol"
+
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+
+	logtesting "knative.dev/pkg/logging/testing"
+)
+
+const tGroupID = "test-group"
+
+func TestAdapterConsume(t *testing.T) {
+	mc, err := kafka.NewMockCluster(1)
+	require.NoError(t, err)
+	defer mc.Close()
+
+	produceMessages(t, mc.BootstrapServers(),
+		newMessage(0, []byte("key1"), `{"msg":"plain"}`),
+		newMessage(0, nil, `{"msg":"binary"}`,
+			kafka.Header{Key: "ce_specversion", Value: []byte("1.0")},
+			kafka.Header{Key: "ce_id", Value: []byte("binary-1")},
+			kafka.Header{Key: "ce_source", Value: []byte("my.source")},
+			kafka.Header{Key: "ce_type", Value: []byte("my.type")},
+		),
+		newMessage(0, nil,
+			`{"specversion":"1.0","id":"structured-1","source":"my.source","type":"my.type"}`,
+			kafka.Header{Key: "content-type", Value: []byte("application/cloudevents+json")},
+		),
+	)
+
+	// the sink rejects the first event once, which must be retried before
+	// any subsequent event of the same partition gets sent
+	ceClient := &fakeSink{
+		failures: 1,
+		acked:    make(chan cloudevents.Event, 3),
+	}
+
+	ctx, cancel := context.WithCancel(logtesting.TestContextWithLogger(t))
+	defer cancel()
+
+	env := &envConfig{
+		BootstrapServers:    []string{mc.BootstrapServers()},
+		Topics:              []string{tTopic},
+		GroupID:             tGroupID,
+		InitialOffset:       "earliest",
+		PartitionBufferSize: 10,
+	}
+	a := NewAdapter(ctx, env, ceClient)
+
+	errCh := make(chan error)
+	go func() {
+		errCh <- a.Start(ctx)
+	}()
+
+	expectIDs := []string{tTopic + "-0-0", "binary-1", "structured-1"}
+	for _, id := range expectIDs {
+		select {
+		case e := <-ceClient.acked:
+			assert.Equal(t, id, e.ID())
+		case <-time.After(30 * time.Second):
+			t.Fatal("Timed out waiting for events to be sent to the sink")
+		}
+	}
+
+	assert.Equal(t, 4, ceClient.sendCount(), "Expected the rejected event to be sent twice")
+
+	cancel()
+
+	select {
+	case err := <-errCh:
+		require.NoError(t, err)
+	case <-time.After(30 * time.Second):
+		t.Fatal("Timed out waiting for the adapter to stop")
+	}
+
+	assert.Equal(t, kafka.Offset(len(expectIDs)), committedOffset(t, mc.BootstrapServers()),
+		"Expected offsets of acknowledged messages to be committed")
+}
+
+func TestAdapterPauseFullPartition(t *testing.T) {
+	mc, err := kafka.NewMockCluster(1)
+	require.NoError(t, err)
+	defer mc.Close()
+
+	consumer, err := kafka.NewConsumer(&kafka.ConfigMap{
+		"bootstrap.servers": mc.BootstrapServers(),
+		"group.id":          tGroupID,
+	})
+	require.NoError(t, err)
+	defer consumer.Close()
+
+	a := &adapter{
+		logger:   logtesting.TestLogger(t),
+		consumer: consumer,
+		workers:  make(map[partition]*partitionWorker),
+	}
+
+	// worker which doesn't process any message until the test reads from
+	// its buffer
+	p := partition{topic: tTopic, id: 0}
+	w := &partitionWorker{msgs: make(chan *kafka.Message, 1)}
+	a.workers[p] = w
+
+	msgs := []*kafka.Message{
+		newMessage(0, nil, `{"n":1}`),
+		newMessage(0, nil, `{"n":2}`),
+		newMessage(0, nil, `{"n":3}`),
+	}
+
+	dispatched := make(chan struct{})
+	go func() {
+		defer close(dispatched)
+		for _, m := range msgs {
+			a.dispatch(context.Background(), m)
+		}
+	}()
+
+	select {
+	case <-dispatched:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Dispatching messages to a full partition blocked")
+	}
+
+	assert.True(t, w.paused, "Expected the partition to be paused")
+	assert.Len(t, w.pending, 2)
+
+	assert.Same(t, msgs[0], <-w.msgs)
+	a.resumeDrainedPartitions()
+	assert.True(t, w.paused, "Expected the partition to remain paused")
+	assert.Len(t, w.pending, 1)
+
+	assert.Same(t, msgs[1], <-w.msgs)
+	a.resumeDrainedPartitions()
+	assert.False(t, w.paused, "Expected the partition to be resumed")
+	assert.Empty(t, w.pending)
+
+	assert.Same(t, msgs[2], <-w.msgs)
+}
+
+// produceMessages produces the given messages to the test topic.
+func produceMessages(t *testing.T, bootstrapServers string, msgs ...*kafka.Message) {
+	t.Helper()
+
+	p, err := kafka.NewProducer(&kafka.ConfigMap{
+		"bootstrap.servers": bootstrapServers,
+	})
+	require.NoError(t, err)
+	defer p.Close()
+
+	deliveries := make(chan kafka.Event, len(msgs))
+
+	for _, m := range msgs {
+		require.NoError(t, p.Produce(m, deliveries))
+	}
+
+	for range msgs {
+		res := (<-deliveries).(*kafka.Message)
+		require.NoError(t, res.TopicPartition.Error)
+	}
+}
+
+// committedOffset returns the offset committed by the test consumer group for
+// partition 0 of the test topic.
+func committedOffset(t *testing.T, bootstrapServers string) kafka.Offset {
+	t.Helper()
+
+	c, err := kafka.NewConsumer(&kafka.ConfigMap{
+		"bootstrap.servers": bootstrapServers,
+		"group.id":          tGroupID,
+	})
+	require.NoError(t, err)
+	defer c.Close()
+
+	topic := tTopic
+
+	tps, err := c.Committed([]kafka.TopicPartition{{Topic: &topic, Partition: 0}}, 10000)
+	require.NoError(t, err)
+	require.Len(t, tps, 1)
+
+	return tps[0].Offset
+}
+
+// fakeSink is a cloudevents.Client which rejects a given number of events
+// before acknowledging all subsequent ones.
+type fakeSink struct {
+	sync.Mutex
+	failures int
+	sent     int
+
+	acked chan cloudevents.Event
+}
+
+var _ cloudevents.Client = (*fakeSink)(nil)
+
+// Send implements cloudevents.Client.
+func (s *fakeSink) Send(_ context.Context, e cloudevents.Event) protocol.Result {
+	s.Lock()
+	defer s.Unlock()
+
+	s.sent++
+
+	if s.failures > 0 {
+		s.failures--
+		return errors.New("fake sink error")
+	}
+
+	s.acked <- e
+	return protocol.ResultACK
+}
+
+// Request implements cloudevents.Client.
+func (*fakeSink) Request(context.Context, cloudevents.Event) (*cloudevents.Event, protocol.Result) {
+	return nil, errors.New("not implemented")
+}
+
+// StartReceiver implements cloudevents.Client.
+func (*fakeSink) StartReceiver(context.Context, interface{}) error {
+	return errors.New("not implemented")
+}
+
+func (s *fakeSink) sendCount() int {
+	s.Lock()
+	defer s.Unlock()
+
+	return s.sent
+}
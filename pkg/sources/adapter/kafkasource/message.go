@@ -0,0 +1,170 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafkasource
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/binding/format"
+	"github.com/cloudevents/sdk-go/v2/binding/spec"
+
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+)
+
+const (
+	// headerPrefix is the prefix of the Kafka headers which contain the
+	// context attributes of events in binary content mode.
+	headerPrefix = "ce_"
+	// headerContentType is the Kafka header which contains the content type
+	// of messages.
+	headerContentType = "content-type"
+)
+
+// extPartitionKey is the CloudEvents extension which carries the key of
+// wrapped Kafka messages.
+// https://github.com/cloudevents/spec/blob/v1.0.2/cloudevents/extensions/partitioning.md
+const extPartitionKey = "partitionkey"
+
+// kafkaSpecs maps Kafka headers to CloudEvent context attributes.
+var kafkaSpecs = spec.WithPrefix(headerPrefix)
+
+// messageToEvent converts the given Kafka message to a CloudEvent.
+//
+// Messages encoded as CloudEvents in either the structured or the binary
+// content mode of the CloudEvents Kafka protocol binding are decoded as such.
+// Any other message is wrapped into an event of type KafkaSourceEventType.
+// https://github.com/cloudevents/spec/blob/v1.0.2/cloudevents/bindings/kafka-protocol-binding.md
+func messageToEvent(msg *kafka.Message, source string) (*cloudevents.Event, error) {
+	contentType, _ := headerValue(msg, headerContentType)
+
+	if f := format.Lookup(contentType); f != nil {
+		return structuredMessageToEvent(msg, f)
+	}
+
+	if specVersion, ok := headerValue(msg, kafkaSpecs.PrefixedSpecVersionName()); ok {
+		return binaryMessageToEvent(msg, specVersion)
+	}
+
+	return wrapMessage(msg, source, contentType), nil
+}
+
+// structuredMessageToEvent decodes a message in structured content mode,
+// where the message value contains the entire event.
+func structuredMessageToEvent(msg *kafka.Message, f format.Format) (*cloudevents.Event, error) {
+	event := cloudevents.NewEvent()
+	if err := f.Unmarshal(msg.Value, &event); err != nil {
+		return nil, fmt.Errorf("decoding structured CloudEvent: %w", err)
+	}
+
+	if err := event.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid CloudEvent: %w", err)
+	}
+
+	return &event, nil
+}
+
+// binaryMessageToEvent decodes a message in binary content mode, where the
+// message value contains the event data, and headers its context attributes.
+func binaryMessageToEvent(msg *kafka.Message, specVersion string) (*cloudevents.Event, error) {
+	sv := kafkaSpecs.Version(specVersion)
+	if sv == nil {
+		return nil, fmt.Errorf("unsupported CloudEvents spec version %q", specVersion)
+	}
+
+	event := cloudevents.NewEvent(sv.String())
+
+	for _, h := range msg.Headers {
+		var err error
+
+		switch {
+		case h.Key == headerContentType:
+			err = event.Context.SetDataContentType(string(h.Value))
+		case h.Key == kafkaSpecs.PrefixedSpecVersionName():
+			continue
+		default:
+			// headers without the attributes prefix are ignored
+			err = sv.SetAttribute(event.Context, h.Key, string(h.Value))
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("setting attribute from header %q: %w", h.Key, err)
+		}
+	}
+
+	if len(msg.Value) > 0 {
+		event.DataEncoded = msg.Value
+	}
+
+	if err := event.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid CloudEvent: %w", err)
+	}
+
+	return &event, nil
+}
+
+// wrapMessage wraps a message which isn't encoded as a CloudEvent into a new
+// event. The ID of this event is deterministic, so that duplicates caused by
+// redeliveries can be detected downstream.
+func wrapMessage(msg *kafka.Message, source, contentType string) *cloudevents.Event {
+	p := partitionOf(msg.TopicPartition)
+
+	event := cloudevents.NewEvent()
+	event.SetID(p.topic + "-" + strconv.FormatInt(int64(p.id), 10) + "-" +
+		strconv.FormatInt(int64(msg.TopicPartition.Offset), 10))
+	event.SetType(v1alpha1.KafkaSourceEventType)
+	event.SetSource(source)
+	event.SetSubject(p.topic)
+
+	if msg.TimestampType != kafka.TimestampNotAvailable {
+		event.SetTime(msg.Timestamp)
+	}
+
+	if len(msg.Key) > 0 {
+		event.SetExtension(extPartitionKey, string(msg.Key))
+	}
+
+	if contentType == "" {
+		contentType = detectContentType(msg.Value)
+	}
+	event.SetDataContentType(contentType)
+	event.DataEncoded = msg.Value
+
+	return &event
+}
+
+// detectContentType returns the content type of the given message value.
+func detectContentType(val []byte) string {
+	if json.Valid(val) {
+		return cloudevents.ApplicationJSON
+	}
+	return "application/octet-stream"
+}
+
+// headerValue returns the value of the given header of a Kafka message.
+func headerValue(msg *kafka.Message, key string) (string, bool) {
+	for _, h := range msg.Headers {
+		if h.Key == key {
+			return string(h.Value), true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafkasource
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+)
+
+const (
+	tTopic  = "test-topic"
+	tSource = "kafka://broker:9092"
+)
+
+func TestMessageToEvent(t *testing.T) {
+	ts := time.Date(2022, time.June, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		msg         *kafka.Message
+		expectEvent func() cloudevents.Event
+		expectErr   bool
+	}{
+		"structured": {
+			msg: newMessage(3, nil,
+				`{"specversion":"1.0","id":"abc","source":"my.source","type":"my.type",`+
+					`"myext":"val","datacontenttype":"application/json","data":{"msg":"hi"}}`,
+				kafka.Header{Key: "content-type", Value: []byte("application/cloudevents+json; charset=UTF-8")},
+			),
+			expectEvent: func() cloudevents.Event {
+				e := cloudevents.NewEvent()
+				e.SetID("abc")
+				e.SetSource("my.source")
+				e.SetType("my.type")
+				e.SetExtension("myext", "val")
+				e.SetDataContentType(cloudevents.ApplicationJSON)
+				e.DataEncoded = []byte(`{"msg":"hi"}`)
+				return e
+			},
+		},
+		"invalid structured": {
+			msg: newMessage(3, nil, `{"specversion":"1.0","id":"abc"}`,
+				kafka.Header{Key: "content-type", Value: []byte("application/cloudevents+json")},
+			),
+			expectErr: true,
+		},
+		"binary": {
+			msg: newMessage(3, nil, `{"msg":"hi"}`,
+				kafka.Header{Key: "ce_specversion", Value: []byte("1.0")},
+				kafka.Header{Key: "ce_id", Value: []byte("abc")},
+				kafka.Header{Key: "ce_source", Value: []byte("my.source")},
+				kafka.Header{Key: "ce_type", Value: []byte("my.type")},
+				kafka.Header{Key: "ce_time", Value: []byte("2022-06-01T12:00:00Z")},
+				kafka.Header{Key: "ce_myext", Value: []byte("val")},
+				kafka.Header{Key: "content-type", Value: []byte("application/json")},
+				kafka.Header{Key: "other", Value: []byte("ignored")},
+			),
+			expectEvent: func() cloudevents.Event {
+				e := cloudevents.NewEvent()
+				e.SetID("abc")
+				e.SetSource("my.source")
+				e.SetType("my.type")
+				e.SetTime(ts)
+				e.SetExtension("myext", "val")
+				e.SetDataContentType(cloudevents.ApplicationJSON)
+				e.DataEncoded = []byte(`{"msg":"hi"}`)
+				return e
+			},
+		},
+		"binary with unsupported version": {
+			msg: newMessage(3, nil, `{"msg":"hi"}`,
+				kafka.Header{Key: "ce_specversion", Value: []byte("0.1")},
+			),
+			expectErr: true,
+		},
+		"plain JSON": {
+			msg: newMessage(3, []byte("key1"), `{"msg":"hi"}`),
+			expectEvent: func() cloudevents.Event {
+				e := cloudevents.NewEvent()
+				e.SetID(tTopic + "-0-3")
+				e.SetSource(tSource)
+				e.SetType(v1alpha1.KafkaSourceEventType)
+				e.SetSubject(tTopic)
+				e.SetTime(ts)
+				e.SetExtension("partitionkey", "key1")
+				e.SetDataContentType(cloudevents.ApplicationJSON)
+				e.DataEncoded = []byte(`{"msg":"hi"}`)
+				return e
+			},
+		},
+		"plain text with content type": {
+			msg: newMessage(3, nil, "hi",
+				kafka.Header{Key: "content-type", Value: []byte("text/plain")},
+			),
+			expectEvent: func() cloudevents.Event {
+				e := cloudevents.NewEvent()
+				e.SetID(tTopic + "-0-3")
+				e.SetSource(tSource)
+				e.SetType(v1alpha1.KafkaSourceEventType)
+				e.SetSubject(tTopic)
+				e.SetTime(ts)
+				e.SetDataContentType(cloudevents.TextPlain)
+				e.DataEncoded = []byte("hi")
+				return e
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			tc.msg.Timestamp = ts
+			tc.msg.TimestampType = kafka.TimestampCreateTime
+
+			event, err := messageToEvent(tc.msg, tSource)
+			if tc.expectErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectEvent().String(), event.String())
+		})
+	}
+}
+
+// newMessage returns a Kafka message consumed from partition 0 of the test
+// topic at the given offset.
+func newMessage(offset kafka.Offset, key []byte, val string, hs ...kafka.Header) *kafka.Message {
+	topic := tTopic
+
+	return &kafka.Message{
+		TopicPartition: kafka.TopicPartition{
+			Topic:     &topic,
+			Partition: 0,
+			Offset:    offset,
+		},
+		Key:     key,
+		Value:   []byte(val),
+		Headers: hs,
+	}
+}
@@ -0,0 +1,216 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafkasource
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+
+	"github.com/triggermesh/triggermesh/pkg/sources/adapter/common"
+)
+
+// Bounds of the backoff between attempts to send an event to the sink.
+const (
+	minRetryBackoff = 1 * time.Second
+	maxRetryBackoff = 32 * time.Second
+)
+
+// partition identifies a partition of a Kafka topic.
+type partition struct {
+	topic string
+	id    int32
+}
+
+// partitionOf returns the partition identified by the given TopicPartition.
+func partitionOf(tp kafka.TopicPartition) partition {
+	var topic string
+	if tp.Topic != nil {
+		topic = *tp.Topic
+	}
+
+	return partition{
+		topic: topic,
+		id:    tp.Partition,
+	}
+}
+
+// String implements fmt.Stringer.
+func (p partition) String() string {
+	return p.topic + "[" + strconv.FormatInt(int64(p.id), 10) + "]"
+}
+
+// topicPartition returns the TopicPartition which identifies the partition.
+func (p partition) topicPartition() kafka.TopicPartition {
+	topic := p.topic
+
+	return kafka.TopicPartition{
+		Topic:     &topic,
+		Partition: p.id,
+	}
+}
+
+// partitionWorker sends the messages of a single partition to the sink, one
+// at a time and in the order they were consumed.
+type partitionWorker struct {
+	msgs   chan *kafka.Message
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	// Messages which didn't fit in the buffer, and whether the
+	// partition is paused until they do. Only accessed from the
+	// goroutine which polls the consumer.
+	pending []*kafka.Message
+	paused  bool
+}
+
+// drainPending moves pending messages to the worker's buffer, in order, until
+// the buffer is full.
+func (w *partitionWorker) drainPending() {
+	for len(w.pending) > 0 {
+		select {
+		case w.msgs <- w.pending[0]:
+			w.pending[0] = nil
+			w.pending = w.pending[1:]
+		default:
+			return
+		}
+	}
+}
+
+// startWorker starts a worker for the given partition.
+func (a *adapter) startWorker(ctx context.Context, p partition) *partitionWorker {
+	ctx, cancel := context.WithCancel(ctx)
+
+	w := &partitionWorker{
+		msgs:   make(chan *kafka.Message, a.bufferSize),
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+	a.workers[p] = w
+
+	go a.runWorker(ctx, w)
+
+	return w
+}
+
+// stopWorker stops the worker of the given partition, if any, and waits for
+// it to return. Messages which have not been acknowledged by the sink yet are
+// discarded, and consumed again by the next owner of the partition.
+func (a *adapter) stopWorker(p partition) {
+	w, ok := a.workers[p]
+	if !ok {
+		return
+	}
+
+	w.cancel()
+	<-w.done
+
+	if w.paused {
+		a.resumePartition(p, w)
+	}
+
+	delete(a.workers, p)
+}
+
+// stopAllWorkers stops the workers of all partitions.
+func (a *adapter) stopAllWorkers() {
+	for p := range a.workers {
+		a.stopWorker(p)
+	}
+}
+
+// runWorker processes the messages received by the given worker until its
+// context is cancelled.
+func (a *adapter) runWorker(ctx context.Context, w *partitionWorker) {
+	defer close(w.done)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case msg := <-w.msgs:
+			if err := a.processMessage(ctx, msg); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// processMessage sends the given message to the sink as a CloudEvent,
+// retrying until the sink acknowledges it, then stores the offset of the next
+// message of the partition so that it gets committed to Kafka.
+//
+// An error is returned only when the context is cancelled before the message
+// could be acknowledged.
+func (a *adapter) processMessage(ctx context.Context, msg *kafka.Message) error {
+	p := partitionOf(msg.TopicPartition)
+	offset := msg.TopicPartition.Offset
+
+	event, err := messageToEvent(msg, a.ceSource)
+	if err != nil {
+		a.logger.Errorw("Discarding message which can not be converted to a CloudEvent", zap.Error(err),
+			zap.Stringer("partition", p), zap.Int64("offset", int64(offset)))
+		a.storeOffset(msg.TopicPartition)
+		return nil
+	}
+
+	backoff := common.NewBackoff(minRetryBackoff, maxRetryBackoff)
+
+	for {
+		res := a.ceClient.Send(ctx, *event)
+		if cloudevents.IsACK(res) {
+			break
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		retryIn := backoff.Duration()
+
+		a.logger.Errorw("Failed to send event to the sink. Retrying in "+retryIn.String(), zap.Error(res),
+			zap.String("id", event.ID()), zap.Stringer("partition", p), zap.Int64("offset", int64(offset)))
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(retryIn):
+		}
+	}
+
+	a.storeOffset(msg.TopicPartition)
+
+	return nil
+}
+
+// storeOffset stores the offset following the given position, to be committed
+// by the consumer.
+func (a *adapter) storeOffset(tp kafka.TopicPartition) {
+	tp.Offset++
+
+	if _, err := a.consumer.StoreOffsets([]kafka.TopicPartition{tp}); err != nil {
+		a.logger.Errorw("Failed to store offset", zap.Error(err),
+			zap.Stringer("partition", partitionOf(tp)), zap.Int64("offset", int64(tp.Offset)))
+	}
+}
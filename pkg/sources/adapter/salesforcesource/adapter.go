@@ -19,6 +19,7 @@ package salesforcesource
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 
 	"go.uber.org/zap"
@@ -30,18 +31,18 @@ import (
 	"knative.dev/pkg/logging"
 
 	"github.com/triggermesh/triggermesh/pkg/apis/sources"
+	"github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/sources/adapter/common/checkpoint"
 	"github.com/triggermesh/triggermesh/pkg/sources/adapter/salesforcesource/auth"
 	sfclient "github.com/triggermesh/triggermesh/pkg/sources/adapter/salesforcesource/client"
 )
 
-const eventType = "com.salesforce.stream.message"
-
 type salesforceAdapter struct {
-	sfVersion         string
-	sfChannel         string
-	sfInitialReplayID int
+	sfVersion       string
+	sfSubscriptions []sfclient.Subscription
 
-	sfAuth auth.Authenticator
+	sfAuth      auth.Authenticator
+	checkpoints checkpoint.Store
 
 	dispatcher *eventDispatcher
 	logger     *zap.SugaredLogger
@@ -49,12 +50,23 @@ type salesforceAdapter struct {
 }
 
 type eventDispatcher struct {
-	eventSource string
+	// CloudEvent attributes of the events received from each channel.
+	channels map[string]ceAttributes
+	// Prefix of the 'source' attribute of events received from channels
+	// which are not subscribed to explicitly.
+	sourcePrefix string
 
 	ceClient cloudevents.Client
 	logger   *zap.SugaredLogger
 }
 
+// ceAttributes are the CloudEvent attributes of the events received from a
+// channel.
+type ceAttributes struct {
+	source    string
+	eventType string
+}
+
 var _ pkgadapter.Adapter = (*salesforceAdapter)(nil)
 var _ sfclient.EventDispatcher = (*eventDispatcher)(nil)
 
@@ -70,16 +82,47 @@ func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClie
 
 	env := envAcc.(*envAccessor)
 
-	source := env.Name
-	if env.SubscriptionChannel[0] != '/' {
-		source += "/"
+	subs := env.Subscriptions
+	if len(subs) == 0 {
+		if env.SubscriptionChannel == "" {
+			logger.Panic("At least one subscription to a Salesforce channel is required")
+		}
+
+		replayID := env.SubscriptionReplayID
+		subs = subscriptions{{
+			Channel:  env.SubscriptionChannel,
+			ReplayID: &replayID,
+		}}
 	}
-	source += env.SubscriptionChannel
 
 	dispatcher := &eventDispatcher{
-		eventSource: source,
-		ceClient:    ceClient,
-		logger:      logger.Named("dispatcher"),
+		channels:     make(map[string]ceAttributes, len(subs)),
+		sourcePrefix: env.Name,
+		ceClient:     ceClient,
+		logger:       logger.Named("dispatcher"),
+	}
+
+	sfSubs := make([]sfclient.Subscription, 0, len(subs))
+
+	for _, s := range subs {
+		replayID := -1
+		if s.ReplayID != nil {
+			replayID = *s.ReplayID
+		}
+
+		sfSubs = append(sfSubs, sfclient.Subscription{
+			Channel:  s.Channel,
+			ReplayID: replayID,
+		})
+
+		attrs := ceAttributes{
+			source:    dispatcher.channelSource(s.Channel),
+			eventType: v1alpha1.SalesforceSourceGenericEventType,
+		}
+		if s.EventType != nil {
+			attrs.eventType = *s.EventType
+		}
+		dispatcher.channels[s.Channel] = attrs
 	}
 
 	jwtAuth, err := auth.NewJWTAuthenticator(env.CertKey, env.ClientID, env.User, env.AuthServer, http.DefaultClient, logger.Named("authenticator"))
@@ -87,11 +130,17 @@ func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClie
 		logger.Panic(err)
 	}
 
+	checkpoints, err := checkpoint.NewStore(env.CheckpointBackend, envAcc.GetNamespace(),
+		"salesforcesource-"+envAcc.GetName(), env.CheckpointOwner.References()...)
+	if err != nil {
+		logger.Panicw("Unable to initialize checkpoint backend", zap.Error(err))
+	}
+
 	adapter := &salesforceAdapter{
-		sfVersion:         env.Version,
-		sfChannel:         env.SubscriptionChannel,
-		sfInitialReplayID: env.SubscriptionReplayID,
-		sfAuth:            jwtAuth,
+		sfVersion:       env.Version,
+		sfSubscriptions: sfSubs,
+		sfAuth:          jwtAuth,
+		checkpoints:     checkpoints,
 
 		dispatcher: dispatcher,
 		logger:     logger,
@@ -103,38 +152,49 @@ func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClie
 
 // Start runs the handler.
 func (a *salesforceAdapter) Start(ctx context.Context) (err error) {
-	replayID := a.sfInitialReplayID
-
-	subs := []sfclient.Subscription{
-		{
-			Channel:  a.sfChannel,
-			ReplayID: replayID,
-		},
-	}
-
-	client := sfclient.NewBayeux(a.sfVersion, subs, a.sfAuth, a.dispatcher, http.DefaultClient, a.logger.Named("bayeux"))
+	client := sfclient.NewBayeux(a.sfVersion, a.sfSubscriptions, a.checkpoints, a.sfAuth, a.dispatcher,
+		http.DefaultClient, a.logger.Named("bayeux"))
 
 	ctx = pkgadapter.ContextWithMetricTag(ctx, a.mt)
 
 	return client.Start(ctx)
 }
 
-func (e *eventDispatcher) DispatchEvent(ctx context.Context, msg *sfclient.ConnectResponse) {
+// DispatchEvent implements sfclient.EventDispatcher.
+func (e *eventDispatcher) DispatchEvent(ctx context.Context, msg *sfclient.ConnectResponse) error {
+	attrs, ok := e.channels[msg.Channel]
+	if !ok {
+		attrs = ceAttributes{
+			source:    e.channelSource(msg.Channel),
+			eventType: v1alpha1.SalesforceSourceGenericEventType,
+		}
+	}
+
 	event := cloudevents.NewEvent(cloudevents.VersionV1)
 
-	event.SetType(eventType)
-	event.SetSource(e.eventSource)
+	event.SetType(attrs.eventType)
+	event.SetSource(attrs.source)
 	event.SetID(uuid.New().String())
 	event.SetSubject(subjectNameFromConnectResponse(msg))
 	if err := event.SetData(cloudevents.ApplicationJSON, msg.Data); err != nil {
-		e.logger.Errorw("Failed to set event data", zap.Error(err))
-		return
+		return fmt.Errorf("setting event data: %w", err)
 	}
 
 	if result := e.ceClient.Send(ctx, event); !cloudevents.IsACK(result) {
-		e.logger.Errorw("Could not send CloudEvent", zap.Error(result))
-		return
+		return fmt.Errorf("sending CloudEvent: %w", result)
+	}
+
+	return nil
+}
+
+// channelSource returns the value of the 'source' attribute of events
+// received from the given channel.
+func (e *eventDispatcher) channelSource(channel string) string {
+	source := e.sourcePrefix
+	if channel == "" || channel[0] != '/' {
+		source += "/"
 	}
+	return source + channel
 }
 
 func (e *eventDispatcher) DispatchError(err error) {
@@ -37,6 +37,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/utils/clock"
 
+	"github.com/triggermesh/triggermesh/pkg/sources/adapter/common/checkpoint"
 	"github.com/triggermesh/triggermesh/pkg/sources/adapter/salesforcesource/auth"
 )
 
@@ -44,6 +45,15 @@ const connectChannel = "/meta/connect"
 const subscribeChannel = "/meta/subscribe"
 const handshakeChannel = "/meta/handshake"
 
+const (
+	// checkpointInterval is the interval at which the replay IDs of the
+	// last processed events are persisted.
+	checkpointInterval = 5 * time.Second
+	// checkpointSaveTimeout is the maximum duration of the persistence of
+	// replay IDs upon termination.
+	checkpointSaveTimeout = 5 * time.Second
+)
+
 // ConnectResponseCallback is the signature for callback functions
 // that handle connect responses that contain data.
 type ConnectResponseCallback func(msg *ConnectResponse)
@@ -72,8 +82,19 @@ type bayeux struct {
 
 	// store replayID per subscription
 	subsReplays map[string]*int64
-
-	dispatcher EventDispatcher
+	// replayID configured per subscription, used when no replayID was
+	// persisted, or when the persisted replayID is no longer valid
+	initialReplays map[string]int64
+	// replayID last persisted per subscription, only accessed by the
+	// worker loop
+	savedReplays map[string]int64
+	// channels on which an event could not be dispatched. Their replayID
+	// isn't advanced past the failed event, so that this event is replayed
+	// by Salesforce once the channel is subscribed to again.
+	heldReplays map[string]bool
+
+	checkpoints checkpoint.Store
+	dispatcher  EventDispatcher
 
 	logger *zap.SugaredLogger
 	ctx    context.Context
@@ -81,14 +102,23 @@ type bayeux struct {
 }
 
 // NewBayeux creates a Bayeux client for Salesforce Streaming API consumption.
-func NewBayeux(apiVersion string, subscriptions []Subscription, authenticator auth.Authenticator, dispatcher EventDispatcher, client *http.Client, logger *zap.SugaredLogger) Bayeux {
+// The replayID of the last event processed on each channel is persisted in the
+// given checkpoint store, and subscriptions are resumed from that replayID
+// after a restart.
+func NewBayeux(apiVersion string, subscriptions []Subscription, checkpoints checkpoint.Store,
+	authenticator auth.Authenticator, dispatcher EventDispatcher, client *http.Client, logger *zap.SugaredLogger) Bayeux {
 
 	// replayID is stored in a map and will keep track of the latest event received,
 	// we copy the configured value for initialization.
 	sr := make(map[string]*int64, len(subscriptions))
+	ir := make(map[string]int64, len(subscriptions))
+	saved := make(map[string]int64, len(subscriptions))
 	for _, s := range subscriptions {
 		r := int64(s.ReplayID)
 		sr[s.Channel] = &r
+		ir[s.Channel] = r
+		// the configured value doesn't need to be persisted
+		saved[s.Channel] = r
 	}
 
 	return &bayeux{
@@ -102,8 +132,12 @@ func NewBayeux(apiVersion string, subscriptions []Subscription, authenticator au
 		errCh:  make(chan error),
 		stopCh: make(chan struct{}),
 
-		dispatcher:  dispatcher,
-		subsReplays: sr,
+		checkpoints:    checkpoints,
+		dispatcher:     dispatcher,
+		subsReplays:    sr,
+		initialReplays: ir,
+		savedReplays:   saved,
+		heldReplays:    make(map[string]bool, len(subscriptions)),
 
 		logger: logger,
 	}
@@ -127,9 +161,22 @@ func (b *bayeux) init() error {
 		}
 		for _, sb := range sbs {
 			if !sb.Successful {
+				// A persisted replayID becomes invalid once the
+				// corresponding event leaves the retention window.
+				// Fall back to the configured replayID so that the
+				// next attempt succeeds.
+				if initial := b.initialReplays[k]; replayID != initial && strings.Contains(sb.Error, "replayId") {
+					b.logger.Warnw("Replay ID is no longer valid, falling back to the configured replay ID",
+						zap.String("channel", k), zap.Int64("replayID", replayID), zap.Int64("initialReplayID", initial))
+					atomic.StoreInt64(v, initial)
+				}
 				return fmt.Errorf("could not subscribe to %s: %s", sb.Subscription, sb.Error)
 			}
 		}
+
+		// the subscription resumes from the held replayID, any event
+		// which failed to be dispatched is about to be replayed
+		b.releaseReplay(k)
 	}
 
 	return nil
@@ -166,6 +213,10 @@ func (b *bayeux) Start(ctx context.Context) error {
 	b.ctx = ctx
 	b.mutex.Unlock()
 
+	if err := b.restoreReplays(ctx); err != nil {
+		return err
+	}
+
 	bom := wait.NewExponentialBackoffManager(time.Second, time.Second*60, time.Second*100, 2, 0, &clock.RealClock{})
 
 	// Connect loop will run until context is done
@@ -250,23 +301,112 @@ func (b *bayeux) Start(ctx context.Context) error {
 		}
 	}()
 
+	t := time.NewTicker(checkpointInterval)
+	defer t.Stop()
+
 	// Worker loop will run until the connect loop is stopped.
 	for {
 		select {
 		case msg := <-b.msgCh:
-			b.dispatcher.DispatchEvent(b.ctx, msg)
+			if err := b.dispatcher.DispatchEvent(b.ctx, msg); err != nil {
+				b.logger.Errorw("Failed to dispatch event", zap.String("channel", msg.Channel),
+					zap.Int64("replayID", msg.Data.Event.ReplayID), zap.Error(err))
+				b.holdReplay(msg.Channel)
+				continue
+			}
 			r, ok := b.subsReplays[msg.Channel]
-			if ok {
+			if ok && !b.isReplayHeld(msg.Channel) {
 				atomic.StoreInt64(r, msg.Data.Event.ReplayID)
 			}
 		case err := <-b.errCh:
 			b.dispatcher.DispatchError(err)
+		case <-t.C:
+			b.saveReplays(b.ctx)
 		case <-b.stopCh:
+			ctx, cancel := context.WithTimeout(context.Background(), checkpointSaveTimeout)
+			defer cancel()
+			b.saveReplays(ctx)
 			return nil
 		}
 	}
 }
 
+// restoreReplays sets the replayID of each subscription to the replayID which
+// was last persisted for its channel, if any.
+func (b *bayeux) restoreReplays(ctx context.Context) error {
+	for channel, r := range b.subsReplays {
+		cp, err := b.checkpoints.Get(ctx, checkpointKey(channel))
+		if err != nil {
+			return fmt.Errorf("reading replay ID of channel %s: %w", channel, err)
+		}
+		if cp == "" {
+			continue
+		}
+
+		replayID, err := strconv.ParseInt(cp, 10, 64)
+		if err != nil {
+			b.logger.Warnw("Ignoring invalid persisted replay ID", zap.String("channel", channel),
+				zap.String("replayID", cp))
+			continue
+		}
+
+		b.logger.Infow("Resuming subscription from persisted replay ID", zap.String("channel", channel),
+			zap.Int64("replayID", replayID))
+
+		atomic.StoreInt64(r, replayID)
+		b.savedReplays[channel] = replayID
+	}
+
+	return nil
+}
+
+// saveReplays persists the replayID of the last event processed on each
+// channel, if it changed since it was last persisted.
+func (b *bayeux) saveReplays(ctx context.Context) {
+	for channel, r := range b.subsReplays {
+		replayID := atomic.LoadInt64(r)
+
+		if b.savedReplays[channel] == replayID {
+			continue
+		}
+
+		if err := b.checkpoints.Set(ctx, checkpointKey(channel), strconv.FormatInt(replayID, 10)); err != nil {
+			b.logger.Errorw("Failed to persist replay ID", zap.String("channel", channel), zap.Error(err))
+			continue
+		}
+		b.savedReplays[channel] = replayID
+	}
+}
+
+// holdReplay stops advancing the replayID of the given channel, until this
+// channel is subscribed to again.
+func (b *bayeux) holdReplay(channel string) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.heldReplays[channel] = true
+}
+
+// releaseReplay resumes advancing the replayID of the given channel.
+func (b *bayeux) releaseReplay(channel string) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	delete(b.heldReplays, channel)
+}
+
+// isReplayHeld returns whether the replayID of the given channel is held.
+func (b *bayeux) isReplayHeld(channel string) bool {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+	return b.heldReplays[channel]
+}
+
+// checkpointKey returns the key under which the replayID of the given channel
+// is persisted. Channel names are converted to valid ConfigMap keys, e.g.
+// "/data/AccountChangeEvent" becomes "data.AccountChangeEvent".
+func checkpointKey(channel string) string {
+	return strings.ReplaceAll(strings.TrimPrefix(channel, "/"), "/", ".")
+}
+
 func (b *bayeux) manageMeta(cr *ConnectResponse) {
 	if cr.Successful {
 		b.logger.Debugf("Meta channel (channel: %s client: %s) ok", cr.Channel, cr.ClientID)
@@ -17,15 +17,20 @@ limitations under the License.
 package client
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
+	"github.com/triggermesh/triggermesh/pkg/sources/adapter/common/checkpoint"
 	"github.com/triggermesh/triggermesh/pkg/sources/adapter/salesforcesource/auth"
 	"github.com/triggermesh/triggermesh/pkg/sources/adapter/salesforcesource/auth/fake"
 	zapt "go.uber.org/zap/zaptest"
@@ -169,7 +174,7 @@ func TestBayeux(t *testing.T) {
 				InstanceURL: sf.URL,
 			})
 
-			b := NewBayeux(tAPIVersion, tSubscription, authenticator, dispatcher, sf.Client(), logger)
+			b := NewBayeux(tAPIVersion, tSubscription, checkpoint.NewMemoryStore(), authenticator, dispatcher, sf.Client(), logger)
 			ctx, cancel := context.WithCancel(context.Background())
 			defer cancel()
 
@@ -192,6 +197,146 @@ func TestBayeux(t *testing.T) {
 	}
 }
 
+func TestBayeuxReplayCheckpoints(t *testing.T) {
+	logger := zapt.NewLogger(t).Sugar()
+
+	const channel = "/channel1"
+
+	store := checkpoint.NewMemoryStore()
+	require.NoError(t, store.Set(context.Background(), checkpointKey(channel), "7"))
+
+	var subscribeReplays []float64
+	var mu sync.Mutex
+
+	handler := mockBayeuxServerHandler([]response{
+		{handshake: handshakeResponse()},
+		{subscribe: subscribeResponse()},
+		{connect: connectResponse(connectWithChannel(channel), connectWithReplayID(41))},
+		{connect: connectResponse(connectWithChannel(channel), connectWithReplayID(42))},
+	})
+
+	sf := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		var req struct {
+			Channel string `json:"channel"`
+			Ext     struct {
+				Replay map[string]float64 `json:"replay"`
+			} `json:"ext"`
+		}
+		if err := json.Unmarshal(body, &req); err == nil && req.Channel == subscribeChannel {
+			mu.Lock()
+			subscribeReplays = append(subscribeReplays, req.Ext.Replay[channel])
+			mu.Unlock()
+		}
+
+		handler(w, r)
+	}))
+	defer sf.Close()
+
+	dispatcher := &eventDispatcher{
+		eof: make(chan struct{}),
+	}
+	authenticator := fake.NewFakeAuthenticator(auth.Credentials{
+		InstanceURL: sf.URL,
+	})
+
+	subs := []Subscription{{Channel: channel, ReplayID: -1}}
+
+	b := NewBayeux(tAPIVersion, subs, store, authenticator, dispatcher, sf.Client(), logger)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	errCh := make(chan error)
+	go func() {
+		errCh <- b.Start(ctx)
+	}()
+
+	select {
+	case <-time.After(4000 * time.Millisecond):
+		t.Fatal("Test timed out.")
+	case <-dispatcher.eof:
+	}
+
+	// stopping the client persists the replayID of the last processed event
+	cancel()
+	select {
+	case <-time.After(4000 * time.Millisecond):
+		t.Fatal("Timed out waiting for the client to stop.")
+	case err := <-errCh:
+		require.NoError(t, err, "The bayeux client failed")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Equal(t, []float64{7}, subscribeReplays, "The subscription should resume from the persisted replayID")
+
+	cp, err := store.Get(context.Background(), checkpointKey(channel))
+	require.NoError(t, err)
+	require.Equal(t, "42", cp, "Unexpected persisted replayID")
+}
+
+func TestBayeuxReplayCheckpointsDispatchFailure(t *testing.T) {
+	logger := zapt.NewLogger(t).Sugar()
+
+	const channel = "/channel1"
+
+	store := checkpoint.NewMemoryStore()
+
+	sf := httptest.NewServer(mockBayeuxServerHandler([]response{
+		{handshake: handshakeResponse()},
+		{subscribe: subscribeResponse()},
+		{connect: connectResponse(connectWithChannel(channel), connectWithReplayID(41))},
+		{connect: connectResponse(connectWithChannel(channel), connectWithReplayID(42))},
+		{connect: connectResponse(connectWithChannel(channel), connectWithReplayID(43))},
+	}))
+	defer sf.Close()
+
+	dispatcher := &eventDispatcher{
+		eof:           make(chan struct{}),
+		failReplayIDs: map[int64]bool{42: true},
+	}
+	authenticator := fake.NewFakeAuthenticator(auth.Credentials{
+		InstanceURL: sf.URL,
+	})
+
+	subs := []Subscription{{Channel: channel, ReplayID: -1}}
+
+	b := NewBayeux(tAPIVersion, subs, store, authenticator, dispatcher, sf.Client(), logger)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	errCh := make(chan error)
+	go func() {
+		errCh <- b.Start(ctx)
+	}()
+
+	select {
+	case <-time.After(4000 * time.Millisecond):
+		t.Fatal("Test timed out.")
+	case <-dispatcher.eof:
+	}
+
+	cancel()
+	select {
+	case <-time.After(4000 * time.Millisecond):
+		t.Fatal("Timed out waiting for the client to stop.")
+	case err := <-errCh:
+		require.NoError(t, err, "The bayeux client failed")
+	}
+
+	require.Len(t, dispatcher.dispatchedEvents, 2, "Unexpected number of dispatched events")
+
+	cp, err := store.Get(context.Background(), checkpointKey(channel))
+	require.NoError(t, err)
+	require.Equal(t, "41", cp, "The persisted replayID should not advance past a failed event")
+}
+
+func TestCheckpointKey(t *testing.T) {
+	require.Equal(t, "data.AccountChangeEvent", checkpointKey("/data/AccountChangeEvent"))
+	require.Equal(t, "event.Order_Placed__e", checkpointKey("/event/Order_Placed__e"))
+}
+
 func handshakeResponse() []HandshakeResponse {
 	return []HandshakeResponse{{
 		CommonResponse: CommonResponse{
@@ -246,20 +391,32 @@ func connectWithAdviceReconnect(reconnect string) connectResponseOption {
 	}
 }
 
+func connectWithReplayID(replayID int64) connectResponseOption {
+	return func(cr *ConnectResponse) {
+		cr.Data.Event.ReplayID = replayID
+	}
+}
+
 var _ EventDispatcher = (*eventDispatcher)(nil)
 
 type eventDispatcher struct {
 	eof              chan struct{}
 	dispatchedEvents []*ConnectResponse
 	dispatchedErrors []error
+	// replayIDs of the events which fail to be dispatched
+	failReplayIDs map[int64]bool
 }
 
-func (e *eventDispatcher) DispatchEvent(ctx context.Context, res *ConnectResponse) {
+func (e *eventDispatcher) DispatchEvent(ctx context.Context, res *ConnectResponse) error {
 	if res.Channel == tResponseFinishChannel {
 		close(e.eof)
-		return
+		return nil
+	}
+	if e.failReplayIDs[res.Data.Event.ReplayID] {
+		return errors.New("dispatch failed")
 	}
 	e.dispatchedEvents = append(e.dispatchedEvents, res)
+	return nil
 }
 
 func (e *eventDispatcher) DispatchError(err error) {
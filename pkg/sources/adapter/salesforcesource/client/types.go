@@ -87,8 +87,11 @@ type PushTopicSObject struct {
 
 // EventDispatcher is an object that can dispatch messages and "non managed" errors
 // received through the stream.
+//
+// DispatchEvent returns an error when the message could not be processed, in
+// which case its replayID is not recorded.
 type EventDispatcher interface {
-	DispatchEvent(context.Context, *ConnectResponse)
+	DispatchEvent(context.Context, *ConnectResponse) error
 	DispatchError(error)
 }
 
@@ -16,7 +16,14 @@ limitations under the License.
 
 package salesforcesource
 
-import "knative.dev/eventing/pkg/adapter/v2"
+import (
+	"encoding/json"
+
+	"knative.dev/eventing/pkg/adapter/v2"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/sources/adapter/common/checkpoint"
+)
 
 // NewEnvConfig satisfies pkgadapter.EnvConfigConstructor.
 func NewEnvConfig() adapter.EnvConfigAccessor {
@@ -32,7 +39,28 @@ type envAccessor struct {
 	CertKey    string `envconfig:"SALESFORCE_AUTH_CERT_KEY" required:"true"`
 	Version    string `envconfig:"SALESFORCE_API_VERSION" default:"48.0"`
 
-	// We are supporting only one subscription + replayID per source instance
-	SubscriptionChannel  string `envconfig:"SALESFORCE_SUBCRIPTION_CHANNEL" required:"true"`
+	// Subscriptions to Salesforce channels.
+	Subscriptions subscriptions `envconfig:"SALESFORCE_SUBSCRIPTIONS"`
+
+	// Single subscription, used only when no subscription is set in
+	// Subscriptions.
+	SubscriptionChannel  string `envconfig:"SALESFORCE_SUBCRIPTION_CHANNEL"`
 	SubscriptionReplayID int    `envconfig:"SALESFORCE_SUBCRIPTION_REPLAY_ID" default:"-1"`
+
+	// Backend used to persist the replay IDs of processed events.
+	CheckpointBackend string `envconfig:"SALESFORCE_CHECKPOINT_BACKEND" default:"kubernetes"`
+	// Component instance which owns the persisted replay IDs.
+	CheckpointOwner checkpoint.Owner `envconfig:"CHECKPOINT_OWNER"`
+}
+
+// subscriptions is a list of subscriptions to Salesforce channels. It is
+// decoded by envconfig from a JSON-serialized list.
+type subscriptions []v1alpha1.SalesforceSubscription
+
+// Decode implements envconfig.Decoder.
+func (s *subscriptions) Decode(value string) error {
+	if value == "" {
+		return nil
+	}
+	return json.Unmarshal([]byte(value), (*[]v1alpha1.SalesforceSubscription)(s))
 }
@@ -18,6 +18,9 @@ package webhooksource
 
 import (
 	"context"
+	"encoding/json"
+
+	"go.uber.org/zap"
 
 	cloudevents "github.com/cloudevents/sdk-go/v2"
 
@@ -25,6 +28,7 @@ import (
 	"knative.dev/pkg/logging"
 
 	"github.com/triggermesh/triggermesh/pkg/apis/sources"
+	"github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
 )
 
 // NewAdapter satisfies pkgadapter.AdapterConstructor.
@@ -36,6 +40,29 @@ func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClie
 	}
 
 	env := envAcc.(*envAccessor)
+	logger := logging.FromContext(ctx)
+
+	var signature *signatureVerifier
+	if env.SignatureHeader != "" {
+		var err error
+		signature, err = newSignatureVerifier(env.SignatureHeader, env.SignatureAlgorithm,
+			env.SignatureSecret, env.SignaturePrefix, env.SignatureEncoding)
+		if err != nil {
+			logger.Panicw("Invalid signature verification parameters", zap.Error(err))
+		}
+
+		if env.SignatureTimestampHeader != "" {
+			signature = signature.withTimestamp(env.SignatureTimestampHeader, env.SignatureTimestampTolerance)
+		}
+	}
+
+	var mapping *v1alpha1.WebhookMapping
+	if env.Mapping != "" {
+		mapping = &v1alpha1.WebhookMapping{}
+		if err := json.Unmarshal([]byte(env.Mapping), mapping); err != nil {
+			logger.Panicw("Invalid request mapping", zap.Error(err))
+		}
+	}
 
 	return &webhookHandler{
 		eventType:       env.EventType,
@@ -43,9 +70,11 @@ func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClie
 		username:        env.BasicAuthUsername,
 		password:        env.BasicAuthPassword,
 		corsAllowOrigin: env.CORSAllowOrigin,
+		signature:       signature,
+		mapping:         mapping,
 
 		ceClient: ceClient,
-		logger:   logging.FromContext(ctx),
+		logger:   logger,
 		mt:       mt,
 	}
 }
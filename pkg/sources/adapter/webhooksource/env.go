@@ -17,6 +17,8 @@ limitations under the License.
 package webhooksource
 
 import (
+	"time"
+
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
 )
 
@@ -33,4 +35,16 @@ type envAccessor struct {
 	BasicAuthUsername string `envconfig:"WEBHOOK_BASICAUTH_USERNAME"`
 	BasicAuthPassword string `envconfig:"WEBHOOK_BASICAUTH_PASSWORD"`
 	CORSAllowOrigin   string `envconfig:"WEBHOOK_CORS_ALLOW_ORIGIN"`
+
+	// JSON-encoded extraction rules of request metadata
+	Mapping string `envconfig:"WEBHOOK_MAPPING"`
+
+	// HMAC signature verification
+	SignatureHeader             string        `envconfig:"WEBHOOK_SIGNATURE_HEADER"`
+	SignatureAlgorithm          string        `envconfig:"WEBHOOK_SIGNATURE_ALGORITHM" default:"sha256"`
+	SignatureSecret             string        `envconfig:"WEBHOOK_SIGNATURE_SECRET"`
+	SignaturePrefix             string        `envconfig:"WEBHOOK_SIGNATURE_PREFIX"`
+	SignatureEncoding           string        `envconfig:"WEBHOOK_SIGNATURE_ENCODING" default:"hex"`
+	SignatureTimestampHeader    string        `envconfig:"WEBHOOK_SIGNATURE_TIMESTAMP_HEADER"`
+	SignatureTimestampTolerance time.Duration `envconfig:"WEBHOOK_SIGNATURE_TIMESTAMP_TOLERANCE" default:"5m"`
 }
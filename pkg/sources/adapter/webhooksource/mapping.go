@@ -0,0 +1,87 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooksource
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/tidwall/gjson"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+)
+
+// applyMapping sets the attributes of the event from the metadata and body
+// of the HTTP request, as defined by the given mapping. Attributes which
+// value can not be found in the request are left untouched.
+func applyMapping(event *cloudevents.Event, m *v1alpha1.WebhookMapping, r *http.Request, body []byte) error {
+	if m.Type != nil {
+		if v, ok := extractValue(m.Type, r, body); ok {
+			event.SetType(v)
+		}
+	}
+
+	if m.Subject != nil {
+		if v, ok := extractValue(m.Subject, r, body); ok {
+			event.SetSubject(v)
+		}
+	}
+
+	if m.ID != nil {
+		if v, ok := extractValue(m.ID, r, body); ok {
+			event.SetID(v)
+		}
+	}
+
+	for i := range m.Extensions {
+		ext := &m.Extensions[i]
+		v, ok := extractValue(&ext.WebhookValueSource, r, body)
+		if !ok {
+			continue
+		}
+		if err := event.Context.SetExtension(ext.Name, v); err != nil {
+			return fmt.Errorf("setting extension %q: %w", ext.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// extractValue returns the value located at the given source within the
+// request, and whether it was found.
+func extractValue(src *v1alpha1.WebhookValueSource, r *http.Request, body []byte) (string, bool) {
+	var v string
+
+	switch {
+	case src.Header != nil:
+		v = r.Header.Get(*src.Header)
+	case src.Query != nil:
+		v = r.URL.Query().Get(*src.Query)
+	case src.Path != nil && *src.Path:
+		v = r.URL.Path
+	case src.Method != nil && *src.Method:
+		v = r.Method
+	case src.BodyPath != nil:
+		if res := gjson.GetBytes(body, *src.BodyPath); res.Exists() {
+			v = res.String()
+		}
+	}
+
+	return v, v != ""
+}
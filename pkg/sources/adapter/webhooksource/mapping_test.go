@@ -0,0 +1,120 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooksource
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+)
+
+func TestApplyMapping(t *testing.T) {
+	const body = `{"action":"opened","repository":{"full_name":"triggermesh/triggermesh"}}`
+
+	req, err := http.NewRequest(http.MethodPost, "/github/hooks?tenant=acme", strings.NewReader(body))
+	require.NoError(t, err)
+	req.Header.Set("X-GitHub-Event", "pull_request")
+	req.Header.Set("X-GitHub-Delivery", "72d3162e-cc78-11e3-81ab-4c9367dc0958")
+
+	mapping := &v1alpha1.WebhookMapping{
+		Type:    &v1alpha1.WebhookValueSource{Header: ptrString("X-GitHub-Event")},
+		Subject: &v1alpha1.WebhookValueSource{BodyPath: ptrString("repository.full_name")},
+		ID:      &v1alpha1.WebhookValueSource{Header: ptrString("X-GitHub-Delivery")},
+		Extensions: []v1alpha1.WebhookExtensionMapping{{
+			Name:               "action",
+			WebhookValueSource: v1alpha1.WebhookValueSource{BodyPath: ptrString("action")},
+		}, {
+			Name:               "tenant",
+			WebhookValueSource: v1alpha1.WebhookValueSource{Query: ptrString("tenant")},
+		}, {
+			Name:               "path",
+			WebhookValueSource: v1alpha1.WebhookValueSource{Path: ptrBool(true)},
+		}, {
+			Name:               "method",
+			WebhookValueSource: v1alpha1.WebhookValueSource{Method: ptrBool(true)},
+		}, {
+			Name:               "missing",
+			WebhookValueSource: v1alpha1.WebhookValueSource{Header: ptrString("X-Missing")},
+		}},
+	}
+
+	event := cloudevents.NewEvent()
+	event.SetID("default-id")
+	event.SetType(tEventType)
+	event.SetSource(tEventSource)
+
+	err = applyMapping(&event, mapping, req, []byte(body))
+	require.NoError(t, err)
+
+	assert.Equal(t, "pull_request", event.Type())
+	assert.Equal(t, "triggermesh/triggermesh", event.Subject())
+	assert.Equal(t, "72d3162e-cc78-11e3-81ab-4c9367dc0958", event.ID())
+
+	exts := event.Extensions()
+	assert.Equal(t, "opened", exts["action"])
+	assert.Equal(t, "acme", exts["tenant"])
+	assert.Equal(t, "/github/hooks", exts["path"])
+	assert.Equal(t, http.MethodPost, exts["method"])
+	assert.NotContains(t, exts, "missing")
+}
+
+func TestApplyMappingDefaults(t *testing.T) {
+	req, err := http.NewRequest(http.MethodPost, "/", strings.NewReader("not json"))
+	require.NoError(t, err)
+
+	mapping := &v1alpha1.WebhookMapping{
+		Type: &v1alpha1.WebhookValueSource{Header: ptrString("X-GitHub-Event")},
+		ID:   &v1alpha1.WebhookValueSource{BodyPath: ptrString("id")},
+	}
+
+	event := cloudevents.NewEvent()
+	event.SetID("default-id")
+	event.SetType(tEventType)
+
+	err = applyMapping(&event, mapping, req, []byte("not json"))
+	require.NoError(t, err)
+
+	assert.Equal(t, tEventType, event.Type(), "type should retain its default value")
+	assert.Equal(t, "default-id", event.ID(), "id should retain its default value")
+}
+
+func TestApplyMappingInvalidExtension(t *testing.T) {
+	req, err := http.NewRequest(http.MethodPost, "/", nil)
+	require.NoError(t, err)
+	req.Header.Set("X-Request-Id", "abc")
+
+	mapping := &v1alpha1.WebhookMapping{
+		Extensions: []v1alpha1.WebhookExtensionMapping{{
+			Name:               "Request-ID",
+			WebhookValueSource: v1alpha1.WebhookValueSource{Header: ptrString("X-Request-Id")},
+		}},
+	}
+
+	event := cloudevents.NewEvent()
+	err = applyMapping(&event, mapping, req, nil)
+	assert.Error(t, err)
+}
+
+func ptrString(s string) *string { return &s }
+func ptrBool(b bool) *bool       { return &b }
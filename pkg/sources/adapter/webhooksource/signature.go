@@ -0,0 +1,160 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooksource
+
+import (
+	"crypto/hmac"
+	"crypto/sha1" //nolint:gosec
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Supported signature algorithms.
+const (
+	signatureAlgorithmSHA1   = "sha1"
+	signatureAlgorithmSHA256 = "sha256"
+)
+
+// Supported signature encodings.
+const (
+	signatureEncodingHex    = "hex"
+	signatureEncodingBase64 = "base64"
+)
+
+// signatureVerifier verifies the HMAC signature of request bodies.
+type signatureVerifier struct {
+	header   string
+	prefix   string
+	encoding string
+	secret   []byte
+	hash     func() hash.Hash
+
+	// replay protection, disabled when timestampHeader is empty
+	timestampHeader string
+	tolerance       time.Duration
+	now             func() time.Time
+}
+
+// newSignatureVerifier returns a signatureVerifier for the given parameters.
+func newSignatureVerifier(header, algorithm, secret, prefix, encoding string) (*signatureVerifier, error) {
+	if secret == "" {
+		return nil, errors.New("the signature secret is empty")
+	}
+
+	var h func() hash.Hash
+	switch algorithm {
+	case signatureAlgorithmSHA1:
+		h = sha1.New
+	case signatureAlgorithmSHA256:
+		h = sha256.New
+	default:
+		return nil, fmt.Errorf("unsupported signature algorithm %q", algorithm)
+	}
+
+	switch encoding {
+	case signatureEncodingHex, signatureEncodingBase64:
+	default:
+		return nil, fmt.Errorf("unsupported signature encoding %q", encoding)
+	}
+
+	return &signatureVerifier{
+		header:   header,
+		prefix:   prefix,
+		encoding: encoding,
+		secret:   []byte(secret),
+		hash:     h,
+		now:      time.Now,
+	}, nil
+}
+
+// withTimestamp enables the rejection of requests which timestamp, read from
+// the given header, differs from the current time by more than tolerance.
+func (v *signatureVerifier) withTimestamp(header string, tolerance time.Duration) *signatureVerifier {
+	v.timestampHeader = header
+	v.tolerance = tolerance
+	return v
+}
+
+// verify returns an error if the signature of the request doesn't match its body.
+func (v *signatureVerifier) verify(r *http.Request, body []byte) error {
+	val := r.Header.Get(v.header)
+	if val == "" {
+		return fmt.Errorf("missing signature header %q", v.header)
+	}
+	if !strings.HasPrefix(val, v.prefix) {
+		return errors.New("malformed signature")
+	}
+
+	signature, err := v.decode(strings.TrimPrefix(val, v.prefix))
+	if err != nil {
+		return errors.New("malformed signature")
+	}
+
+	mac := hmac.New(v.hash, v.secret)
+
+	if v.timestampHeader != "" {
+		ts := r.Header.Get(v.timestampHeader)
+		if err := v.checkTimestamp(ts); err != nil {
+			return err
+		}
+		mac.Write([]byte(ts + "."))
+	}
+
+	mac.Write(body)
+
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		return errors.New("signature does not match")
+	}
+	return nil
+}
+
+// checkTimestamp verifies that the given Unix timestamp is within the
+// tolerance of the current time.
+func (v *signatureVerifier) checkTimestamp(ts string) error {
+	if ts == "" {
+		return fmt.Errorf("missing timestamp header %q", v.timestampHeader)
+	}
+
+	sec, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil {
+		return errors.New("malformed timestamp")
+	}
+
+	age := v.now().Sub(time.Unix(sec, 0))
+	if age < 0 {
+		age = -age
+	}
+	if age > v.tolerance {
+		return errors.New("timestamp is outside of the tolerance window")
+	}
+	return nil
+}
+
+func (v *signatureVerifier) decode(s string) ([]byte, error) {
+	if v.encoding == signatureEncodingBase64 {
+		return base64.StdEncoding.DecodeString(s)
+	}
+	return hex.DecodeString(s)
+}
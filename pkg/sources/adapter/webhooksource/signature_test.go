@@ -0,0 +1,135 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooksource
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const tSignatureSecret = "s3cr3t"
+
+func TestSignatureVerifier(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	body := []byte(`{"hello":"world"}`)
+
+	tc := map[string]struct {
+		encoding  string
+		tolerance time.Duration
+		timestamp time.Time
+		signature string
+
+		expectErr string
+	}{
+		"hex signature": {
+			encoding:  signatureEncodingHex,
+			signature: hex.EncodeToString(hmacSHA256("", body)),
+		},
+		"base64 signature": {
+			encoding:  signatureEncodingBase64,
+			signature: base64.StdEncoding.EncodeToString(hmacSHA256("", body)),
+		},
+		"malformed signature": {
+			encoding:  signatureEncodingHex,
+			signature: "not hex",
+			expectErr: "malformed signature",
+		},
+		"timestamp within tolerance": {
+			encoding:  signatureEncodingHex,
+			tolerance: time.Minute,
+			timestamp: now.Add(-30 * time.Second),
+			signature: hex.EncodeToString(hmacSHA256(strconv.FormatInt(now.Add(-30*time.Second).Unix(), 10)+".", body)),
+		},
+		"timestamp outside of tolerance": {
+			encoding:  signatureEncodingHex,
+			tolerance: time.Minute,
+			timestamp: now.Add(-2 * time.Minute),
+			signature: hex.EncodeToString(hmacSHA256(strconv.FormatInt(now.Add(-2*time.Minute).Unix(), 10)+".", body)),
+			expectErr: "timestamp is outside of the tolerance window",
+		},
+		"timestamp not signed": {
+			encoding:  signatureEncodingHex,
+			tolerance: time.Minute,
+			timestamp: now,
+			signature: hex.EncodeToString(hmacSHA256("", body)),
+			expectErr: "signature does not match",
+		},
+	}
+
+	//nolint:scopelint
+	for name, c := range tc {
+		t.Run(name, func(t *testing.T) {
+			v, err := newSignatureVerifier("X-Signature", signatureAlgorithmSHA256, tSignatureSecret, "v1=", c.encoding)
+			require.NoError(t, err)
+
+			req, _ := http.NewRequest(http.MethodPost, "/", nil)
+			req.Header.Set("X-Signature", "v1="+c.signature)
+
+			if !c.timestamp.IsZero() {
+				v = v.withTimestamp("X-Timestamp", c.tolerance)
+				v.now = func() time.Time { return now }
+				req.Header.Set("X-Timestamp", strconv.FormatInt(c.timestamp.Unix(), 10))
+			}
+
+			err = v.verify(req, body)
+			if c.expectErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, c.expectErr)
+			}
+		})
+	}
+}
+
+func TestNewSignatureVerifier(t *testing.T) {
+	_, err := newSignatureVerifier("X-Signature", "md5", tSignatureSecret, "", signatureEncodingHex)
+	assert.EqualError(t, err, `unsupported signature algorithm "md5"`)
+
+	_, err = newSignatureVerifier("X-Signature", signatureAlgorithmSHA1, "", "", signatureEncodingHex)
+	assert.EqualError(t, err, "the signature secret is empty")
+
+	_, err = newSignatureVerifier("X-Signature", signatureAlgorithmSHA1, tSignatureSecret, "", "base32")
+	assert.EqualError(t, err, `unsupported signature encoding "base32"`)
+}
+
+// tSignatureVerifier returns a verifier of GitHub-style signatures.
+func tSignatureVerifier(t *testing.T) *signatureVerifier {
+	v, err := newSignatureVerifier("X-Hub-Signature-256", signatureAlgorithmSHA256, tSignatureSecret, "sha256=", signatureEncodingHex)
+	require.NoError(t, err)
+	return v
+}
+
+// sign returns the hex-encoded signature of the given body, with the given prefix.
+func sign(prefix, body string) string {
+	return prefix + hex.EncodeToString(hmacSHA256("", []byte(body)))
+}
+
+func hmacSHA256(signedPrefix string, body []byte) []byte {
+	mac := hmac.New(sha256.New, []byte(tSignatureSecret))
+	mac.Write([]byte(signedPrefix))
+	mac.Write(body)
+	return mac.Sum(nil)
+}
@@ -18,6 +18,7 @@ package webhooksource
 
 import (
 	"context"
+	"crypto/subtle"
 	"errors"
 	"fmt"
 	"io/ioutil"
@@ -30,6 +31,8 @@ import (
 
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
 	"knative.dev/pkg/logging"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
 )
 
 const (
@@ -44,6 +47,12 @@ type webhookHandler struct {
 	password        string
 	corsAllowOrigin string
 
+	// optional verification of request signatures
+	signature *signatureVerifier
+
+	// optional extraction of request metadata into event attributes
+	mapping *v1alpha1.WebhookMapping
+
 	ceClient cloudevents.Client
 	logger   *zap.SugaredLogger
 	mt       *pkgadapter.MetricTag
@@ -119,7 +128,7 @@ func (h *webhookHandler) handleAll(ctx context.Context) http.HandlerFunc {
 				h.handleError(errors.New("wrong authentication header"), http.StatusBadRequest, w)
 				return
 			}
-			if us != h.username || ps != h.password {
+			if !secureEqual(us, h.username) || !secureEqual(ps, h.password) {
 				h.handleError(errors.New("credentials are not valid"), http.StatusUnauthorized, w)
 				return
 			}
@@ -132,6 +141,13 @@ func (h *webhookHandler) handleAll(ctx context.Context) http.HandlerFunc {
 			return
 		}
 
+		if h.signature != nil {
+			if err := h.signature.verify(r, body); err != nil {
+				h.handleError(fmt.Errorf("invalid request signature: %w", err), http.StatusUnauthorized, w)
+				return
+			}
+		}
+
 		event := cloudevents.NewEvent(cloudevents.VersionV1)
 		event.SetType(h.eventType)
 		event.SetSource(h.eventSource)
@@ -141,6 +157,13 @@ func (h *webhookHandler) handleAll(ctx context.Context) http.HandlerFunc {
 			return
 		}
 
+		if h.mapping != nil {
+			if err := applyMapping(&event, h.mapping, r, body); err != nil {
+				h.handleError(fmt.Errorf("failed to map request metadata: %w", err), http.StatusInternalServerError, w)
+				return
+			}
+		}
+
 		if result := h.ceClient.Send(ctx, event); !cloudevents.IsACK(result) {
 			h.handleError(fmt.Errorf("could not send Cloud Event: %w", result), http.StatusInternalServerError, w)
 		}
@@ -154,6 +177,11 @@ func (h *webhookHandler) handleError(err error, code int, w http.ResponseWriter)
 	http.Error(w, err.Error(), code)
 }
 
+// secureEqual compares two strings in constant time.
+func secureEqual(a, b string) bool {
+	return subtle.ConstantTimeCompare([]byte(a), []byte(b)) == 1
+}
+
 func healthCheckHandler(w http.ResponseWriter, _ *http.Request) {
 	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
 	w.WriteHeader(http.StatusOK)
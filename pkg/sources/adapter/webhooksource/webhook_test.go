@@ -46,9 +46,10 @@ func TestWebhookEvent(t *testing.T) {
 	tc := map[string]struct {
 		body io.Reader
 
-		username string
-		password string
-		headers  map[string]string
+		username  string
+		password  string
+		signature *signatureVerifier
+		headers   map[string]string
 
 		expectedCode             int
 		expectedResponseContains string
@@ -114,6 +115,33 @@ func TestWebhookEvent(t *testing.T) {
 
 			expectedCode: http.StatusOK,
 		},
+		"signature missing": {
+			body:      read("arbitrary message"),
+			signature: tSignatureVerifier(t),
+
+			expectedCode:             http.StatusUnauthorized,
+			expectedResponseContains: `missing signature header "X-Hub-Signature-256"`,
+		},
+		"signature mismatch": {
+			body: read("arbitrary message"),
+			headers: map[string]string{
+				"X-Hub-Signature-256": sign("sha256=", "other message"),
+			},
+			signature: tSignatureVerifier(t),
+
+			expectedCode:             http.StatusUnauthorized,
+			expectedResponseContains: "signature does not match",
+		},
+		"signature success": {
+			body: read("arbitrary message"),
+			headers: map[string]string{
+				"X-Hub-Signature-256": sign("sha256=", "arbitrary message"),
+			},
+			signature: tSignatureVerifier(t),
+
+			expectedCode:      http.StatusOK,
+			expectedEventData: "arbitrary message",
+		},
 	}
 
 	for name, c := range tc {
@@ -127,6 +155,7 @@ func TestWebhookEvent(t *testing.T) {
 				eventSource: tEventSource,
 				username:    c.username,
 				password:    c.password,
+				signature:   c.signature,
 
 				ceClient: ceClient,
 				logger:   logger,
@@ -18,6 +18,7 @@ package awsdynamodbsource
 
 import (
 	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 
 	"knative.dev/eventing/pkg/reconciler/source"
 	"knative.dev/pkg/apis"
@@ -31,6 +32,8 @@ import (
 
 const healthPortName = "health"
 
+const envInitialPosition = "DYNAMODB_INITIAL_POSITION"
+
 // adapterConfig contains properties used to configure the source's adapter.
 // These are automatically populated by envconfig.
 type adapterConfig struct {
@@ -52,9 +55,23 @@ func (r *Reconciler) BuildAdapter(src commonv1alpha1.Reconcilable, sinkURI *apis
 
 		resource.EnvVar(common.EnvARN, typedSrc.Spec.ARN.String()),
 		resource.EnvVars(reconciler.MakeAWSAuthEnvVars(typedSrc.Spec.Auth)...),
+		resource.EnvVars(makeInitialPositionEnvVars(typedSrc.Spec.InitialPosition)...),
 		resource.EnvVars(r.adapterCfg.configs.ToEnvVars()...),
 
 		resource.Port(healthPortName, 8080),
 		resource.StartupProbe("/health", healthPortName),
 	), nil
 }
+
+// makeInitialPositionEnvVars returns environment variables which control the
+// position from which stream records are read in the absence of a checkpoint.
+func makeInitialPositionEnvVars(pos *v1alpha1.AWSDynamoDBInitialPosition) []corev1.EnvVar {
+	if pos == nil {
+		return nil
+	}
+
+	return []corev1.EnvVar{{
+		Name:  envInitialPosition,
+		Value: string(*pos),
+	}}
+}
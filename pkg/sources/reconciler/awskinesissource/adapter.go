@@ -17,7 +17,10 @@ limitations under the License.
 package awskinesissource
 
 import (
+	"time"
+
 	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 
 	"knative.dev/eventing/pkg/reconciler/source"
 	"knative.dev/pkg/apis"
@@ -31,6 +34,12 @@ import (
 
 const healthPortName = "health"
 
+const (
+	envInitialPosition        = "KINESIS_INITIAL_POSITION"
+	envInitialTimestamp       = "KINESIS_INITIAL_TIMESTAMP"
+	envShardDiscoveryInterval = "KINESIS_SHARD_DISCOVERY_INTERVAL"
+)
+
 // adapterConfig contains properties used to configure the source's adapter.
 // These are automatically populated by envconfig.
 type adapterConfig struct {
@@ -52,9 +61,43 @@ func (r *Reconciler) BuildAdapter(src commonv1alpha1.Reconcilable, sinkURI *apis
 
 		resource.EnvVar(common.EnvARN, typedSrc.Spec.ARN.String()),
 		resource.EnvVars(reconciler.MakeAWSAuthEnvVars(typedSrc.Spec.Auth)...),
+		resource.EnvVars(makeConsumerOptionsEnvVars(typedSrc.Spec.ConsumerOptions)...),
 		resource.EnvVars(r.adapterCfg.configs.ToEnvVars()...),
 
 		resource.Port(healthPortName, 8080),
 		resource.StartupProbe("/health", healthPortName),
 	), nil
 }
+
+// makeConsumerOptionsEnvVars returns environment variables which control the
+// consumption of records from the stream.
+func makeConsumerOptionsEnvVars(opts *v1alpha1.AWSKinesisSourceConsumerOptions) []corev1.EnvVar {
+	if opts == nil {
+		return nil
+	}
+
+	var envs []corev1.EnvVar
+
+	if pos := opts.InitialPosition; pos != nil {
+		envs = append(envs, corev1.EnvVar{
+			Name:  envInitialPosition,
+			Value: string(*pos),
+		})
+	}
+
+	if ts := opts.InitialTimestamp; ts != nil {
+		envs = append(envs, corev1.EnvVar{
+			Name:  envInitialTimestamp,
+			Value: ts.UTC().Format(time.RFC3339),
+		})
+	}
+
+	if itv := opts.ShardDiscoveryInterval; itv != nil {
+		envs = append(envs, corev1.EnvVar{
+			Name:  envShardDiscoveryInterval,
+			Value: itv.String(),
+		})
+	}
+
+	return envs
+}
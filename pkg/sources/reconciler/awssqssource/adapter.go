@@ -17,6 +17,8 @@ limitations under the License.
 package awssqssource
 
 import (
+	"strconv"
+
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 
@@ -30,7 +32,15 @@ import (
 	"github.com/triggermesh/triggermesh/pkg/sources/reconciler"
 )
 
-const envMessageProcessor = "SQS_MESSAGE_PROCESSOR"
+const (
+	envMessageProcessor  = "SQS_MESSAGE_PROCESSOR"
+	envVisibilityTimeout = "SQS_VISIBILITY_TIMEOUT"
+	envRetryBackoff      = "SQS_RETRY_BACKOFF"
+	envMaxReceiveCount   = "SQS_MAX_RECEIVE_COUNT"
+	envDeadLetterSink    = "SQS_DEAD_LETTER_SINK"
+	envProcessingWorkers = "SQS_PROCESSING_WORKERS"
+	envPreserveGroupOrd  = "SQS_PRESERVE_GROUP_ORDER"
+)
 
 const healthPortName = "health"
 
@@ -52,6 +62,8 @@ func (r *Reconciler) BuildAdapter(src commonv1alpha1.Reconcilable, sinkURI *apis
 
 	var optEnvs []corev1.EnvVar
 	optEnvs = maybeSetMessageProcessor(optEnvs, typedSrc)
+	optEnvs = maybeSetReceiveOptions(optEnvs, typedSrc)
+	optEnvs = maybeSetDeliveryOptions(optEnvs, typedSrc)
 
 	return common.NewAdapterDeployment(src, sinkURI,
 		resource.Image(r.adapterCfg.Image),
@@ -80,3 +92,63 @@ func maybeSetMessageProcessor(envs []corev1.EnvVar, src *v1alpha1.AWSSQSSource)
 
 	return envs
 }
+
+// maybeSetReceiveOptions conditionally sets the environment variables which
+// control the behavior of message receivers and processors.
+func maybeSetReceiveOptions(envs []corev1.EnvVar, src *v1alpha1.AWSSQSSource) []corev1.EnvVar {
+	opts := src.Spec.ReceiveOptions
+	if opts == nil {
+		return envs
+	}
+
+	if opts.VisibilityTimeout != nil {
+		envs = append(envs, corev1.EnvVar{
+			Name:  envVisibilityTimeout,
+			Value: opts.VisibilityTimeout.String(),
+		})
+	}
+
+	if opts.ProcessingWorkers != nil {
+		envs = append(envs, corev1.EnvVar{
+			Name:  envProcessingWorkers,
+			Value: strconv.Itoa(int(*opts.ProcessingWorkers)),
+		})
+	}
+
+	if opts.PreserveGroupOrder != nil {
+		envs = append(envs, corev1.EnvVar{
+			Name:  envPreserveGroupOrd,
+			Value: strconv.FormatBool(*opts.PreserveGroupOrder),
+		})
+	}
+
+	return envs
+}
+
+// maybeSetDeliveryOptions conditionally sets the environment variables which
+// control the handling of messages which events could not be delivered.
+func maybeSetDeliveryOptions(envs []corev1.EnvVar, src *v1alpha1.AWSSQSSource) []corev1.EnvVar {
+	opts := src.Spec.DeliveryOptions
+	if opts == nil {
+		return envs
+	}
+
+	if opts.RetryBackoff != nil {
+		envs = append(envs, corev1.EnvVar{
+			Name:  envRetryBackoff,
+			Value: opts.RetryBackoff.String(),
+		})
+	}
+
+	if dls := src.Status.DeadLetterSinkURI; dls != nil && opts.MaxReceiveCount != nil {
+		envs = append(envs, corev1.EnvVar{
+			Name:  envMaxReceiveCount,
+			Value: strconv.Itoa(int(*opts.MaxReceiveCount)),
+		}, corev1.EnvVar{
+			Name:  envDeadLetterSink,
+			Value: dls.String(),
+		})
+	}
+
+	return envs
+}
@@ -19,6 +19,9 @@ package awssqssource
 import (
 	"context"
 
+	corev1 "k8s.io/api/core/v1"
+
+	"knative.dev/pkg/controller"
 	"knative.dev/pkg/reconciler"
 
 	commonv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
@@ -42,5 +45,35 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, src *v1alpha1.AWSSQSSour
 	// inject source into context for usage in reconciliation logic
 	ctx = commonv1alpha1.WithReconcilable(ctx, src)
 
+	if err := r.resolveDeadLetterSink(ctx, src); err != nil {
+		return err
+	}
+
 	return r.base.ReconcileAdapter(ctx, r)
 }
+
+// resolveDeadLetterSink resolves the URL of the source's dead-letter sink and
+// propagates it to its status.
+func (r *Reconciler) resolveDeadLetterSink(ctx context.Context, src *v1alpha1.AWSSQSSource) error {
+	src.Status.DeadLetterSinkURI = nil
+
+	opts := src.Spec.DeliveryOptions
+	if opts == nil || opts.DeadLetterSink == nil {
+		return nil
+	}
+
+	dls := *opts.DeadLetterSink.DeepCopy()
+	if dlsRef := dls.Ref; dlsRef != nil && dlsRef.Namespace == "" {
+		dlsRef.Namespace = src.Namespace
+	}
+
+	uri, err := r.base.SinkResolver.URIFromDestinationV1(ctx, dls, src)
+	if err != nil {
+		src.GetStatusManager().MarkNoSink()
+		return controller.NewPermanentError(reconciler.NewEvent(corev1.EventTypeWarning,
+			common.ReasonBadSinkURI, "Could not resolve dead-letter sink URI: %s", err))
+	}
+	src.Status.DeadLetterSinkURI = uri
+
+	return nil
+}
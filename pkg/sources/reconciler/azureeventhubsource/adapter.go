@@ -17,6 +17,8 @@ limitations under the License.
 package azureeventhubsource
 
 import (
+	"time"
+
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 
@@ -31,6 +33,22 @@ import (
 
 const healthPortName = "health"
 
+const (
+	envConsumerGroup            = "EVENTHUB_CONSUMER_GROUP"
+	envStartPosition            = "EVENTHUB_START_POSITION"
+	envStartTime                = "EVENTHUB_START_TIME"
+	envCheckpointBackend        = "EVENTHUB_CHECKPOINT_BACKEND"
+	envCheckpointBlobAccount    = "EVENTHUB_CHECKPOINT_BLOB_ACCOUNT"
+	envCheckpointBlobContainer  = "EVENTHUB_CHECKPOINT_BLOB_CONTAINER"
+	envCheckpointBlobAccountKey = "EVENTHUB_CHECKPOINT_BLOB_ACCOUNT_KEY"
+)
+
+// Values of envCheckpointBackend.
+const (
+	checkpointBackendKubernetes = "kubernetes"
+	checkpointBackendAzureBlob  = "azureBlob"
+)
+
 // adapterConfig contains properties used to configure the source's adapter.
 // These are automatically populated by envconfig.
 type adapterConfig struct {
@@ -66,9 +84,73 @@ func (r *Reconciler) BuildAdapter(src commonv1alpha1.Reconcilable, sinkURI *apis
 		resource.EnvVar(common.EnvHubNamespace, typedSrc.Spec.EventHubID.Namespace),
 		resource.EnvVar(common.EnvHubName, typedSrc.Spec.EventHubID.ResourceName),
 		resource.EnvVars(hubEnvs...),
+		resource.EnvVars(makeConsumerEnvVars(typedSrc.Spec.ConsumerGroup, typedSrc.Spec.ConsumerOptions)...),
 		resource.EnvVars(r.adapterCfg.configs.ToEnvVars()...),
 
 		resource.Port(healthPortName, 8080),
 		resource.StartupProbe("/health", healthPortName),
 	), nil
 }
+
+// makeConsumerEnvVars returns environment variables which control the
+// consumption of events from the Event Hubs instance.
+func makeConsumerEnvVars(group *string, opts *v1alpha1.AzureEventHubSourceConsumerOptions) []corev1.EnvVar {
+	var envs []corev1.EnvVar
+
+	if group != nil {
+		envs = append(envs, corev1.EnvVar{
+			Name:  envConsumerGroup,
+			Value: *group,
+		})
+	}
+
+	var blobStore *v1alpha1.AzureEventHubBlobCheckpointStore
+
+	if opts != nil {
+		if pos := opts.StartPosition; pos != nil {
+			envs = append(envs, corev1.EnvVar{
+				Name:  envStartPosition,
+				Value: string(*pos),
+			})
+		}
+
+		if ts := opts.StartTime; ts != nil {
+			envs = append(envs, corev1.EnvVar{
+				Name:  envStartTime,
+				Value: ts.UTC().Format(time.RFC3339),
+			})
+		}
+
+		if opts.CheckpointStore != nil {
+			blobStore = opts.CheckpointStore.AzureBlob
+		}
+	}
+
+	if blobStore == nil {
+		return append(envs, corev1.EnvVar{
+			Name:  envCheckpointBackend,
+			Value: checkpointBackendKubernetes,
+		})
+	}
+
+	envs = append(envs,
+		corev1.EnvVar{
+			Name:  envCheckpointBackend,
+			Value: checkpointBackendAzureBlob,
+		},
+		corev1.EnvVar{
+			Name:  envCheckpointBlobAccount,
+			Value: blobStore.AccountName,
+		},
+		corev1.EnvVar{
+			Name:  envCheckpointBlobContainer,
+			Value: blobStore.ContainerName,
+		},
+	)
+
+	if key := blobStore.AccountKey; key != nil {
+		envs = common.MaybeAppendValueFromEnvVar(envs, envCheckpointBlobAccountKey, *key)
+	}
+
+	return envs
+}
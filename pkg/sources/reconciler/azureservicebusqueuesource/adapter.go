@@ -17,6 +17,8 @@ limitations under the License.
 package azureservicebusqueuesource
 
 import (
+	"strconv"
+
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 
@@ -63,6 +65,50 @@ func (r *Reconciler) BuildAdapter(src commonv1alpha1.Reconcilable, sinkURI *apis
 
 		resource.EnvVar(common.EnvServiceBusEntityResourceID, typedSrc.Spec.QueueID.String()),
 		resource.EnvVars(authEnvs...),
+		resource.EnvVars(maybeSetReceiveOptions(nil, &typedSrc.Spec)...),
 		resource.EnvVars(r.adapterCfg.configs.ToEnvVars()...),
 	), nil
 }
+
+// maybeSetReceiveOptions conditionally sets the environment variables which
+// control the reception and settlement of messages.
+func maybeSetReceiveOptions(envs []corev1.EnvVar, spec *v1alpha1.AzureServiceBusQueueSourceSpec) []corev1.EnvVar {
+	if spec.SessionsEnabled != nil {
+		envs = append(envs, corev1.EnvVar{
+			Name:  common.EnvServiceBusSessionsEnabled,
+			Value: strconv.FormatBool(*spec.SessionsEnabled),
+		})
+	}
+
+	if spec.MaxConcurrency != nil {
+		envs = append(envs, corev1.EnvVar{
+			Name:  common.EnvServiceBusMaxConcurrency,
+			Value: strconv.Itoa(int(*spec.MaxConcurrency)),
+		})
+	}
+
+	if spec.PrefetchCount != nil {
+		envs = append(envs, corev1.EnvVar{
+			Name:  common.EnvServiceBusPrefetchCount,
+			Value: strconv.Itoa(int(*spec.PrefetchCount)),
+		})
+	}
+
+	if s := spec.Settlement; s != nil {
+		if s.OnFailure != nil {
+			envs = append(envs, corev1.EnvVar{
+				Name:  common.EnvServiceBusOnFailure,
+				Value: string(*s.OnFailure),
+			})
+		}
+
+		if s.MaxDeliveryAttempts != nil {
+			envs = append(envs, corev1.EnvVar{
+				Name:  common.EnvServiceBusMaxDelivery,
+				Value: strconv.Itoa(int(*s.MaxDeliveryAttempts)),
+			})
+		}
+	}
+
+	return envs
+}
@@ -82,6 +82,15 @@ func ensureSubscription(ctx context.Context, cli servicebustopics.SubscriptionsC
 		// use Azure's defaults
 		desiredSubs := servicebus.SBSubscription{}
 
+		// Sessions can only be enabled at creation time. Toggling this
+		// attribute on an existing source is not reflected on its
+		// Subscription.
+		if se := typedSrc.Spec.SessionsEnabled; se != nil && *se {
+			desiredSubs.SBSubscriptionProperties = &servicebus.SBSubscriptionProperties{
+				RequiresSession: se,
+			}
+		}
+
 		restCtx, cancel = context.WithTimeout(ctx, crudTimeout)
 		defer cancel()
 
@@ -0,0 +1,118 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafkasource
+
+import (
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	"knative.dev/eventing/pkg/reconciler/source"
+	"knative.dev/pkg/apis"
+
+	commonv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+	common "github.com/triggermesh/triggermesh/pkg/reconciler"
+	"github.com/triggermesh/triggermesh/pkg/reconciler/resource"
+)
+
+const (
+	envBootstrapServers = "KAFKA_BOOTSTRAP_SERVERS"
+	envTopics           = "KAFKA_TOPICS"
+	envGroupID          = "KAFKA_GROUP_ID"
+	envInitialOffset    = "KAFKA_INITIAL_OFFSET"
+	envSASLUsername     = "KAFKA_SASL_USERNAME"
+	envSASLPassword     = "KAFKA_SASL_PASSWORD"
+	envSecurityProtocol = "KAFKA_SECURITY_PROTOCOL"
+	envSASLMechanisms   = "KAFKA_SASL_MECHANISMS"
+)
+
+// adapterConfig contains properties used to configure the adapter.
+// These are automatically populated by envconfig.
+type adapterConfig struct {
+	// Container image
+	Image string `default:"gcr.io/triggermesh/kafkasource-adapter"`
+	// Configuration accessor for logging/metrics/tracing
+	configs source.ConfigAccessor
+}
+
+// Verify that Reconciler implements common.AdapterBuilder.
+var _ common.AdapterBuilder[*appsv1.Deployment] = (*Reconciler)(nil)
+
+// BuildAdapter implements common.AdapterBuilder.
+func (r *Reconciler) BuildAdapter(src commonv1alpha1.Reconcilable, sinkURI *apis.URL) (*appsv1.Deployment, error) {
+	typedSrc := src.(*v1alpha1.KafkaSource)
+
+	return common.NewAdapterDeployment(src, sinkURI,
+		resource.Image(r.adapterCfg.Image),
+
+		resource.EnvVars(makeAppEnv(typedSrc)...),
+		resource.EnvVars(r.adapterCfg.configs.ToEnvVars()...),
+	), nil
+}
+
+// makeAppEnv returns the environment variables specific to the source's
+// adapter.
+func makeAppEnv(src *v1alpha1.KafkaSource) []corev1.EnvVar {
+	env := []corev1.EnvVar{
+		{
+			Name:  envBootstrapServers,
+			Value: strings.Join(src.Spec.BootstrapServers, ","),
+		}, {
+			Name:  envTopics,
+			Value: strings.Join(src.Spec.Topics, ","),
+		}, {
+			Name:  envGroupID,
+			Value: src.Spec.GroupID,
+		},
+	}
+
+	if o := src.Spec.InitialOffset; o != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  envInitialOffset,
+			Value: string(*o),
+		})
+	}
+
+	if u := src.Spec.SASLUsername; u != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  envSASLUsername,
+			Value: *u,
+		})
+	}
+
+	if p := src.Spec.SASLPassword; p != nil {
+		env = common.MaybeAppendValueFromEnvVar(env, envSASLPassword, *p)
+	}
+
+	if p := src.Spec.SecurityProtocol; p != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  envSecurityProtocol,
+			Value: *p,
+		})
+	}
+
+	if m := src.Spec.SASLMechanisms; m != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  envSASLMechanisms,
+			Value: *m,
+		})
+	}
+
+	return env
+}
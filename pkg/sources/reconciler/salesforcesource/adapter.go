@@ -17,7 +17,8 @@ limitations under the License.
 package salesforcesource
 
 import (
-	"strconv"
+	"encoding/json"
+	"fmt"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -37,8 +38,8 @@ const (
 	envSalesforceAuthUser     = "SALESFORCE_AUTH_USER"
 	envSalesforceAuthCertKey  = "SALESFORCE_AUTH_CERT_KEY"
 	envSalesforceAPIVersion   = "SALESFORCE_API_VERSION"
-	envSalesforceChannel      = "SALESFORCE_SUBCRIPTION_CHANNEL"
-	envSalesforceReplayID     = "SALESFORCE_SUBCRIPTION_REPLAY_ID"
+
+	envSalesforceSubscriptions = "SALESFORCE_SUBSCRIPTIONS"
 )
 
 // adapterConfig contains properties used to configure the source's adapter.
@@ -57,6 +58,11 @@ var _ common.AdapterBuilder[*appsv1.Deployment] = (*Reconciler)(nil)
 func (r *Reconciler) BuildAdapter(src commonv1alpha1.Reconcilable, sinkURI *apis.URL) (*appsv1.Deployment, error) {
 	typedSrc := src.(*v1alpha1.SalesforceSource)
 
+	subs, err := json.Marshal(typedSrc.GetSubscriptions())
+	if err != nil {
+		return nil, fmt.Errorf("serializing subscriptions to JSON: %w", err)
+	}
+
 	appEnv := []corev1.EnvVar{
 		{
 			Name:  envSalesforceAuthClientID,
@@ -71,8 +77,8 @@ func (r *Reconciler) BuildAdapter(src commonv1alpha1.Reconcilable, sinkURI *apis
 			Value: typedSrc.Spec.Auth.User,
 		},
 		{
-			Name:  envSalesforceChannel,
-			Value: typedSrc.Spec.Subscription.Channel,
+			Name:  envSalesforceSubscriptions,
+			Value: string(subs),
 		},
 	}
 
@@ -80,13 +86,6 @@ func (r *Reconciler) BuildAdapter(src commonv1alpha1.Reconcilable, sinkURI *apis
 		envSalesforceAuthCertKey, typedSrc.Spec.Auth.CertKey,
 	)
 
-	if typedSrc.Spec.Subscription.ReplayID != nil {
-		appEnv = append(appEnv, corev1.EnvVar{
-			Name:  envSalesforceReplayID,
-			Value: strconv.Itoa(*typedSrc.Spec.Subscription.ReplayID),
-		})
-	}
-
 	if typedSrc.Spec.APIVersion != nil {
 		appEnv = append(appEnv, corev1.EnvVar{
 			Name:  envSalesforceAPIVersion,
@@ -17,6 +17,8 @@ limitations under the License.
 package webhooksource
 
 import (
+	"encoding/json"
+
 	corev1 "k8s.io/api/core/v1"
 
 	"knative.dev/eventing/pkg/reconciler/source"
@@ -35,6 +37,15 @@ const (
 	envWebhookBasicAuthUsername = "WEBHOOK_BASICAUTH_USERNAME"
 	envWebhookBasicAuthPassword = "WEBHOOK_BASICAUTH_PASSWORD"
 	envCorsAllowOrigin          = "WEBHOOK_CORS_ALLOW_ORIGIN"
+	envWebhookMapping           = "WEBHOOK_MAPPING"
+
+	envWebhookSignatureHeader             = "WEBHOOK_SIGNATURE_HEADER"
+	envWebhookSignatureAlgorithm          = "WEBHOOK_SIGNATURE_ALGORITHM"
+	envWebhookSignatureSecret             = "WEBHOOK_SIGNATURE_SECRET"
+	envWebhookSignaturePrefix             = "WEBHOOK_SIGNATURE_PREFIX"
+	envWebhookSignatureEncoding           = "WEBHOOK_SIGNATURE_ENCODING"
+	envWebhookSignatureTimestampHeader    = "WEBHOOK_SIGNATURE_TIMESTAMP_HEADER"
+	envWebhookSignatureTimestampTolerance = "WEBHOOK_SIGNATURE_TIMESTAMP_TOLERANCE"
 )
 
 // adapterConfig contains properties used to configure the adapter.
@@ -80,6 +91,15 @@ func makeWebhookEnvs(src *v1alpha1.WebhookSource) []corev1.EnvVar {
 		})
 	}
 
+	if mapping := src.Spec.Mapping; mapping != nil {
+		if b, err := json.Marshal(mapping); err == nil {
+			envs = append(envs, corev1.EnvVar{
+				Name:  envWebhookMapping,
+				Value: string(b),
+			})
+		}
+	}
+
 	if user := src.Spec.BasicAuthUsername; user != nil {
 		envs = append(envs, corev1.EnvVar{
 			Name:  envWebhookBasicAuthUsername,
@@ -93,5 +113,43 @@ func makeWebhookEnvs(src *v1alpha1.WebhookSource) []corev1.EnvVar {
 		)
 	}
 
+	if sig := src.Spec.Signature; sig != nil {
+		envs = append(envs, corev1.EnvVar{
+			Name:  envWebhookSignatureHeader,
+			Value: sig.Header,
+		}, corev1.EnvVar{
+			Name:  envWebhookSignatureAlgorithm,
+			Value: string(sig.Algorithm),
+		})
+
+		envs = common.MaybeAppendValueFromEnvVar(envs,
+			envWebhookSignatureSecret, sig.Secret,
+		)
+
+		if prefix := sig.Prefix; prefix != nil {
+			envs = append(envs, corev1.EnvVar{
+				Name:  envWebhookSignaturePrefix,
+				Value: *prefix,
+			})
+		}
+
+		if enc := sig.Encoding; enc != nil {
+			envs = append(envs, corev1.EnvVar{
+				Name:  envWebhookSignatureEncoding,
+				Value: string(*enc),
+			})
+		}
+
+		if ts := sig.Timestamp; ts != nil {
+			envs = append(envs, corev1.EnvVar{
+				Name:  envWebhookSignatureTimestampHeader,
+				Value: ts.Header,
+			}, corev1.EnvVar{
+				Name:  envWebhookSignatureTimestampTolerance,
+				Value: ts.Tolerance.String(),
+			})
+		}
+	}
+
 	return envs
 }
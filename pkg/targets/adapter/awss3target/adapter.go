@@ -20,9 +20,13 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"strings"
+	"text/template"
+	"time"
 
+	"github.com/google/uuid"
 	"go.uber.org/zap"
 
 	cloudevents "github.com/cloudevents/sdk-go/v2"
@@ -30,6 +34,7 @@ import (
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
 	"knative.dev/pkg/logging"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/s3"
@@ -65,7 +70,7 @@ func NewTarget(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClien
 			WithRegion(region).
 			WithMaxRetries(5)))
 
-	return &adapter{
+	s3Adapter := &adapter{
 		awsArnString: env.AwsTargetArn,
 		awsArn:       a,
 		s3Client:     s3.New(s3Session),
@@ -76,6 +81,29 @@ func NewTarget(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClien
 
 		sr: metrics.MustNewEventProcessingStatsReporter(mt),
 	}
+
+	if env.BatchFormat != "" {
+		var newWriter func() objectWriter
+
+		switch v1alpha1.AWSS3TargetBatchFormat(env.BatchFormat) {
+		case v1alpha1.AWSS3TargetBatchFormatNDJSON:
+			newWriter = func() objectWriter { return newEventsNDJSONWriter(env.DiscardCEContext) }
+		case v1alpha1.AWSS3TargetBatchFormatParquet:
+			newWriter = func() objectWriter { return newEventsParquetWriter(env.DiscardCEContext) }
+		default:
+			logger.Panicf("Unsupported batch format %q", env.BatchFormat)
+		}
+
+		s3Adapter.keyPrefix, err = template.New("keyPrefix").Parse(env.BatchKeyPrefix)
+		if err != nil {
+			logger.Panicf("Error parsing key prefix template: %v", err)
+		}
+
+		s3Adapter.batcher = newBatcher(newWriter, s3Adapter.writeBatch,
+			env.BatchMaxEvents, env.BatchMaxBytes, env.BatchFlushInterval)
+	}
+
+	return s3Adapter
 }
 
 var _ pkgadapter.Adapter = (*adapter)(nil)
@@ -89,16 +117,47 @@ type adapter struct {
 	ceClient         cloudevents.Client
 	logger           *zap.SugaredLogger
 
+	// set when events are buffered into batched objects
+	batcher   *batcher
+	keyPrefix *template.Template
+
 	sr *metrics.EventProcessingStatsReporter
 }
 
+// Maximum duration of the write of a batched object.
+const batchWriteTimeout = 1 * time.Minute
+
 func (a *adapter) Start(ctx context.Context) error {
 	a.logger.Info("Starting AWS S3 Target adapter")
-	return a.ceClient.StartReceiver(ctx, a.dispatch)
+
+	if a.batcher == nil {
+		return a.ceClient.StartReceiver(ctx, a.dispatch)
+	}
+
+	// Write buffered events as soon as the adapter is stopped, so that
+	// in-flight requests get their response before the receiver shuts down.
+	stopped := make(chan struct{})
+	defer close(stopped)
+	go func() {
+		select {
+		case <-ctx.Done():
+			a.batcher.Close()
+		case <-stopped:
+		}
+	}()
+
+	err := a.ceClient.StartReceiver(ctx, a.dispatch)
+	a.batcher.Close()
+
+	return err
 }
 
 // Parse and send the aws event
-func (a *adapter) dispatch(event cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
+func (a *adapter) dispatch(ctx context.Context, event cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
+	if a.batcher != nil {
+		return a.dispatchBatched(ctx, &event)
+	}
+
 	var dataReader *bytes.Reader
 	if event.Type() == v1alpha1.EventTypeAWSS3Put || a.discardCEContext {
 		dataReader = bytes.NewReader(event.Data())
@@ -127,8 +186,72 @@ func (a *adapter) dispatch(event cloudevents.Event) (*cloudevents.Event, cloudev
 		return a.reportError("error publishing object to s3 bucket", err)
 	}
 
+	return a.responseEvent(result.GoString())
+}
+
+// dispatchBatched adds the event to the current batch, and responds once
+// that batch has been written.
+func (a *adapter) dispatchBatched(ctx context.Context, event *cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
+	b, err := a.batcher.Add(event)
+	if err != nil {
+		return a.reportError("error adding event to batch", err)
+	}
+
+	res, err := b.Wait(ctx)
+	if err != nil {
+		return a.reportError("error writing batch to s3 bucket", err)
+	}
+
+	responseEvent, result := a.responseEvent(res.output)
+	if responseEvent != nil {
+		responseEvent.SetSubject(res.key)
+	}
+	return responseEvent, result
+}
+
+// writeBatch implements batchWriteFunc.
+func (a *adapter) writeBatch(content []byte, w objectWriter) (*batchResult, error) {
+	now := time.Now().UTC()
+
+	var key strings.Builder
+	if err := a.keyPrefix.Execute(&key, struct{ Time time.Time }{Time: now}); err != nil {
+		return nil, fmt.Errorf("rendering key prefix: %w", err)
+	}
+	key.WriteString(now.Format("20060102T150405Z"))
+	key.WriteByte('-')
+	key.WriteString(uuid.New().String())
+	key.WriteString(w.Extension())
+
+	bucket := strings.Split(a.awsArn.Resource, "/")[0]
+	putInput := &s3.PutObjectInput{
+		Bucket:      &bucket,
+		Key:         aws.String(key.String()),
+		Body:        bytes.NewReader(content),
+		ContentType: aws.String(w.ContentType()),
+	}
+	if enc := w.ContentEncoding(); enc != "" {
+		putInput.ContentEncoding = &enc
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), batchWriteTimeout)
+	defer cancel()
+
+	result, err := a.s3Client.PutObjectWithContext(ctx, putInput)
+	if err != nil {
+		a.logger.Errorw("Error writing batch to s3 bucket", zap.String("key", key.String()), zap.Error(err))
+		return nil, err
+	}
+
+	return &batchResult{
+		key:    key.String(),
+		output: result.GoString(),
+	}, nil
+}
+
+// responseEvent returns the event sent in response to a write operation.
+func (a *adapter) responseEvent(output string) (*cloudevents.Event, cloudevents.Result) {
 	responseEvent := cloudevents.NewEvent(cloudevents.VersionV1)
-	err = responseEvent.SetData(cloudevents.ApplicationJSON, result.GoString())
+	err := responseEvent.SetData(cloudevents.ApplicationJSON, output)
 	if err != nil {
 		return a.reportError("error generating response event", err)
 	}
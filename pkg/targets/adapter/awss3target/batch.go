@@ -0,0 +1,123 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awss3target
+
+import (
+	"context"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+
+	commonbatch "github.com/triggermesh/triggermesh/pkg/targets/adapter/common/batch"
+)
+
+// objectWriter writes events to the content of a S3 object.
+type objectWriter interface {
+	// Write appends an event to the object, and returns the approximate
+	// size of the written event in bytes, before compression.
+	Write(*cloudevents.Event) (int, error)
+	// Close finalizes the object and returns its content.
+	Close() ([]byte, error)
+
+	// ContentType returns the media type of the object.
+	ContentType() string
+	// ContentEncoding returns the content encoding of the object, if any.
+	ContentEncoding() string
+	// Extension returns the extension of the object's key.
+	Extension() string
+}
+
+// batch is a set of events which are written to the same object.
+type batch struct {
+	w     objectWriter
+	write batchWriteFunc
+
+	// closed once the batch has been written
+	done chan struct{}
+	res  *batchResult
+	err  error
+}
+
+var _ commonbatch.Batch = (*batch)(nil)
+
+// batchResult is the result of the write of a batch.
+type batchResult struct {
+	key    string
+	output string
+}
+
+// Wait blocks until the batch has been written, or the given context is
+// cancelled.
+func (b *batch) Wait(ctx context.Context) (*batchResult, error) {
+	select {
+	case <-b.done:
+		return b.res, b.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// Flush implements commonbatch.Batch. It writes the batch and notifies its
+// waiters.
+func (b *batch) Flush() {
+	defer close(b.done)
+
+	content, err := b.w.Close()
+	if err != nil {
+		b.err = err
+		return
+	}
+
+	b.res, b.err = b.write(content, b.w)
+}
+
+// batchWriteFunc writes the content of a batch.
+type batchWriteFunc func(content []byte, w objectWriter) (*batchResult, error)
+
+// batcher accumulates events into batches, which are written to objects.
+type batcher struct {
+	*commonbatch.Batcher
+}
+
+// newBatcher returns a batcher which writes batches using the given function.
+func newBatcher(newWriter func() objectWriter, write batchWriteFunc,
+	maxEvents, maxBytes int, flushInterval time.Duration) *batcher {
+
+	newBatch := func() commonbatch.Batch {
+		return &batch{
+			w:     newWriter(),
+			write: write,
+			done:  make(chan struct{}),
+		}
+	}
+
+	return &batcher{
+		Batcher: commonbatch.New(newBatch, maxEvents, maxBytes, flushInterval),
+	}
+}
+
+// Add adds the given event to the current batch, and returns that batch.
+func (bt *batcher) Add(e *cloudevents.Event) (*batch, error) {
+	b, err := bt.Batcher.Add(func(b commonbatch.Batch) (int, error) {
+		return b.(*batch).w.Write(e)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return b.(*batch), nil
+}
@@ -0,0 +1,301 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awss3target
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xitongsys/parquet-go-source/buffer"
+	"github.com/xitongsys/parquet-go/reader"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+)
+
+func TestBatcherThresholds(t *testing.T) {
+	testCases := map[string]struct {
+		maxEvents     int
+		maxBytes      int
+		flushInterval time.Duration
+		numEvents     int
+		expectBatches []int
+	}{
+		"max events reached": {
+			maxEvents:     2,
+			maxBytes:      1 << 20,
+			flushInterval: time.Hour,
+			numEvents:     4,
+			expectBatches: []int{2, 2},
+		},
+		"max bytes reached": {
+			maxEvents:     100,
+			maxBytes:      3 * len(tEventData),
+			flushInterval: time.Hour,
+			numEvents:     3,
+			expectBatches: []int{3},
+		},
+		"flush interval elapsed": {
+			maxEvents:     100,
+			maxBytes:      1 << 20,
+			flushInterval: 10 * time.Millisecond,
+			numEvents:     3,
+			expectBatches: []int{3},
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			w := &writesRecorder{}
+
+			bt := newBatcher(newCountingWriter, w.write, tc.maxEvents, tc.maxBytes, tc.flushInterval)
+
+			batches := make([]*batch, 0, tc.numEvents)
+			for i := 0; i < tc.numEvents; i++ {
+				b, err := bt.Add(newEvent(t))
+				require.NoError(t, err)
+				batches = append(batches, b)
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			for _, b := range batches {
+				_, err := b.Wait(ctx)
+				require.NoError(t, err)
+			}
+
+			assert.ElementsMatch(t, tc.expectBatches, w.counts())
+		})
+	}
+}
+
+func TestNDJSONWriter(t *testing.T) {
+	testCases := map[string]struct {
+		discardCEContext bool
+		data             []byte
+		expectLine       string
+	}{
+		"full event": {
+			discardCEContext: false,
+			data:             []byte(`{"msg": "hello"}`),
+			expectLine: `{"specversion":"1.0","id":"` + tEventID + `","source":"` + tEventSource + `",` +
+				`"type":"` + tEventType + `","datacontenttype":"application/json","data":{"msg":"hello"}}`,
+		},
+		"JSON data only": {
+			discardCEContext: true,
+			data:             []byte(`{"msg": "hello"}`),
+			expectLine:       `{"msg":"hello"}`,
+		},
+		"non-JSON data only": {
+			discardCEContext: true,
+			data:             []byte("hello\nworld"),
+			expectLine:       `"hello\nworld"`,
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			w := newEventsNDJSONWriter(tc.discardCEContext)
+
+			e := newEvent(t)
+			e.DataEncoded = tc.data
+
+			for i := 0; i < 2; i++ {
+				_, err := w.Write(e)
+				require.NoError(t, err)
+			}
+
+			content, err := w.Close()
+			require.NoError(t, err)
+
+			gz, err := gzip.NewReader(bytes.NewReader(content))
+			require.NoError(t, err)
+
+			var lines []string
+			s := bufio.NewScanner(gz)
+			for s.Scan() {
+				lines = append(lines, s.Text())
+			}
+			require.NoError(t, s.Err())
+
+			require.Len(t, lines, 2)
+			for _, l := range lines {
+				assert.JSONEq(t, tc.expectLine, l)
+			}
+		})
+	}
+}
+
+func TestParquetWriter(t *testing.T) {
+	ts := time.Date(2022, time.June, 1, 12, 0, 0, 0, time.UTC)
+
+	w := newEventsParquetWriter(false)
+
+	for i := 0; i < 3; i++ {
+		e := newEvent(t)
+		if i == 0 {
+			e.SetSubject("test-subject")
+			e.SetTime(ts)
+		}
+
+		_, err := w.Write(e)
+		require.NoError(t, err)
+	}
+
+	content, err := w.Close()
+	require.NoError(t, err)
+
+	rows := make([]parquetEvent, 3)
+	readParquet(t, content, new(parquetEvent), &rows)
+
+	for i, r := range rows {
+		require.NotNil(t, r.ID)
+		assert.Equal(t, tEventID, *r.ID)
+		require.NotNil(t, r.Source)
+		assert.Equal(t, tEventSource, *r.Source)
+		require.NotNil(t, r.Type)
+		assert.Equal(t, tEventType, *r.Type)
+		require.NotNil(t, r.DataContentType)
+		assert.Equal(t, cloudevents.ApplicationJSON, *r.DataContentType)
+		require.NotNil(t, r.Data)
+		assert.JSONEq(t, tEventData, *r.Data)
+
+		if i == 0 {
+			require.NotNil(t, r.Subject)
+			assert.Equal(t, "test-subject", *r.Subject)
+			require.NotNil(t, r.Time)
+			assert.Equal(t, ts.UnixMilli(), *r.Time)
+			continue
+		}
+
+		assert.Nil(t, r.Subject, "Expected empty subjects to be written as null values")
+		assert.Nil(t, r.Time, "Expected empty times to be written as null values")
+	}
+}
+
+func TestParquetWriterDiscardCEContext(t *testing.T) {
+	w := newEventsParquetWriter(true)
+
+	for i := 0; i < 2; i++ {
+		_, err := w.Write(newEvent(t))
+		require.NoError(t, err)
+	}
+
+	content, err := w.Close()
+	require.NoError(t, err)
+
+	rows := make([]parquetEventData, 2)
+	readParquet(t, content, new(parquetEventData), &rows)
+
+	for _, r := range rows {
+		require.NotNil(t, r.Data)
+		assert.JSONEq(t, tEventData, *r.Data)
+	}
+}
+
+// readParquet reads all rows of the given Parquet object, which has the given
+// schema, into rows. The number of rows must match the length of rows.
+func readParquet(t *testing.T, content []byte, schema, rows interface{}) {
+	t.Helper()
+
+	f, err := buffer.NewBufferFile(content)
+	require.NoError(t, err)
+
+	pr, err := reader.NewParquetReader(f, schema, 1)
+	require.NoError(t, err)
+	defer pr.ReadStop()
+
+	require.EqualValues(t, reflect.ValueOf(rows).Elem().Len(), pr.GetNumRows())
+	require.NoError(t, pr.Read(rows))
+}
+
+const (
+	tEventID     = "0000"
+	tEventSource = "test.source"
+	tEventType   = "test.type"
+	tEventData   = `{"msg":"hello"}`
+)
+
+// newEvent returns a test event.
+func newEvent(t *testing.T) *cloudevents.Event {
+	t.Helper()
+
+	e := cloudevents.NewEvent()
+	e.SetID(tEventID)
+	e.SetSource(tEventSource)
+	e.SetType(tEventType)
+	e.SetDataContentType(cloudevents.ApplicationJSON)
+	// SetData would mark []byte data as base64-encoded, unlike the
+	// decoding of events received over HTTP.
+	e.DataEncoded = []byte(tEventData)
+
+	return &e
+}
+
+// countingWriter is an objectWriter which counts written events.
+type countingWriter struct {
+	count int
+}
+
+func newCountingWriter() objectWriter {
+	return &countingWriter{}
+}
+
+func (w *countingWriter) Write(e *cloudevents.Event) (int, error) {
+	w.count++
+	return len(e.Data()), nil
+}
+
+func (w *countingWriter) Close() ([]byte, error) {
+	return []byte{byte(w.count)}, nil
+}
+
+func (*countingWriter) ContentType() string     { return "" }
+func (*countingWriter) ContentEncoding() string { return "" }
+func (*countingWriter) Extension() string       { return "" }
+
+// writesRecorder records the number of events contained in written batches.
+type writesRecorder struct {
+	sync.Mutex
+	writes []int
+}
+
+func (r *writesRecorder) write(content []byte, _ objectWriter) (*batchResult, error) {
+	r.Lock()
+	defer r.Unlock()
+
+	r.writes = append(r.writes, int(content[0]))
+
+	return &batchResult{}, nil
+}
+
+func (r *writesRecorder) counts() []int {
+	r.Lock()
+	defer r.Unlock()
+
+	return append([]int(nil), r.writes...)
+}
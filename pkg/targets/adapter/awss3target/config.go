@@ -17,6 +17,8 @@ limitations under the License.
 package awss3target
 
 import (
+	"time"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/credentials"
 
@@ -36,6 +38,13 @@ type envAccessor struct {
 	AwsTargetArn string `envconfig:"ARN" required:"true"`
 
 	DiscardCEContext bool `envconfig:"AWS_DISCARD_CE_CONTEXT"`
+
+	// Buffering of events into batched objects. Disabled when no format is set.
+	BatchFormat        string        `envconfig:"AWS_S3_BATCH_FORMAT"`
+	BatchMaxEvents     int           `envconfig:"AWS_S3_BATCH_MAX_EVENTS" default:"1000"`
+	BatchMaxBytes      int           `envconfig:"AWS_S3_BATCH_MAX_BYTES" default:"5242880"`
+	BatchFlushInterval time.Duration `envconfig:"AWS_S3_BATCH_FLUSH_INTERVAL" default:"30s"`
+	BatchKeyPrefix     string        `envconfig:"AWS_S3_BATCH_KEY_PREFIX"`
 }
 
 func (e *envAccessor) GetAwsConfig() *aws.Config {
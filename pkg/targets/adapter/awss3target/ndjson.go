@@ -0,0 +1,107 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awss3target
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+)
+
+// eventsNDJSONWriter is an objectWriter which writes events as
+// gzip-compressed, newline-delimited JSON.
+type eventsNDJSONWriter struct {
+	discardCEContext bool
+
+	buf bytes.Buffer
+	gz  *gzip.Writer
+}
+
+var _ objectWriter = (*eventsNDJSONWriter)(nil)
+
+// newEventsNDJSONWriter returns an objectWriter which writes events in the
+// NDJSON format. When discardCEContext is true, only the data of events is
+// written.
+func newEventsNDJSONWriter(discardCEContext bool) *eventsNDJSONWriter {
+	w := &eventsNDJSONWriter{
+		discardCEContext: discardCEContext,
+	}
+	w.gz = gzip.NewWriter(&w.buf)
+
+	return w
+}
+
+// Write implements objectWriter.
+func (w *eventsNDJSONWriter) Write(e *cloudevents.Event) (int, error) {
+	line, err := w.line(e)
+	if err != nil {
+		return 0, err
+	}
+
+	line = append(line, '\n')
+
+	return w.gz.Write(line)
+}
+
+// line returns the JSON representation of the given event, on a single line.
+func (w *eventsNDJSONWriter) line(e *cloudevents.Event) ([]byte, error) {
+	if !w.discardCEContext {
+		b, err := json.Marshal(e)
+		if err != nil {
+			return nil, fmt.Errorf("marshaling CloudEvent: %w", err)
+		}
+		return b, nil
+	}
+
+	// Data which isn't valid JSON is written as a JSON string.
+	var line bytes.Buffer
+	if err := json.Compact(&line, e.Data()); err == nil && line.Len() > 0 {
+		return line.Bytes(), nil
+	}
+
+	b, err := json.Marshal(string(e.Data()))
+	if err != nil {
+		return nil, fmt.Errorf("marshaling CloudEvent data: %w", err)
+	}
+	return b, nil
+}
+
+// Close implements objectWriter.
+func (w *eventsNDJSONWriter) Close() ([]byte, error) {
+	if err := w.gz.Close(); err != nil {
+		return nil, fmt.Errorf("compressing data: %w", err)
+	}
+	return w.buf.Bytes(), nil
+}
+
+// ContentType implements objectWriter.
+func (*eventsNDJSONWriter) ContentType() string {
+	return "application/x-ndjson"
+}
+
+// ContentEncoding implements objectWriter.
+func (*eventsNDJSONWriter) ContentEncoding() string {
+	return "gzip"
+}
+
+// Extension implements objectWriter.
+func (*eventsNDJSONWriter) Extension() string {
+	return ".ndjson.gz"
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awss3target
+
+import (
+	"bytes"
+	"fmt"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/xitongsys/parquet-go/writer"
+)
+
+// parquetEvent is the schema of the rows of Parquet objects, each row being
+// an event. Empty attributes are written as null values.
+type parquetEvent struct {
+	ID              *string `parquet:"name=id, type=BYTE_ARRAY, convertedtype=UTF8, repetitiontype=OPTIONAL"`
+	Source          *string `parquet:"name=source, type=BYTE_ARRAY, convertedtype=UTF8, repetitiontype=OPTIONAL"`
+	Type            *string `parquet:"name=type, type=BYTE_ARRAY, convertedtype=UTF8, repetitiontype=OPTIONAL"`
+	Subject         *string `parquet:"name=subject, type=BYTE_ARRAY, convertedtype=UTF8, repetitiontype=OPTIONAL"`
+	Time            *int64  `parquet:"name=time, type=INT64, convertedtype=TIMESTAMP_MILLIS, repetitiontype=OPTIONAL"`
+	DataContentType *string `parquet:"name=datacontenttype, type=BYTE_ARRAY, convertedtype=UTF8, repetitiontype=OPTIONAL"`
+	Data            *string `parquet:"name=data, type=BYTE_ARRAY, repetitiontype=OPTIONAL"`
+}
+
+// parquetEventData is the schema of the rows of Parquet objects when the
+// context of events is discarded.
+type parquetEventData struct {
+	Data *string `parquet:"name=data, type=BYTE_ARRAY, repetitiontype=OPTIONAL"`
+}
+
+// eventsParquetWriter is an objectWriter which writes events as the rows of
+// a Parquet object.
+type eventsParquetWriter struct {
+	discardCEContext bool
+
+	buf bytes.Buffer
+	// created upon the first write
+	pw *writer.ParquetWriter
+}
+
+var _ objectWriter = (*eventsParquetWriter)(nil)
+
+// newEventsParquetWriter returns an objectWriter which writes events in the
+// Parquet format. When discardCEContext is true, only the data of events is
+// written.
+func newEventsParquetWriter(discardCEContext bool) *eventsParquetWriter {
+	return &eventsParquetWriter{
+		discardCEContext: discardCEContext,
+	}
+}
+
+// init creates the underlying Parquet writer if it doesn't exist yet.
+func (w *eventsParquetWriter) init() error {
+	if w.pw != nil {
+		return nil
+	}
+
+	var schema interface{} = new(parquetEvent)
+	if w.discardCEContext {
+		schema = new(parquetEventData)
+	}
+
+	pw, err := writer.NewParquetWriterFromWriter(&w.buf, schema, 1)
+	if err != nil {
+		return fmt.Errorf("creating Parquet writer: %w", err)
+	}
+	w.pw = pw
+
+	return nil
+}
+
+// Write implements objectWriter.
+func (w *eventsParquetWriter) Write(e *cloudevents.Event) (int, error) {
+	if err := w.init(); err != nil {
+		return 0, err
+	}
+
+	data := string(e.Data())
+	n := len(data)
+
+	var row interface{} = parquetEventData{
+		Data: &data,
+	}
+
+	if !w.discardCEContext {
+		var t *int64
+		if ts := e.Time(); !ts.IsZero() {
+			ms := ts.UnixMilli()
+			t = &ms
+			n += 8
+		}
+
+		id, source, typ := e.ID(), e.Source(), e.Type()
+		subject, contentType := optionalString(e.Subject()), optionalString(e.DataContentType())
+
+		n += len(id) + len(source) + len(typ) + len(e.Subject()) + len(e.DataContentType())
+
+		row = parquetEvent{
+			ID:              &id,
+			Source:          &source,
+			Type:            &typ,
+			Subject:         subject,
+			Time:            t,
+			DataContentType: contentType,
+			Data:            &data,
+		}
+	}
+
+	if err := w.pw.Write(row); err != nil {
+		return 0, fmt.Errorf("writing Parquet row: %w", err)
+	}
+
+	return n, nil
+}
+
+// Close implements objectWriter.
+func (w *eventsParquetWriter) Close() ([]byte, error) {
+	if err := w.init(); err != nil {
+		return nil, err
+	}
+
+	if err := w.pw.WriteStop(); err != nil {
+		return nil, fmt.Errorf("finalizing Parquet object: %w", err)
+	}
+
+	return w.buf.Bytes(), nil
+}
+
+// ContentType implements objectWriter.
+func (*eventsParquetWriter) ContentType() string {
+	return "application/vnd.apache.parquet"
+}
+
+// ContentEncoding implements objectWriter.
+func (*eventsParquetWriter) ContentEncoding() string {
+	return ""
+}
+
+// Extension implements objectWriter.
+func (*eventsParquetWriter) Extension() string {
+	return ".parquet"
+}
+
+// optionalString returns nil for empty strings, which are written as null
+// values.
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package batch allows target adapters to group the items they send to a
+// downstream service into batches, which are flushed when either their count
+// or size threshold is reached, or after a maximum delay.
+package batch
+
+import (
+	"sync"
+	"time"
+)
+
+// Batch is a set of items which are flushed together.
+type Batch interface {
+	// Flush sends the items of the batch to their destination and
+	// notifies their waiters. It is called exactly once per batch.
+	Flush()
+}
+
+// AddFunc adds an item to the given batch, and returns the approximate size
+// of that item in bytes.
+type AddFunc func(Batch) (int, error)
+
+// Batcher accumulates items into batches, which are flushed when either
+// their count or size threshold is reached, or after a maximum delay.
+type Batcher struct {
+	newBatch func() Batch
+
+	maxItems      int
+	maxBytes      int
+	flushInterval time.Duration
+
+	mu      sync.Mutex
+	current *openBatch
+	closed  bool
+
+	// tracks in-flight flushes
+	wg sync.WaitGroup
+}
+
+// openBatch is a batch which is still accepting items.
+type openBatch struct {
+	b Batch
+
+	items int
+	size  int
+	timer *time.Timer
+}
+
+// New returns a Batcher which creates batches using the given function.
+func New(newBatch func() Batch, maxItems, maxBytes int, flushInterval time.Duration) *Batcher {
+	return &Batcher{
+		newBatch:      newBatch,
+		maxItems:      maxItems,
+		maxBytes:      maxBytes,
+		flushInterval: flushInterval,
+	}
+}
+
+// Add adds an item to the current batch using the given function, and returns
+// that batch. A batch is created if there is no current batch.
+func (bt *Batcher) Add(add AddFunc) (Batch, error) {
+	bt.mu.Lock()
+
+	ob := bt.current
+	if ob == nil {
+		ob = &openBatch{
+			b: bt.newBatch(),
+		}
+	}
+
+	n, err := add(ob.b)
+	if err != nil {
+		bt.mu.Unlock()
+		return nil, err
+	}
+	ob.items++
+	ob.size += n
+
+	if bt.current == nil {
+		bt.current = ob
+		ob.timer = time.AfterFunc(bt.flushInterval, func() { bt.flushIfCurrent(ob) })
+	}
+
+	// Once the batcher is closed, items are flushed immediately by the
+	// caller, since in-flight flushes may already have been waited for.
+	closed := bt.closed
+	full := ob.items >= bt.maxItems || ob.size >= bt.maxBytes
+
+	if closed || full {
+		bt.detachCurrent()
+	}
+	if full && !closed {
+		bt.wg.Add(1)
+		go func() {
+			defer bt.wg.Done()
+			ob.b.Flush()
+		}()
+	}
+
+	bt.mu.Unlock()
+
+	if closed {
+		ob.b.Flush()
+	}
+
+	return ob.b, nil
+}
+
+// Close flushes the current batch and waits for all in-flight flushes to
+// complete. Items added after the batcher is closed are flushed
+// individually.
+func (bt *Batcher) Close() {
+	bt.mu.Lock()
+	bt.closed = true
+	ob := bt.current
+	if ob != nil {
+		bt.detachCurrent()
+		bt.wg.Add(1)
+	}
+	bt.mu.Unlock()
+
+	if ob != nil {
+		ob.b.Flush()
+		bt.wg.Done()
+	}
+
+	bt.wg.Wait()
+}
+
+// flushIfCurrent flushes the given batch if it is still the current batch.
+func (bt *Batcher) flushIfCurrent(ob *openBatch) {
+	bt.mu.Lock()
+	if bt.current != ob {
+		bt.mu.Unlock()
+		return
+	}
+	bt.detachCurrent()
+	bt.wg.Add(1)
+	bt.mu.Unlock()
+
+	defer bt.wg.Done()
+	ob.b.Flush()
+}
+
+// detachCurrent detaches the current batch from the batcher, so that
+// subsequent items are added to a new batch.
+// The caller must hold the batcher's lock.
+func (bt *Batcher) detachCurrent() {
+	bt.current.timer.Stop()
+	bt.current = nil
+}
@@ -0,0 +1,193 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package batch
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatcherThresholds(t *testing.T) {
+	testCases := map[string]struct {
+		maxItems      int
+		maxBytes      int
+		flushInterval time.Duration
+		numItems      int
+		expectBatches []int
+	}{
+		"max items reached": {
+			maxItems:      2,
+			maxBytes:      1 << 20,
+			flushInterval: time.Hour,
+			numItems:      4,
+			expectBatches: []int{2, 2},
+		},
+		"max bytes reached": {
+			maxItems:      100,
+			maxBytes:      3 * tItemSize,
+			flushInterval: time.Hour,
+			numItems:      3,
+			expectBatches: []int{3},
+		},
+		"flush interval elapsed": {
+			maxItems:      100,
+			maxBytes:      1 << 20,
+			flushInterval: 10 * time.Millisecond,
+			numItems:      3,
+			expectBatches: []int{3},
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			r := &flushRecorder{}
+
+			bt := New(r.newBatch, tc.maxItems, tc.maxBytes, tc.flushInterval)
+
+			batches := make([]*countingBatch, 0, tc.numItems)
+			for i := 0; i < tc.numItems; i++ {
+				b, err := bt.Add(addItem)
+				require.NoError(t, err)
+				batches = append(batches, b.(*countingBatch))
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			for _, b := range batches {
+				require.NoError(t, b.wait(ctx))
+			}
+
+			assert.ElementsMatch(t, tc.expectBatches, r.counts())
+		})
+	}
+}
+
+func TestBatcherAddError(t *testing.T) {
+	r := &flushRecorder{}
+
+	bt := New(r.newBatch, 100, 1<<20, time.Hour)
+
+	addErr := errors.New("invalid item")
+	_, err := bt.Add(func(Batch) (int, error) { return 0, addErr })
+	assert.ErrorIs(t, err, addErr)
+
+	b, err := bt.Add(addItem)
+	require.NoError(t, err)
+
+	bt.Close()
+
+	assert.Equal(t, 1, b.(*countingBatch).items, "Expected the failed item to be excluded from the batch")
+	assert.Equal(t, []int{1}, r.counts())
+}
+
+func TestBatcherClose(t *testing.T) {
+	r := &flushRecorder{}
+
+	bt := New(r.newBatch, 100, 1<<20, time.Hour)
+
+	b, err := bt.Add(addItem)
+	require.NoError(t, err)
+
+	bt.Close()
+
+	select {
+	case <-b.(*countingBatch).done:
+	default:
+		t.Fatal("Expected pending batch to be flushed on close")
+	}
+	assert.Equal(t, []int{1}, r.counts())
+
+	// items added after close are flushed immediately
+	b, err = bt.Add(addItem)
+	require.NoError(t, err)
+
+	select {
+	case <-b.(*countingBatch).done:
+	default:
+		t.Fatal("Expected item to be flushed immediately after close")
+	}
+	assert.Equal(t, []int{1, 1}, r.counts())
+}
+
+// tItemSize is the size of the items added by addItem.
+const tItemSize = 10
+
+// addItem is an AddFunc which adds an item of size tItemSize to a
+// countingBatch.
+func addItem(b Batch) (int, error) {
+	b.(*countingBatch).items++
+	return tItemSize, nil
+}
+
+// countingBatch is a Batch which counts its items.
+type countingBatch struct {
+	items int
+	r     *flushRecorder
+
+	done chan struct{}
+}
+
+// Flush implements Batch.
+func (b *countingBatch) Flush() {
+	defer close(b.done)
+	b.r.record(b.items)
+}
+
+// wait blocks until the batch has been flushed.
+func (b *countingBatch) wait(ctx context.Context) error {
+	select {
+	case <-b.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// flushRecorder records the number of items contained in flushed batches.
+type flushRecorder struct {
+	sync.Mutex
+	flushes []int
+}
+
+func (r *flushRecorder) newBatch() Batch {
+	return &countingBatch{
+		r:    r,
+		done: make(chan struct{}),
+	}
+}
+
+func (r *flushRecorder) record(items int) {
+	r.Lock()
+	defer r.Unlock()
+
+	r.flushes = append(r.flushes, items)
+}
+
+func (r *flushRecorder) counts() []int {
+	r.Lock()
+	defer r.Unlock()
+
+	return append([]int(nil), r.flushes...)
+}
@@ -18,7 +18,6 @@ package confluenttarget
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"time"
 
@@ -29,7 +28,7 @@ import (
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
 	"knative.dev/pkg/logging"
 
-	"gopkg.in/confluentinc/confluent-kafka-go.v1/kafka"
+	"github.com/confluentinc/confluent-kafka-go/kafka"
 
 	"github.com/triggermesh/triggermesh/pkg/apis/targets"
 	"github.com/triggermesh/triggermesh/pkg/metrics"
@@ -49,7 +48,12 @@ func NewTarget(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClien
 
 	env := envAcc.(*envAccessor)
 
-	kafkaClient, err := NewKafkaClient(&kafka.ConfigMap{
+	writeMessage, err := newMessageWriter(env.ContentMode, env.DiscardCEContext)
+	if err != nil {
+		logger.Panic(err)
+	}
+
+	cfg := &kafka.ConfigMap{
 		"bootstrap.servers":       env.BootstrapServers,
 		"sasl.username":           env.SASLUsername,
 		"sasl.password":           env.SASLPassword,
@@ -57,7 +61,14 @@ func NewTarget(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClien
 		"security.protocol":       env.SecurityProtocol,
 		"broker.version.fallback": env.BrokerVersionFallback,
 		"api.version.fallback.ms": env.APIVersionFallbackMs,
-	})
+		"linger.ms":               env.LingerMs,
+		"batch.num.messages":      env.BatchNumMessages,
+	}
+	if env.Partitioner != "" {
+		_ = cfg.SetKey("partitioner", env.Partitioner)
+	}
+
+	kafkaClient, err := NewKafkaClient(cfg)
 	if err != nil {
 		logger.Panic(err)
 	}
@@ -71,7 +82,9 @@ func NewTarget(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClien
 		newTopicPartitions:        env.NewTopicPartitions,
 		newTopicReplicationFactor: env.NewTopicReplicationFactor,
 
-		discardCEContext: env.DiscardCEContext,
+		messageKey:    newMessageKeyFunc(env.MessageKeyAttribute, env.MessageKeyDataPath),
+		writeMessage:  writeMessage,
+		asyncDelivery: env.AsyncDelivery,
 
 		ceClient: ceClient,
 		logger:   logger,
@@ -93,7 +106,9 @@ type confluentAdapter struct {
 	newTopicPartitions        int
 	newTopicReplicationFactor int
 
-	discardCEContext bool
+	messageKey    messageKeyFunc
+	writeMessage  messageWriter
+	asyncDelivery bool
 
 	ceClient cloudevents.Client
 	logger   *zap.SugaredLogger
@@ -105,9 +120,16 @@ type confluentAdapter struct {
 func (a *confluentAdapter) Start(ctx context.Context) error {
 	a.logger.Info("Starting Confluent adapter")
 
+	deliveryReportsDone := make(chan struct{})
+	go func() {
+		defer close(deliveryReportsDone)
+		a.handleDeliveryReports(a.kafkaClient.Events())
+	}()
+
 	defer func() {
 		a.kafkaClient.Flush(a.flushTimeout)
 		a.kafkaClient.Close()
+		<-deliveryReportsDone
 	}()
 
 	if a.createTopicIfMissing {
@@ -122,38 +144,48 @@ func (a *confluentAdapter) Start(ctx context.Context) error {
 	return nil
 }
 
-func (a *confluentAdapter) dispatch(event cloudevents.Event) cloudevents.Result {
-	var msgVal []byte
-
-	if a.discardCEContext {
-		msgVal = event.Data()
-	} else {
-		jsonEvent, err := json.Marshal(event)
-		if err != nil {
-			a.logger.Errorw("Error marshalling CloudEvent", zap.Error(err))
-			return cloudevents.ResultNACK
-		}
-		msgVal = jsonEvent
+func (a *confluentAdapter) dispatch(ctx context.Context, event cloudevents.Event) cloudevents.Result {
+	key, err := a.messageKey(&event)
+	if err != nil {
+		a.logger.Errorw("Error determining Kafka message key", zap.Error(err))
+		return cloudevents.ResultNACK
 	}
 
 	km := &kafka.Message{
-		Key:            []byte(event.ID()),
+		Key:            key,
 		TopicPartition: kafka.TopicPartition{Topic: &a.topic, Partition: kafka.PartitionAny},
-		Value:          msgVal,
 	}
 
-	// librdkafka provides buffering, we set channel size to 1
-	// to avoid blocking tests as they execute in the same thread
-	deliveryChan := make(chan kafka.Event, 1)
-	defer close(deliveryChan)
+	if err := a.writeMessage(&event, km); err != nil {
+		a.logger.Errorw("Error writing Kafka message", zap.Error(err))
+		return cloudevents.ResultNACK
+	}
+
+	// Delivery reports are received on the producer's events channel, and
+	// forwarded to the sender of the message via its opaque value.
+	// librdkafka batches the messages produced concurrently.
+	var delivered chan *kafka.Message
+	if !a.asyncDelivery {
+		delivered = make(chan *kafka.Message, 1)
+		km.Opaque = delivered
+	}
 
-	if err := a.kafkaClient.Produce(km, deliveryChan); err != nil {
+	if err := a.kafkaClient.Produce(km, nil); err != nil {
 		a.logger.Errorw("Error producing Kafka message", zap.String("msg", km.String()), zap.Error(err))
 		return cloudevents.ResultNACK
 	}
 
-	r := <-deliveryChan
-	m := r.(*kafka.Message)
+	if a.asyncDelivery {
+		return cloudevents.ResultACK
+	}
+
+	var m *kafka.Message
+	select {
+	case m = <-delivered:
+	case <-ctx.Done():
+		a.logger.Errorw("Gave up waiting for message delivery", zap.Error(ctx.Err()))
+		return cloudevents.ResultNACK
+	}
 
 	if m.TopicPartition.Error != nil {
 		a.logger.Errorw("Message delivery failed", zap.Error(m.TopicPartition.Error))
@@ -166,6 +198,28 @@ func (a *confluentAdapter) dispatch(event cloudevents.Event) cloudevents.Result
 	return cloudevents.ResultACK
 }
 
+// handleDeliveryReports processes the events emitted by the Kafka producer
+// until the given channel is closed.
+func (a *confluentAdapter) handleDeliveryReports(events <-chan kafka.Event) {
+	for e := range events {
+		switch ev := e.(type) {
+		case *kafka.Message:
+			if delivered, ok := ev.Opaque.(chan *kafka.Message); ok {
+				delivered <- ev
+				continue
+			}
+			// Message produced with asynchronous delivery, nobody
+			// waits for its delivery report.
+			if ev.TopicPartition.Error != nil {
+				a.logger.Errorw("Message delivery failed", zap.Error(ev.TopicPartition.Error))
+			}
+
+		case kafka.Error:
+			a.logger.Errorw("Kafka producer error", zap.Error(ev))
+		}
+	}
+}
+
 //ensureTopic creates a topic if missing
 func (a *confluentAdapter) ensureTopic(ctx context.Context, topic string) error {
 	a.logger.Debug("Ensuring topic %q", topic)
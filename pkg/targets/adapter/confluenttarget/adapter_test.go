@@ -26,7 +26,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	zapt "go.uber.org/zap/zaptest"
 
-	"gopkg.in/confluentinc/confluent-kafka-go.v1/kafka"
+	"github.com/confluentinc/confluent-kafka-go/kafka"
 
 	adaptertest "github.com/triggermesh/triggermesh/pkg/targets/adapter/test"
 )
@@ -159,6 +159,9 @@ func TestConfluentProduce(t *testing.T) {
 				logger:   logger,
 				topic:    c.topic,
 
+				messageKey:   newMessageKeyFunc("", ""),
+				writeMessage: writeStructuredMessage,
+
 				createTopicIfMissing: c.createTopic,
 			}
 
@@ -210,7 +213,9 @@ func createFakeEvent() *cloudevents.Event {
 type fakeKOpts func(*fakeKafkaClient)
 
 func newFakeKafkaClient(opts ...fakeKOpts) KafkaClient {
-	fake := &fakeKafkaClient{}
+	fake := &fakeKafkaClient{
+		events: make(chan kafka.Event, 1),
+	}
 
 	for _, f := range opts {
 		f(fake)
@@ -221,15 +226,20 @@ func newFakeKafkaClient(opts ...fakeKOpts) KafkaClient {
 type fakeKafkaClient struct {
 	admin      KafkaAdminClient
 	produceErr error
+	events     chan kafka.Event
 }
 
 var _ KafkaClient = (*fakeKafkaClient)(nil)
 
-func (c *fakeKafkaClient) Flush(timeoutMs int) int { return 0 }
-func (c *fakeKafkaClient) Close()                  {}
+func (c *fakeKafkaClient) Flush(timeoutMs int) int  { return 0 }
+func (c *fakeKafkaClient) Close()                   { close(c.events) }
+func (c *fakeKafkaClient) Events() chan kafka.Event { return c.events }
 func (c *fakeKafkaClient) Produce(msg *kafka.Message, deliveryChan chan kafka.Event) error {
-	deliveryChan <- msg
-	return c.produceErr
+	if c.produceErr != nil {
+		return c.produceErr
+	}
+	c.events <- msg
+	return nil
 }
 func (c *fakeKafkaClient) CreateKafkaAdminClient() (KafkaAdminClient, error) {
 	return c.admin, nil
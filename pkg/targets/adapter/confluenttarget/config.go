@@ -45,6 +45,23 @@ type envAccessor struct {
 	CreateTopicTimeoutMillisecs int    `envconfig:"CONFLUENT_CREATE_TOPIC_TIMEOUT_MS" default:"10000"`
 	NewTopicPartitions          int    `envconfig:"CONFLUENT_TOPIC_PARTITIONS" default:"1"`
 	NewTopicReplicationFactor   int    `envconfig:"CONFLUENT_TOPIC_REPLICATION_FACTOR" default:"1"`
+	LingerMs                    string `envconfig:"CONFLUENT_LINGER_MS" default:"5"`
+	BatchNumMessages            string `envconfig:"CONFLUENT_BATCH_NUM_MESSAGES" default:"10000"`
 
 	DiscardCEContext bool `envconfig:"CONFLUENT_DISCARD_CE_CONTEXT"`
+
+	// Content mode of produced messages, either "structured" or "binary".
+	ContentMode string `envconfig:"CONFLUENT_CONTENT_MODE" default:"structured"`
+
+	// Source of the key of produced messages. Messages are keyed by event
+	// ID when neither is set.
+	MessageKeyAttribute string `envconfig:"CONFLUENT_MESSAGE_KEY_ATTRIBUTE"`
+	MessageKeyDataPath  string `envconfig:"CONFLUENT_MESSAGE_KEY_DATA_PATH"`
+
+	// Partitioner used to assign keyed messages to partitions.
+	Partitioner string `envconfig:"CONFLUENT_PARTITIONER"`
+
+	// Whether events are acknowledged as soon as they are queued for
+	// delivery, instead of once their delivery has been confirmed.
+	AsyncDelivery bool `envconfig:"CONFLUENT_ASYNC_DELIVERY"`
 }
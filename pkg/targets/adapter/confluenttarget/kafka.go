@@ -19,7 +19,7 @@ package confluenttarget
 import (
 	"context"
 
-	"gopkg.in/confluentinc/confluent-kafka-go.v1/kafka"
+	"github.com/confluentinc/confluent-kafka-go/kafka"
 )
 
 // KafkaAdminClient is a wrapper of the Confluent Kafka admin
@@ -34,6 +34,7 @@ type KafkaAdminClient interface {
 // functions needed for the Confluent adapter.
 type KafkaClient interface {
 	Produce(msg *kafka.Message, deliveryChan chan kafka.Event) error
+	Events() chan kafka.Event
 	CreateKafkaAdminClient() (KafkaAdminClient, error)
 	Flush(timeoutMs int) int
 	Close()
@@ -69,6 +70,10 @@ func (c *kafkaClient) Produce(msg *kafka.Message, deliveryChan chan kafka.Event)
 }
 
 // CreateKafkaAdminClient creates the default implementation of KafkaAdminClient
+func (c *kafkaClient) Events() chan kafka.Event {
+	return c.producer.Events()
+}
+
 func (c *kafkaClient) CreateKafkaAdminClient() (KafkaAdminClient, error) {
 	adminClient, err := kafka.NewAdminClientFromProducer(c.producer)
 	if err != nil {
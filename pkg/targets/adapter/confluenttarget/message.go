@@ -0,0 +1,187 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package confluenttarget
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/tidwall/gjson"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/binding/spec"
+	"github.com/cloudevents/sdk-go/v2/types"
+
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+)
+
+// Content modes of produced Kafka messages.
+// https://github.com/cloudevents/spec/blob/v1.0.2/cloudevents/bindings/kafka-protocol-binding.md#13-content-modes
+const (
+	contentModeStructured = "structured"
+	contentModeBinary     = "binary"
+)
+
+const (
+	// headerPrefix is the prefix of the Kafka headers which contain the
+	// context attributes of events in binary content mode.
+	headerPrefix = "ce_"
+	// headerContentType is the Kafka header which contains the content type
+	// of messages.
+	headerContentType = "content-type"
+)
+
+// kafkaSpecs maps CloudEvent context attributes to Kafka headers.
+var kafkaSpecs = spec.WithPrefix(headerPrefix)
+
+// messageKeyFunc returns the key of the Kafka message an event is produced
+// as.
+type messageKeyFunc func(*cloudevents.Event) ([]byte, error)
+
+// newMessageKeyFunc returns a messageKeyFunc which reads the message key from
+// either the given CloudEvent attribute or extension, or the given path
+// within the event data. Messages are keyed by event ID when neither is set.
+func newMessageKeyFunc(attribute, dataPath string) messageKeyFunc {
+	switch {
+	case attribute != "":
+		return func(e *cloudevents.Event) ([]byte, error) {
+			v, err := attributeValue(e, attribute)
+			if err != nil {
+				return nil, err
+			}
+			if v == "" {
+				return nil, fmt.Errorf("event has no value for key attribute %q", attribute)
+			}
+			return []byte(v), nil
+		}
+
+	case dataPath != "":
+		return func(e *cloudevents.Event) ([]byte, error) {
+			res := gjson.GetBytes(e.Data(), dataPath)
+			if !res.Exists() {
+				return nil, fmt.Errorf("event data has no value at key path %q", dataPath)
+			}
+			return []byte(res.String()), nil
+		}
+
+	default:
+		return func(e *cloudevents.Event) ([]byte, error) {
+			return []byte(e.ID()), nil
+		}
+	}
+}
+
+// attributeValue returns the string representation of the given CloudEvent
+// context attribute or extension.
+func attributeValue(e *cloudevents.Event, name string) (string, error) {
+	if attr := kafkaSpecs.Version(e.SpecVersion()).Attribute(headerPrefix + name); attr != nil {
+		v := attr.Get(e.Context)
+		if v == nil {
+			return "", nil
+		}
+		return types.Format(v)
+	}
+
+	ext, ok := e.Extensions()[name]
+	if !ok {
+		return "", nil
+	}
+	return types.Format(ext)
+}
+
+// messageWriter writes the value and headers of Kafka messages.
+type messageWriter func(*cloudevents.Event, *kafka.Message) error
+
+// newMessageWriter returns a messageWriter for the given content mode.
+func newMessageWriter(contentMode string, discardCEContext bool) (messageWriter, error) {
+	switch contentMode {
+	case contentModeBinary:
+		return writeBinaryMessage, nil
+	case contentModeStructured, "":
+		if discardCEContext {
+			return writeDataOnlyMessage, nil
+		}
+		return writeStructuredMessage, nil
+	default:
+		return nil, fmt.Errorf("unsupported content mode %q", contentMode)
+	}
+}
+
+// writeStructuredMessage writes the entire event as the value of the message.
+func writeStructuredMessage(e *cloudevents.Event, m *kafka.Message) error {
+	val, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Errorf("marshaling CloudEvent: %w", err)
+	}
+
+	m.Value = val
+	m.Headers = append(m.Headers, kafka.Header{
+		Key:   headerContentType,
+		Value: []byte(cloudevents.ApplicationCloudEventsJSON),
+	})
+
+	return nil
+}
+
+// writeDataOnlyMessage writes the event data as the value of the message, and
+// discards the event context.
+func writeDataOnlyMessage(e *cloudevents.Event, m *kafka.Message) error {
+	m.Value = e.Data()
+	return nil
+}
+
+// writeBinaryMessage writes the event data as the value of the message, and
+// its context attributes as headers.
+func writeBinaryMessage(e *cloudevents.Event, m *kafka.Message) error {
+	m.Value = e.Data()
+
+	for _, attr := range kafkaSpecs.Version(e.SpecVersion()).Attributes() {
+		v := attr.Get(e.Context)
+		if v == nil {
+			continue
+		}
+
+		s, err := types.Format(v)
+		if err != nil {
+			return fmt.Errorf("formatting value of attribute %q: %w", attr.Name(), err)
+		}
+
+		name := attr.PrefixedName()
+		if attr.Kind() == spec.DataContentType {
+			name = headerContentType
+		}
+
+		m.Headers = append(m.Headers, kafka.Header{
+			Key:   name,
+			Value: []byte(s),
+		})
+	}
+
+	for name, v := range e.Extensions() {
+		s, err := types.Format(v)
+		if err != nil {
+			return fmt.Errorf("formatting value of extension %q: %w", name, err)
+		}
+
+		m.Headers = append(m.Headers, kafka.Header{
+			Key:   headerPrefix + name,
+			Value: []byte(s),
+		})
+	}
+
+	return nil
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package confluenttarget
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+)
+
+func TestMessageKey(t *testing.T) {
+	testCases := map[string]struct {
+		attribute string
+		dataPath  string
+		expectKey string
+		expectErr bool
+	}{
+		"default to event ID": {
+			expectKey: tEventID,
+		},
+		"context attribute": {
+			attribute: "subject",
+			expectKey: tEventSubject,
+		},
+		"extension": {
+			attribute: "partitionkey",
+			expectKey: "entity-1",
+		},
+		"missing attribute": {
+			attribute: "dataschema",
+			expectErr: true,
+		},
+		"data path": {
+			dataPath:  "hello",
+			expectKey: "world",
+		},
+		"missing data path": {
+			dataPath:  "missing",
+			expectErr: true,
+		},
+	}
+
+	e := createFakeEvent()
+	e.SetExtension("partitionkey", "entity-1")
+	e.DataEncoded = []byte(tEventData)
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			key, err := newMessageKeyFunc(tc.attribute, tc.dataPath)(e)
+			if tc.expectErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectKey, string(key))
+		})
+	}
+}
+
+func TestMessageWriter(t *testing.T) {
+	ts := time.Date(2022, time.June, 1, 12, 0, 0, 0, time.UTC)
+
+	e := createFakeEvent()
+	e.SetTime(ts)
+	e.SetExtension("myext", 42)
+	e.DataEncoded = []byte(tEventData)
+
+	t.Run("structured", func(t *testing.T) {
+		w, err := newMessageWriter(contentModeStructured, false)
+		require.NoError(t, err)
+
+		m := &kafka.Message{}
+		require.NoError(t, w(e, m))
+
+		expectVal := `{"specversion":"1.0","id":"` + tEventID + `","source":"` + tEventSource + `",` +
+			`"type":"` + tEventType + `","subject":"` + tEventSubject + `","time":"2022-06-01T12:00:00Z",` +
+			`"myext":42,"datacontenttype":"application/json","data":` + tEventData + `}`
+		assert.JSONEq(t, expectVal, string(m.Value))
+		assert.Equal(t, map[string]string{
+			"content-type": cloudevents.ApplicationCloudEventsJSON,
+		}, headersMap(m.Headers))
+	})
+
+	t.Run("data only", func(t *testing.T) {
+		w, err := newMessageWriter(contentModeStructured, true)
+		require.NoError(t, err)
+
+		m := &kafka.Message{}
+		require.NoError(t, w(e, m))
+
+		assert.JSONEq(t, tEventData, string(m.Value))
+		assert.Empty(t, m.Headers)
+	})
+
+	t.Run("binary", func(t *testing.T) {
+		w, err := newMessageWriter(contentModeBinary, true)
+		require.NoError(t, err)
+
+		m := &kafka.Message{}
+		require.NoError(t, w(e, m))
+
+		assert.JSONEq(t, tEventData, string(m.Value))
+		assert.Equal(t, map[string]string{
+			"ce_specversion": "1.0",
+			"ce_id":          tEventID,
+			"ce_source":      tEventSource,
+			"ce_type":        tEventType,
+			"ce_subject":     tEventSubject,
+			"ce_time":        "2022-06-01T12:00:00Z",
+			"ce_myext":       "42",
+			"content-type":   cloudevents.ApplicationJSON,
+		}, headersMap(m.Headers))
+	})
+
+	t.Run("unsupported content mode", func(t *testing.T) {
+		_, err := newMessageWriter("batched", false)
+		assert.Error(t, err)
+	})
+}
+
+// headersMap returns the given Kafka headers as a map.
+func headersMap(hs []kafka.Header) map[string]string {
+	m := make(map[string]string, len(hs))
+	for _, h := range hs {
+		m[h.Key] = string(h.Value)
+	}
+	return m
+}
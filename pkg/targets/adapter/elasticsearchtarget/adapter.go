@@ -32,6 +32,8 @@ import (
 
 	"github.com/elastic/go-elasticsearch/v7"
 	"github.com/elastic/go-elasticsearch/v7/esapi"
+	"github.com/elastic/go-elasticsearch/v7/esutil"
+	"github.com/tidwall/gjson"
 
 	"github.com/triggermesh/triggermesh/pkg/apis/targets"
 	"github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
@@ -61,16 +63,27 @@ func NewTarget(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClien
 		logger.Panicf("Error creating CloudEvents replier: %v", err)
 	}
 
-	return &esAdapter{
+	index, err := parseIndexTemplate(env.IndexName)
+	if err != nil {
+		logger.Panicf("Error parsing index name %q: %v", env.IndexName, err)
+	}
+
+	a := &esAdapter{
 		config:           env.GetElasticsearchConfig(),
 		replier:          replier,
-		index:            env.IndexName,
+		index:            index,
+		docIDPath:        env.DocumentIDPath,
+		pipeline:         env.Pipeline,
 		discardCEContext: env.DiscardCEContext,
 		ceClient:         ceClient,
 		logger:           logger,
 
 		sr: metrics.MustNewEventProcessingStatsReporter(mt),
 	}
+
+	a.bulk = newBulkIndexer(a.sendBulk, env.BulkMaxActions, env.BulkMaxBytes, env.BulkFlushInterval)
+
+	return a
 }
 
 var _ pkgadapter.Adapter = (*esAdapter)(nil)
@@ -79,10 +92,14 @@ type esAdapter struct {
 	config *elasticsearch.Config
 	client *elasticsearch.Client
 
-	index string
+	index     indexTemplate
+	docIDPath string
+	pipeline  string
 
 	discardCEContext bool
 
+	bulk *bulkIndexer
+
 	replier  *targetce.Replier
 	ceClient cloudevents.Client
 	logger   *zap.SugaredLogger
@@ -113,6 +130,8 @@ func (a *esAdapter) Start(ctx context.Context) error {
 		a.logger.Debug("Connected to Elasticsearch: %s", string(info))
 	}
 
+	defer a.bulk.Close()
+
 	return a.ceClient.StartReceiver(ctx, a.dispatch)
 }
 
@@ -120,7 +139,13 @@ func (a *esAdapter) dispatch(ctx context.Context, event cloudevents.Event) (*clo
 	var data []byte
 
 	if a.discardCEContext {
-		data = event.Data()
+		// Documents must be serialized on a single line within the body
+		// of bulk requests.
+		var buf bytes.Buffer
+		if err := json.Compact(&buf, event.Data()); err != nil {
+			return a.replier.Error(&event, targetce.ErrorCodeRequestParsing, err, nil)
+		}
+		data = buf.Bytes()
 	} else {
 		jsonEvent, err := json.Marshal(event)
 		if err != nil {
@@ -129,28 +154,70 @@ func (a *esAdapter) dispatch(ctx context.Context, event cloudevents.Event) (*clo
 		data = jsonEvent
 	}
 
-	req := esapi.IndexRequest{
-		Index: a.index,
-		Body:  bytes.NewReader(data),
+	index, err := a.index.Render(&event)
+	if err != nil {
+		return a.replier.Error(&event, targetce.ErrorCodeRequestValidation, err, nil)
+	}
+
+	docID, err := a.documentID(&event)
+	if err != nil {
+		return a.replier.Error(&event, targetce.ErrorCodeRequestValidation, err, nil)
 	}
 
-	res, err := req.Do(ctx, a.client)
+	item, err := a.bulk.Add(index, docID, data)
 	if err != nil {
 		return a.replier.Error(&event, targetce.ErrorCodeAdapterProcess, err, nil)
+	}
 
+	res, err := item.Wait(ctx)
+	if err != nil {
+		return a.replier.Error(&event, targetce.ErrorCodeAdapterProcess, err, res)
 	}
-	defer res.Body.Close()
-	if res.IsError() {
-		return a.replier.Error(&event, targetce.ErrorCodeAdapterProcess, err, nil)
 
+	a.logger.Debugw("Indexed CloudEvent", zap.String("index", res.Index),
+		zap.String("id", res.DocumentID), zap.String("result", res.Result))
+	return a.replier.Ok(&event, res)
+}
+
+// documentID returns the ID of the document the given event is indexed as.
+// Because documents are indexed with a deterministic ID, redelivered events
+// overwrite their previously indexed document instead of creating a
+// duplicate.
+func (a *esAdapter) documentID(event *cloudevents.Event) (string, error) {
+	if a.docIDPath == "" {
+		return event.ID(), nil
 	}
 
-	var resp map[string]interface{}
-	if err := json.NewDecoder(res.Body).Decode(&resp); err != nil {
-		return a.replier.Error(&event, targetce.ErrorCodeParseResponse, err, nil)
+	res := gjson.GetBytes(event.Data(), a.docIDPath)
+	if !res.Exists() || res.String() == "" {
+		return "", fmt.Errorf("event data has no value at document ID path %q", a.docIDPath)
+	}
 
+	return res.String(), nil
+}
+
+// sendBulk sends the given NDJSON body to the Bulk API.
+func (a *esAdapter) sendBulk(body []byte) (*esutil.BulkIndexerResponse, error) {
+	req := esapi.BulkRequest{
+		Body:     bytes.NewReader(body),
+		Pipeline: a.pipeline,
 	}
 
-	a.logger.Debug("Indexed CloudEvent: ", resp["result"])
-	return a.replier.Ok(&event, res)
+	res, err := req.Do(context.Background(), a.client)
+	if err != nil {
+		return nil, fmt.Errorf("sending bulk request: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		msg, _ := ioutil.ReadAll(res.Body)
+		return nil, fmt.Errorf("bulk request returned status %d: %s", res.StatusCode, msg)
+	}
+
+	resp := &esutil.BulkIndexerResponse{}
+	if err := json.NewDecoder(res.Body).Decode(resp); err != nil {
+		return nil, fmt.Errorf("decoding bulk response: %w", err)
+	}
+
+	return resp, nil
 }
@@ -0,0 +1,185 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elasticsearchtarget
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/elastic/go-elasticsearch/v7/esutil"
+
+	"github.com/triggermesh/triggermesh/pkg/targets/adapter/common/batch"
+)
+
+// bulkItem is a document which is indexed as part of a bulk request.
+type bulkItem struct {
+	// closed once the bulk request the item belongs to has been sent
+	done chan struct{}
+	res  *esutil.BulkIndexerResponseItem
+	err  error
+}
+
+// Wait blocks until the item has been indexed, or the given context is
+// cancelled.
+func (i *bulkItem) Wait(ctx context.Context) (*esutil.BulkIndexerResponseItem, error) {
+	select {
+	case <-i.done:
+		return i.res, i.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// bulkRequest is a set of items which are sent in the same request to the
+// Bulk API.
+type bulkRequest struct {
+	send bulkSendFunc
+
+	body  bytes.Buffer
+	items []*bulkItem
+}
+
+var _ batch.Batch = (*bulkRequest)(nil)
+
+// bulkSendFunc sends the given NDJSON body to the Bulk API.
+type bulkSendFunc func(body []byte) (*esutil.BulkIndexerResponse, error)
+
+// bulkIndexer accumulates documents into bulk requests, which are sent when
+// either their action count or size threshold is reached, or after a
+// maximum delay.
+//
+// Contrary to esutil.BulkIndexer, the outcome of every document is reported
+// to its waiter, including when the entire bulk request fails.
+type bulkIndexer struct {
+	*batch.Batcher
+}
+
+// newBulkIndexer returns a bulkIndexer which sends requests using the given
+// function.
+func newBulkIndexer(send bulkSendFunc, maxActions, maxBytes int, flushInterval time.Duration) *bulkIndexer {
+	newRequest := func() batch.Batch {
+		return &bulkRequest{
+			send: send,
+		}
+	}
+
+	return &bulkIndexer{
+		Batcher: batch.New(newRequest, maxActions, maxBytes, flushInterval),
+	}
+}
+
+// bulkActionMeta is the metadata line of an "index" action.
+type bulkActionMeta struct {
+	Index bulkActionMetaIndex `json:"index"`
+}
+
+type bulkActionMetaIndex struct {
+	Index string `json:"_index"`
+	ID    string `json:"_id,omitempty"`
+}
+
+// Add adds an "index" action for the given document to the current bulk
+// request. The document must be serialized as single-line JSON.
+func (bi *bulkIndexer) Add(index, id string, doc []byte) (*bulkItem, error) {
+	meta, err := json.Marshal(bulkActionMeta{
+		Index: bulkActionMetaIndex{
+			Index: index,
+			ID:    id,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("serializing action metadata: %w", err)
+	}
+
+	item := &bulkItem{
+		done: make(chan struct{}),
+	}
+
+	_, err = bi.Batcher.Add(func(b batch.Batch) (int, error) {
+		r := b.(*bulkRequest)
+
+		n := len(meta) + len(doc) + 2
+
+		r.body.Grow(n)
+		r.body.Write(meta)
+		r.body.WriteByte('\n')
+		r.body.Write(doc)
+		r.body.WriteByte('\n')
+		r.items = append(r.items, item)
+
+		return n, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
+
+// Flush implements batch.Batch. It sends the bulk request and notifies the
+// waiters of each of its items.
+func (r *bulkRequest) Flush() {
+	defer func() {
+		for _, item := range r.items {
+			close(item.done)
+		}
+	}()
+
+	resp, err := r.send(r.body.Bytes())
+	if err == nil && len(resp.Items) != len(r.items) {
+		err = fmt.Errorf("bulk response contains %d items, expected %d", len(resp.Items), len(r.items))
+	}
+	if err != nil {
+		for _, item := range r.items {
+			item.err = err
+		}
+		return
+	}
+
+	for i, item := range r.items {
+		// Each item of a bulk response is a map containing a single
+		// entry keyed by the name of the action (e.g. "index").
+		for _, res := range resp.Items[i] {
+			res := res
+			item.res = &res
+			item.err = itemError(&res)
+		}
+	}
+}
+
+// itemError returns the error reported for the given item of a bulk
+// response, if any.
+func itemError(res *esutil.BulkIndexerResponseItem) error {
+	if res.Error.Type == "" && res.Status <= 201 {
+		return nil
+	}
+
+	if res.Error.Type == "" {
+		return fmt.Errorf("document indexing returned status %d", res.Status)
+	}
+
+	err := fmt.Errorf("document indexing returned status %d (%s): %s",
+		res.Status, res.Error.Type, res.Error.Reason)
+	if res.Error.Cause.Type != "" {
+		err = fmt.Errorf("%w, caused by %s: %s", err, res.Error.Cause.Type, res.Error.Cause.Reason)
+	}
+
+	return err
+}
@@ -0,0 +1,199 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elasticsearchtarget
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/go-elasticsearch/v7/esutil"
+)
+
+const tDoc = `{"msg":"hello"}`
+
+func TestBulkIndexerThresholds(t *testing.T) {
+	testCases := map[string]struct {
+		maxActions     int
+		maxBytes       int
+		flushInterval  time.Duration
+		numDocs        int
+		expectRequests []int
+	}{
+		"max actions reached": {
+			maxActions:     2,
+			maxBytes:       1 << 20,
+			flushInterval:  time.Hour,
+			numDocs:        4,
+			expectRequests: []int{2, 2},
+		},
+		"max bytes reached": {
+			maxActions:     100,
+			maxBytes:       100, // ~60 bytes per action
+			flushInterval:  time.Hour,
+			numDocs:        2,
+			expectRequests: []int{2},
+		},
+		"flush interval elapsed": {
+			maxActions:     100,
+			maxBytes:       1 << 20,
+			flushInterval:  10 * time.Millisecond,
+			numDocs:        3,
+			expectRequests: []int{3},
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			s := &bulkRecorder{}
+
+			bi := newBulkIndexer(s.send, tc.maxActions, tc.maxBytes, tc.flushInterval)
+
+			items := make([]*bulkItem, 0, tc.numDocs)
+			for i := 0; i < tc.numDocs; i++ {
+				item, err := bi.Add("test-index", strconv.Itoa(i), []byte(tDoc))
+				require.NoError(t, err)
+				items = append(items, item)
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			for i, item := range items {
+				res, err := item.Wait(ctx)
+				require.NoError(t, err)
+				assert.Equal(t, strconv.Itoa(i), res.DocumentID)
+			}
+
+			assert.ElementsMatch(t, tc.expectRequests, s.counts())
+		})
+	}
+}
+
+func TestBulkIndexerItemErrors(t *testing.T) {
+	t.Run("failed item", func(t *testing.T) {
+		s := &bulkRecorder{
+			failIDs: map[string]struct{}{"1": {}},
+		}
+
+		bi := newBulkIndexer(s.send, 2, 1<<20, time.Hour)
+
+		okItem, err := bi.Add("test-index", "0", []byte(tDoc))
+		require.NoError(t, err)
+		failedItem, err := bi.Add("test-index", "1", []byte(tDoc))
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		_, err = okItem.Wait(ctx)
+		assert.NoError(t, err)
+
+		res, err := failedItem.Wait(ctx)
+		assert.EqualError(t, err, "document indexing returned status 400 (mapper_parsing_exception): failed to parse")
+		require.NotNil(t, res)
+		assert.Equal(t, 400, res.Status)
+	})
+
+	t.Run("failed request", func(t *testing.T) {
+		sendErr := errors.New("connection refused")
+		send := func([]byte) (*esutil.BulkIndexerResponse, error) {
+			return nil, sendErr
+		}
+
+		bi := newBulkIndexer(send, 2, 1<<20, time.Hour)
+
+		items := make([]*bulkItem, 2)
+		for i := range items {
+			item, err := bi.Add("test-index", strconv.Itoa(i), []byte(tDoc))
+			require.NoError(t, err)
+			items[i] = item
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		for _, item := range items {
+			_, err := item.Wait(ctx)
+			assert.ErrorIs(t, err, sendErr)
+		}
+	})
+}
+
+// bulkRecorder records the number of documents contained in sent bulk
+// requests, and responds to them as the Bulk API would.
+type bulkRecorder struct {
+	// IDs of documents which fail to be indexed
+	failIDs map[string]struct{}
+
+	sync.Mutex
+	requests []int
+}
+
+func (r *bulkRecorder) send(body []byte) (*esutil.BulkIndexerResponse, error) {
+	resp := &esutil.BulkIndexerResponse{}
+
+	s := bufio.NewScanner(bytes.NewReader(body))
+	for s.Scan() {
+		var meta bulkActionMeta
+		if err := json.Unmarshal(s.Bytes(), &meta); err != nil {
+			return nil, err
+		}
+		if !s.Scan() {
+			return nil, errors.New("missing document line")
+		}
+
+		item := esutil.BulkIndexerResponseItem{
+			Index:      meta.Index.Index,
+			DocumentID: meta.Index.ID,
+			Result:     "created",
+			Status:     201,
+		}
+		if _, fail := r.failIDs[meta.Index.ID]; fail {
+			item.Result = ""
+			item.Status = 400
+			item.Error.Type = "mapper_parsing_exception"
+			item.Error.Reason = "failed to parse"
+			resp.HasErrors = true
+		}
+
+		resp.Items = append(resp.Items, map[string]esutil.BulkIndexerResponseItem{"index": item})
+	}
+
+	r.Lock()
+	defer r.Unlock()
+	r.requests = append(r.requests, len(resp.Items))
+
+	return resp, nil
+}
+
+func (r *bulkRecorder) counts() []int {
+	r.Lock()
+	defer r.Unlock()
+
+	return append([]int(nil), r.requests...)
+}
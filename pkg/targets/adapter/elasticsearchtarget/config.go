@@ -20,6 +20,7 @@ import (
 	"crypto/tls"
 	"crypto/x509"
 	"net/http"
+	"time"
 
 	"github.com/elastic/go-elasticsearch/v7"
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
@@ -40,8 +41,21 @@ type envAccessor struct {
 	CACert     string   `envconfig:"ELASTICSEARCH_CACERT"`
 	SkipVerify bool     `envconfig:"ELASTICSEARCH_SKIPVERIFY" default:"false"`
 
+	// Name of the index documents are written to. May contain placeholders
+	// which are rendered for each event.
 	IndexName string `envconfig:"ELASTICSEARCH_INDEX" required:"true"`
 
+	// Path of the document ID within the event data, in GJSON syntax.
+	// The ID of the event is used when not set.
+	DocumentIDPath string `envconfig:"ELASTICSEARCH_DOCUMENT_ID_PATH"`
+	// Ingest pipeline documents are processed with.
+	Pipeline string `envconfig:"ELASTICSEARCH_PIPELINE"`
+
+	// Thresholds of bulk requests.
+	BulkMaxActions    int           `envconfig:"ELASTICSEARCH_BULK_MAX_ACTIONS" default:"500"`
+	BulkMaxBytes      int           `envconfig:"ELASTICSEARCH_BULK_MAX_BYTES" default:"5242880"`
+	BulkFlushInterval time.Duration `envconfig:"ELASTICSEARCH_BULK_FLUSH_INTERVAL" default:"1s"`
+
 	DiscardCEContext bool `envconfig:"ELASTICSEARCH_DISCARD_CE_CONTEXT"`
 
 	// CloudEvents responses parametrization
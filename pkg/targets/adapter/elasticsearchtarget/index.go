@@ -0,0 +1,201 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elasticsearchtarget
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/types"
+)
+
+// indexTemplate renders the name of the index a CloudEvent is written to.
+//
+// Templates contain placeholders enclosed in curly braces. A placeholder is
+// either a date pattern composed of the tokens yyyy, yy, MM, dd, HH, mm and ss
+// (e.g. '{yyyy.MM.dd}'), which is rendered with the time of the event in UTC
+// or the current time if the event has no time attribute, or the name of a
+// CloudEvent context attribute or extension (e.g. '{type}').
+// Rendered values are converted to lower case, as required by Elasticsearch.
+type indexTemplate []indexTemplatePart
+
+// indexTemplatePart is a part of an index name template.
+type indexTemplatePart struct {
+	literal    string
+	attribute  string
+	timeLayout string
+}
+
+// dateTokens maps the tokens of date patterns to their Go layout
+// equivalent, in matching order.
+var dateTokens = []struct{ token, layout string }{
+	{"yyyy", "2006"},
+	{"yy", "06"},
+	{"MM", "01"},
+	{"dd", "02"},
+	{"HH", "15"},
+	{"mm", "04"},
+	{"ss", "05"},
+}
+
+// dateSeparators are the characters allowed between the tokens of a date
+// pattern.
+const dateSeparators = ".-_/:"
+
+// parseIndexTemplate parses the given index name template.
+func parseIndexTemplate(tpl string) (indexTemplate, error) {
+	var parts indexTemplate
+
+	for tpl != "" {
+		start := strings.IndexByte(tpl, '{')
+		if start == -1 {
+			if strings.IndexByte(tpl, '}') != -1 {
+				return nil, errors.New("unexpected closing brace")
+			}
+			parts = append(parts, indexTemplatePart{literal: tpl})
+			break
+		}
+
+		if start > 0 {
+			if strings.IndexByte(tpl[:start], '}') != -1 {
+				return nil, errors.New("unexpected closing brace")
+			}
+			parts = append(parts, indexTemplatePart{literal: tpl[:start]})
+		}
+
+		end := strings.IndexByte(tpl[start:], '}')
+		if end == -1 {
+			return nil, errors.New("unterminated placeholder")
+		}
+		end += start
+
+		placeholder := tpl[start+1 : end]
+		if placeholder == "" {
+			return nil, errors.New("empty placeholder")
+		}
+
+		if layout, ok := dateLayout(placeholder); ok {
+			parts = append(parts, indexTemplatePart{timeLayout: layout})
+		} else {
+			parts = append(parts, indexTemplatePart{attribute: placeholder})
+		}
+
+		tpl = tpl[end+1:]
+	}
+
+	return parts, nil
+}
+
+// dateLayout returns the Go time layout equivalent to the given date
+// pattern, and whether the pattern is a valid date pattern.
+func dateLayout(pattern string) (string, bool) {
+	var layout strings.Builder
+	var hasToken bool
+
+	for pattern != "" {
+		var matched bool
+		for _, t := range dateTokens {
+			if strings.HasPrefix(pattern, t.token) {
+				layout.WriteString(t.layout)
+				pattern = pattern[len(t.token):]
+				matched, hasToken = true, true
+				break
+			}
+		}
+		if matched {
+			continue
+		}
+
+		if !strings.ContainsRune(dateSeparators, rune(pattern[0])) {
+			return "", false
+		}
+		layout.WriteByte(pattern[0])
+		pattern = pattern[1:]
+	}
+
+	return layout.String(), hasToken
+}
+
+// Render returns the name of the index the given event is written to.
+func (t indexTemplate) Render(e *cloudevents.Event) (string, error) {
+	var idx strings.Builder
+
+	for _, p := range t {
+		switch {
+		case p.literal != "":
+			idx.WriteString(p.literal)
+
+		case p.timeLayout != "":
+			ts := e.Time()
+			if ts.IsZero() {
+				ts = time.Now()
+			}
+			idx.WriteString(ts.UTC().Format(p.timeLayout))
+
+		default:
+			v, err := attributeValue(e, p.attribute)
+			if err != nil {
+				return "", err
+			}
+			idx.WriteString(strings.ToLower(v))
+		}
+	}
+
+	return idx.String(), nil
+}
+
+// attributeValue returns the string representation of the given CloudEvent
+// context attribute or extension.
+func attributeValue(e *cloudevents.Event, name string) (string, error) {
+	var v string
+
+	switch name {
+	case "id":
+		v = e.ID()
+	case "source":
+		v = e.Source()
+	case "type":
+		v = e.Type()
+	case "subject":
+		v = e.Subject()
+	case "specversion":
+		v = e.SpecVersion()
+	case "datacontenttype":
+		v = e.DataContentType()
+	case "dataschema":
+		v = e.DataSchema()
+	default:
+		ext, ok := e.Extensions()[name]
+		if !ok {
+			break
+		}
+		s, err := types.Format(ext)
+		if err != nil {
+			return "", fmt.Errorf("formatting value of extension %q: %w", name, err)
+		}
+		v = s
+	}
+
+	if v == "" {
+		return "", fmt.Errorf("event has no value for attribute %q referenced in the index name", name)
+	}
+
+	return v, nil
+}
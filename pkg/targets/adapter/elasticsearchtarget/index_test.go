@@ -0,0 +1,88 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elasticsearchtarget
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+)
+
+func TestIndexTemplate(t *testing.T) {
+	testCases := map[string]struct {
+		template    string
+		expectIndex string
+		expectErr   bool
+	}{
+		"static name": {
+			template:    "events",
+			expectIndex: "events",
+		},
+		"attributes and date": {
+			template:    "events-{type}-{yyyy.MM.dd}",
+			expectIndex: "events-test.type-2022.06.01",
+		},
+		"extension and time": {
+			template:    "{myext}_{yyyyMMddHH}",
+			expectIndex: "abc_2022060114",
+		},
+		"missing attribute": {
+			template:  "events-{subject}",
+			expectErr: true,
+		},
+	}
+
+	e := cloudevents.NewEvent()
+	e.SetID("0000")
+	e.SetSource("test.source")
+	e.SetType("Test.Type")
+	e.SetTime(time.Date(2022, time.June, 1, 16, 0, 0, 0, time.FixedZone("UTC+2", 2*60*60)))
+	e.SetExtension("myext", "ABC")
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			tpl, err := parseIndexTemplate(tc.template)
+			require.NoError(t, err)
+
+			idx, err := tpl.Render(&e)
+			if tc.expectErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectIndex, idx)
+		})
+	}
+}
+
+func TestParseIndexTemplateErrors(t *testing.T) {
+	for _, tpl := range []string{
+		"events-{type",
+		"events-type}",
+		"events-{}",
+		"{type}}",
+	} {
+		_, err := parseIndexTemplate(tpl)
+		assert.Error(t, err, "Expected template %q to be invalid", tpl)
+	}
+}
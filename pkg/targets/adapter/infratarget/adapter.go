@@ -33,6 +33,7 @@ import (
 	"github.com/triggermesh/triggermesh/pkg/apis/targets"
 	"github.com/triggermesh/triggermesh/pkg/metrics"
 	"github.com/triggermesh/triggermesh/pkg/targets/adapter/infratarget/vm"
+	gojavm "github.com/triggermesh/triggermesh/pkg/targets/adapter/infratarget/vm/goja"
 	jsvm "github.com/triggermesh/triggermesh/pkg/targets/adapter/infratarget/vm/javascript"
 )
 
@@ -54,12 +55,26 @@ func NewTarget(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClien
 		ceClient:           ceClient,
 		logger:             logger,
 		typeLoopProtection: env.TypeLoopProtection,
+		sink:               env.Sink,
 
 		sr: metrics.MustNewEventProcessingStatsReporter(mt),
 	}
 
 	if env.ScriptCode != "" {
-		adapter.vm = jsvm.New(env.ScriptCode, time.Duration(env.ScriptTimeout)*time.Millisecond, logger.Named("vm"))
+		timeout := time.Duration(env.ScriptTimeout) * time.Millisecond
+		state := vm.NewStateStore(env.StateBridge, env.StateTTL)
+
+		switch env.ScriptEngine {
+		case "otto":
+			adapter.vm = jsvm.New(env.ScriptCode, timeout, state, logger.Named("vm"))
+		case "goja":
+			var err error
+			if adapter.vm, err = gojavm.New(env.ScriptCode, timeout, state, logger.Named("vm")); err != nil {
+				logger.Panicw("Error initializing goja virtual machine", zap.Error(err))
+			}
+		default:
+			logger.Panicf("Unsupported script engine %q", env.ScriptEngine)
+		}
 	}
 
 	switch env.StateHeadersPolicy {
@@ -80,6 +95,7 @@ type infraAdapter struct {
 	preProcessHeaders  preProcessHeaders
 	postProcessHeaders postProcessHeaders
 	typeLoopProtection bool
+	sink               string
 
 	ceClient cloudevents.Client
 	logger   *zap.SugaredLogger
@@ -94,7 +110,7 @@ func (a *infraAdapter) Start(ctx context.Context) error {
 }
 
 func (a *infraAdapter) dispatch(ctx context.Context, event cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
-	var out *cloudevents.Event
+	var outs []*cloudevents.Event
 	var err error
 
 	// Preprocess headers will modify incoming event.
@@ -108,45 +124,63 @@ func (a *infraAdapter) dispatch(ctx context.Context, event cloudevents.Event) (*
 	}
 
 	// VM execution receives preprocessed event as input but does not modify it,
-	// instead it returns a new output event.
+	// instead it returns new output events.
 	if a.vm != nil {
-		out, err = a.vm.Exec(&event)
+		outs, err = a.vm.Exec(&event)
 		if err != nil {
 			r := cloudevents.NewHTTPResult(http.StatusInternalServerError, "Error executing script: %w", err)
 			a.logger.Errorw("Error executing script", zap.Error(r))
 			return nil, r
 		}
 	} else {
-		out = &event
+		outs = []*cloudevents.Event{&event}
 	}
 
-	// if no CloudEvent is produced there is no need for post-processing
-	if out == nil {
-		return nil, cloudevents.ResultACK
-	}
+	for _, out := range outs {
+		// if event type loop protection is enabled, make sure the output type
+		// does not match the incoming type.
+		if a.typeLoopProtection {
+			if event.Type() == out.Type() {
+				r := cloudevents.NewHTTPResult(http.StatusInternalServerError, "incoming and outgoing CloudEvents have the same type %q. Skipping", event.Type())
+				a.logger.Errorw("CE type error", zap.Error(r))
+				return nil, r
+			}
+		}
 
-	// if event type loop protection is enabled, make sure the output type
-	// does not match the incoming type.
-	if a.typeLoopProtection {
-		if event.Type() == out.Type() {
-			r := cloudevents.NewHTTPResult(http.StatusInternalServerError, "incoming and outgoing CloudEvents have the same type %q. Skipping", event.Type())
-			a.logger.Errorw("CE type error", zap.Error(r))
-			return nil, r
+		// Postprocess headers modifies the output event using the preprocessed
+		// incoming event. Missing headers from input event might be copied to the
+		// output as part of this process.
+		if a.postProcessHeaders != nil {
+			if err = a.postProcessHeaders(&event, out); err != nil {
+				r := cloudevents.NewHTTPResult(http.StatusInternalServerError, "Post processing headers: %w", err)
+				a.logger.Errorw("Post processing headers", zap.Error(r))
+				return nil, r
+			}
 		}
 	}
 
-	// Postprocess headers modifies the output event using the preprocessed
-	// incoming event. Missing headers from input event might be copied to the
-	// output as part of this process.
-	if a.postProcessHeaders != nil && out != nil {
-		if err = a.postProcessHeaders(&event, out); err != nil {
-			r := cloudevents.NewHTTPResult(http.StatusInternalServerError, "Post processing headers: %w", err)
-			a.logger.Errorw("Post processing headers", zap.Error(r))
-			return nil, r
+	if a.sink != "" {
+		for _, out := range outs {
+			if result := a.ceClient.Send(ctx, *out); !cloudevents.IsACK(result) {
+				r := cloudevents.NewHTTPResult(http.StatusInternalServerError, "Sending event to the sink: %w", result)
+				a.logger.Errorw("Error sending event to the sink", zap.Error(r))
+				return nil, r
+			}
 		}
+		return nil, cloudevents.ResultACK
 	}
 
-	return out, cloudevents.ResultACK
+	switch len(outs) {
+	// if no CloudEvent is produced there is nothing to reply with
+	case 0:
+		return nil, cloudevents.ResultACK
+	case 1:
+		return outs[0], cloudevents.ResultACK
+	default:
+		r := cloudevents.NewHTTPResult(http.StatusInternalServerError, "the script produced %d events, which can only be sent to a sink", len(outs))
+		a.logger.Errorw("Multiple output events", zap.Error(r))
+		return nil, r
+	}
 }
 
 type postProcessHeaders func(in, out *cloudevents.Event) error
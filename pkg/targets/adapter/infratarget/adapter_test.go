@@ -19,18 +19,24 @@ package infratarget
 import (
 	"context"
 	"encoding/json"
+	"strconv"
 	"testing"
 	"time"
 
+	cloudevents "github.com/cloudevents/sdk-go/v2"
 	cetest "github.com/cloudevents/sdk-go/v2/client/test"
 	ceevent "github.com/cloudevents/sdk-go/v2/event"
 	"github.com/stretchr/testify/assert"
-	jsvm "github.com/triggermesh/triggermesh/pkg/targets/adapter/infratarget/vm/javascript"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 	"go.uber.org/zap/zaptest/observer"
 
 	logtesting "knative.dev/pkg/logging/testing"
+
+	"github.com/triggermesh/triggermesh/pkg/targets/adapter/infratarget/vm"
+	gojavm "github.com/triggermesh/triggermesh/pkg/targets/adapter/infratarget/vm/goja"
+	jsvm "github.com/triggermesh/triggermesh/pkg/targets/adapter/infratarget/vm/javascript"
 )
 
 const (
@@ -494,7 +500,7 @@ func TestInfraRequests(t *testing.T) {
 			}
 
 			if tc.userScript != "" {
-				adapter.vm = jsvm.New(tc.userScript, time.Second, logger.Named("vm"))
+				adapter.vm = jsvm.New(tc.userScript, time.Second, vm.NewStateStore(tc.stateBridge, 0), logger.Named("vm"))
 			}
 
 			switch tc.stateHeadersPolicy {
@@ -555,6 +561,109 @@ func TestInfraRequests(t *testing.T) {
 	}
 }
 
+func TestInfraEngines(t *testing.T) {
+	engines := map[string]func(t *testing.T, script string, state *vm.StateStore) vm.InfraVM{
+		"otto": func(t *testing.T, script string, state *vm.StateStore) vm.InfraVM {
+			return jsvm.New(script, time.Second, state, logtesting.TestLogger(t))
+		},
+		"goja": func(t *testing.T, script string, state *vm.StateStore) vm.InfraVM {
+			v, err := gojavm.New(script, time.Second, state, logtesting.TestLogger(t))
+			require.NoError(t, err)
+			return v
+		},
+	}
+
+	for name, newVM := range engines {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			t.Run("state is scoped by bridge", func(t *testing.T) {
+				const script = `
+				function handle(input) {
+					var count = state.get("count") || 0;
+					state.set("count", count + 1);
+
+					return {
+						type: "test.response.type",
+						data: {"count": count + 1}
+					}
+				}
+				`
+
+				adapter := &infraAdapter{
+					vm:     newVM(t, script, vm.NewStateStore(tStateBridge, 0)),
+					logger: logtesting.TestLogger(t),
+				}
+
+				for _, tc := range []struct {
+					bridge      string
+					expectCount string
+				}{
+					{bridge: "", expectCount: `{"count":1}`},
+					{bridge: tStateBridge, expectCount: `{"count":2}`},
+					{bridge: "other-bridge", expectCount: `{"count":1}`},
+					{bridge: "", expectCount: `{"count":3}`},
+				} {
+					var opts []eventOpts
+					if tc.bridge != "" {
+						opts = append(opts, withExtension("statefulbridge", tc.bridge))
+					}
+
+					out, res := adapter.dispatch(context.Background(), *createJSONEvent(t, opts...))
+					require.True(t, cloudevents.IsACK(res), "Unexpected result: %v", res)
+					require.NotNil(t, out)
+					assert.Equal(t, tc.expectCount, string(out.Data()))
+				}
+			})
+
+			const multiScript = `
+			function handle(input) {
+				emit({type: "test.emitted.type", data: {"index": 0}});
+				emit({type: "test.emitted.type", data: {"index": 1}});
+
+				return [{type: "test.response.type", data: {"index": 2}}];
+			}
+			`
+
+			t.Run("multiple events are sent to the sink", func(t *testing.T) {
+				ceClient, sent := cetest.NewMockSenderClient(t, 3)
+
+				adapter := &infraAdapter{
+					vm:       newVM(t, multiScript, nil),
+					sink:     "http://sink.example.com",
+					ceClient: ceClient,
+					logger:   logtesting.TestLogger(t),
+				}
+
+				out, res := adapter.dispatch(context.Background(), *createJSONEvent(t))
+				require.True(t, cloudevents.IsACK(res), "Unexpected result: %v", res)
+				assert.Nil(t, out)
+
+				for i := 0; i < 3; i++ {
+					select {
+					case e := <-sent:
+						assert.Equal(t, tID+"-"+strconv.Itoa(i), e.ID())
+						assert.Equal(t, `{"index":`+strconv.Itoa(i)+`}`, string(e.Data()))
+					case <-time.After(3 * time.Second):
+						assert.FailNow(t, "expected cloud event was not sent to the sink")
+					}
+				}
+			})
+
+			t.Run("multiple events without sink", func(t *testing.T) {
+				adapter := &infraAdapter{
+					vm:     newVM(t, multiScript, nil),
+					logger: logtesting.TestLogger(t),
+				}
+
+				out, res := adapter.dispatch(context.Background(), *createJSONEvent(t))
+				assert.Nil(t, out)
+				assert.False(t, cloudevents.IsACK(res))
+				assert.Contains(t, res.Error(), "can only be sent to a sink")
+			})
+		})
+	}
+}
+
 type eventOpts func(*ceevent.Event)
 
 func createJSONEvent(t *testing.T, opts ...eventOpts) *ceevent.Event {
@@ -17,6 +17,8 @@ limitations under the License.
 package infratarget
 
 import (
+	"time"
+
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
 )
 
@@ -28,9 +30,11 @@ func EnvAccessorCtor() pkgadapter.EnvConfigAccessor {
 type envAccessor struct {
 	pkgadapter.EnvConfig
 
-	ScriptCode         string `envconfig:"INFRA_SCRIPT_CODE" required:"true"`
-	ScriptTimeout      int    `envconfig:"INFRA_SCRIPT_TIMEOUT" default:"2000"`
-	StateHeadersPolicy string `envconfig:"INFRA_STATE_HEADERS_POLICY" default:"propagate"`
-	StateBridge        string `envconfig:"INFRA_STATE_BRIDGE"`
-	TypeLoopProtection bool   `envconfig:"INFRA_TYPE_LOOP_PROTECTION" default:"true"`
+	ScriptCode         string        `envconfig:"INFRA_SCRIPT_CODE" required:"true"`
+	ScriptTimeout      int           `envconfig:"INFRA_SCRIPT_TIMEOUT" default:"2000"`
+	ScriptEngine       string        `envconfig:"INFRA_SCRIPT_ENGINE" default:"otto"`
+	StateHeadersPolicy string        `envconfig:"INFRA_STATE_HEADERS_POLICY" default:"propagate"`
+	StateBridge        string        `envconfig:"INFRA_STATE_BRIDGE"`
+	StateTTL           time.Duration `envconfig:"INFRA_STATE_TTL"`
+	TypeLoopProtection bool          `envconfig:"INFRA_TYPE_LOOP_PROTECTION" default:"true"`
 }
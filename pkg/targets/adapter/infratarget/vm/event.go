@@ -0,0 +1,135 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vm
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/clbanning/mxj"
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+)
+
+// InputEvent returns the JSON representation of the given event, as passed
+// to user scripts. XML data is converted to JSON beforehand.
+func InputEvent(event *cloudevents.Event) ([]byte, error) {
+	if dmt := event.DataMediaType(); dmt == "application/xml" || dmt == "text/xml" {
+		xml, err := mxj.NewMapXml(event.Data())
+		if err != nil {
+			return nil, fmt.Errorf("error parsing XML event data contents: %w", err)
+		}
+		if err = event.SetData(cloudevents.ApplicationJSON, xml.Old()); err != nil {
+			return nil, fmt.Errorf("error setting JSON event data converted from XML: %w", err)
+		}
+	}
+
+	b, err := event.MarshalJSON()
+	if err != nil {
+		return nil, fmt.Errorf("error serializing event: %w", err)
+	}
+	return b, nil
+}
+
+// OutputEvents converts values produced by a user script into CloudEvents.
+// Each value can be either an event object, an array of event objects, or
+// nil. Missing context attributes are defaulted using the incoming event.
+//
+// IDs of events which are produced alongside other events are defaulted to
+// the ID of the incoming event suffixed with the index of the produced event.
+func OutputEvents(in *cloudevents.Event, values ...interface{}) ([]*cloudevents.Event, error) {
+	var objs []map[string]interface{}
+
+	for _, v := range values {
+		// normalize the value exported by the VM into JSON types
+		b, err := json.Marshal(v)
+		if err != nil {
+			return nil, fmt.Errorf("error serializing exec response: %w", err)
+		}
+		var res interface{}
+		if err := json.Unmarshal(b, &res); err != nil {
+			return nil, fmt.Errorf("error deserializing exec response: %w", err)
+		}
+
+		switch t := res.(type) {
+		case map[string]interface{}:
+			objs = append(objs, t)
+
+		case []interface{}:
+			for _, item := range t {
+				obj, ok := item.(map[string]interface{})
+				if !ok {
+					return nil, fmt.Errorf("unexpected execution result item type %T: %v", item, item)
+				}
+				objs = append(objs, obj)
+			}
+
+		case nil:
+
+		default:
+			return nil, fmt.Errorf("unexpected execution result type %T: %v", t, t)
+		}
+	}
+
+	events := make([]*cloudevents.Event, 0, len(objs))
+	for i, obj := range objs {
+		// events produced from the same input must not share an ID
+		id := in.ID()
+		if len(objs) > 1 {
+			id += "-" + strconv.Itoa(i)
+		}
+
+		e, err := outputEvent(in, id, obj)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, e)
+	}
+
+	return events, nil
+}
+
+// outputEvent converts an event object produced by a user script into a
+// CloudEvent.
+func outputEvent(in *cloudevents.Event, id string, obj map[string]interface{}) (*cloudevents.Event, error) {
+	// defaulting missing fields, we do not check CloudEvent type
+	// because it is most probably used at filters and defaulting
+	// to the incoming one might end up in a loop.
+	if _, ok := obj["specversion"]; !ok {
+		obj["specversion"] = in.SpecVersion()
+	}
+	if _, ok := obj["source"]; !ok {
+		obj["source"] = in.Source()
+	}
+	if _, ok := obj["id"]; !ok {
+		obj["id"] = id
+	}
+	if _, ok := obj["datacontenttype"]; !ok {
+		obj["datacontenttype"] = cloudevents.ApplicationJSON
+	}
+
+	b, err := json.Marshal(obj)
+	if err != nil {
+		return nil, fmt.Errorf("error serializing exec response: %w", err)
+	}
+
+	event := &cloudevents.Event{}
+	if err := event.UnmarshalJSON(b); err != nil {
+		return nil, fmt.Errorf("error unmarshaling exec response into event: %w", err)
+	}
+	return event, nil
+}
@@ -0,0 +1,279 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package goja implements an InfraVM which runs user scripts using the goja
+// ECMAScript engine.
+//
+// In addition to the features of ECMAScript 5.1, goja supports most of the
+// ES6 syntax and standard library (arrow functions, classes, let/const,
+// template literals, destructuring, Map/Set, Promise, ...), as well as
+// CommonJS modules through the require() function.
+package goja
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	js "github.com/dop251/goja"
+	"github.com/dop251/goja_nodejs/console"
+	"github.com/dop251/goja_nodejs/require"
+	_ "github.com/dop251/goja_nodejs/util" // registers the "util" module
+	"go.uber.org/zap"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+
+	"github.com/triggermesh/triggermesh/pkg/targets/adapter/infratarget/vm"
+)
+
+// scriptName is the name given to the user script in stack traces.
+const scriptName = "script.js"
+
+type gojaVM struct {
+	program  *js.Program
+	registry *require.Registry
+	pool     sync.Pool
+	timeout  time.Duration
+	state    *vm.StateStore
+	logger   *zap.SugaredLogger
+}
+
+// runtime is an instance of the goja runtime in which the user script was
+// already evaluated.
+type runtime struct {
+	*js.Runtime
+	handle js.Callable
+	parse  js.Callable
+}
+
+// New creates a new VM that runs the given script using the goja engine.
+func New(script string, timeout time.Duration, state *vm.StateStore, logger *zap.SugaredLogger) (vm.InfraVM, error) {
+	prg, err := js.Compile(scriptName, script, false)
+	if err != nil {
+		return nil, fmt.Errorf("compiling script: %w", err)
+	}
+
+	// Only native modules can be loaded. Scripts do not have access to
+	// the file system of the adapter.
+	registry := require.NewRegistry(require.WithLoader(func(string) ([]byte, error) {
+		return nil, require.ModuleFileDoesNotExistError
+	}))
+	registry.RegisterNativeModule("console", console.RequireWithPrinter(printer{logger}))
+
+	g := &gojaVM{
+		program:  prg,
+		registry: registry,
+		timeout:  timeout,
+		state:    state,
+		logger:   logger,
+	}
+
+	// validate the script once upfront to surface errors at startup
+	rt, err := g.newRuntime()
+	if err != nil {
+		return nil, err
+	}
+	g.pool.Put(rt)
+
+	return g, nil
+}
+
+// newRuntime returns a runtime in which the user script was evaluated.
+func (g *gojaVM) newRuntime() (*runtime, error) {
+	rt := js.New()
+
+	g.registry.Enable(rt)
+	if err := rt.Set("console", require.Require(rt, "console")); err != nil {
+		return nil, fmt.Errorf("injecting console object: %w", err)
+	}
+	if err := rt.Set("log", func(msg string) { g.logger.Info(msg) }); err != nil {
+		return nil, fmt.Errorf("injecting log function: %w", err)
+	}
+
+	if _, err := rt.RunProgram(g.program); err != nil {
+		return nil, fmt.Errorf("evaluating script: %w", err)
+	}
+
+	handleVal := rt.Get("handle")
+	handle, ok := js.AssertFunction(handleVal)
+	if !ok {
+		return nil, errors.New("script does not implement handle(input) function")
+	}
+	if handleVal.ToObject(rt).Get("length").ToInteger() != 1 {
+		return nil, errors.New("handle(input) function accepts exactly one parameter")
+	}
+
+	parse, ok := js.AssertFunction(rt.Get("JSON").ToObject(rt).Get("parse"))
+	if !ok {
+		return nil, errors.New("JSON.parse is not a function")
+	}
+
+	return &runtime{
+		Runtime: rt,
+		handle:  handle,
+		parse:   parse,
+	}, nil
+}
+
+// getRuntime returns a runtime from the pool, or a new one if the pool is
+// empty.
+func (g *gojaVM) getRuntime() (*runtime, error) {
+	if rt, ok := g.pool.Get().(*runtime); ok {
+		return rt, nil
+	}
+	return g.newRuntime()
+}
+
+var errTimeout = errors.New("VM execution timed out")
+
+// Exec runs the user script using the CloudEvent as a parameter.
+func (g *gojaVM) Exec(event *cloudevents.Event) ([]*cloudevents.Event, error) {
+	b, err := vm.InputEvent(event)
+	if err != nil {
+		return nil, err
+	}
+
+	rt, err := g.getRuntime()
+	if err != nil {
+		return nil, err
+	}
+
+	var emitted []interface{}
+
+	if err := rt.Set("emit", emitFunc(&emitted)); err != nil {
+		return nil, fmt.Errorf("error injecting emit function: %w", err)
+	}
+
+	var st *vm.StateScope
+	if g.state != nil {
+		st = g.state.Scope(event)
+	}
+	if err := rt.Set("state", stateObject(rt, st)); err != nil {
+		return nil, fmt.Errorf("error injecting state object: %w", err)
+	}
+
+	timer := time.AfterFunc(g.timeout, func() {
+		rt.Interrupt(errTimeout)
+	})
+
+	res, err := g.run(rt, b)
+
+	// An interrupted runtime is discarded, since the interruption might
+	// not have been handled yet if the execution ended concurrently.
+	if timer.Stop() {
+		g.pool.Put(rt)
+	}
+
+	if err != nil {
+		var ierr *js.InterruptedError
+		if errors.As(err, &ierr) && ierr.Value() == errTimeout {
+			return nil, errTimeout
+		}
+		return nil, fmt.Errorf("error running JS script: %w", err)
+	}
+
+	// we expect res to be a response cloud event, an array of
+	// cloud events or nil.
+	return vm.OutputEvents(event, append(emitted, res)...)
+}
+
+// run invokes the handle function of the user script with the given
+// serialized event as input, and returns the exported result.
+func (g *gojaVM) run(rt *runtime, event []byte) (interface{}, error) {
+	input, err := rt.parse(js.Undefined(), rt.ToValue(string(event)))
+	if err != nil {
+		return nil, fmt.Errorf("parsing input event: %w", err)
+	}
+
+	res, err := rt.handle(js.Undefined(), input)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Export(), nil
+}
+
+// emitFunc returns a function that queues events to be produced
+// in addition to the value returned by the user script.
+func emitFunc(emitted *[]interface{}) func(js.FunctionCall) js.Value {
+	return func(call js.FunctionCall) js.Value {
+		for _, arg := range call.Arguments {
+			*emitted = append(*emitted, arg.Export())
+		}
+		return js.Undefined()
+	}
+}
+
+// stateObject returns an object that gives user scripts access to
+// the given state scope. The state is unavailable when st is nil.
+func stateObject(rt *runtime, st *vm.StateScope) *js.Object {
+	obj := rt.NewObject()
+
+	check := func() {
+		if st == nil {
+			panic(rt.NewGoError(errors.New("state store is not available")))
+		}
+	}
+
+	_ = obj.Set("get", func(call js.FunctionCall) js.Value {
+		check()
+		v, ok, err := st.Get(call.Argument(0).String())
+		if err != nil {
+			panic(rt.NewGoError(err))
+		}
+		if !ok {
+			return js.Undefined()
+		}
+		return rt.ToValue(v)
+	})
+
+	_ = obj.Set("set", func(call js.FunctionCall) js.Value {
+		check()
+		ttl := time.Duration(-1)
+		if arg := call.Argument(2); !js.IsUndefined(arg) {
+			ttl = time.Duration(arg.ToFloat() * float64(time.Second))
+		}
+		if err := st.Set(call.Argument(0).String(), call.Argument(1).Export(), ttl); err != nil {
+			panic(rt.NewGoError(err))
+		}
+		return js.Undefined()
+	})
+
+	_ = obj.Set("delete", func(call js.FunctionCall) js.Value {
+		check()
+		st.Delete(call.Argument(0).String())
+		return js.Undefined()
+	})
+
+	return obj
+}
+
+// printer logs messages printed using the console object.
+type printer struct {
+	logger *zap.SugaredLogger
+}
+
+var _ console.Printer = (*printer)(nil)
+
+// Log implements console.Printer.
+func (p printer) Log(s string) { p.logger.Info(s) }
+
+// Warn implements console.Printer.
+func (p printer) Warn(s string) { p.logger.Warn(s) }
+
+// Error implements console.Printer.
+func (p printer) Error(s string) { p.logger.Error(s) }
@@ -0,0 +1,262 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package goja
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+
+	logtesting "knative.dev/pkg/logging/testing"
+
+	"github.com/triggermesh/triggermesh/pkg/targets/adapter/infratarget/vm"
+)
+
+const (
+	tID        = "abc123"
+	tSource    = "test.source"
+	tType      = "test.type"
+	tInputData = `{"items":[{"name":"a","qty":1},{"name":"b","qty":2}]}`
+)
+
+func TestExec(t *testing.T) {
+	testCases := map[string]struct {
+		script      string
+		expectData  []string
+		expectTypes []string
+		expectErr   string
+	}{
+		"ES6 syntax": {
+			script: `
+			const handle = ({ data: { items } }) => {
+				const total = items.reduce((sum, { qty }) => sum + qty, 0);
+				return {
+					type: ` + "`${items.length}.items`" + `,
+					data: { total, names: items.map(i => i.name) },
+				};
+			};
+			`,
+			expectData:  []string{`{"names":["a","b"],"total":3}`},
+			expectTypes: []string{"2.items"},
+		},
+		"Native module": {
+			script: `
+			const util = require("util");
+
+			function handle(input) {
+				return { type: util.format("%s.response", input.type), data: {} };
+			}
+			`,
+			expectData:  []string{`{}`},
+			expectTypes: []string{tType + ".response"},
+		},
+		"One event per array item": {
+			script: `
+			function handle(input) {
+				return input.data.items.map(item => ({ type: "item", data: item }));
+			}
+			`,
+			expectData:  []string{`{"name":"a","qty":1}`, `{"name":"b","qty":2}`},
+			expectTypes: []string{"item", "item"},
+		},
+		"Emitted and returned events": {
+			script: `
+			function handle(input) {
+				for (const item of input.data.items) {
+					emit({ type: "item", data: item });
+				}
+				return { type: "summary", data: { count: input.data.items.length } };
+			}
+			`,
+			expectData:  []string{`{"name":"a","qty":1}`, `{"name":"b","qty":2}`, `{"count":2}`},
+			expectTypes: []string{"item", "item", "summary"},
+		},
+		"No event": {
+			script: `
+			function handle(input) {}
+			`,
+		},
+		"Runtime error": {
+			script: `
+			function handle(input) {
+				throw new Error("testing errors while running");
+			}
+			`,
+			expectErr: "testing errors while running",
+		},
+		"Module file": {
+			script: `
+			function handle(input) {
+				return require("./lib.js");
+			}
+			`,
+			expectErr: "Invalid module",
+		},
+		"Timeout": {
+			script: `
+			function handle(input) {
+				for (;;) {}
+			}
+			`,
+			expectErr: "VM execution timed out",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			v, err := New(tc.script, 100*time.Millisecond, nil, logtesting.TestLogger(t))
+			require.NoError(t, err)
+
+			out, err := v.Exec(newEvent())
+			if tc.expectErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectErr)
+				return
+			}
+			require.NoError(t, err)
+
+			var data, types []string
+			for _, e := range out {
+				data = append(data, string(e.Data()))
+				types = append(types, e.Type())
+			}
+			assert.Equal(t, tc.expectData, data)
+			assert.Equal(t, tc.expectTypes, types)
+		})
+	}
+}
+
+func TestNewErrors(t *testing.T) {
+	testCases := map[string]struct {
+		script    string
+		expectErr string
+	}{
+		"Syntax error": {
+			script: `
+			function handle(input) {
+				a { " ;
+			}
+			`,
+			expectErr: "script.js: Line 3",
+		},
+		"Missing handle function": {
+			script: `
+			function handleme(input) {}
+			`,
+			expectErr: "script does not implement handle(input) function",
+		},
+		"Wrong handle parameters": {
+			script: `
+			function handle() {}
+			`,
+			expectErr: "handle(input) function accepts exactly one parameter",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			_, err := New(tc.script, time.Second, nil, logtesting.TestLogger(t))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.expectErr)
+		})
+	}
+}
+
+func TestState(t *testing.T) {
+	const script = `
+	function handle(input) {
+		const seen = state.get("seen") ?? [];
+		seen.push(input.id);
+		state.set("seen", seen, 60);
+
+		if (seen.length > 1) {
+			state.delete("seen");
+		}
+
+		return { type: "seen", data: seen };
+	}
+	`
+
+	v, err := New(script, time.Second, vm.NewStateStore("", 0), logtesting.TestLogger(t))
+	require.NoError(t, err)
+
+	for _, expect := range []string{
+		`["` + tID + `"]`,
+		`["` + tID + `","` + tID + `"]`,
+		`["` + tID + `"]`,
+	} {
+		out, err := v.Exec(newEvent())
+		require.NoError(t, err)
+		require.Len(t, out, 1)
+		assert.Equal(t, expect, string(out[0].Data()))
+	}
+
+	t.Run("state not available", func(t *testing.T) {
+		v, err := New(script, time.Second, nil, logtesting.TestLogger(t))
+		require.NoError(t, err)
+
+		_, err = v.Exec(newEvent())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "state store is not available")
+	})
+}
+
+func TestConsole(t *testing.T) {
+	const script = `
+	function handle(input) {
+		console.log("processing", input.id);
+		console.warn("warning");
+		log("using log");
+	}
+	`
+
+	core, logs := observer.New(zap.InfoLevel)
+
+	v, err := New(script, time.Second, nil, zap.New(core).Sugar())
+	require.NoError(t, err)
+
+	_, err = v.Exec(newEvent())
+	require.NoError(t, err)
+
+	var msgs []string
+	for _, l := range logs.All() {
+		msgs = append(msgs, l.Level.String()+": "+l.Message)
+	}
+	assert.Equal(t, []string{
+		"info: processing " + tID,
+		"warn: warning",
+		"info: using log",
+	}, msgs)
+}
+
+func newEvent() *cloudevents.Event {
+	e := cloudevents.NewEvent()
+	e.SetID(tID)
+	e.SetSource(tSource)
+	e.SetType(tType)
+	e.SetDataContentType(cloudevents.ApplicationJSON)
+	e.DataEncoded = []byte(tInputData)
+	return &e
+}
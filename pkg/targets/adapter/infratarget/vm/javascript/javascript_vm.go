@@ -25,7 +25,6 @@ import (
 	"sync"
 	"time"
 
-	"github.com/clbanning/mxj"
 	cloudevents "github.com/cloudevents/sdk-go/v2"
 	"github.com/robertkrimen/otto"
 	"go.uber.org/zap"
@@ -37,10 +36,11 @@ type javascriptVM struct {
 	pool     *sync.Pool
 	timeout  time.Duration
 	template string
+	state    *vm.StateStore
 }
 
 // New creates a new VM that can run a scoped virtual machine
-func New(script string, timeout time.Duration, logger *zap.SugaredLogger) vm.InfraVM {
+func New(script string, timeout time.Duration, state *vm.StateStore, logger *zap.SugaredLogger) vm.InfraVM {
 	// escaping to avoid issues with go formatting
 	script = strings.ReplaceAll(script, "%", "%%")
 	template := script + `
@@ -85,86 +85,62 @@ func New(script string, timeout time.Duration, logger *zap.SugaredLogger) vm.Inf
 		pool:     &pool,
 		timeout:  timeout,
 		template: template,
+		state:    state,
 	}
 }
 
 // Exec runs the embedded user script using the CloudEvent as a parameter.
-func (j *javascriptVM) Exec(event *cloudevents.Event) (*cloudevents.Event, error) {
-
-	if dmt := event.DataMediaType(); dmt == "application/xml" || dmt == "text/xml" {
-		xml, err := mxj.NewMapXml(event.Data())
-		if err != nil {
-			return nil, fmt.Errorf("error parsing XML event data contents: %w", err)
-		}
-		if err = event.SetData(cloudevents.ApplicationJSON, xml.Old()); err != nil {
-			return nil, fmt.Errorf("error setting JSON event data converted from XML: %w", err)
-		}
-	}
-
-	b, err := event.MarshalJSON()
+func (j *javascriptVM) Exec(event *cloudevents.Event) ([]*cloudevents.Event, error) {
+	b, err := vm.InputEvent(event)
 	if err != nil {
-		return nil, fmt.Errorf("error serializing event: %w", err)
+		return nil, err
 	}
 
 	script := fmt.Sprintf(j.template, b)
 
-	jsres, err := j.timeScopedExec(script)
-	if err != nil {
-		return nil, fmt.Errorf("error running JS script: %v", err)
-	}
-
-	res, err := jsres.Export()
-	if err != nil {
-		return nil, fmt.Errorf("error retrieving result: %v", err)
-	}
+	var emitted []interface{}
 
-	// we expect res to be a response cloud event or nil.
-	switch t := res.(type) {
-	case map[string]interface{}:
-		// defaulting missing fields, we do not check CloudEvent type
-		// because it is most probably used at filters and defaulting
-		// to the incoming one might end up in a loop.
-		if _, ok := t["specversion"]; !ok {
-			t["specversion"] = event.SpecVersion()
-		}
-		if _, ok := t["source"]; !ok {
-			t["source"] = event.Source()
-		}
-		if _, ok := t["id"]; !ok {
-			t["id"] = event.ID()
-		}
-		if _, ok := t["datacontenttype"]; !ok {
-			t["datacontenttype"] = cloudevents.ApplicationJSON
+	bind := func(o *otto.Otto) error {
+		if err := o.Set("emit", emitFunc(&emitted)); err != nil {
+			return fmt.Errorf("error injecting emit function: %w", err)
 		}
 
-		b, err := json.Marshal(t)
-		if err != nil {
-			return nil, fmt.Errorf("error serializing exec response: %w", err)
+		var st *vm.StateScope
+		if j.state != nil {
+			st = j.state.Scope(event)
 		}
-
-		event := &cloudevents.Event{}
-		err = event.UnmarshalJSON(b)
-		if err != nil {
-			return nil, fmt.Errorf("error unmarshaling exec response into event: %w", err)
+		if err := o.Set("state", stateObject(o, st)); err != nil {
+			return fmt.Errorf("error injecting state object: %w", err)
 		}
-		return event, nil
+		return nil
+	}
 
-	case nil:
-		return nil, nil
+	jsres, err := j.timeScopedExec(script, bind)
+	if err != nil {
+		return nil, fmt.Errorf("error running JS script: %v", err)
+	}
 
-	default:
-		err = fmt.Errorf("unexpected execution result type %T: %v", t, t)
+	res, err := jsres.Export()
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving result: %v", err)
 	}
 
-	return nil, err
+	// we expect res to be a response cloud event, an array of
+	// cloud events or nil.
+	return vm.OutputEvents(event, append(emitted, res)...)
 }
 
 var errTimeout = errors.New("VM execution timed out")
 
 // timeScopedExec runs the script in an scoped time window
-func (j *javascriptVM) timeScopedExec(script string) (res *otto.Value, err error) {
+func (j *javascriptVM) timeScopedExec(script string, bind func(*otto.Otto) error) (res *otto.Value, err error) {
 	ovm := j.pool.Get().(*otto.Otto)
 
+	if err := bind(ovm); err != nil {
+		j.pool.Put(ovm)
+		return nil, err
+	}
+
 	ctx, cancel := context.WithTimeout(context.Background(), j.timeout)
 	defer cancel()
 
@@ -209,3 +185,78 @@ func vmLogger(logger *zap.SugaredLogger) func(string) {
 		logger.Info(msg)
 	}
 }
+
+// emitFunc returns a function that queues events to be produced
+// in addition to the value returned by the user script.
+func emitFunc(emitted *[]interface{}) func(otto.FunctionCall) otto.Value {
+	return func(call otto.FunctionCall) otto.Value {
+		for _, arg := range call.ArgumentList {
+			v, err := arg.Export()
+			if err != nil {
+				panic(call.Otto.MakeCustomError("EmitError", err.Error()))
+			}
+			*emitted = append(*emitted, v)
+		}
+		return otto.UndefinedValue()
+	}
+}
+
+// stateObject returns an object that gives user scripts access to
+// the given state scope. The state is unavailable when st is nil.
+func stateObject(o *otto.Otto, st *vm.StateScope) *otto.Object {
+	obj, _ := o.Object(`({})`)
+
+	check := func(call otto.FunctionCall) {
+		if st == nil {
+			panic(call.Otto.MakeCustomError("StateError", "state store is not available"))
+		}
+	}
+
+	_ = obj.Set("get", func(call otto.FunctionCall) otto.Value {
+		check(call)
+		v, ok, err := st.Get(call.Argument(0).String())
+		if err != nil {
+			panic(call.Otto.MakeCustomError("StateError", err.Error()))
+		}
+		if !ok {
+			return otto.UndefinedValue()
+		}
+		b, err := json.Marshal(v)
+		if err != nil {
+			panic(call.Otto.MakeCustomError("StateError", err.Error()))
+		}
+		res, err := call.Otto.Call("JSON.parse", nil, string(b))
+		if err != nil {
+			panic(err)
+		}
+		return res
+	})
+
+	_ = obj.Set("set", func(call otto.FunctionCall) otto.Value {
+		check(call)
+		v, err := call.Argument(1).Export()
+		if err != nil {
+			panic(call.Otto.MakeCustomError("StateError", err.Error()))
+		}
+		ttl := time.Duration(-1)
+		if arg := call.Argument(2); arg.IsDefined() {
+			secs, err := arg.ToFloat()
+			if err != nil {
+				panic(call.Otto.MakeCustomError("StateError", err.Error()))
+			}
+			ttl = time.Duration(secs * float64(time.Second))
+		}
+		if err := st.Set(call.Argument(0).String(), v, ttl); err != nil {
+			panic(call.Otto.MakeCustomError("StateError", err.Error()))
+		}
+		return otto.UndefinedValue()
+	})
+
+	_ = obj.Set("delete", func(call otto.FunctionCall) otto.Value {
+		check(call)
+		st.Delete(call.Argument(0).String())
+		return otto.UndefinedValue()
+	})
+
+	return obj
+}
@@ -0,0 +1,213 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vm
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+)
+
+// BridgeExtension is the CloudEvent extension which identifies the workflow
+// (bridge) an event belongs to.
+const BridgeExtension = "statefulbridge"
+
+// sweepInterval is the minimum interval between two removals of expired keys
+// from the store.
+const sweepInterval = time.Minute
+
+// StateStore is an in-memory key/value store which allows user scripts to
+// persist values between events.
+// Keys are scoped by the identifier of the bridge the processed events belong
+// to, and can be given a time-to-live after which they expire.
+//
+// The store is local to the adapter instance, its contents are not shared
+// across replicas and do not survive restarts.
+type StateStore struct {
+	defaultBridge string
+	defaultTTL    time.Duration
+
+	mu        sync.Mutex
+	bridges   map[string]map[string]stateEntry
+	lastSweep time.Time
+
+	// allows overriding the clock in tests
+	now func() time.Time
+}
+
+// stateEntry is a value stored in the StateStore.
+type stateEntry struct {
+	// JSON representation of the value
+	value []byte
+	// zero if the entry never expires
+	expires time.Time
+}
+
+// NewStateStore returns a StateStore which scopes keys by the given bridge
+// identifier when processed events do not carry any, and expires keys after
+// the given TTL unless a different TTL is set when writing them.
+// A TTL of zero disables expiration.
+func NewStateStore(defaultBridge string, defaultTTL time.Duration) *StateStore {
+	return &StateStore{
+		defaultBridge: defaultBridge,
+		defaultTTL:    defaultTTL,
+		bridges:       make(map[string]map[string]stateEntry),
+		now:           time.Now,
+	}
+}
+
+// Scope returns a view of the store which is scoped by the bridge of the
+// given event.
+func (s *StateStore) Scope(e *cloudevents.Event) *StateScope {
+	bridge := s.defaultBridge
+	if v, ok := e.Extensions()[BridgeExtension]; ok {
+		if b, ok := v.(string); ok && b != "" {
+			bridge = b
+		}
+	}
+
+	return &StateScope{
+		store:  s,
+		bridge: bridge,
+	}
+}
+
+// StateScope gives access to the keys of a single bridge in a StateStore.
+type StateScope struct {
+	store  *StateStore
+	bridge string
+}
+
+// Get returns the value of the given key. The returned boolean is false if
+// the key does not exist or has expired.
+func (s *StateScope) Get(key string) (interface{}, bool, error) {
+	b, ok := s.store.get(s.bridge, key)
+	if !ok {
+		return nil, false, nil
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return nil, false, fmt.Errorf("deserializing value of key %q: %w", key, err)
+	}
+	return v, true, nil
+}
+
+// Set writes the value of the given key. The key expires after the given
+// TTL, or after the store's default TTL if the given TTL is negative.
+// A TTL of zero disables expiration.
+func (s *StateScope) Set(key string, value interface{}, ttl time.Duration) error {
+	b, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("serializing value of key %q: %w", key, err)
+	}
+
+	s.store.set(s.bridge, key, b, ttl)
+	return nil
+}
+
+// Delete removes the given key.
+func (s *StateScope) Delete(key string) {
+	s.store.delete(s.bridge, key)
+}
+
+func (s *StateStore) get(bridge, key string) ([]byte, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, ok := s.bridges[bridge][key]
+	if !ok {
+		return nil, false
+	}
+
+	if e.expired(s.now()) {
+		s.deleteLocked(bridge, key)
+		return nil, false
+	}
+
+	return e.value, true
+}
+
+func (s *StateStore) set(bridge, key string, value []byte, ttl time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	s.sweepLocked(now)
+
+	if ttl < 0 {
+		ttl = s.defaultTTL
+	}
+
+	e := stateEntry{value: value}
+	if ttl > 0 {
+		e.expires = now.Add(ttl)
+	}
+
+	keys, ok := s.bridges[bridge]
+	if !ok {
+		keys = make(map[string]stateEntry)
+		s.bridges[bridge] = keys
+	}
+	keys[key] = e
+}
+
+func (s *StateStore) delete(bridge, key string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.deleteLocked(bridge, key)
+}
+
+// deleteLocked removes a key from the store, and the bridge itself if it
+// doesn't contain any key anymore. Callers must hold the lock.
+func (s *StateStore) deleteLocked(bridge, key string) {
+	keys, ok := s.bridges[bridge]
+	if !ok {
+		return
+	}
+
+	delete(keys, key)
+	if len(keys) == 0 {
+		delete(s.bridges, bridge)
+	}
+}
+
+// sweepLocked removes expired keys from the store, at most once per
+// sweepInterval. Callers must hold the lock.
+func (s *StateStore) sweepLocked(now time.Time) {
+	if now.Sub(s.lastSweep) < sweepInterval {
+		return
+	}
+	s.lastSweep = now
+
+	for bridge, keys := range s.bridges {
+		for key, e := range keys {
+			if e.expired(now) {
+				s.deleteLocked(bridge, key)
+			}
+		}
+	}
+}
+
+// expired returns whether the entry is expired at the given time.
+func (e stateEntry) expired(t time.Time) bool {
+	return !e.expires.IsZero() && !t.Before(e.expires)
+}
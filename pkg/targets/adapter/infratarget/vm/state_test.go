@@ -0,0 +1,92 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vm
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+)
+
+func TestStateScope(t *testing.T) {
+	s := NewStateStore("default-bridge", 0)
+
+	noBridge := cloudevents.NewEvent()
+	defaultBridge := cloudevents.NewEvent()
+	defaultBridge.SetExtension(BridgeExtension, "default-bridge")
+	otherBridge := cloudevents.NewEvent()
+	otherBridge.SetExtension(BridgeExtension, "other-bridge")
+
+	require.NoError(t, s.Scope(&noBridge).Set("key", map[string]interface{}{"n": 1}, -1))
+
+	v, ok, err := s.Scope(&defaultBridge).Get("key")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, map[string]interface{}{"n": 1.0}, v)
+
+	_, ok, err = s.Scope(&otherBridge).Get("key")
+	require.NoError(t, err)
+	assert.False(t, ok, "Key should not be visible from another bridge")
+
+	s.Scope(&defaultBridge).Delete("key")
+
+	_, ok, err = s.Scope(&noBridge).Get("key")
+	require.NoError(t, err)
+	assert.False(t, ok, "Key should have been deleted")
+	assert.Empty(t, s.bridges, "Empty bridges should be removed")
+}
+
+func TestStateExpiration(t *testing.T) {
+	const defaultTTL = 10 * time.Second
+
+	now := time.Date(2022, time.June, 1, 12, 0, 0, 0, time.UTC)
+
+	s := NewStateStore("", defaultTTL)
+	s.now = func() time.Time { return now }
+
+	e := cloudevents.NewEvent()
+	st := s.Scope(&e)
+
+	require.NoError(t, st.Set("default", "v", -1))
+	require.NoError(t, st.Set("short", "v", time.Second))
+	require.NoError(t, st.Set("never", "v", 0))
+
+	exists := func(key string) bool {
+		_, ok, err := st.Get(key)
+		require.NoError(t, err)
+		return ok
+	}
+
+	now = now.Add(time.Second)
+	assert.True(t, exists("default"))
+	assert.False(t, exists("short"))
+	assert.True(t, exists("never"))
+
+	now = now.Add(defaultTTL)
+	assert.False(t, exists("default"))
+	assert.True(t, exists("never"))
+
+	// expired keys which are never read again get swept on write
+	require.NoError(t, st.Set("short", "v", time.Second))
+	now = now.Add(sweepInterval)
+	require.NoError(t, st.Set("other", "v", 0))
+	assert.Len(t, s.bridges[""], 2)
+}
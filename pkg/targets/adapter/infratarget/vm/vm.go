@@ -39,5 +39,7 @@ type Param struct {
 // InfraVM is an abstraction of the virtual machine
 // that executes user scripts on events.
 type InfraVM interface {
-	Exec(*cloudevents.Event) (*cloudevents.Event, error)
+	// Exec runs the user script using the given CloudEvent as input, and
+	// returns the CloudEvents it produced, if any.
+	Exec(*cloudevents.Event) ([]*cloudevents.Event, error)
 }
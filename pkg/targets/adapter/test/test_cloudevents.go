@@ -51,6 +51,8 @@ func (c *FakeCloudEventsClient) Send(ctx context.Context, out event.Event) proto
 		return protocol.ResultACK
 	case func(event cloudevents.Event) protocol.Result:
 		return fn(out)
+	case func(ctx context.Context, event cloudevents.Event) protocol.Result:
+		return fn(ctx, out)
 	}
 
 	return http.NewResult(200, "%w", res)
@@ -31,6 +31,14 @@ import (
 	"github.com/triggermesh/triggermesh/pkg/reconciler/resource"
 )
 
+const (
+	envBatchFormat        = "AWS_S3_BATCH_FORMAT"
+	envBatchMaxEvents     = "AWS_S3_BATCH_MAX_EVENTS"
+	envBatchMaxBytes      = "AWS_S3_BATCH_MAX_BYTES"
+	envBatchFlushInterval = "AWS_S3_BATCH_FLUSH_INTERVAL"
+	envBatchKeyPrefix     = "AWS_S3_BATCH_KEY_PREFIX"
+)
+
 // adapterConfig contains properties used to configure the target's adapter.
 // Public fields are automatically populated by envconfig.
 type adapterConfig struct {
@@ -75,5 +83,50 @@ func makeAppEnv(o *v1alpha1.AWSS3Target) []corev1.EnvVar {
 		},
 	}
 
+	envs = append(envs, makeBatchingEnvVars(o.Spec.Batching)...)
+
+	return envs
+}
+
+// makeBatchingEnvVars returns environment variables which control the
+// buffering of events into batched objects.
+func makeBatchingEnvVars(b *v1alpha1.AWSS3TargetBatching) []corev1.EnvVar {
+	if b == nil {
+		return nil
+	}
+
+	envs := []corev1.EnvVar{{
+		Name:  envBatchFormat,
+		Value: string(b.Format),
+	}}
+
+	if n := b.MaxEvents; n != nil {
+		envs = append(envs, corev1.EnvVar{
+			Name:  envBatchMaxEvents,
+			Value: strconv.FormatInt(int64(*n), 10),
+		})
+	}
+
+	if n := b.MaxBytes; n != nil {
+		envs = append(envs, corev1.EnvVar{
+			Name:  envBatchMaxBytes,
+			Value: strconv.FormatInt(*n, 10),
+		})
+	}
+
+	if itv := b.FlushInterval; itv != nil {
+		envs = append(envs, corev1.EnvVar{
+			Name:  envBatchFlushInterval,
+			Value: itv.String(),
+		})
+	}
+
+	if p := b.KeyPrefix; p != nil {
+		envs = append(envs, corev1.EnvVar{
+			Name:  envBatchKeyPrefix,
+			Value: *p,
+		})
+	}
+
 	return envs
 }
@@ -32,6 +32,14 @@ import (
 	"github.com/triggermesh/triggermesh/pkg/reconciler/resource"
 )
 
+const (
+	envContentMode         = "CONFLUENT_CONTENT_MODE"
+	envMessageKeyAttribute = "CONFLUENT_MESSAGE_KEY_ATTRIBUTE"
+	envMessageKeyDataPath  = "CONFLUENT_MESSAGE_KEY_DATA_PATH"
+	envPartitioner         = "CONFLUENT_PARTITIONER"
+	envAsyncDelivery       = "CONFLUENT_ASYNC_DELIVERY"
+)
+
 // adapterConfig contains properties used to configure the target's adapter.
 // Public fields are automatically populated by envconfig.
 type adapterConfig struct {
@@ -99,5 +107,41 @@ func makeAppEnv(o *v1alpha1.ConfluentTarget) []corev1.EnvVar {
 		})
 	}
 
+	if m := o.Spec.ContentMode; m != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  envContentMode,
+			Value: string(*m),
+		})
+	}
+
+	if k := o.Spec.MessageKey; k != nil {
+		if k.Attribute != nil {
+			env = append(env, corev1.EnvVar{
+				Name:  envMessageKeyAttribute,
+				Value: *k.Attribute,
+			})
+		}
+		if k.DataPath != nil {
+			env = append(env, corev1.EnvVar{
+				Name:  envMessageKeyDataPath,
+				Value: *k.DataPath,
+			})
+		}
+	}
+
+	if p := o.Spec.Partitioner; p != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  envPartitioner,
+			Value: *p,
+		})
+	}
+
+	if a := o.Spec.AsyncDelivery; a != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  envAsyncDelivery,
+			Value: strconv.FormatBool(*a),
+		})
+	}
+
 	return env
 }
@@ -32,7 +32,15 @@ import (
 	"github.com/triggermesh/triggermesh/pkg/reconciler/resource"
 )
 
-const envEventsPayloadPolicy = "EVENTS_PAYLOAD_POLICY"
+const (
+	envEventsPayloadPolicy = "EVENTS_PAYLOAD_POLICY"
+
+	envDocumentIDPath    = "ELASTICSEARCH_DOCUMENT_ID_PATH"
+	envPipeline          = "ELASTICSEARCH_PIPELINE"
+	envBulkMaxActions    = "ELASTICSEARCH_BULK_MAX_ACTIONS"
+	envBulkMaxBytes      = "ELASTICSEARCH_BULK_MAX_BYTES"
+	envBulkFlushInterval = "ELASTICSEARCH_BULK_FLUSH_INTERVAL"
+)
 
 // adapterConfig contains properties used to configure the target's adapter.
 // Public fields are automatically populated by envconfig.
@@ -113,6 +121,22 @@ func makeAppEnv(o *v1alpha1.ElasticsearchTarget) []corev1.EnvVar {
 		})
 	}
 
+	if p := o.Spec.DocumentIDPath; p != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  envDocumentIDPath,
+			Value: *p,
+		})
+	}
+
+	if p := o.Spec.Pipeline; p != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  envPipeline,
+			Value: *p,
+		})
+	}
+
+	env = append(env, makeBulkEnvVars(o.Spec.Bulk)...)
+
 	if o.Spec.EventOptions != nil && o.Spec.EventOptions.PayloadPolicy != nil {
 		env = append(env, corev1.EnvVar{
 			Name:  envEventsPayloadPolicy,
@@ -122,3 +146,36 @@ func makeAppEnv(o *v1alpha1.ElasticsearchTarget) []corev1.EnvVar {
 
 	return env
 }
+
+// makeBulkEnvVars returns environment variables which control the
+// thresholds of requests sent to the Bulk API.
+func makeBulkEnvVars(b *v1alpha1.ElasticsearchTargetBulk) []corev1.EnvVar {
+	if b == nil {
+		return nil
+	}
+
+	var env []corev1.EnvVar
+
+	if n := b.MaxActions; n != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  envBulkMaxActions,
+			Value: strconv.FormatInt(int64(*n), 10),
+		})
+	}
+
+	if n := b.MaxBytes; n != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  envBulkMaxBytes,
+			Value: strconv.FormatInt(*n, 10),
+		})
+	}
+
+	if itv := b.FlushInterval; itv != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  envBulkFlushInterval,
+			Value: itv.String(),
+		})
+	}
+
+	return env
+}
@@ -34,8 +34,10 @@ import (
 const (
 	envInfraScriptCode         = "INFRA_SCRIPT_CODE"
 	envInfraScriptTimeout      = "INFRA_SCRIPT_TIMEOUT"
+	envInfraScriptEngine       = "INFRA_SCRIPT_ENGINE"
 	envInfraStateHeadersPolicy = "INFRA_STATE_HEADERS_POLICY"
 	envInfraStateBridge        = "INFRA_STATE_BRIDGE"
+	envInfraStateTTL           = "INFRA_STATE_TTL"
 	envInfraTypeLoopProtection = "INFRA_TYPE_LOOP_PROTECTION"
 )
 
@@ -52,10 +54,10 @@ type adapterConfig struct {
 var _ common.AdapterBuilder[*servingv1.Service] = (*Reconciler)(nil)
 
 // BuildAdapter implements common.AdapterBuilder.
-func (r *Reconciler) BuildAdapter(trg commonv1alpha1.Reconcilable, _ *apis.URL) (*servingv1.Service, error) {
+func (r *Reconciler) BuildAdapter(trg commonv1alpha1.Reconcilable, sinkURI *apis.URL) (*servingv1.Service, error) {
 	typedTrg := trg.(*v1alpha1.InfraTarget)
 
-	return common.NewAdapterKnService(trg, nil,
+	return common.NewAdapterKnService(trg, sinkURI,
 		resource.Image(r.adapterCfg.Image),
 		resource.EnvVars(makeAppEnv(typedTrg)...),
 		resource.EnvVars(r.adapterCfg.obsConfig.ToEnvVars()...),
@@ -77,6 +79,13 @@ func makeAppEnv(o *v1alpha1.InfraTarget) []corev1.EnvVar {
 				Value: strconv.Itoa(*o.Spec.Script.Timeout),
 			})
 		}
+
+		if o.Spec.Script.Engine != nil {
+			env = append(env, corev1.EnvVar{
+				Name:  envInfraScriptEngine,
+				Value: string(*o.Spec.Script.Engine),
+			})
+		}
 	}
 
 	if o.Spec.State != nil {
@@ -94,6 +103,13 @@ func makeAppEnv(o *v1alpha1.InfraTarget) []corev1.EnvVar {
 				Value: *o.Spec.State.Bridge,
 			})
 		}
+
+		if o.Spec.State.TTL != nil {
+			env = append(env, corev1.EnvVar{
+				Name:  envInfraStateTTL,
+				Value: o.Spec.State.TTL.String(),
+			})
+		}
 	}
 
 	if o.Spec.TypeLoopProtection != nil {